@@ -54,7 +54,6 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
-	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
 	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
@@ -67,6 +66,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
@@ -109,11 +109,14 @@ import (
 	custombankkeeper "github.com/gridiron-zone/gridiron/x/bank/keeper"
 	custombanktypes "github.com/gridiron-zone/gridiron/x/bank/types"
 	"github.com/gridiron-zone/gridiron/x/erc20"
+	erc20client "github.com/gridiron-zone/gridiron/x/erc20/client"
 	erc20keeper "github.com/gridiron-zone/gridiron/x/erc20/keeper"
 	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
 	"github.com/gridiron-zone/gridiron/x/gauge"
 	gaugekeeper "github.com/gridiron-zone/gridiron/x/gauge/keeper"
 	gaugetypes "github.com/gridiron-zone/gridiron/x/gauge/types"
+	customgov "github.com/gridiron-zone/gridiron/x/gov"
+	customgovkeeper "github.com/gridiron-zone/gridiron/x/gov/keeper"
 	"github.com/gridiron-zone/gridiron/x/maker"
 	makerclient "github.com/gridiron-zone/gridiron/x/maker/client"
 	makerkeeper "github.com/gridiron-zone/gridiron/x/maker/keeper"
@@ -172,6 +175,11 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		makerclient.BatchSetBackingProposalHandler,
 		makerclient.BatchSetCollateralProposalHandler,
 		oracleclient.RegisterTargetProposalHandler,
+		oracleclient.SetTargetVotingParamsProposalHandler,
+		erc20client.RegisterCoinProposalHandler,
+		erc20client.RegisterERC20ProposalHandler,
+		erc20client.ToggleTokenConversionProposalHandler,
+		erc20client.DeleteTokenPairProposalHandler,
 	)
 
 	return govProposalHandlers
@@ -187,7 +195,7 @@ var (
 	ModuleBasics = module.NewBasicManager(
 		auth.AppModuleBasic{},
 		genutil.AppModuleBasic{},
-		bank.AppModuleBasic{},
+		custombank.AppModuleBasic{},
 		capability.AppModuleBasic{},
 		customstaking.AppModuleBasic{},
 		distr.AppModuleBasic{},
@@ -232,6 +240,7 @@ var (
 		vetypes.DistributionPoolName:   nil,
 		gaugetypes.ModuleName:          nil,
 		votertypes.ModuleName:          nil,
+		votertypes.BribePoolName:       nil,
 		customvestingtypes.ModuleName:  {authtypes.Minter},
 		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		mgravitytypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
@@ -270,6 +279,7 @@ type Gridiron struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -285,7 +295,7 @@ type Gridiron struct {
 	StakingKeeper    customstakingkeeper.Keeper
 	SlashingKeeper   slashingkeeper.Keeper
 	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
+	GovKeeper        customgovkeeper.Keeper
 	CrisisKeeper     crisiskeeper.Keeper
 	UpgradeKeeper    upgradekeeper.Keeper
 	ParamsKeeper     paramskeeper.Keeper
@@ -320,6 +330,9 @@ type Gridiron struct {
 	// mm is the module manager
 	mm *module.Manager
 
+	// configurator registers the module services and store migrations
+	configurator module.Configurator
+
 	// sm is the simulation manager
 	sm *module.SimulationManager
 
@@ -373,6 +386,7 @@ func NewGridiron(
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -412,7 +426,10 @@ func NewGridiron(
 	nftKeeper := nftkeeper.NewKeeper(keys[nfttypes.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
 	app.NftKeeper = vekeeper.NewNftKeeper(nftKeeper, getVeKeeper)
 
-	app.VeKeeper = *vekeeper.NewKeeper(appCodec, keys[vetypes.StoreKey], keys[vetypes.MemStoreKey], app.GetSubspace(vetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper)
+	getVeErc20Keeper := func() vetypes.Erc20Keeper {
+		return erc20Keeper
+	}
+	app.VeKeeper = *vekeeper.NewKeeper(appCodec, keys[vetypes.StoreKey], keys[vetypes.MemStoreKey], app.GetSubspace(vetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, getVeErc20Keeper)
 	veKeeper = app.VeKeeper
 
 	stakingKeeper := customstakingkeeper.NewKeeper(
@@ -435,8 +452,11 @@ func NewGridiron(
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	// NOTE: the ve staking hooks must precede the distribution hooks
+	stakingKeeper.SetDistrKeeper(app.DistrKeeper)
 	app.StakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
+			stakingKeeper.Hooks(),
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
 			app.GravityKeeper.Hooks(),
@@ -450,15 +470,6 @@ func NewGridiron(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
-	// Create Transfer Keepers
-	app.TransferKeeper = ibctransferkeeper.NewKeeper(
-		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
-	)
-	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
-
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -485,9 +496,26 @@ func NewGridiron(
 		app.BankKeeper,
 		app.EvmKeeper,
 	)
+	app.Erc20Keeper.SetICS4Wrapper(app.IBCKeeper.ChannelKeeper)
 	erc20Keeper = app.Erc20Keeper
 	erc20Module := erc20.NewAppModule(appCodec, app.Erc20Keeper, app.AccountKeeper, app.BankKeeper)
 
+	// Create Transfer Keepers, where the erc20 keeper wraps the channel keeper
+	// to account the ERC20-native tokens escrowed for IBC transfers
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		app.Erc20Keeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
+	)
+	transferModule := transfer.NewAppModule(app.TransferKeeper)
+
+	// transfer stack contains (from top to bottom):
+	// - erc20 middleware
+	// - transfer
+	var transferStack ibcporttypes.IBCModule
+	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+
 	app.OracleKeeper = *oraclekeeper.NewKeeper(
 		appCodec,
 		keys[oracletypes.StoreKey],
@@ -496,6 +524,7 @@ func NewGridiron(
 		app.BankKeeper,
 		app.DistrKeeper,
 		app.StakingKeeper,
+		app.Erc20Keeper,
 		distrtypes.ModuleName,
 	)
 	oracleModule := oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper)
@@ -512,16 +541,18 @@ func NewGridiron(
 	)
 	makerModule := maker.NewAppModule(appCodec, app.MakerKeeper, app.AccountKeeper, app.BankKeeper)
 
+	distrModule := customstakingkeeper.NewDistrAppModule(distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper), app.DistrKeeper, app.StakingKeeper)
+
 	nftModule := vekeeper.NewNftAppModule(nft.NewAppModule(appCodec, nftKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry), app.NftKeeper)
 
 	veModule := ve.NewAppModule(appCodec, app.VeKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.GaugeKeeper = *gaugekeeper.NewKeeper(appCodec, keys[gaugetypes.StoreKey], keys[gaugetypes.MemStoreKey],
-		app.GetSubspace(gaugetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, app.VeKeeper)
+		app.GetSubspace(gaugetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, app.VeKeeper, app.Erc20Keeper)
 	gaugeModule := gauge.NewAppModule(appCodec, app.GaugeKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.VoterKeeper = *voterkeeper.NewKeeper(appCodec, keys[votertypes.StoreKey], keys[votertypes.MemStoreKey],
-		app.GetSubspace(votertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.VeKeeper, app.GaugeKeeper)
+		app.GetSubspace(votertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, app.VeKeeper, app.GaugeKeeper)
 	voterModule := voter.NewAppModule(appCodec, app.VoterKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.VestingKeeper = *customvestingkeeper.NewKeeper(appCodec, keys[customvestingtypes.StoreKey], app.GetSubspace(customvestingtypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.VeKeeper, authtypes.FeeCollectorName)
@@ -530,6 +561,7 @@ func NewGridiron(
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
 			app.Erc20Keeper.EvmHooks(),
+			app.NftKeeper.EvmHooks(),
 		),
 	)
 
@@ -568,17 +600,18 @@ func NewGridiron(
 		AddRoute(makertypes.RouterKey, maker.NewMakerProposalHandler(app.MakerKeeper)).
 		AddRoute(oracletypes.RouterKey, oracle.NewOracleProposalHandler(app.OracleKeeper)).
 		AddRoute(banktypes.RouterKey, custombank.NewBankProposalHandler(app.BankKeeper)).
+		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(app.Erc20Keeper)).
 		AddRoute(mgravitytypes.RouterKey, mgravitykeeper.NewGravityProposalHandler(app.GravityKeeper)).
 		AddRoute(bech32ibctypes.RouterKey, bech32ibc.NewBech32IBCProposalHandler(app.Bech32IbcKeeper))
 
-	app.GovKeeper = govkeeper.NewKeeper(
+	app.GovKeeper = customgovkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
+		&stakingKeeper, app.VeKeeper, govRouter,
 	)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	/****  Module Options ****/
@@ -600,9 +633,9 @@ func NewGridiron(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		customgov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		distrModule,
 		customstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
@@ -726,7 +759,8 @@ func NewGridiron(
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	app.sm = module.NewSimulationManager(
@@ -734,7 +768,7 @@ func NewGridiron(
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		customgov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		customstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -786,6 +820,8 @@ func NewGridiron(
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.setUpgradeHandlers()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -923,6 +959,28 @@ func (app *Gridiron) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// IBC Go TestingApp functions
+
+// GetStakingKeeper implements the TestingApp interface.
+func (app *Gridiron) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper.Keeper
+}
+
+// GetIBCKeeper implements the TestingApp interface.
+func (app *Gridiron) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper implements the TestingApp interface.
+func (app *Gridiron) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig implements the TestingApp interface.
+func (app *Gridiron) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (app *Gridiron) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
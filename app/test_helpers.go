@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
+	customvestingtypes "github.com/gridiron-zone/gridiron/x/vesting/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
@@ -24,6 +25,12 @@ func Setup(isCheckTx bool) *Gridiron {
 	if !isCheckTx {
 		genesisState := NewDefaultGenesisState()
 
+		// The strategic reserve cannot be allocated to the empty custodian
+		// address of the default genesis, which breaks the bank invariants
+		vestingGenesis := customvestingtypes.DefaultGenesis()
+		vestingGenesis.AllocationAddresses.StrategicReserveCustodianAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+		genesisState[customvestingtypes.ModuleName] = app.(*Gridiron).AppCodec().MustMarshalJSON(vestingGenesis)
+
 		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 		if err != nil {
 			panic(err)
@@ -0,0 +1,19 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// UpgradeName is the name of the software upgrade plan which runs the
+// registered store migrations of the modules
+const UpgradeName = "v2"
+
+// setUpgradeHandlers sets the handler of the software upgrade, which migrates
+// the module stores from the consensus versions of the previous binary
+func (app *Gridiron) setUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+}
@@ -40,6 +40,7 @@ import (
 
 	gridiron "github.com/gridiron-zone/gridiron/types"
 	makertypes "github.com/gridiron-zone/gridiron/x/maker/types"
+	customstakingtypes "github.com/gridiron-zone/gridiron/x/staking/types"
 	customvestingtypes "github.com/gridiron-zone/gridiron/x/vesting/types"
 
 	"github.com/tharsis/ethermint/crypto/hd"
@@ -447,7 +448,7 @@ func initGenFiles(
 	bankGenState.Balances = genBalances
 	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
 
-	var stakingGenState stakingtypes.GenesisState
+	var stakingGenState customstakingtypes.GenesisState
 	clientCtx.Codec.MustUnmarshalJSON(appGenState[stakingtypes.ModuleName], &stakingGenState)
 
 	stakingGenState.Params.BondDenom = coinDenom
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity ^0.8.0;
+
+/**
+ * @dev Interface of the AMM pair contracts whose trading fees are claimed by
+ * the gauges. The pair contract is the ERC20 token of the pool denom, i.e.,
+ * the LP token, and credits the trading fees to the LP holders. Calling
+ * `claimFees` transfers the fees accrued to the caller in `token0` and
+ * `token1` of the pair.
+ */
+interface IPair {
+  function token0() external view returns (address);
+
+  function token1() external view returns (address);
+
+  function claimFees() external returns (uint256 claimed0, uint256 claimed1);
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity ^0.8.0;
+
+/**
+ * @dev Price feed fed by the x/oracle module, compatible with Chainlink's
+ * AggregatorV3Interface and the legacy AggregatorInterface getters.
+ *
+ * The oracle module account deploys one feed per target denom and is the only
+ * account allowed to push new rounds. Answers are exchange rates denominated
+ * in USD, scaled by `decimals`.
+ */
+contract OracleFeed {
+  struct Round {
+    int256 answer;
+    uint256 updatedAt;
+  }
+
+  address public owner;
+  uint8 public decimals;
+  uint256 public latestRound;
+  string public description;
+
+  mapping(uint256 => Round) private _rounds;
+
+  event AnswerUpdated(int256 indexed current, uint256 indexed roundId, uint256 updatedAt);
+  event NewRound(uint256 indexed roundId, address indexed startedBy, uint256 startedAt);
+
+  constructor(uint8 decimals_, string memory description_) {
+    owner = msg.sender;
+    decimals = decimals_;
+    description = description_;
+  }
+
+  function version() external pure returns (uint256) {
+    return 1;
+  }
+
+  function getRoundData(uint80 roundId)
+    public
+    view
+    returns (
+      uint80,
+      int256,
+      uint256,
+      uint256,
+      uint80
+    )
+  {
+    Round memory round = _rounds[roundId];
+    require(round.updatedAt > 0, "No data present");
+    return (roundId, round.answer, round.updatedAt, round.updatedAt, roundId);
+  }
+
+  function latestRoundData()
+    external
+    view
+    returns (
+      uint80 roundId,
+      int256 answer,
+      uint256 startedAt,
+      uint256 updatedAt,
+      uint80 answeredInRound
+    )
+  {
+    return getRoundData(uint80(latestRound));
+  }
+
+  function latestAnswer() external view returns (int256) {
+    return _rounds[latestRound].answer;
+  }
+
+  function latestTimestamp() external view returns (uint256) {
+    return _rounds[latestRound].updatedAt;
+  }
+
+  function getAnswer(uint256 roundId) external view returns (int256) {
+    return _rounds[roundId].answer;
+  }
+
+  function getTimestamp(uint256 roundId) external view returns (uint256) {
+    return _rounds[roundId].updatedAt;
+  }
+
+  /**
+   * @dev Starts a new round with `answer` observed at `timestamp`.
+   * Only callable by the owner, i.e., the oracle module account.
+   */
+  function updateAnswer(int256 answer, uint256 timestamp) external {
+    require(msg.sender == owner, "caller is not the owner");
+
+    uint256 roundId = latestRound + 1;
+    latestRound = roundId;
+    _rounds[roundId] = Round(answer, timestamp);
+
+    emit NewRound(roundId, msg.sender, timestamp);
+    emit AnswerUpdated(answer, roundId, timestamp);
+  }
+}
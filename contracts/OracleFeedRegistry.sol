@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity ^0.8.0;
+
+/**
+ * @dev Registry of the price feeds maintained by the x/oracle module, keyed
+ * by the target coin denom (e.g. "uusd" or "erc20/0x...").
+ */
+contract OracleFeedRegistry {
+  address public owner;
+
+  mapping(string => address) private _feeds;
+
+  event FeedSet(string denom, address indexed feed);
+
+  constructor() {
+    owner = msg.sender;
+  }
+
+  /**
+   * @dev Returns the feed of `denom`, or the zero address if it has none.
+   */
+  function getFeed(string calldata denom) external view returns (address) {
+    return _feeds[denom];
+  }
+
+  /**
+   * @dev Sets the feed of `denom`. Only callable by the owner, i.e., the
+   * oracle module account.
+   */
+  function setFeed(string calldata denom, address feed) external {
+    require(msg.sender == owner, "caller is not the owner");
+
+    _feeds[denom] = feed;
+
+    emit FeedSet(denom, feed);
+  }
+}
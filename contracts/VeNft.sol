@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity ^0.8.0;
+
+interface IERC721Receiver {
+  function onERC721Received(
+    address operator,
+    address from,
+    uint256 tokenId,
+    bytes calldata data
+  ) external returns (bytes4);
+}
+
+/**
+ * @dev ERC-721 mirror of the veNFTs kept by the x/ve module. The token ID is
+ * the numeric ve ID. The owner, i.e., the ve module account, mints and burns
+ * the tokens along with the veNFTs, and is allowed to move any token so that
+ * transfers made on the native side can be mirrored. Transfers made here are
+ * synced back to the native side by the ve module after the transaction, and
+ * the whole transaction is reverted if the ve cannot be transferred.
+ */
+contract VeNft {
+  address public owner;
+  string public name;
+  string public symbol;
+
+  mapping(uint256 => address) private _owners;
+  mapping(address => uint256) private _balances;
+  mapping(uint256 => address) private _tokenApprovals;
+  mapping(address => mapping(address => bool)) private _operatorApprovals;
+
+  uint256 public totalSupply;
+
+  event Transfer(address indexed from, address indexed to, uint256 indexed tokenId);
+  event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId);
+  event ApprovalForAll(address indexed owner, address indexed operator, bool approved);
+
+  modifier onlyOwner() {
+    require(msg.sender == owner, "caller is not the owner");
+    _;
+  }
+
+  constructor(string memory name_, string memory symbol_) {
+    owner = msg.sender;
+    name = name_;
+    symbol = symbol_;
+  }
+
+  function supportsInterface(bytes4 interfaceId) external pure returns (bool) {
+    return interfaceId == 0x01ffc9a7 || interfaceId == 0x80ac58cd;
+  }
+
+  function balanceOf(address tokenOwner) external view returns (uint256) {
+    require(tokenOwner != address(0), "VeNft: zero address");
+    return _balances[tokenOwner];
+  }
+
+  function ownerOf(uint256 tokenId) public view returns (address) {
+    address tokenOwner = _owners[tokenId];
+    require(tokenOwner != address(0), "VeNft: invalid token ID");
+    return tokenOwner;
+  }
+
+  function getApproved(uint256 tokenId) external view returns (address) {
+    ownerOf(tokenId);
+    return _tokenApprovals[tokenId];
+  }
+
+  function isApprovedForAll(address tokenOwner, address operator) public view returns (bool) {
+    return _operatorApprovals[tokenOwner][operator];
+  }
+
+  function approve(address to, uint256 tokenId) external {
+    address tokenOwner = ownerOf(tokenId);
+    require(to != tokenOwner, "VeNft: approval to current owner");
+    require(
+      msg.sender == tokenOwner || isApprovedForAll(tokenOwner, msg.sender),
+      "VeNft: caller not approved"
+    );
+
+    _tokenApprovals[tokenId] = to;
+    emit Approval(tokenOwner, to, tokenId);
+  }
+
+  function setApprovalForAll(address operator, bool approved) external {
+    require(operator != msg.sender, "VeNft: approve to caller");
+
+    _operatorApprovals[msg.sender][operator] = approved;
+    emit ApprovalForAll(msg.sender, operator, approved);
+  }
+
+  function transferFrom(address from, address to, uint256 tokenId) external {
+    _transfer(from, to, tokenId);
+  }
+
+  function safeTransferFrom(address from, address to, uint256 tokenId) external {
+    _transfer(from, to, tokenId);
+    _checkOnERC721Received(from, to, tokenId, "");
+  }
+
+  function safeTransferFrom(address from, address to, uint256 tokenId, bytes calldata data) external {
+    _transfer(from, to, tokenId);
+    _checkOnERC721Received(from, to, tokenId, data);
+  }
+
+  /**
+   * @dev Mints `tokenId` to `to`. Only callable by the owner.
+   */
+  function mint(address to, uint256 tokenId) external onlyOwner {
+    require(to != address(0), "VeNft: mint to zero address");
+    require(_owners[tokenId] == address(0), "VeNft: token already minted");
+
+    _balances[to] += 1;
+    _owners[tokenId] = to;
+    totalSupply += 1;
+
+    emit Transfer(address(0), to, tokenId);
+  }
+
+  /**
+   * @dev Burns `tokenId`. Only callable by the owner.
+   */
+  function burn(uint256 tokenId) external onlyOwner {
+    address tokenOwner = ownerOf(tokenId);
+
+    delete _tokenApprovals[tokenId];
+    _balances[tokenOwner] -= 1;
+    delete _owners[tokenId];
+    totalSupply -= 1;
+
+    emit Transfer(tokenOwner, address(0), tokenId);
+  }
+
+  function _transfer(address from, address to, uint256 tokenId) private {
+    require(ownerOf(tokenId) == from, "VeNft: transfer from wrong owner");
+    require(to != address(0), "VeNft: transfer to zero address");
+    require(
+      msg.sender == from ||
+        msg.sender == _tokenApprovals[tokenId] ||
+        isApprovedForAll(from, msg.sender) ||
+        msg.sender == owner,
+      "VeNft: caller not approved"
+    );
+
+    delete _tokenApprovals[tokenId];
+    _balances[from] -= 1;
+    _balances[to] += 1;
+    _owners[tokenId] = to;
+
+    emit Transfer(from, to, tokenId);
+  }
+
+  function _checkOnERC721Received(address from, address to, uint256 tokenId, bytes memory data) private {
+    if (to.code.length == 0) {
+      return;
+    }
+    try IERC721Receiver(to).onERC721Received(msg.sender, from, tokenId, data) returns (bytes4 retval) {
+      require(retval == IERC721Receiver.onERC721Received.selector, "VeNft: non ERC721Receiver");
+    } catch (bytes memory reason) {
+      if (reason.length == 0) {
+        revert("VeNft: non ERC721Receiver");
+      }
+      assembly {
+        revert(add(32, reason), mload(reason))
+      }
+    }
+  }
+}
@@ -0,0 +1,68 @@
+package contracts_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	"github.com/tendermint/tendermint/version"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+
+	"github.com/gridiron-zone/gridiron/app"
+)
+
+// ContractsTestSuite runs the compiled contracts in the EVM of the app
+type ContractsTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *app.Gridiron
+}
+
+func TestContractsTestSuite(t *testing.T) {
+	suite.Run(t, new(ContractsTestSuite))
+}
+
+func (suite *ContractsTestSuite) SetupTest() {
+	require := suite.Require()
+
+	// consensus key
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	consAddress := sdk.ConsAddress(privCons.PubKey().Address())
+
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Version: tmversion.Consensus{
+			Block: version.BlockProtocol,
+		},
+		ChainID:         "gridiron_5000-101",
+		Height:          1,
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	})
+
+	// set validator, which is required by the EVM as the block proposer
+	valAddr := sdk.ValAddress(consAddress.Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr, privCons.PubKey(), stakingtypes.Description{})
+	require.NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper.Keeper, suite.ctx, validator, true)
+	suite.app.StakingKeeper.AfterValidatorCreated(suite.ctx, validator.GetOperator())
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator)
+	require.NoError(err)
+}
+
+// call calls the contract method from the account, and returns the unpacked outputs
+func (suite *ContractsTestSuite) call(contractABI abi.ABI, from, contract common.Address, method string, args ...interface{}) []interface{} {
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contractABI, from, contract, method, args...)
+	suite.Require().NoError(err)
+	out, err := contractABI.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return out
+}
@@ -0,0 +1,42 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/OracleFeed.json
+	OracleFeedJSON []byte // nolint: golint
+
+	// OracleFeedContract is the compiled oracle price feed contract
+	OracleFeedContract evmtypes.CompiledContract
+
+	//go:embed compiled_contracts/OracleFeedRegistry.json
+	OracleFeedRegistryJSON []byte // nolint: golint
+
+	// OracleFeedRegistryContract is the compiled oracle price feed registry contract
+	OracleFeedRegistryContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(OracleFeedJSON, &OracleFeedContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(OracleFeedContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+
+	err = json.Unmarshal(OracleFeedRegistryJSON, &OracleFeedRegistryContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(OracleFeedRegistryContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
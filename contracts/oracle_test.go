@@ -0,0 +1,59 @@
+package contracts_test
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gridiron-zone/gridiron/contracts"
+	oracletypes "github.com/gridiron-zone/gridiron/x/oracle/types"
+)
+
+func (suite *ContractsTestSuite) TestOracleFeed() {
+	require := suite.Require()
+	k := suite.app.OracleKeeper
+	denom := "uusd"
+	from := oracletypes.ModuleAddress
+	feedABI := contracts.OracleFeedContract.ABI
+	registryABI := contracts.OracleFeedRegistryContract.ABI
+
+	feed, err := k.DeployFeed(suite.ctx, denom)
+	require.NoError(err)
+	registry, found := k.GetFeedRegistry(suite.ctx)
+	require.True(found)
+
+	require.Equal(feed, suite.call(registryABI, from, registry, "getFeed", denom)[0])
+	require.Equal(common.Address{}, suite.call(registryABI, from, registry, "getFeed", "uother")[0])
+	require.Equal(from, suite.call(feedABI, from, feed, "owner")[0])
+	require.Equal(uint8(oracletypes.FeedDecimals), suite.call(feedABI, from, feed, "decimals")[0])
+	require.Equal(oracletypes.FeedDescription(denom), suite.call(feedABI, from, feed, "description")[0])
+	require.Zero(suite.call(feedABI, from, feed, "latestRound")[0].(*big.Int).Sign())
+
+	// the exchange rate is pushed as a new round
+	rate := sdk.NewDecWithPrec(12345, 4)
+	k.SetExchangeRate(suite.ctx, denom, rate)
+	k.UpdateFeeds(suite.ctx)
+
+	timestamp := big.NewInt(suite.ctx.BlockTime().Unix())
+	round := suite.call(feedABI, from, feed, "latestRoundData")
+	require.Equal([]interface{}{big.NewInt(1), rate.BigInt(), timestamp, timestamp, big.NewInt(1)}, round)
+	require.Equal(rate.BigInt(), suite.call(feedABI, from, feed, "latestAnswer")[0])
+	require.Equal(timestamp, suite.call(feedABI, from, feed, "latestTimestamp")[0])
+
+	// the next round keeps the history
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	newRate := sdk.NewDecWithPrec(23456, 4)
+	k.SetExchangeRate(suite.ctx, denom, newRate)
+	k.UpdateFeeds(suite.ctx)
+
+	require.Equal(big.NewInt(2), suite.call(feedABI, from, feed, "latestRound")[0])
+	require.Equal(newRate.BigInt(), suite.call(feedABI, from, feed, "latestAnswer")[0])
+	require.Equal(rate.BigInt(), suite.call(feedABI, from, feed, "getAnswer", big.NewInt(1))[0])
+	require.Equal(timestamp, suite.call(feedABI, from, feed, "getTimestamp", big.NewInt(1))[0])
+
+	// only the oracle module account can push answers
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, feedABI, common.BytesToAddress(feed.Bytes()), feed, "updateAnswer", big.NewInt(1), timestamp)
+	require.Error(err)
+}
@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/IPair.json
+	IPairJSON []byte // nolint: golint
+
+	// IPairContract is the interface of AMM pair contracts for claiming trading fees
+	IPairContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(IPairJSON, &IPairContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(IPairContract.ABI.Methods) == 0 {
+		panic("load contract failed")
+	}
+}
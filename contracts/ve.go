@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/VeNft.json
+	VeNftJSON []byte // nolint: golint
+
+	// VeNftContract is the compiled ERC-721 mirror contract of veNFTs
+	VeNftContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(VeNftJSON, &VeNftContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(VeNftContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
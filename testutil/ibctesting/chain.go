@@ -0,0 +1,227 @@
+// Package ibctesting sets up Gridiron chains for the ibc-go testing package,
+// which can be coordinated with the chains running the ibc-go simapp.
+package ibctesting
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/encoding"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/gridiron-zone/gridiron/app"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	customstakingtypes "github.com/gridiron-zone/gridiron/x/staking/types"
+	customvestingtypes "github.com/gridiron-zone/gridiron/x/vesting/types"
+)
+
+// TxGasLimit is the gas limit of the transactions delivered to the Gridiron
+// test chains, which is raised from the one of the ibc-go testing package to
+// afford the EVM calls of the ERC20 transfers.
+const TxGasLimit = 10_000_000
+
+// txConfig overrides the gas limit of the transactions generated by the ibc-go
+// testing package.
+type txConfig struct {
+	client.TxConfig
+}
+
+func (cfg txConfig) NewTxBuilder() client.TxBuilder {
+	return txBuilder{cfg.TxConfig.NewTxBuilder()}
+}
+
+type txBuilder struct {
+	client.TxBuilder
+}
+
+func (b txBuilder) SetGasLimit(uint64) {
+	b.TxBuilder.SetGasLimit(TxGasLimit)
+}
+
+// NewTestChain initializes a new Gridiron test chain with 4 validators. Unlike
+// the chains of the ibc-go testing package, the sender accounts sign with
+// Ethereum keys and are funded in the gas token, which is not registered in
+// x/erc20 at genesis.
+func NewTestChain(t *testing.T, coord *ibctesting.Coordinator, chainID string) *ibctesting.TestChain {
+	app.SetupConfig()
+
+	var (
+		validatorsPerChain = 4
+		validators         []*tmtypes.Validator
+		signersByAddress   = make(map[string]tmtypes.PrivValidator, validatorsPerChain)
+	)
+	for i := 0; i < validatorsPerChain; i++ {
+		privVal := mock.NewPV()
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		validators = append(validators, tmtypes.NewValidator(pubKey, 1))
+		signersByAddress[pubKey.Address().String()] = privVal
+	}
+	valSet := tmtypes.NewValidatorSet(validators)
+	signers := make([]tmtypes.PrivValidator, 0, len(valSet.Validators))
+	for _, val := range valSet.Validators {
+		signers = append(signers, signersByAddress[val.PubKey.Address().String()])
+	}
+
+	var (
+		genAccs    []authtypes.GenesisAccount
+		genBals    []banktypes.Balance
+		senderAccs []ibctesting.SenderAccount
+	)
+	amount, ok := sdk.NewIntFromString("10000000000000000000")
+	require.True(t, ok)
+	for i := 0; i < ibctesting.MaxAccounts; i++ {
+		senderPrivKey, err := ethsecp256k1.GenerateKey()
+		require.NoError(t, err)
+		acc := &ethermint.EthAccount{
+			BaseAccount: authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), uint64(i), 0),
+			CodeHash:    common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+		}
+		genAccs = append(genAccs, acc)
+		genBals = append(genBals, banktypes.Balance{
+			Address: acc.GetAddress().String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(gridiron.BaseDenom, amount)),
+		})
+		senderAccs = append(senderAccs, ibctesting.SenderAccount{
+			SenderAccount: acc,
+			SenderPrivKey: senderPrivKey,
+		})
+	}
+
+	gridironApp := setupWithGenesisValSet(t, valSet, genAccs, chainID, genBals...)
+
+	header := tmproto.Header{
+		ChainID: chainID,
+		Height:  1,
+		Time:    coord.CurrentTime.UTC(),
+	}
+	chain := &ibctesting.TestChain{
+		T:              t,
+		Coordinator:    coord,
+		ChainID:        chainID,
+		App:            gridironApp,
+		CurrentHeader:  header,
+		QueryServer:    gridironApp.GetIBCKeeper(),
+		TxConfig:       txConfig{gridironApp.GetTxConfig()},
+		Codec:          gridironApp.AppCodec(),
+		Vals:           valSet,
+		Signers:        signers,
+		SenderPrivKey:  senderAccs[0].SenderPrivKey,
+		SenderAccount:  senderAccs[0].SenderAccount,
+		SenderAccounts: senderAccs,
+	}
+
+	coord.CommitBlock(chain)
+
+	return chain
+}
+
+// setupWithGenesisValSet initializes a new Gridiron with the validator set and
+// the genesis accounts, the first of which delegates to each validator.
+func setupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, chainID string, balances ...banktypes.Balance) *app.Gridiron {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	gridironApp := app.NewGridiron(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 5, encodingConfig, simapp.EmptyAppOptions{}).(*app.Gridiron)
+	cdc := encodingConfig.Marshaler
+	genesisState := app.NewDefaultGenesisState()
+
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	var stakingGenesis customstakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenesis)
+	bondAmt := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+
+	for _, val := range valSet.Validators {
+		pk, err := cryptocodec.FromTmPubKeyInterface(val.PubKey)
+		require.NoError(t, err)
+		pkAny, err := codectypes.NewAnyWithValue(pk)
+		require.NoError(t, err)
+		stakingGenesis.Validators = append(stakingGenesis.Validators, stakingtypes.Validator{
+			OperatorAddress:   sdk.ValAddress(val.Address).String(),
+			ConsensusPubkey:   pkAny,
+			Jailed:            false,
+			Status:            stakingtypes.Bonded,
+			Tokens:            bondAmt,
+			DelegatorShares:   sdk.OneDec(),
+			Description:       stakingtypes.Description{},
+			UnbondingHeight:   int64(0),
+			UnbondingTime:     time.Unix(0, 0).UTC(),
+			Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			MinSelfDelegation: sdk.ZeroInt(),
+		})
+		stakingGenesis.Delegations = append(stakingGenesis.Delegations, stakingtypes.NewDelegation(genAccs[0].GetAddress(), val.Address.Bytes(), sdk.OneDec()))
+	}
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenesis)
+
+	balances = append(balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(stakingGenesis.Params.BondDenom, bondAmt.MulRaw(int64(len(valSet.Validators))))),
+	})
+	// The testing chains have no allocation addresses to vest the genesis
+	// allocation to.
+	var vestingGenesis customvestingtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[customvestingtypes.ModuleName], &vestingGenesis)
+	vestingGenesis.Allocated = true
+	genesisState[customvestingtypes.ModuleName] = cdc.MustMarshalJSON(&vestingGenesis)
+
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, sdk.NewCoins(), []banktypes.Metadata{})
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	consensusParams := *simapp.DefaultConsensusParams
+	consensusParams.Block = &abci.BlockParams{MaxBytes: simapp.DefaultConsensusParams.Block.MaxBytes, MaxGas: -1}
+	gridironApp.InitChain(
+		abci.RequestInitChain{
+			ChainId:         chainID,
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: &consensusParams,
+			AppStateBytes:   stateBytes,
+		},
+	)
+
+	// The blocks of the testing chains have no proposer, from which the EVM
+	// derives the coinbase, so the empty consensus address is mapped to a
+	// validator.
+	ctx := gridironApp.GetBaseApp().NewContext(false, tmproto.Header{ChainID: chainID})
+	ctx.KVStore(gridironApp.GetKey(stakingtypes.StoreKey)).Set(
+		stakingtypes.GetValidatorByConsAddrKey(sdk.ConsAddress{}),
+		sdk.ValAddress(valSet.Validators[0].Address),
+	)
+
+	gridironApp.Commit()
+	gridironApp.BeginBlock(
+		abci.RequestBeginBlock{
+			Header: tmproto.Header{
+				ChainID:            chainID,
+				Height:             gridironApp.LastBlockHeight() + 1,
+				AppHash:            gridironApp.LastCommitID().Hash,
+				ValidatorsHash:     valSet.Hash(),
+				NextValidatorsHash: valSet.Hash(),
+			},
+		},
+	)
+
+	return gridironApp
+}
@@ -98,10 +98,12 @@ func MakeEncodingConfig(_ *testing.T) simparams.EncodingConfig {
 
 func Erc20Keeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_" + types.StoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, sdk.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -110,7 +112,7 @@ func Erc20Keeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	paramsSubspace := typesparams.NewSubspace(cdc,
 		types.Amino,
 		storeKey,
-		nil,
+		tStoreKey,
 		"Erc20Params",
 	)
 	// AccountKeeper
@@ -45,6 +45,7 @@ func OracleKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		nil,
 		nil,
+		nil,
 		distrtypes.ModuleName,
 	)
 
@@ -2,12 +2,16 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	gridirontypes "github.com/gridiron-zone/gridiron/x/bank/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -37,28 +41,93 @@ func (k Keeper) Balance(ctx context.Context, req *types.QueryBalanceRequest) (*t
 	return &types.QueryBalanceResponse{Balance: &balance}, nil
 }
 
-// AllBalances implements the Query/AllBalances gRPC method
+// AllBalances implements the Query/AllBalances gRPC method, including the
+// ERC20-native tokens.
 func (k Keeper) AllBalances(ctx context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
-	return k.BaseKeeper.AllBalances(ctx, req)
+	return k.allBalances(ctx, req, false)
 }
 
 // SpendableBalances implements a gRPC query handler for retrieving an account's
-// spendable balances.
+// spendable balances, including the ERC20-native tokens.
 func (k Keeper) SpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
-	return k.BaseKeeper.SpendableBalances(ctx, req)
+	return k.spendableBalances(ctx, req, false)
 }
 
-// TotalSupply implements the Query/TotalSupply gRPC method
+// TotalSupply implements the Query/TotalSupply gRPC method, including the
+// ERC20-native tokens.
 func (k Keeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	return k.totalSupply(ctx, req, false)
+}
+
+func (k Keeper) allBalances(ctx context.Context, req *types.QueryAllBalancesRequest, nativeOnly bool) (*types.QueryAllBalancesResponse, error) {
+	res, err := k.BaseKeeper.AllBalances(ctx, req)
+	if err != nil || nativeOnly {
+		return res, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	addr, _ := sdk.AccAddressFromBech32(req.Address)
+	tokens := k.erc20Keeper().GetNativeErc20Balances(sdkCtx, addr)
+	res.Balances = appendNativeErc20Tokens(res.Balances, req.Pagination, res.Pagination, tokens)
+	return res, nil
+}
+
+func (k Keeper) spendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest, nativeOnly bool) (*types.QuerySpendableBalancesResponse, error) {
+	res, err := k.BaseKeeper.SpendableBalances(ctx, req)
+	if err != nil || nativeOnly {
+		return res, err
+	}
+
+	// ERC20-native tokens are never locked by vesting accounts
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	addr, _ := sdk.AccAddressFromBech32(req.Address)
+	tokens := k.erc20Keeper().GetNativeErc20Balances(sdkCtx, addr)
+	res.Balances = appendNativeErc20Tokens(res.Balances, req.Pagination, res.Pagination, tokens)
+	return res, nil
+}
+
+func (k Keeper) totalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest, nativeOnly bool) (*types.QueryTotalSupplyResponse, error) {
+	var pageReq *query.PageRequest
+	if req != nil {
+		pageReq = req.Pagination
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	totalSupply, pageRes, err := k.GetPaginatedTotalSupply(sdkCtx, req.Pagination)
+	totalSupply, pageRes, err := k.GetPaginatedTotalSupply(sdkCtx, pageReq)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if !nativeOnly {
+		tokens := k.erc20Keeper().GetNativeErc20TotalSupply(sdkCtx)
+		totalSupply = appendNativeErc20Tokens(totalSupply, pageReq, pageRes, tokens)
+	}
+
 	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
 
+// appendNativeErc20Tokens merges the ERC20-native tokens into the coins of the
+// last page, since the tokens are kept in the EVM rather than the bank store.
+func appendNativeErc20Tokens(coins sdk.Coins, pageReq *query.PageRequest, pageRes *query.PageResponse, tokens sdk.Coins) sdk.Coins {
+	if tokens.Empty() || (pageRes != nil && len(pageRes.NextKey) != 0) {
+		return coins
+	}
+	// Same as query.Paginate, total is counted for the request without limit
+	if pageRes != nil && (pageReq == nil || pageReq.CountTotal || pageReq.Limit == 0) {
+		pageRes.Total += uint64(len(tokens))
+	}
+
+	coins = append(coins, tokens...)
+	reverse := pageReq != nil && pageReq.Reverse
+	sort.SliceStable(coins, func(i, j int) bool {
+		if reverse {
+			return coins[i].Denom > coins[j].Denom
+		}
+		return coins[i].Denom < coins[j].Denom
+	})
+	return coins
+}
+
 // SupplyOf implements the Query/SupplyOf gRPC method
 func (k Keeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
 	if req == nil {
@@ -113,3 +182,53 @@ func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRe
 		Metadata: metadata,
 	}, nil
 }
+
+// Querier implements the gridiron bank Query service, which is able to
+// exclude the ERC20-native tokens from the results.
+type Querier struct {
+	Keeper
+}
+
+var _ gridirontypes.QueryServer = Querier{}
+
+// AllBalances implements the Query/AllBalances gRPC method
+func (q Querier) AllBalances(ctx context.Context, req *gridirontypes.QueryAllBalancesRequest) (*gridirontypes.QueryAllBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := q.allBalances(ctx, &types.QueryAllBalancesRequest{Address: req.Address, Pagination: req.Pagination}, req.NativeOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gridirontypes.QueryAllBalancesResponse{Balances: res.Balances, Pagination: res.Pagination}, nil
+}
+
+// SpendableBalances implements the Query/SpendableBalances gRPC method
+func (q Querier) SpendableBalances(ctx context.Context, req *gridirontypes.QuerySpendableBalancesRequest) (*gridirontypes.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := q.spendableBalances(ctx, &types.QuerySpendableBalancesRequest{Address: req.Address, Pagination: req.Pagination}, req.NativeOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gridirontypes.QuerySpendableBalancesResponse{Balances: res.Balances, Pagination: res.Pagination}, nil
+}
+
+// TotalSupply implements the Query/TotalSupply gRPC method
+func (q Querier) TotalSupply(ctx context.Context, req *gridirontypes.QueryTotalSupplyRequest) (*gridirontypes.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := q.totalSupply(ctx, &types.QueryTotalSupplyRequest{Pagination: req.Pagination}, req.NativeOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gridirontypes.QueryTotalSupplyResponse{Supply: res.Supply, Pagination: res.Pagination}, nil
+}
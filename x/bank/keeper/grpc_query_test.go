@@ -8,7 +8,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gridiron-zone/gridiron/app"
 	gridtypes "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/bank/keeper"
+	bankv1beta1 "github.com/gridiron-zone/gridiron/x/bank/types"
+	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -231,3 +235,87 @@ func (suite *KeeperTestSuite) TestKeeper_DenomMetadata() {
 	require.Equal(t, uusmMeta.DenomUnits[0], meta.DenomUnits[0])
 	require.Equal(t, uusmMeta.DenomUnits[1], meta.DenomUnits[1])
 }
+
+func (suite *KeeperTestSuite) TestKeeper_NativeErc20Balances() {
+	suite.SetupTest()
+	var (
+		t       = suite.T()
+		k       = suite.app.BankKeeper
+		querier = keeper.Querier{Keeper: k.(keeper.Keeper)}
+		denom   = gridtypes.AttoIronDenom
+	)
+
+	contract, err := suite.app.Erc20Keeper.DeployERC20Contract(suite.ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "utoken", Exponent: 0}},
+		Name:       "TOKEN",
+		Symbol:     "TOKEN",
+	})
+	require.NoError(t, err)
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, erc20types.REGISTRATION_ORIGIN_MSG)
+	require.NoError(t, err)
+	token := sdk.NewInt64Coin(pair.Denom, 1000)
+	err = suite.app.Erc20Keeper.SendCoins(suite.ctx, nil, suite.addrs[0], nil, sdk.NewCoins(token))
+	require.NoError(t, err)
+
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(gridtypes.MicroUSMDenom, 10)))
+	require.NoError(t, err)
+
+	balance := sdk.NewCoin(denom, sdk.NewInt(1134))
+	other := sdk.NewInt64Coin(gridtypes.MicroUSMDenom, 10)
+	pageReq := &query.PageRequest{Key: nil, Limit: 10, CountTotal: true}
+
+	res, err := k.AllBalances(sdk.WrapSDKContext(suite.ctx), &types.QueryAllBalancesRequest{Address: suite.addrs[0].String(), Pagination: pageReq})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(balance, token, other), res.Balances)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	// Tokens are appended to the last page only
+	res, err = k.AllBalances(sdk.WrapSDKContext(suite.ctx), &types.QueryAllBalancesRequest{
+		Address:    suite.addrs[0].String(),
+		Pagination: &query.PageRequest{Key: nil, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(balance), res.Balances)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	res, err = k.AllBalances(sdk.WrapSDKContext(suite.ctx), &types.QueryAllBalancesRequest{
+		Address:    suite.addrs[0].String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(token, other), res.Balances)
+
+	spendableRes, err := k.SpendableBalances(sdk.WrapSDKContext(suite.ctx), &types.QuerySpendableBalancesRequest{Address: suite.addrs[0].String(), Pagination: pageReq})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(balance, token, other), spendableRes.Balances)
+
+	supplyRes, err := k.TotalSupply(sdk.WrapSDKContext(suite.ctx), &types.QueryTotalSupplyRequest{Pagination: pageReq})
+	require.NoError(t, err)
+	require.Len(t, supplyRes.Supply, 3)
+	require.Equal(t, token, supplyRes.Supply[1])
+
+	// Native-only results exclude the tokens
+	nativeRes, err := querier.AllBalances(sdk.WrapSDKContext(suite.ctx), &bankv1beta1.QueryAllBalancesRequest{
+		Address:    suite.addrs[0].String(),
+		Pagination: pageReq,
+		NativeOnly: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(balance, other), nativeRes.Balances)
+	require.Equal(t, uint64(2), nativeRes.Pagination.Total)
+
+	nativeSpendableRes, err := querier.SpendableBalances(sdk.WrapSDKContext(suite.ctx), &bankv1beta1.QuerySpendableBalancesRequest{
+		Address:    suite.addrs[0].String(),
+		NativeOnly: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(balance, other), nativeSpendableRes.Balances)
+
+	nativeSupplyRes, err := querier.TotalSupply(sdk.WrapSDKContext(suite.ctx), &bankv1beta1.QueryTotalSupplyRequest{NativeOnly: true})
+	require.NoError(t, err)
+	require.Len(t, nativeSupplyRes.Supply, 2)
+	require.Equal(t, sdk.ZeroInt(), nativeSupplyRes.Supply.AmountOf(pair.Denom))
+
+	allSupplyRes, err := querier.TotalSupply(sdk.WrapSDKContext(suite.ctx), &bankv1beta1.QueryTotalSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, supplyRes.Supply, allSupplyRes.Supply)
+}
@@ -1,13 +1,32 @@
 package bank
 
 import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gridiron-zone/gridiron/x/bank/keeper"
+	"github.com/gridiron-zone/gridiron/x/bank/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
 
+// AppModuleBasic defines the basic application module used by the bank module.
+type AppModuleBasic struct {
+	bank.AppModuleBasic
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bank module.
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
 // AppModule implements an application module for the bank module.
 type AppModule struct {
 	bank.AppModule
@@ -18,6 +37,9 @@ type AppModule struct {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
 	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	if k, ok := am.keeper.(keeper.Keeper); ok {
+		types.RegisterQueryServer(cfg.QueryServer(), keeper.Querier{Keeper: k})
+	}
 
 	m := bankkeeper.NewMigrator(am.keeper)
 	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
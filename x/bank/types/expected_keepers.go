@@ -13,4 +13,6 @@ type Erc20Keeper interface {
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, nativeCoins sdk.Coins, nativeErc20Tokens sdk.Coins) error
+	GetNativeErc20Balances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetNativeErc20TotalSupply(ctx sdk.Context) sdk.Coins
 }
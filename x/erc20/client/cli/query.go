@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -11,6 +12,10 @@ import (
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
 )
 
+const (
+	FlagOrigin = "origin"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group erc20 queries under a subcommand
@@ -35,8 +40,11 @@ func GetTokenPairsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "token-pairs",
 		Short: "Gets token pairs registered",
-		Long:  "Gets token pairs registered",
-		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Gets token pairs registered, optionally of the registration origin.
+
+$ gridirond query erc20 token-pairs --origin REGISTRATION_ORIGIN_EVM_HOOK
+`),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -50,8 +58,22 @@ func GetTokenPairsCmd() *cobra.Command {
 				return err
 			}
 
+			originStr, err := cmd.Flags().GetString(FlagOrigin)
+			if err != nil {
+				return err
+			}
+			origin := types.REGISTRATION_ORIGIN_UNSPECIFIED
+			if originStr != "" {
+				value, ok := types.RegistrationOrigin_value[originStr]
+				if !ok {
+					return fmt.Errorf("invalid registration origin: %s", originStr)
+				}
+				origin = types.RegistrationOrigin(value)
+			}
+
 			req := &types.QueryTokenPairsRequest{
 				Pagination: pageReq,
+				Origin:     origin,
 			}
 
 			res, err := queryClient.TokenPairs(context.Background(), req)
@@ -63,7 +85,9 @@ func GetTokenPairsCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagOrigin, "", "registration origin of the token pairs, e.g., REGISTRATION_ORIGIN_EVM_HOOK")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token pairs")
 	return cmd
 }
 
@@ -2,10 +2,17 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
 )
 
@@ -19,7 +26,354 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(
+		CmdRegisterERC20(),
+		CmdConvertCoin(),
+		CmdConvertERC20(),
+	)
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+// CmdRegisterERC20 will create a MsgRegisterERC20 tx and sign it with the given key.
+func CmdRegisterERC20() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Register a token pair for an ERC20 contract",
+		Long: strings.TrimSpace(`
+Register a token pair for an ERC20 contract, so that the ERC20 token can be held and sent as a bank coin.
+The registration fee in the module params is burned if the registration policy is the fee policy.
+
+$ gridirond tx erc20 register-erc20 0xdAC17F958D2ee523a2206206994597C13D831ec7
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterERC20{
+				Sender:          clientCtx.GetFromAddress().String(),
+				ContractAddress: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConvertCoin will create a MsgConvertCoin tx and sign it with the given key.
+func CmdConvertCoin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coin [coin] [receiver-hex]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Convert a coin into the ERC20 token of the token pair",
+		Long: strings.TrimSpace(`
+Convert a coin into the distinct ERC20 token of the token pair. The receiver defaults to the sender.
+
+$ gridirond tx erc20 convert-coin 1000uusdc 0xdAC17F958D2ee523a2206206994597C13D831ec7
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			receiver := common.BytesToAddress(clientCtx.GetFromAddress()).Hex()
+			if len(args) == 2 {
+				receiver = args[1]
+			}
+
+			msg := &types.MsgConvertCoin{
+				Coin:     coin,
+				Receiver: receiver,
+				Sender:   clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConvertERC20 will create a MsgConvertERC20 tx and sign it with the given key.
+func CmdConvertERC20() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20 [contract-address] [amount] [receiver-bech32]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Convert an ERC20 token into the coin of the token pair",
+		Long: strings.TrimSpace(`
+Convert an ERC20 token into the distinct coin of the token pair. The receiver defaults to the sender.
+
+$ gridirond tx erc20 convert-erc20 0xdAC17F958D2ee523a2206206994597C13D831ec7 1000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			receiver := clientCtx.GetFromAddress().String()
+			if len(args) == 3 {
+				receiver = args[2]
+			}
+
+			msg := &types.MsgConvertERC20{
+				ContractAddress: args[0],
+				Amount:          amount,
+				Receiver:        receiver,
+				Sender:          common.BytesToAddress(clientCtx.GetFromAddress()).Hex(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterCoinProposalCmd implements the command to submit a register-coin proposal
+func NewRegisterCoinProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-coin [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to register a token pair for a native coin",
+		Long: strings.TrimSpace(
+			`Submit a proposal to register a token pair for a native coin along with an initial deposit.
+The denom metadata of the coin must exist.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := &types.RegisterCoinProposal{
+				Title:       title,
+				Description: description,
+				Denom:       args[0],
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterERC20ProposalCmd implements the command to submit a register-erc20 proposal
+func NewRegisterERC20ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 [contract-address] [denom]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a proposal to register a token pair for an ERC20 contract",
+		Long: strings.TrimSpace(
+			`Submit a proposal to register a token pair for an ERC20 contract along with an initial deposit.
+If the denom is specified, the coin of the denom is distinct from the ERC20 token, and they are converted
+to each other by the convert-coin and convert-erc20 commands. Otherwise the coin "erc20/{contract-address}"
+just represents the ERC20 token.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := &types.RegisterERC20Proposal{
+				Title:        title,
+				Description:  description,
+				Erc20Address: args[0],
+			}
+			if len(args) == 2 {
+				content.Denom = args[1]
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewToggleTokenConversionProposalCmd implements the command to submit a toggle-token-conversion proposal
+func NewToggleTokenConversionProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "toggle-token-conversion [token]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to toggle the conversion of an ERC20-native token pair",
+		Long: strings.TrimSpace(
+			`Submit a proposal to toggle the conversion of an ERC20-native token pair along with an initial deposit.
+The token can be either the hex contract address of the ERC20 or the Cosmos base denomination.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := &types.ToggleTokenConversionProposal{
+				Title:       title,
+				Description: description,
+				Token:       args[0],
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteTokenPairProposalCmd implements the command to submit a delete-token-pair proposal
+func NewDeleteTokenPairProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-token-pair [token]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to delete an ERC20-native token pair",
+		Long: strings.TrimSpace(
+			`Submit a proposal to delete an ERC20-native token pair along with an initial deposit.
+The token can be either the hex contract address of the ERC20 or the Cosmos base denomination.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := &types.DeleteTokenPairProposal{
+				Title:       title,
+				Description: description,
+				Token:       args[0],
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getProposalArgs(cmd *cobra.Command) (title, description string, deposit sdk.Coins, err error) {
+	title, err = cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return
+	}
+
+	description, err = cmd.Flags().GetString(cli.FlagDescription)
+	if err != nil {
+		return
+	}
+
+	depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return
+	}
+
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func addProposalTxFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1uiron", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
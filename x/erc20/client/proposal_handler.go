@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/gridiron-zone/gridiron/x/erc20/client/cli"
+	"github.com/gridiron-zone/gridiron/x/erc20/client/rest"
+)
+
+var (
+	RegisterCoinProposalHandler          = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd, rest.RegisterCoinProposalRESTHandler)
+	RegisterERC20ProposalHandler         = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd, rest.RegisterERC20ProposalRESTHandler)
+	ToggleTokenConversionProposalHandler = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd, rest.ToggleTokenConversionProposalRESTHandler)
+	DeleteTokenPairProposalHandler       = govclient.NewProposalHandler(cli.NewDeleteTokenPairProposalCmd, rest.DeleteTokenPairProposalRESTHandler)
+)
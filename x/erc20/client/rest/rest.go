@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+)
+
+// TokenPairProposalRequest defines a request of the token pair proposals, in
+// which the fields not of the proposal are ignored
+type TokenPairProposalRequest struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token        string       `json:"token,omitempty" yaml:"token"`
+	Erc20Address string       `json:"erc20address,omitempty" yaml:"erc20address"`
+	Denom        string       `json:"denom,omitempty" yaml:"denom"`
+}
+
+func RegisterCoinProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "register_coin",
+		Handler: newTokenPairProposalHandler(clientCtx, func(req TokenPairProposalRequest) govtypes.Content {
+			return &types.RegisterCoinProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Denom:       req.Denom,
+			}
+		}),
+	}
+}
+
+func RegisterERC20ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "register_erc20",
+		Handler: newTokenPairProposalHandler(clientCtx, func(req TokenPairProposalRequest) govtypes.Content {
+			return &types.RegisterERC20Proposal{
+				Title:        req.Title,
+				Description:  req.Description,
+				Erc20Address: req.Erc20Address,
+				Denom:        req.Denom,
+			}
+		}),
+	}
+}
+
+func ToggleTokenConversionProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "toggle_token_conversion",
+		Handler: newTokenPairProposalHandler(clientCtx, func(req TokenPairProposalRequest) govtypes.Content {
+			return &types.ToggleTokenConversionProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Token:       req.Token,
+			}
+		}),
+	}
+}
+
+func DeleteTokenPairProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "delete_token_pair",
+		Handler: newTokenPairProposalHandler(clientCtx, func(req TokenPairProposalRequest) govtypes.Content {
+			return &types.DeleteTokenPairProposal{
+				Title:       req.Title,
+				Description: req.Description,
+				Token:       req.Token,
+			}
+		}),
+	}
+}
+
+func newTokenPairProposalHandler(clientCtx client.Context, newContent func(req TokenPairProposalRequest) govtypes.Content) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TokenPairProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		from, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg, err := govtypes.NewMsgSubmitProposal(newContent(req), req.Deposit, from)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
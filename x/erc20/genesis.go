@@ -2,6 +2,7 @@ package erc20
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gridiron-zone/gridiron/x/erc20/keeper"
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
 )
@@ -29,6 +30,18 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	for _, holder := range genState.TokenHolders {
+		addr, err := sdk.AccAddressFromBech32(holder.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetTokenHolder(ctx, addr, common.HexToAddress(holder.Erc20Address))
+	}
+
+	for _, escrow := range genState.IbcEscrows {
+		k.SetIBCEscrow(ctx, escrow.ChannelId, common.HexToAddress(escrow.Erc20Address), escrow.Amount)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -36,6 +49,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	genesis.TokenPairs = k.GetAllTokenPairs(ctx)
+	genesis.TokenHolders = k.GetAllTokenHolders(ctx)
+	genesis.IbcEscrows = k.GetAllIBCEscrows(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
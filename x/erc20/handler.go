@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gridiron-zone/gridiron/x/erc20/keeper"
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
 )
@@ -12,11 +13,21 @@ import (
 // NewHandler ...
 func NewHandler(k keeper.Keeper) sdk.Handler {
 	// this line is used by starport scaffolding # handler/msgServer
+	msgServer := keeper.NewMsgServerImpl(k)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 		switch msg := msg.(type) {
+		case *types.MsgRegisterERC20:
+			res, err := msgServer.RegisterERC20(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgConvertCoin:
+			res, err := msgServer.ConvertCoin(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgConvertERC20:
+			res, err := msgServer.ConvertERC20(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -24,3 +35,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+// NewErc20ProposalHandler creates a governance handler to manage erc20 proposals.
+func NewErc20ProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RegisterCoinProposal:
+			return keeper.HandleRegisterCoinProposal(ctx, k, c)
+		case *types.RegisterERC20Proposal:
+			return keeper.HandleRegisterERC20Proposal(ctx, k, c)
+		case *types.ToggleTokenConversionProposal:
+			return keeper.HandleToggleTokenConversionProposal(ctx, k, c)
+		case *types.DeleteTokenPairProposal:
+			return keeper.HandleDeleteTokenPairProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
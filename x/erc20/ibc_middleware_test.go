@@ -0,0 +1,315 @@
+package erc20_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+	"github.com/tharsis/evmos/v4/contracts"
+
+	"github.com/gridiron-zone/gridiron/app"
+	gridironibctesting "github.com/gridiron-zone/gridiron/testutil/ibctesting"
+	"github.com/gridiron-zone/gridiron/x/erc20/keeper"
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+)
+
+type IBCMiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// chainA runs Gridiron and chainB runs the ibc-go simapp
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+
+	contract common.Address
+	denom    string
+}
+
+func TestIBCMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCMiddlewareTestSuite))
+}
+
+func (suite *IBCMiddlewareTestSuite) SetupTest() {
+	suite.coordinator = &ibctesting.Coordinator{
+		T:           suite.T(),
+		CurrentTime: time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	suite.chainA = gridironibctesting.NewTestChain(suite.T(), suite.coordinator, "gridiron_9000-1")
+	suite.chainB = ibctesting.NewTestChain(suite.T(), suite.coordinator, ibctesting.GetChainID(2))
+	suite.coordinator.Chains = map[string]*ibctesting.TestChain{
+		suite.chainA.ChainID: suite.chainA,
+		suite.chainB.ChainID: suite.chainB,
+	}
+
+	gridironApp := suite.gridiron()
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress()
+
+	var err error
+	suite.contract, err = gridironApp.Erc20Keeper.DeployERC20Contract(ctx, banktypes.Metadata{
+		Description: "Test token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "utest", Exponent: 0},
+			{Denom: "test", Exponent: 6},
+		},
+		Base:    "utest",
+		Display: "test",
+		Name:    "Test",
+		Symbol:  "TEST",
+	})
+	suite.Require().NoError(err)
+	pair, err := gridironApp.Erc20Keeper.RegisterERC20(ctx, suite.contract, types.REGISTRATION_ORIGIN_MSG)
+	suite.Require().NoError(err)
+	suite.Require().True(pair.IsNativeERC20())
+	suite.denom = pair.Denom
+
+	err = gridironApp.Erc20Keeper.SendCoins(ctx, nil, sender, nil, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1000)))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func (suite *IBCMiddlewareTestSuite) gridiron() *app.Gridiron {
+	return suite.chainA.App.(*app.Gridiron)
+}
+
+// transfer sends the coin through the channel of the endpoint, which times
+// out at the block height of the counterparty chain, and returns the sent packet
+func (suite *IBCMiddlewareTestSuite) transfer(endpoint *ibctesting.Endpoint, coin sdk.Coin, receiver string, timeoutHeight uint64) channeltypes.Packet {
+	chain := endpoint.Chain
+	revision := clienttypes.ParseChainID(endpoint.Counterparty.Chain.ChainID)
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		chain.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(revision, timeoutHeight), 0,
+	)
+	res, err := chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+func (suite *IBCMiddlewareTestSuite) balanceA(addr sdk.AccAddress) sdk.Int {
+	return suite.gridiron().BankKeeper.GetBalance(suite.chainA.GetContext(), addr, suite.denom).Amount
+}
+
+func (suite *IBCMiddlewareTestSuite) escrowA() sdk.Int {
+	return suite.gridiron().Erc20Keeper.GetIBCEscrow(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, suite.contract)
+}
+
+func (suite *IBCMiddlewareTestSuite) TestTransferOutAndBack() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	escrowAddress := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	timeoutHeight := uint64(110)
+
+	// Send the ERC20-native tokens from Gridiron, which escrows them
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewInt64Coin(suite.denom, 400), receiver.String(), timeoutHeight)
+	suite.Require().Equal(sdk.NewInt(600), suite.balanceA(sender))
+	suite.Require().Equal(sdk.NewInt(400), suite.balanceA(escrowAddress))
+	suite.Require().Equal(sdk.NewInt(400), suite.escrowA())
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// The vouchers on the counterparty chain trace the ERC20 denom
+	trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, suite.denom,
+	))
+	suite.Require().Equal(suite.denom, trace.BaseDenom)
+	suite.Require().Equal("transfer/"+suite.path.EndpointB.ChannelID, trace.Path)
+	simapp := suite.chainB.GetSimApp()
+	_, found := simapp.TransferKeeper.GetDenomTrace(suite.chainB.GetContext(), trace.Hash())
+	suite.Require().True(found)
+	voucher := simapp.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, trace.IBCDenom())
+	suite.Require().Equal(sdk.NewInt(400), voucher.Amount)
+
+	// Send part of the vouchers back, which unescrows the tokens
+	packet = suite.transfer(suite.path.EndpointB, sdk.NewInt64Coin(trace.IBCDenom(), 300), sender.String(), timeoutHeight)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.Require().Equal(sdk.NewInt(900), suite.balanceA(sender))
+	suite.Require().Equal(sdk.NewInt(100), suite.balanceA(escrowAddress))
+	suite.Require().Equal(sdk.NewInt(100), suite.escrowA())
+	voucher = simapp.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, trace.IBCDenom())
+	suite.Require().Equal(sdk.NewInt(100), voucher.Amount)
+
+	// The escrow is exported
+	genesis := suite.gridiron().Erc20Keeper.GetAllIBCEscrows(suite.chainA.GetContext())
+	suite.Require().Equal([]types.IBCEscrow{{
+		ChannelId:    suite.path.EndpointA.ChannelID,
+		Erc20Address: suite.contract.String(),
+		Amount:       sdk.NewInt(100),
+	}}, genesis)
+}
+
+func (suite *IBCMiddlewareTestSuite) TestTransferRefund() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	escrowAddress := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+	// The tokens are refunded upon an error acknowledgement
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewInt64Coin(suite.denom, 400), "invalid", 110)
+	suite.Require().Equal(sdk.NewInt(400), suite.escrowA())
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.Require().Equal(sdk.NewInt(1000), suite.balanceA(sender))
+	suite.Require().True(suite.balanceA(escrowAddress).IsZero())
+	suite.Require().True(suite.escrowA().IsZero())
+
+	// The tokens are refunded upon a timeout
+	timeoutHeight := uint64(suite.chainB.CurrentHeader.Height) + 1
+	packet = suite.transfer(suite.path.EndpointA, sdk.NewInt64Coin(suite.denom, 400), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+	suite.Require().Equal(sdk.NewInt(400), suite.escrowA())
+
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().Equal(sdk.NewInt(1000), suite.balanceA(sender))
+	suite.Require().True(suite.balanceA(escrowAddress).IsZero())
+	suite.Require().True(suite.escrowA().IsZero())
+	suite.Require().Empty(suite.gridiron().Erc20Keeper.GetAllIBCEscrows(suite.chainA.GetContext()))
+}
+
+func (suite *IBCMiddlewareTestSuite) TestSendPacketUnescrowedTokens() {
+	endpoint := suite.path.EndpointA
+	ctx := suite.chainA.GetContext()
+
+	// The packet of tokens which the escrow account does not hold is rejected
+	data := transfertypes.NewFungibleTokenPacketData(
+		suite.denom, "400", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+	)
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), 1, endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID,
+		clienttypes.NewHeight(0, 110), 0,
+	)
+	chanCap := suite.chainA.GetChannelCapability(endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	err := suite.gridiron().Erc20Keeper.SendPacket(ctx, chanCap, packet)
+	suite.Require().ErrorIs(err, types.ErrIBCEscrow)
+	suite.Require().True(suite.escrowA().IsZero())
+}
+
+// registerVoucher registers an ERC20 token with the distinct coin of the
+// vouchers of the counterparty bond denom, and supplies the module escrow with
+// the liquidity of the ERC20 token to convert the vouchers into.
+func (suite *IBCMiddlewareTestSuite) registerVoucher(liquidity int64, autoConvert bool) (string, string) {
+	gridironApp := suite.gridiron()
+	ctx := suite.chainA.GetContext()
+	k := gridironApp.Erc20Keeper
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+
+	contract, err := k.DeployERC20Contract(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ustake", Exponent: 0}},
+		Name:       "Bridged Stake",
+		Symbol:     "STAKE",
+	})
+	suite.Require().NoError(err)
+	_, err = k.CallEVM(ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, types.ModuleAddress, contract, "mint", types.ModuleAddress, big.NewInt(liquidity))
+	suite.Require().NoError(err)
+	err = keeper.HandleRegisterERC20Proposal(ctx, k, &types.RegisterERC20Proposal{Erc20Address: contract.String(), Denom: voucher})
+	suite.Require().NoError(err)
+
+	if autoConvert {
+		params := k.GetParams(ctx)
+		params.AutoConvertChannels = []string{suite.path.EndpointA.ChannelID}
+		k.SetParams(ctx, params)
+	}
+	suite.coordinator.CommitBlock(suite.chainA)
+	return voucher, types.CreateDenom(contract.String())
+}
+
+func (suite *IBCMiddlewareTestSuite) TestAutoConvertVouchers() {
+	sender := suite.chainB.SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccount.GetAddress()
+	voucher, erc20Denom := suite.registerVoucher(1000, true)
+	k := suite.gridiron().Erc20Keeper
+	simapp := suite.chainB.GetSimApp()
+	balanceB := simapp.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// The received vouchers are converted to the ERC20 tokens of the receiver
+	packet := suite.transfer(suite.path.EndpointB, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), receiver.String(), 110)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().True(suite.gridiron().BankKeeper.GetBalance(ctx, receiver, voucher).IsZero())
+	suite.Require().True(suite.gridiron().BankKeeper.GetSupply(ctx, voucher).IsZero())
+	suite.Require().Equal(sdk.NewInt64Coin(erc20Denom, 400), k.GetBalance(ctx, receiver, erc20Denom))
+	suite.Require().Equal(sdk.NewInt64Coin(erc20Denom, 600), k.GetBalance(ctx, types.ModuleAddress.Bytes(), erc20Denom))
+	suite.Require().Equal(balanceB.SubAmount(sdk.NewInt(400)), simapp.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, sdk.DefaultBondDenom))
+
+	// The vouchers refunded upon an error acknowledgement are converted back
+	err := k.ConvertERC20(ctx, common.BytesToAddress(receiver), receiver, common.HexToAddress(erc20Denom[len(types.DenomPrefix)+1:]), sdk.NewInt(300))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+	packet = suite.transfer(suite.path.EndpointA, sdk.NewInt64Coin(voucher, 300), "invalid", 110)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	ctx = suite.chainA.GetContext()
+	suite.Require().True(suite.gridiron().BankKeeper.GetBalance(ctx, receiver, voucher).IsZero())
+	suite.Require().Equal(sdk.NewInt64Coin(erc20Denom, 400), k.GetBalance(ctx, receiver, erc20Denom))
+
+	// The vouchers refunded upon a timeout are converted back
+	err = k.ConvertERC20(ctx, common.BytesToAddress(receiver), receiver, common.HexToAddress(erc20Denom[len(types.DenomPrefix)+1:]), sdk.NewInt(300))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+	timeoutHeight := uint64(suite.chainB.CurrentHeader.Height) + 1
+	packet = suite.transfer(suite.path.EndpointA, sdk.NewInt64Coin(voucher, 300), sender.String(), timeoutHeight)
+
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	ctx = suite.chainA.GetContext()
+	suite.Require().True(suite.gridiron().BankKeeper.GetBalance(ctx, receiver, voucher).IsZero())
+	suite.Require().Equal(sdk.NewInt64Coin(erc20Denom, 400), k.GetBalance(ctx, receiver, erc20Denom))
+}
+
+func (suite *IBCMiddlewareTestSuite) TestAutoConvertInsufficientLiquidity() {
+	sender := suite.chainB.SenderAccount.GetAddress()
+	receiver := suite.chainA.SenderAccount.GetAddress()
+	voucher, erc20Denom := suite.registerVoucher(100, true)
+	simapp := suite.chainB.GetSimApp()
+	balanceB := simapp.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// The receipt of the vouchers is discarded along with the failed conversion,
+	// and the sender is refunded
+	packet := suite.transfer(suite.path.EndpointB, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), receiver.String(), 110)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().True(suite.gridiron().BankKeeper.GetSupply(ctx, voucher).IsZero())
+	suite.Require().True(suite.gridiron().Erc20Keeper.GetBalance(ctx, receiver, erc20Denom).IsZero())
+	suite.Require().Equal(balanceB, simapp.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, sdk.DefaultBondDenom))
+}
+
+func (suite *IBCMiddlewareTestSuite) TestAutoConvertDisabledChannel() {
+	receiver := suite.chainA.SenderAccount.GetAddress()
+	voucher, erc20Denom := suite.registerVoucher(1000, false)
+
+	// The vouchers received on the channel not enabled are kept as coins
+	packet := suite.transfer(suite.path.EndpointB, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), receiver.String(), 110)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().Equal(sdk.NewInt64Coin(voucher, 400), suite.gridiron().BankKeeper.GetBalance(ctx, receiver, voucher))
+	suite.Require().True(suite.gridiron().Erc20Keeper.GetBalance(ctx, receiver, erc20Denom).IsZero())
+}
@@ -97,13 +97,28 @@ func (k Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.A
 		if !found {
 			panic(sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token pair '%s' with denom '%s' not found", id, coin.Denom))
 		}
+		if pair.HasDistinctCoin() {
+			// The coin distinct from the ERC20 token is not mirrored in the EVM
+			continue
+		}
 		tokenContracts = append(tokenContracts, pair.GetERC20Contract())
 		amounts = append(amounts, coin.Amount.BigInt())
 	}
 
+	nativeErc20Start := len(tokenContracts)
 	for _, coin := range nativeErc20Tokens {
 		denomSplit := strings.Split(coin.Denom, "/")
 		contract := common.HexToAddress(denomSplit[1]) // had checked preceding
+		pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, contract))
+		if !found {
+			return sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token pair with contract '%s' not found", contract)
+		}
+		if !pair.Enabled {
+			return sdkerrors.Wrapf(types.ErrTokenPairDisabled, "token pair with contract '%s' is disabled", contract)
+		}
+		if pair.HasDistinctCoin() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token with contract '%s' must be converted to coin '%s'", contract, pair.Denom)
+		}
 		tokenContracts = append(tokenContracts, contract)
 		amounts = append(amounts, coin.Amount.BigInt())
 	}
@@ -123,6 +138,11 @@ func (k Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.A
 		if err != nil {
 			return err
 		}
+
+		// transfers by calling EVM do not go through the EVM hooks
+		if i >= nativeErc20Start {
+			k.updateTokenHolders(ctx, contract, from, to)
+		}
 	}
 
 	return nil
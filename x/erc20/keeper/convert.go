@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+	"github.com/tharsis/evmos/v4/contracts"
+)
+
+// ConvertCoin burns the coin of the sender, and releases the same amount of
+// the ERC20 token from the module escrow to the receiver.
+func (k Keeper) ConvertCoin(ctx sdk.Context, sender sdk.AccAddress, receiver common.Address, coin sdk.Coin) error {
+	pair, err := k.getConvertibleTokenPair(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(coin)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return err
+	}
+
+	err = k.transferERC20(ctx, pair.GetERC20Contract(), types.ModuleAddress, receiver, coin.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+// ConvertERC20 locks the ERC20 token of the sender in the module escrow, and
+// mints the same amount of the coin to the receiver.
+func (k Keeper) ConvertERC20(ctx sdk.Context, sender common.Address, receiver sdk.AccAddress, contract common.Address, amount sdk.Int) error {
+	pair, err := k.getConvertibleTokenPair(ctx, contract.String())
+	if err != nil {
+		return err
+	}
+
+	err = k.transferERC20(ctx, contract, sender, types.ModuleAddress, amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, amount))
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+// getConvertibleTokenPair returns the enabled token pair of ERC20-native token
+// with the distinct coin.
+func (k Keeper) getConvertibleTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token pair with token '%s' not found", token)
+	}
+	if !pair.HasDistinctCoin() {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrUndefinedOwner, "token pair with token '%s' is not convertible", token)
+	}
+	if !pair.Enabled {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairDisabled, "token pair with token '%s' is disabled", token)
+	}
+	return pair, nil
+}
+
+// transferERC20 transfers the ERC20 token, and checks the balance of the
+// recipient increases by the exact amount, e.g., against fee-on-transfer tokens
+// or tokens returning false instead of reverting.
+func (k Keeper) transferERC20(ctx sdk.Context, contract, from, to common.Address, amount *big.Int) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	balanceBefore, err := k.balanceOf(ctx, contract, to)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.CallEVM(ctx, erc20, from, contract, "transfer", to, amount)
+	if err != nil {
+		return err
+	}
+
+	balanceAfter, err := k.balanceOf(ctx, contract, to)
+	if err != nil {
+		return err
+	}
+	if new(big.Int).Sub(balanceAfter, balanceBefore).Cmp(amount) != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidConversionBalance, "balance of %s increased by %s, expected %s", to, new(big.Int).Sub(balanceAfter, balanceBefore), amount)
+	}
+	return nil
+}
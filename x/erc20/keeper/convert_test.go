@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gridiron-zone/gridiron/x/erc20/keeper"
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tharsis/evmos/v4/contracts"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_Convert() {
+	suite.SetupTest()
+	var (
+		t     = suite.T()
+		k     = suite.app.Erc20Keeper
+		erc20 = contracts.ERC20MinterBurnerDecimalsContract.ABI
+		addr  = sdk.AccAddress([]byte("token_converter_____"))
+		denom = "uext"
+	)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+
+	contract, err := k.DeployERC20Contract(suite.ctx, suite.coinMetadata)
+	require.NoError(t, err)
+	_, err = k.CallEVM(suite.ctx, erc20, types.ModuleAddress, contract, "mint", common.BytesToAddress(addr), big.NewInt(1000))
+	require.NoError(t, err)
+
+	// Register the ERC20 with the distinct coin by proposal
+	err = keeper.HandleRegisterERC20Proposal(suite.ctx, k, &types.RegisterERC20Proposal{Erc20Address: contract.String(), Denom: "erc20/" + denom})
+	require.ErrorIs(t, err, types.ErrEVMDenom)
+	err = keeper.HandleRegisterERC20Proposal(suite.ctx, k, &types.RegisterERC20Proposal{Erc20Address: contract.String(), Denom: denom})
+	require.NoError(t, err)
+	pair, found := k.GetTokenPair(suite.ctx, k.GetTokenPairID(suite.ctx, denom))
+	require.True(t, found)
+	require.True(t, pair.HasDistinctCoin())
+	require.Equal(t, types.REGISTRATION_ORIGIN_GOVERNANCE, pair.Origin)
+	_, found = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+	require.True(t, found)
+	erc20Denom := types.CreateDenom(pair.Erc20Address)
+
+	// Convert ERC20 into coin
+	err = k.ConvertERC20(suite.ctx, common.BytesToAddress(addr), addr, contract, sdk.NewInt(1001))
+	require.Error(t, err)
+	err = k.ConvertERC20(suite.ctx, common.BytesToAddress(addr), addr, contract, sdk.NewInt(600))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 600), suite.app.BankKeeper.GetBalance(suite.ctx, addr, denom))
+	require.Equal(t, sdk.NewInt64Coin(denom, 600), suite.app.BankKeeper.GetSupply(suite.ctx, denom))
+	require.Equal(t, sdk.NewInt64Coin(erc20Denom, 400), k.GetBalance(suite.ctx, addr, erc20Denom))
+	require.Equal(t, sdk.NewInt64Coin(erc20Denom, 600), k.GetBalance(suite.ctx, types.ModuleAddress.Bytes(), erc20Denom))
+
+	// The distinct coin is sent as a bank coin, while the ERC20 token is not
+	other := sdk.AccAddress([]byte("token_receiver______"))
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, addr, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, addr, other, sdk.NewCoins(sdk.NewInt64Coin(erc20Denom, 100)))
+	require.Error(t, err)
+	require.True(t, k.GetNativeErc20Balances(suite.ctx, addr).Empty())
+	require.True(t, k.GetNativeErc20TotalSupply(suite.ctx).Empty())
+
+	// Deleting the token pair is prohibited while the coin has a supply
+	err = keeper.HandleDeleteTokenPairProposal(suite.ctx, k, &types.DeleteTokenPairProposal{Token: denom})
+	require.ErrorIs(t, err, types.ErrInternalTokenPair)
+
+	// Convert coin into ERC20
+	receiver := common.BytesToAddress([]byte("token_receiver______"))
+	err = k.ConvertCoin(suite.ctx, addr, receiver, sdk.NewInt64Coin(denom, 500))
+	require.NoError(t, err)
+	require.True(t, suite.app.BankKeeper.GetBalance(suite.ctx, addr, denom).IsZero())
+	require.Equal(t, sdk.NewInt64Coin(erc20Denom, 500), k.GetBalance(suite.ctx, receiver.Bytes(), erc20Denom))
+	require.Equal(t, sdk.NewInt64Coin(erc20Denom, 100), k.GetBalance(suite.ctx, types.ModuleAddress.Bytes(), erc20Denom))
+
+	// Toggle the conversion
+	err = keeper.HandleToggleTokenConversionProposal(suite.ctx, k, &types.ToggleTokenConversionProposal{Token: denom})
+	require.NoError(t, err)
+	err = k.ConvertCoin(suite.ctx, other, receiver, sdk.NewInt64Coin(denom, 100))
+	require.ErrorIs(t, err, types.ErrTokenPairDisabled)
+	err = k.ConvertERC20(suite.ctx, common.BytesToAddress(addr), addr, contract, sdk.NewInt(100))
+	require.ErrorIs(t, err, types.ErrTokenPairDisabled)
+
+	// Token pairs with the coin just representing the ERC20 token are not convertible
+	contract2, err := k.DeployERC20Contract(suite.ctx, suite.coinMetadata)
+	require.NoError(t, err)
+	err = keeper.HandleRegisterERC20Proposal(suite.ctx, k, &types.RegisterERC20Proposal{Erc20Address: contract2.String()})
+	require.NoError(t, err)
+	err = k.ConvertERC20(suite.ctx, common.BytesToAddress(addr), addr, contract2, sdk.NewInt(100))
+	require.ErrorIs(t, err, types.ErrUndefinedOwner)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RegisterCoinProposal() {
+	suite.SetupTest()
+	t := suite.T()
+
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, suite.coinMetadata)
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000)))
+	require.NoError(t, err)
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, "uusd"))
+	require.True(t, found)
+	require.Equal(t, types.REGISTRATION_ORIGIN_COIN, pair.Origin)
+
+	err = keeper.HandleRegisterCoinProposal(suite.ctx, suite.app.Erc20Keeper, &types.RegisterCoinProposal{Denom: "uusd"})
+	require.ErrorIs(t, err, types.ErrTokenPairAlreadyExists)
+	err = keeper.HandleRegisterCoinProposal(suite.ctx, suite.app.Erc20Keeper, &types.RegisterCoinProposal{Denom: "ueur"})
+	require.ErrorIs(t, err, types.ErrEVMDenom)
+}
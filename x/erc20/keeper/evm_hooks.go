@@ -83,9 +83,9 @@ func (h EvmHooks) PostTxProcessing(
 			// No token is registered for the caller contract,
 			// so it must be native erc20 token.
 
-			pair, err = h.k.RegisterERC20(ctx, contractAddr)
+			pair, err = h.k.RegisterERC20ByHook(ctx, contractAddr)
 			if err != nil {
-				h.k.Logger(ctx).Error("failed to register token pair", "error", err.Error())
+				h.k.Logger(ctx).Debug("failed to register token pair", "contract", contractAddr, "error", err.Error())
 				continue
 			}
 		} else {
@@ -97,7 +97,9 @@ func (h EvmHooks) PostTxProcessing(
 		}
 
 		if pair.ContractOwner != types.OWNER_MODULE {
-			// Only native coin needs bank operations
+			// Only native coin needs bank operations,
+			// while holders of native erc20 token are indexed
+			h.k.updateTokenHolders(ctx, contractAddr, from, to)
 			continue
 		}
 
@@ -24,13 +24,18 @@ func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
 
 	var pairs []types.TokenPair
-	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var pair types.TokenPair
 		if err := k.cdc.Unmarshal(value, &pair); err != nil {
-			return err
+			return false, err
 		}
-		pairs = append(pairs, pair)
-		return nil
+		if req.Origin != types.REGISTRATION_ORIGIN_UNSPECIFIED && pair.Origin != req.Origin {
+			return false, nil
+		}
+		if accumulate {
+			pairs = append(pairs, pair)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -44,6 +44,18 @@ func TestKeeper_TokenPairs(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(len(pairs)), res.Pagination.Total)
 	require.Equal(t, pairs, res.TokenPairs)
+
+	// Filter by the registration origin
+	hooked := types.NewTokenPair(common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"), "DAI", types.OWNER_EXTERNAL)
+	hooked.Origin = types.REGISTRATION_ORIGIN_EVM_HOOK
+	k.SetTokenPair(ctx, hooked)
+	res, err = k.TokenPairs(sdk.WrapSDKContext(ctx), &types.QueryTokenPairsRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+		Origin:     types.REGISTRATION_ORIGIN_EVM_HOOK,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+	require.Equal(t, []types.TokenPair{hooked}, res.TokenPairs)
 }
 
 func TestKeeper_TokenPair(t *testing.T) {
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+)
+
+// SendPacket implements the ICS4 Wrapper interface. The ERC20-native tokens
+// escrowed by the transfer module are accounted for the channel, and the
+// escrow account must actually hold the accounted amount, which fails the
+// transfer of the tokens that deduct fees or otherwise do not move the full
+// amount.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil && k.IsDenomForErc20(data.Denom) {
+		amount, ok := sdk.NewIntFromString(data.Amount)
+		if !ok {
+			return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+		}
+		contract := common.HexToAddress(data.Denom[len(types.DenomPrefix)+1:])
+		if err := k.escrowIBCTokens(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), contract, amount); err != nil {
+			return err
+		}
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// OnRecvPacket releases the accounted escrow of the ERC20-native tokens which
+// are returned to this chain, after the transfer module has unescrowed them
+// successfully. The vouchers received on a channel enabled for auto-conversion
+// are converted to the ERC20 tokens of the receiver, and an error
+// acknowledgement is returned if the conversion fails, which discards the
+// receipt of the vouchers as well.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, ack exported.Acknowledgement) exported.Acknowledgement {
+	if !ack.Success() {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return ack
+	}
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		denom := data.Denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		if k.IsDenomForErc20(denom) {
+			k.releaseIBCEscrow(ctx, packet.GetDestChannel(), denom, data.Amount)
+		}
+		return ack
+	}
+
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	voucher := transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	if err := k.autoConvertIBCVoucher(ctx, packet.GetDestChannel(), voucher, data.Amount, data.Receiver); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+	return ack
+}
+
+// OnAcknowledgementPacket handles the tokens which are refunded upon an error
+// acknowledgement
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+	if ack.Success() {
+		return nil
+	}
+	return k.onRefundPacket(ctx, packet)
+}
+
+// OnTimeoutPacket handles the tokens which are refunded upon a timeout
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.onRefundPacket(ctx, packet)
+}
+
+// onRefundPacket releases the accounted escrow of the refunded ERC20-native
+// tokens, and converts the vouchers refunded on a channel enabled for
+// auto-conversion back to the ERC20 tokens of the sender.
+func (k Keeper) onRefundPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+	if k.IsDenomForErc20(data.Denom) {
+		k.releaseIBCEscrow(ctx, packet.GetSourceChannel(), data.Denom, data.Amount)
+		return nil
+	}
+
+	// The refund must not fail on the conversion, so the vouchers are kept
+	// as coins if they cannot be converted
+	cacheCtx, writeCache := ctx.CacheContext()
+	voucher := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	if err := k.autoConvertIBCVoucher(cacheCtx, packet.GetSourceChannel(), voucher, data.Amount, data.Sender); err != nil {
+		k.Logger(ctx).Error("failed to convert refunded ibc vouchers", "channel", packet.GetSourceChannel(), "denom", voucher, "error", err.Error())
+		return nil
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// autoConvertIBCVoucher converts the vouchers of the holder to the ERC20 token
+// of the token pair, if the channel is enabled for auto-conversion and the
+// vouchers are convertible. The vouchers of a native coin token pair need no
+// conversion, since the bank already mints the ERC20 token along with them.
+func (k Keeper) autoConvertIBCVoucher(ctx sdk.Context, channelID, voucher, amountStr, holderStr string) error {
+	if !k.GetParams(ctx).IsAutoConvertChannel(channelID) {
+		return nil
+	}
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, voucher))
+	if !found || !pair.HasDistinctCoin() || !pair.Enabled {
+		return nil
+	}
+
+	amount, ok := sdk.NewIntFromString(amountStr)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", amountStr)
+	}
+	holder, err := sdk.AccAddressFromBech32(holderStr)
+	if err != nil {
+		return err
+	}
+	return k.ConvertCoin(ctx, holder, common.BytesToAddress(holder), sdk.NewCoin(voucher, amount))
+}
+
+// escrowIBCTokens accounts the amount of the ERC20-native token escrowed for
+// the channel and checks it against the balance of the escrow account
+func (k Keeper) escrowIBCTokens(ctx sdk.Context, portID, channelID string, contract common.Address, amount sdk.Int) error {
+	escrowed := k.GetIBCEscrow(ctx, channelID, contract).Add(amount)
+
+	escrowAddress := transfertypes.GetEscrowAddress(portID, channelID)
+	balance, err := k.balanceOf(ctx, contract, common.BytesToAddress(escrowAddress))
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(escrowed.BigInt()) < 0 {
+		return sdkerrors.Wrapf(
+			types.ErrIBCEscrow,
+			"balance %s of escrow account %s is less than escrowed amount %s of token %s",
+			balance, escrowAddress, escrowed, contract,
+		)
+	}
+
+	k.SetIBCEscrow(ctx, channelID, contract, escrowed)
+	return nil
+}
+
+// releaseIBCEscrow deducts the amount of the ERC20-native token released from
+// the escrow of the channel. Tokens escrowed before the accounting was in
+// place may be released beyond the accounted amount, which is floored at zero.
+func (k Keeper) releaseIBCEscrow(ctx sdk.Context, channelID, denom, amountStr string) {
+	amount, ok := new(big.Int).SetString(amountStr, 10)
+	if !ok {
+		return
+	}
+	contract := common.HexToAddress(denom[len(types.DenomPrefix)+1:])
+	escrowed := k.GetIBCEscrow(ctx, channelID, contract).BigInt()
+	if escrowed.Cmp(amount) < 0 {
+		k.Logger(ctx).Error("released ibc escrow exceeds the accounted amount", "channel", channelID, "contract", contract, "escrowed", escrowed, "released", amount)
+		amount = escrowed
+	}
+	k.SetIBCEscrow(ctx, channelID, contract, sdk.NewIntFromBigInt(escrowed.Sub(escrowed, amount)))
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+)
+
+// GetIBCEscrow returns the amount of an ERC20-native token escrowed for IBC
+// transfers through the channel
+func (k Keeper) GetIBCEscrow(ctx sdk.Context, channelID string, erc20 common.Address) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetIBCEscrowKey(channelID, erc20))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// SetIBCEscrow sets the amount of an ERC20-native token escrowed for IBC
+// transfers through the channel, which is removed if it is not positive
+func (k Keeper) SetIBCEscrow(ctx sdk.Context, channelID string, erc20 common.Address, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetIBCEscrowKey(channelID, erc20)
+	if !amount.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// GetAllIBCEscrows returns the amounts of all the ERC20-native tokens escrowed
+// for IBC transfers
+func (k Keeper) GetAllIBCEscrows(ctx sdk.Context) []types.IBCEscrow {
+	var escrows []types.IBCEscrow
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixIBCEscrow)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of the form KeyPrefixIBCEscrow || channelLen (1 byte) || channel || erc20
+		key := iterator.Key()[len(types.KeyPrefixIBCEscrow):]
+		channelLen := int(key[0])
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		escrows = append(escrows, types.IBCEscrow{
+			ChannelId:    string(key[1 : 1+channelLen]),
+			Erc20Address: common.BytesToAddress(key[1+channelLen:]).String(),
+			Amount:       amount,
+		})
+	}
+
+	return escrows
+}
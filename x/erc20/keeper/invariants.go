@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
 	gridiron "github.com/gridiron-zone/gridiron/types"
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
 )
@@ -12,6 +14,7 @@ import (
 // RegisterInvariants registers the erc20 module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "consistent-balance", ConsistentBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "ibc-escrow", IBCEscrowInvariant(k))
 }
 
 // ConsistentBalanceInvariant checks that all accounts have consistent balances in bank and erc20
@@ -28,6 +31,11 @@ func ConsistentBalanceInvariant(k Keeper) sdk.Invariant {
 				// skip gas token
 				return false
 			}
+			pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, balance.Denom))
+			if found && pair.HasDistinctCoin() {
+				// skip coin distinct from the ERC20 token, which is not mirrored
+				return false
+			}
 
 			erc20Balance := k.GetBalance(ctx, addr, balance.Denom)
 			if !erc20Balance.IsEqual(balance) {
@@ -46,3 +54,30 @@ func ConsistentBalanceInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// IBCEscrowInvariant checks that the ICS-20 escrow accounts hold the accounted
+// amounts of the ERC20-native tokens escrowed for IBC transfers
+func IBCEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, escrow := range k.GetAllIBCEscrows(ctx) {
+			escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, escrow.ChannelId)
+			balance, err := k.balanceOf(ctx, common.HexToAddress(escrow.Erc20Address), common.BytesToAddress(escrowAddress))
+			if err != nil || balance.Cmp(escrow.Amount.BigInt()) < 0 {
+				count++
+				msg += fmt.Sprintf("\tescrow account %s of channel %s holds less than %s of token %s\n", escrowAddress, escrow.ChannelId, escrow.Amount, escrow.Erc20Address)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "ibc-escrow",
+			fmt.Sprintf("insufficient escrow balances found %d\n%s", count, msg),
+		), broken
+	}
+}
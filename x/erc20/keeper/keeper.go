@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
 )
 
@@ -19,6 +20,7 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	evmKeeper     types.EVMKeeper
+	ics4Wrapper   porttypes.ICS4Wrapper
 }
 
 func NewKeeper(
@@ -49,6 +51,13 @@ func NewKeeper(
 	}
 }
 
+// SetICS4Wrapper sets the ICS4 wrapper to which the IBC packets sent by the
+// transfer module are passed down. It must be called before the keeper is
+// used as the ICS4 wrapper of the transfer keeper.
+func (k *Keeper) SetICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = ics4Wrapper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
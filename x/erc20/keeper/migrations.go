@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.setMissingParams(ctx)
+	m.keeper.backfillTokenHolders(ctx)
+	return nil
+}
+
+// setMissingParams sets the params, which were not stored before, to their
+// defaults, since reading a missing param panics.
+func (k Keeper) setMissingParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramstore.Has(ctx, pair.Key) {
+			k.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// backfillTokenHolders indexes the holders of the ERC20-native tokens which
+// were registered before the holders were indexed, by checking the balances
+// of all the accounts.
+func (k Keeper) backfillTokenHolders(ctx sdk.Context) {
+	var contracts []common.Address
+	for _, pair := range k.GetAllTokenPairs(ctx) {
+		if pair.IsNativeERC20() && !pair.HasDistinctCoin() {
+			contracts = append(contracts, pair.GetERC20Contract())
+		}
+	}
+	if len(contracts) == 0 {
+		return
+	}
+
+	var accounts []common.Address
+	k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) (stop bool) {
+		accounts = append(accounts, common.BytesToAddress(account.GetAddress()))
+		return false
+	})
+
+	for _, contract := range contracts {
+		k.updateTokenHolders(ctx, contract, accounts...)
+	}
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gridiron-zone/gridiron/x/erc20/keeper"
+	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tharsis/evmos/v4/contracts"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3BackfillsTokenHolders() {
+	suite.SetupTest()
+	var (
+		t     = suite.T()
+		k     = suite.app.Erc20Keeper
+		erc20 = contracts.ERC20MinterBurnerDecimalsContract.ABI
+		addr1 = sdk.AccAddress([]byte("token_holder_1______"))
+		addr2 = sdk.AccAddress([]byte("token_holder_2______"))
+	)
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+	}
+
+	contract, err := k.DeployERC20Contract(suite.ctx, suite.coinMetadata)
+	require.NoError(t, err)
+	pair, err := k.RegisterERC20(suite.ctx, contract, erc20types.REGISTRATION_ORIGIN_MSG)
+	require.NoError(t, err)
+
+	// The token was held before the holders were indexed
+	_, err = k.CallEVM(suite.ctx, erc20, erc20types.ModuleAddress, contract, "mint", common.BytesToAddress(addr1), big.NewInt(1000))
+	require.NoError(t, err)
+	require.False(t, k.HasTokenHolder(suite.ctx, addr1, contract))
+	require.True(t, k.GetNativeErc20Balances(suite.ctx, addr1).Empty())
+
+	err = keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	require.NoError(t, err)
+
+	require.True(t, k.HasTokenHolder(suite.ctx, addr1, contract))
+	require.False(t, k.HasTokenHolder(suite.ctx, addr2, contract))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 1000)), k.GetNativeErc20Balances(suite.ctx, addr1))
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3SetsParams() {
+	suite.SetupTest()
+	var (
+		t = suite.T()
+		k = suite.app.Erc20Keeper
+	)
+
+	// The params were not stored before
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(erc20types.ModuleName+"/"))
+	for _, key := range [][]byte{erc20types.KeyRegistrationPolicy, erc20types.KeyRegistrationAllowlist, erc20types.KeyRegistrationFee, erc20types.KeyAutoConvertChannels} {
+		store.Delete(key)
+	}
+	require.Panics(t, func() { k.GetParams(suite.ctx) })
+
+	err := keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	require.NoError(t, err)
+	require.Equal(t, erc20types.DefaultParams(), k.GetParams(suite.ctx))
+	require.Equal(t, erc20types.REGISTRATION_POLICY_FEE, k.GetParams(suite.ctx).RegistrationPolicy)
+
+	// The params already set are kept
+	params := erc20types.NewParams(erc20types.REGISTRATION_POLICY_OPEN, nil, nil, nil)
+	k.SetParams(suite.ctx, params)
+	err = keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(suite.ctx))
+}
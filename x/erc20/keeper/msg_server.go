@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
 )
 
@@ -15,3 +19,78 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+// RegisterERC20 implements types.MsgServer
+func (m msgServer) RegisterERC20(c context.Context, msg *types.MsgRegisterERC20) (*types.MsgRegisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, err := m.Keeper.RegisterERC20ByMsg(ctx, sender, common.HexToAddress(msg.ContractAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgRegisterERC20Response{Denom: pair.Denom}, nil
+}
+
+// ConvertCoin implements types.MsgServer
+func (m msgServer) ConvertCoin(c context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.ConvertCoin(ctx, sender, common.HexToAddress(msg.Receiver), msg.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// ConvertERC20 implements types.MsgServer
+func (m msgServer) ConvertERC20(c context.Context, msg *types.MsgConvertERC20) (*types.MsgConvertERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.ConvertERC20(ctx, common.HexToAddress(msg.Sender), receiver, common.HexToAddress(msg.ContractAddress), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgConvertERC20Response{}, nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+)
+
+func HandleRegisterCoinProposal(ctx sdk.Context, k Keeper, p *types.RegisterCoinProposal) error {
+	_, err := k.registerCoin(ctx, p.Denom, types.REGISTRATION_ORIGIN_GOVERNANCE)
+	return err
+}
+
+func HandleRegisterERC20Proposal(ctx sdk.Context, k Keeper, p *types.RegisterERC20Proposal) error {
+	_, err := k.RegisterERC20WithCoin(ctx, common.HexToAddress(p.Erc20Address), p.Denom, types.REGISTRATION_ORIGIN_GOVERNANCE)
+	return err
+}
+
+func HandleToggleTokenConversionProposal(ctx sdk.Context, k Keeper, p *types.ToggleTokenConversionProposal) error {
+	pair, err := k.getNativeErc20TokenPair(ctx, p.Token)
+	if err != nil {
+		return err
+	}
+
+	pair.Enabled = !pair.Enabled
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleTokenConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(pair.Enabled)),
+		),
+	)
+
+	return nil
+}
+
+func HandleDeleteTokenPairProposal(ctx sdk.Context, k Keeper, p *types.DeleteTokenPairProposal) error {
+	pair, err := k.getNativeErc20TokenPair(ctx, p.Token)
+	if err != nil {
+		return err
+	}
+
+	if pair.HasDistinctCoin() && k.bankKeeper.HasSupply(ctx, pair.Denom) {
+		return sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin '%s' of token pair still has a supply, which must be converted first", pair.Denom)
+	}
+
+	// NOTE: the bank denom metadata is kept, which prevents the contract from
+	// being registered again
+	k.DeleteTokenPair(ctx, pair)
+	k.DeleteTokenHolders(ctx, pair.GetERC20Contract())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+// getNativeErc20TokenPair returns the token pair of ERC20-native token, since
+// the token pairs of native coin are mirrored by the bank and must stay intact.
+func (k Keeper) getNativeErc20TokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token pair with token '%s' not found", token)
+	}
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrUndefinedOwner, "token pair with token '%s' is not of ERC20-native token", token)
+	}
+	return pair, nil
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gridiron-zone/gridiron/x/erc20/keeper"
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_TokenPairProposals() {
+	suite.SetupTest()
+	var (
+		t    = suite.T()
+		k    = suite.app.Erc20Keeper
+		addr = sdk.AccAddress([]byte("token_holder________"))
+	)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+
+	contract, err := k.DeployERC20Contract(suite.ctx, suite.coinMetadata)
+	require.NoError(t, err)
+	pair, err := k.RegisterERC20(suite.ctx, contract, types.REGISTRATION_ORIGIN_MSG)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 1000))
+	require.NoError(t, k.SendCoins(suite.ctx, nil, addr, nil, coins))
+
+	// Only the token pairs of ERC20-native token can be toggled or deleted
+	err = keeper.HandleToggleTokenConversionProposal(suite.ctx, k, &types.ToggleTokenConversionProposal{Token: "unknown"})
+	require.ErrorIs(t, err, types.ErrTokenPairNotFound)
+	coinPair := types.NewTokenPair(contract, "uusd", types.OWNER_MODULE)
+	k.SetTokenPair(suite.ctx, coinPair)
+	k.SetDenomMap(suite.ctx, coinPair.Denom, coinPair.GetID())
+	err = keeper.HandleDeleteTokenPairProposal(suite.ctx, k, &types.DeleteTokenPairProposal{Token: "uusd"})
+	require.ErrorIs(t, err, types.ErrUndefinedOwner)
+
+	// Toggle
+	err = keeper.HandleToggleTokenConversionProposal(suite.ctx, k, &types.ToggleTokenConversionProposal{Token: pair.Denom})
+	require.NoError(t, err)
+	pair, _ = k.GetTokenPair(suite.ctx, pair.GetID())
+	require.False(t, pair.Enabled)
+	err = k.SendCoins(suite.ctx, addr, nil, nil, coins)
+	require.ErrorIs(t, err, types.ErrTokenPairDisabled)
+	require.True(t, k.GetNativeErc20Balances(suite.ctx, addr).Empty())
+
+	err = keeper.HandleToggleTokenConversionProposal(suite.ctx, k, &types.ToggleTokenConversionProposal{Token: pair.Erc20Address})
+	require.NoError(t, err)
+	pair, _ = k.GetTokenPair(suite.ctx, pair.GetID())
+	require.True(t, pair.Enabled)
+	require.Equal(t, coins, k.GetNativeErc20Balances(suite.ctx, addr))
+
+	// Delete
+	err = keeper.HandleDeleteTokenPairProposal(suite.ctx, k, &types.DeleteTokenPairProposal{Token: pair.Erc20Address})
+	require.NoError(t, err)
+	require.False(t, k.IsTokenPairRegistered(suite.ctx, pair.GetID()))
+	require.False(t, k.HasTokenHolder(suite.ctx, addr, contract))
+	_, err = k.RegisterERC20(suite.ctx, contract, types.REGISTRATION_ORIGIN_MSG)
+	require.Error(t, err)
+}
@@ -1,20 +1,27 @@
 package keeper
 
 import (
+	"bytes"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	gridiron "github.com/gridiron-zone/gridiron/types"
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 	"github.com/tharsis/evmos/v4/contracts"
 )
 
 // RegisterCoin deploys an erc20 contract and creates the token pair for the existing cosmos coin
 func (k Keeper) RegisterCoin(ctx sdk.Context, denom string) (*types.TokenPair, error) {
+	return k.registerCoin(ctx, denom, types.REGISTRATION_ORIGIN_COIN)
+}
+
+func (k Keeper) registerCoin(ctx sdk.Context, denom string, origin types.RegistrationOrigin) (*types.TokenPair, error) {
 	// Prohibit denominations that contain the "iron" denom
 	if strings.Contains(denom, gridiron.DisplayDenom) {
 		return nil, sdkerrors.Wrapf(types.ErrEVMDenom, "cannot register the EVM denomination %s", denom)
@@ -44,10 +51,20 @@ func (k Keeper) RegisterCoin(ctx sdk.Context, denom string) (*types.TokenPair, e
 	}
 
 	pair := types.NewTokenPair(addr, coinMetadata.Base, types.OWNER_MODULE)
+	pair.Origin = origin
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCoin,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyOrigin, pair.Origin.String()),
+		),
+	)
+
 	return &pair, nil
 }
 
@@ -58,56 +75,189 @@ func (k Keeper) DeployERC20Contract(
 	coinMetadata banktypes.Metadata,
 ) (common.Address, error) {
 	decimals := uint8(coinMetadata.DenomUnits[0].Exponent)
-	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(
-		"",
+	contractAddr, err := k.DeployContract(
+		ctx,
+		types.ModuleAddress,
+		contracts.ERC20MinterBurnerDecimalsContract,
 		coinMetadata.Name,
 		coinMetadata.Symbol,
 		decimals,
 	)
 	if err != nil {
-		return common.Address{}, sdkerrors.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", coinMetadata.Name, err.Error())
+		return common.Address{}, sdkerrors.Wrapf(err, "failed to deploy contract for %s", coinMetadata.Name)
 	}
 
-	data := make([]byte, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
-	copy(data[:len(contracts.ERC20MinterBurnerDecimalsContract.Bin)], contracts.ERC20MinterBurnerDecimalsContract.Bin)
-	copy(data[len(contracts.ERC20MinterBurnerDecimalsContract.Bin):], ctorArgs)
+	return contractAddr, nil
+}
 
-	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+// DeployContract deploys the compiled contract on the EVM from the given
+// account, which is usually a module account that becomes the owner.
+func (k Keeper) DeployContract(
+	ctx sdk.Context,
+	from common.Address,
+	contract evmtypes.CompiledContract,
+	args ...interface{},
+) (common.Address, error) {
+	ctorArgs, err := contract.ABI.Pack("", args...)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrABIPack, "constructor arguments are invalid: %s", err.Error())
+	}
+
+	data := make([]byte, len(contract.Bin)+len(ctorArgs))
+	copy(data[:len(contract.Bin)], contract.Bin)
+	copy(data[len(contract.Bin):], ctorArgs)
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
 	if err != nil {
 		return common.Address{}, err
 	}
 
-	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
-	_, err = k.CallEVMWithData(ctx, types.ModuleAddress, nil, data)
+	contractAddr := crypto.CreateAddress(from, nonce)
+	_, err = k.CallEVMWithData(ctx, from, nil, data)
 	if err != nil {
-		return common.Address{}, sdkerrors.Wrapf(err, "failed to deploy contract for %s", coinMetadata.Name)
+		return common.Address{}, err
 	}
 
 	return contractAddr, nil
 }
 
 // RegisterERC20 registers the token pair between the coin and the ERC20
-func (k Keeper) RegisterERC20(ctx sdk.Context, contract common.Address) (types.TokenPair, error) {
+func (k Keeper) RegisterERC20(ctx sdk.Context, contract common.Address, origin types.RegistrationOrigin) (types.TokenPair, error) {
+	return k.RegisterERC20WithCoin(ctx, contract, "", origin)
+}
+
+// RegisterERC20WithCoin registers the token pair between the ERC20 and the coin
+// of the given denom, which is distinct from the ERC20 token and converted to
+// it explicitly. If the denom is empty, the coin just represents the ERC20 token.
+func (k Keeper) RegisterERC20WithCoin(ctx sdk.Context, contract common.Address, denom string, origin types.RegistrationOrigin) (types.TokenPair, error) {
 	if k.IsERC20Registered(ctx, contract) {
 		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", contract.String())
 	}
 
-	metadata, err := k.CreateCoinMetadata(ctx, contract)
+	if len(denom) == 0 {
+		denom = types.CreateDenom(contract.String())
+	} else {
+		if types.HasErc20DenomPrefix(denom) {
+			return types.TokenPair{}, sdkerrors.Wrapf(types.ErrEVMDenom, "cannot register the denomination %s with prefix %s", denom, types.DenomPrefix)
+		}
+		if strings.Contains(denom, gridiron.DisplayDenom) {
+			return types.TokenPair{}, sdkerrors.Wrapf(types.ErrEVMDenom, "cannot register the EVM denomination %s", denom)
+		}
+		// The distinct coin is only minted by converting the ERC20 token
+		if k.bankKeeper.HasSupply(ctx, denom) {
+			return types.TokenPair{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "base denomination '%s' already has a supply", denom)
+		}
+	}
+
+	if err := k.ValidateERC20Contract(ctx, contract); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	metadata, err := k.CreateCoinMetadata(ctx, contract, denom)
 	if err != nil {
 		return types.TokenPair{}, sdkerrors.Wrap(err, "failed to create wrapped coin denom metadata for ERC20")
 	}
 
-	pair := types.NewTokenPair(contract, metadata.Name, types.OWNER_EXTERNAL)
+	pair := types.NewTokenPair(contract, metadata.Base, types.OWNER_EXTERNAL)
+	pair.Origin = origin
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyOrigin, pair.Origin.String()),
+		),
+	)
+
 	k.Logger(ctx).Info("registered erc20 token pair", "token_pair", pair)
 	return pair, nil
 }
 
-// CreateCoinMetadata generates the coin metadata to represent the ERC20 token.
-func (k Keeper) CreateCoinMetadata(ctx sdk.Context, contract common.Address) (*banktypes.Metadata, error) {
+// RegisterERC20ByHook registers the ERC20 contract which emits a Transfer event,
+// only if the registration policy allows the automatic registration.
+func (k Keeper) RegisterERC20ByHook(ctx sdk.Context, contract common.Address) (types.TokenPair, error) {
+	params := k.GetParams(ctx)
+	switch params.RegistrationPolicy {
+	case types.REGISTRATION_POLICY_OPEN:
+	case types.REGISTRATION_POLICY_ALLOWLIST:
+		if !params.IsAllowlisted(contract) {
+			return types.TokenPair{}, sdkerrors.Wrapf(types.ErrRegistrationNotAllowed, "contract %s not in allowlist", contract)
+		}
+	default:
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrRegistrationNotAllowed, "contract %s must be registered with fee", contract)
+	}
+
+	return k.RegisterERC20(ctx, contract, types.REGISTRATION_ORIGIN_EVM_HOOK)
+}
+
+// RegisterERC20ByMsg registers the ERC20 contract on request of the sender,
+// which pays the registration fee under the fee policy.
+func (k Keeper) RegisterERC20ByMsg(ctx sdk.Context, sender sdk.AccAddress, contract common.Address) (types.TokenPair, error) {
+	params := k.GetParams(ctx)
+	if params.RegistrationPolicy == types.REGISTRATION_POLICY_ALLOWLIST && !params.IsAllowlisted(contract) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrRegistrationNotAllowed, "contract %s not in allowlist", contract)
+	}
+
+	if params.RegistrationPolicy == types.REGISTRATION_POLICY_FEE && !params.RegistrationFee.IsZero() {
+		// The registration fee is burned
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, params.RegistrationFee)
+		if err != nil {
+			return types.TokenPair{}, err
+		}
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, params.RegistrationFee)
+		if err != nil {
+			return types.TokenPair{}, err
+		}
+	}
+
+	return k.RegisterERC20(ctx, contract, types.REGISTRATION_ORIGIN_MSG)
+}
+
+// erc20Selectors are the selectors of the ERC20 methods required to be
+// dispatched by a registered contract
+var erc20Selectors = func() [][]byte {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	var selectors [][]byte
+	for _, method := range []string{"totalSupply", "balanceOf", "transfer", "transferFrom", "approve", "allowance"} {
+		selectors = append(selectors, erc20.Methods[method].ID)
+	}
+	return selectors
+}()
+
+// ValidateERC20Contract checks that the bytecode of the contract dispatches the
+// ERC20 methods, and that the ERC20 data and supply can be queried.
+// NOTE: proxy contracts are rejected since the dispatch is not in their bytecode.
+func (k Keeper) ValidateERC20Contract(ctx sdk.Context, contract common.Address) error {
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if account == nil || !account.IsContract() {
+		return sdkerrors.Wrapf(types.ErrInvalidERC20Contract, "no contract code at %s", contract)
+	}
+
+	code := k.evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
+	for _, selector := range erc20Selectors {
+		// The function dispatcher pushes the selectors onto the stack by PUSH4
+		if !bytes.Contains(code, append([]byte{byte(vm.PUSH4)}, selector...)) {
+			return sdkerrors.Wrapf(types.ErrInvalidERC20Contract, "contract %s does not dispatch ERC20 method %x", contract, selector)
+		}
+	}
+
+	if _, err := k.QueryERC20(ctx, contract); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidERC20Contract, "contract %s: %s", contract, err)
+	}
+	if _, err := k.totalSupply(ctx, contract); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidERC20Contract, "contract %s: %s", contract, err)
+	}
+
+	return nil
+}
+
+// CreateCoinMetadata generates the metadata of the coin of the base denom to
+// pair with the ERC20 token.
+func (k Keeper) CreateCoinMetadata(ctx sdk.Context, contract common.Address, base string) (*banktypes.Metadata, error) {
 	strContract := contract.String()
 
 	erc20Data, err := k.QueryERC20(ctx, contract)
@@ -115,19 +265,16 @@ func (k Keeper) CreateCoinMetadata(ctx sdk.Context, contract common.Address) (*b
 		return nil, err
 	}
 
-	_, found := k.bankKeeper.GetDenomMetaData(ctx, types.CreateDenom(strContract))
+	_, found := k.bankKeeper.GetDenomMetaData(ctx, base)
 	if found {
 		// metadata already exists; exit
 		return nil, sdkerrors.Wrap(types.ErrInternalTokenPair, "denom metadata already registered")
 	}
 
-	if k.IsDenomRegistered(ctx, types.CreateDenom(strContract)) {
+	if k.IsDenomRegistered(ctx, base) {
 		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin denomination already registered: %s", erc20Data.Name)
 	}
 
-	// base denomination
-	base := types.CreateDenom(strContract)
-
 	// create a bank denom metadata based on the ERC20 token ABI details
 	// metadata name should always be the contract since it's the key
 	// to the bank store
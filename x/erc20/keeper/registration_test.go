@@ -1,8 +1,14 @@
 package keeper_test
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/contracts"
 	gridiron "github.com/gridiron-zone/gridiron/types"
 	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
 	"github.com/stretchr/testify/require"
@@ -32,7 +38,7 @@ func (suite *KeeperTestSuite) TestKeeper_RegisterCoin() {
 	require.NoError(t, err)
 	require.Equal(t, "0xd567B3d7B8FE3C79a1AD8dA978812cfC4Fa05e75", addr.String())
 
-	tokenPair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, addr)
+	tokenPair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, addr, erc20types.REGISTRATION_ORIGIN_MSG)
 	require.NoError(t, err)
 
 	require.Equal(t, "0xd567B3d7B8FE3C79a1AD8dA978812cfC4Fa05e75", tokenPair.Erc20Address)
@@ -46,3 +52,93 @@ func (suite *KeeperTestSuite) TestKeeper_RegisterCoin() {
 	require.Equal(t, "USDT", erc20Data.Symbol)
 	require.Equal(t, uint8(0), erc20Data.Decimals)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_DeployContract() {
+	suite.SetupTest()
+	t := suite.T()
+
+	_, err := suite.app.Erc20Keeper.DeployContract(suite.ctx, erc20types.ModuleAddress, contracts.OracleFeedContract, "invalid")
+	require.ErrorIs(t, err, erc20types.ErrABIPack)
+
+	feed, err := suite.app.Erc20Keeper.DeployContract(suite.ctx, erc20types.ModuleAddress, contracts.OracleFeedContract, uint8(18), "uusd / uUSD")
+	require.NoError(t, err)
+
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.OracleFeedContract.ABI, erc20types.ModuleAddress, feed, "updateAnswer", big.NewInt(1e18), big.NewInt(1000))
+	require.NoError(t, err)
+
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.OracleFeedContract.ABI, erc20types.ModuleAddress, feed, "latestRoundData")
+	require.NoError(t, err)
+	round, err := contracts.OracleFeedContract.ABI.Unpack("latestRoundData", res.Ret)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(1), big.NewInt(1e18), big.NewInt(1000), big.NewInt(1000), big.NewInt(1)}, round)
+
+	res, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.OracleFeedContract.ABI, erc20types.ModuleAddress, feed, "description")
+	require.NoError(t, err)
+	description, err := contracts.OracleFeedContract.ABI.Unpack("description", res.Ret)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"uusd / uUSD"}, description)
+
+	// Only the deployer can update the answer
+	other := common.BytesToAddress(suite.app.AccountKeeper.GetModuleAddress(govtypes.ModuleName))
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.OracleFeedContract.ABI, other, feed, "updateAnswer", big.NewInt(1e18), big.NewInt(1000))
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RegistrationPolicy() {
+	suite.SetupTest()
+	var (
+		t      = suite.T()
+		k      = suite.app.Erc20Keeper
+		sender = sdk.AccAddress([]byte("erc20_registrant____"))
+		fee    = sdk.NewCoins(sdk.NewInt64Coin(gridiron.AttoIronDenom, 1000))
+	)
+
+	// Contracts not implementing ERC20 are rejected
+	k.SetParams(suite.ctx, erc20types.NewParams(erc20types.REGISTRATION_POLICY_OPEN, nil, fee, nil))
+	feed, err := k.DeployContract(suite.ctx, erc20types.ModuleAddress, contracts.OracleFeedContract, uint8(18), "uusd / uUSD")
+	require.NoError(t, err)
+	_, err = k.RegisterERC20ByHook(suite.ctx, feed)
+	require.ErrorIs(t, err, erc20types.ErrInvalidERC20Contract)
+	_, err = k.RegisterERC20ByHook(suite.ctx, common.BytesToAddress(sender))
+	require.ErrorIs(t, err, erc20types.ErrInvalidERC20Contract)
+
+	contract1, err := k.DeployERC20Contract(suite.ctx, suite.coinMetadata)
+	require.NoError(t, err)
+	contract2, err := k.DeployERC20Contract(suite.ctx, suite.coinMetadata)
+	require.NoError(t, err)
+	contract3, err := k.DeployERC20Contract(suite.ctx, suite.coinMetadata)
+	require.NoError(t, err)
+
+	// Allowlist only
+	k.SetParams(suite.ctx, erc20types.NewParams(erc20types.REGISTRATION_POLICY_ALLOWLIST, []string{contract1.String()}, fee, nil))
+	_, err = k.RegisterERC20ByHook(suite.ctx, contract2)
+	require.ErrorIs(t, err, erc20types.ErrRegistrationNotAllowed)
+	_, err = k.RegisterERC20ByMsg(suite.ctx, sender, contract2)
+	require.ErrorIs(t, err, erc20types.ErrRegistrationNotAllowed)
+	pair, err := k.RegisterERC20ByHook(suite.ctx, contract1)
+	require.NoError(t, err)
+	require.Equal(t, erc20types.REGISTRATION_ORIGIN_EVM_HOOK, pair.Origin)
+	require.True(t, pair.Enabled)
+
+	// Fee to register
+	k.SetParams(suite.ctx, erc20types.NewParams(erc20types.REGISTRATION_POLICY_FEE, nil, fee, nil))
+	_, err = k.RegisterERC20ByHook(suite.ctx, contract2)
+	require.ErrorIs(t, err, erc20types.ErrRegistrationNotAllowed)
+	_, err = k.RegisterERC20ByMsg(suite.ctx, sender, contract2)
+	require.Error(t, err)
+	require.False(t, k.IsERC20Registered(suite.ctx, contract2))
+
+	require.NoError(t, app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, fee))
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, gridiron.AttoIronDenom)
+	pair, err = k.RegisterERC20ByMsg(suite.ctx, sender, contract2)
+	require.NoError(t, err)
+	require.Equal(t, erc20types.REGISTRATION_ORIGIN_MSG, pair.Origin)
+	require.True(t, suite.app.BankKeeper.GetAllBalances(suite.ctx, sender).IsZero())
+	require.Equal(t, supply.Sub(fee[0]), suite.app.BankKeeper.GetSupply(suite.ctx, gridiron.AttoIronDenom))
+
+	// Open
+	k.SetParams(suite.ctx, erc20types.NewParams(erc20types.REGISTRATION_POLICY_OPEN, nil, fee, nil))
+	pair, err = k.RegisterERC20ByHook(suite.ctx, contract3)
+	require.NoError(t, err)
+	require.Equal(t, erc20types.REGISTRATION_ORIGIN_EVM_HOOK, pair.Origin)
+}
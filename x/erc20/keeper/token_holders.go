@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+)
+
+// SetTokenHolder indexes the holder of an ERC20-native token
+func (k Keeper) SetTokenHolder(ctx sdk.Context, holder sdk.AccAddress, erc20 common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenHolderKey(holder, erc20), []byte{1})
+}
+
+// DeleteTokenHolder removes the holder of an ERC20-native token from the index
+func (k Keeper) DeleteTokenHolder(ctx sdk.Context, holder sdk.AccAddress, erc20 common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenHolderKey(holder, erc20))
+}
+
+// HasTokenHolder returns true if the holder of an ERC20-native token is indexed
+func (k Keeper) HasTokenHolder(ctx sdk.Context, holder sdk.AccAddress, erc20 common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTokenHolderKey(holder, erc20))
+}
+
+// IterateHeldTokens iterates the ERC20-native tokens held by the holder
+func (k Keeper) IterateHeldTokens(ctx sdk.Context, holder sdk.AccAddress, cb func(erc20 common.Address) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenHolderPrefix(holder))
+	defer iterator.Close()
+
+	prefixLen := len(types.GetTokenHolderPrefix(holder))
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(common.BytesToAddress(iterator.Key()[prefixLen:])) {
+			break
+		}
+	}
+}
+
+// DeleteTokenHolders removes all the holders of an ERC20-native token from the index
+func (k Keeper) DeleteTokenHolders(ctx sdk.Context, erc20 common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenHolder)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.HasSuffix(iterator.Key(), erc20.Bytes()) {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllTokenHolders returns all the indexed holders of ERC20-native tokens
+func (k Keeper) GetAllTokenHolders(ctx sdk.Context) []types.TokenHolder {
+	var holders []types.TokenHolder
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenHolder)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of the form KeyPrefixTokenHolder || holderLen (1 byte) || holder || erc20
+		key := iterator.Key()[len(types.KeyPrefixTokenHolder):]
+		holderLen := int(key[0])
+		holders = append(holders, types.TokenHolder{
+			Erc20Address: common.BytesToAddress(key[1+holderLen:]).String(),
+			Address:      sdk.AccAddress(key[1 : 1+holderLen]).String(),
+		})
+	}
+
+	return holders
+}
+
+// updateTokenHolders indexes or unindexes the accounts by their balances of
+// the ERC20-native token, which is called after the token is transferred.
+func (k Keeper) updateTokenHolders(ctx sdk.Context, erc20 common.Address, accounts ...common.Address) {
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, erc20))
+	if !found || !pair.IsNativeERC20() || pair.HasDistinctCoin() {
+		return
+	}
+
+	for _, account := range accounts {
+		if account == (common.Address{}) {
+			continue
+		}
+
+		balance, err := k.balanceOf(ctx, erc20, account)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get balance of token holder", "contract", erc20, "holder", account, "error", err.Error())
+			continue
+		}
+
+		holder := sdk.AccAddress(account.Bytes())
+		if balance.Sign() > 0 {
+			k.SetTokenHolder(ctx, holder, erc20)
+		} else {
+			k.DeleteTokenHolder(ctx, holder, erc20)
+		}
+	}
+}
+
+// GetNativeErc20Balances returns the balances of the ERC20-native tokens of the
+// enabled token pairs held by the account
+func (k Keeper) GetNativeErc20Balances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	balances := sdk.NewCoins()
+	k.IterateHeldTokens(ctx, addr, func(erc20 common.Address) (stop bool) {
+		pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, erc20))
+		if !found || !pair.Enabled {
+			return false
+		}
+		balance, err := k.balanceOf(ctx, erc20, common.BytesToAddress(addr))
+		if err == nil && balance.Sign() > 0 {
+			balances = balances.Add(sdk.NewCoin(types.CreateDenom(erc20.String()), sdk.NewIntFromBigInt(balance)))
+		}
+		return false
+	})
+	return balances
+}
+
+// GetNativeErc20TotalSupply returns the total supply of all the ERC20-native
+// tokens of the enabled token pairs
+func (k Keeper) GetNativeErc20TotalSupply(ctx sdk.Context) sdk.Coins {
+	supply := sdk.NewCoins()
+	for _, pair := range k.GetAllTokenPairs(ctx) {
+		if !pair.IsNativeERC20() || pair.HasDistinctCoin() || !pair.Enabled {
+			continue
+		}
+		amt, err := k.totalSupply(ctx, pair.GetERC20Contract())
+		if err == nil && amt.Sign() > 0 {
+			supply = supply.Add(sdk.NewCoin(pair.Denom, sdk.NewIntFromBigInt(amt)))
+		}
+	}
+	return supply
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tharsis/evmos/v4/contracts"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_TokenHolders() {
+	suite.SetupTest()
+	var (
+		t     = suite.T()
+		k     = suite.app.Erc20Keeper
+		erc20 = contracts.ERC20MinterBurnerDecimalsContract.ABI
+		addr1 = sdk.AccAddress([]byte("token_holder_1______"))
+		addr2 = sdk.AccAddress([]byte("token_holder_2______"))
+		addr3 = sdk.AccAddress([]byte("token_holder_3______"))
+	)
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3} {
+		suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+	}
+
+	contract, err := k.DeployERC20Contract(suite.ctx, suite.coinMetadata)
+	require.NoError(t, err)
+	pair, err := k.RegisterERC20(suite.ctx, contract, erc20types.REGISTRATION_ORIGIN_MSG)
+	require.NoError(t, err)
+	require.True(t, pair.IsNativeERC20())
+
+	// Mint and transfer through the bank
+	err = k.SendCoins(suite.ctx, nil, addr1, nil, sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 1000)))
+	require.NoError(t, err)
+	require.True(t, k.HasTokenHolder(suite.ctx, addr1, contract))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 1000)), k.GetNativeErc20Balances(suite.ctx, addr1))
+
+	err = k.SendCoins(suite.ctx, addr1, addr2, nil, sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 1000)))
+	require.NoError(t, err)
+	require.False(t, k.HasTokenHolder(suite.ctx, addr1, contract))
+	require.True(t, k.HasTokenHolder(suite.ctx, addr2, contract))
+	require.True(t, k.GetNativeErc20Balances(suite.ctx, addr1).Empty())
+
+	// Transfer in the EVM, which is indexed by the hooks
+	_, err = k.CallEVM(suite.ctx, erc20, common.BytesToAddress(addr2), contract, "transfer", common.BytesToAddress(addr3), big.NewInt(400))
+	require.NoError(t, err)
+	require.False(t, k.HasTokenHolder(suite.ctx, addr3, contract))
+
+	event := erc20.Events[erc20types.ERC20EventTransfer]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(400))
+	require.NoError(t, err)
+	receipt := &ethtypes.Receipt{Logs: []*ethtypes.Log{{
+		Address: contract,
+		Topics:  []common.Hash{event.ID, common.BytesToHash(addr2), common.BytesToHash(addr3)},
+		Data:    data,
+	}}}
+	err = k.EvmHooks().PostTxProcessing(suite.ctx, nil, receipt)
+	require.NoError(t, err)
+	require.True(t, k.HasTokenHolder(suite.ctx, addr2, contract))
+	require.True(t, k.HasTokenHolder(suite.ctx, addr3, contract))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 400)), k.GetNativeErc20Balances(suite.ctx, addr3))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 1000)), k.GetNativeErc20TotalSupply(suite.ctx))
+	require.Len(t, k.GetAllTokenHolders(suite.ctx), 2)
+}
@@ -15,6 +15,12 @@ func CreateDenom(address string) string {
 	return fmt.Sprintf("%s/%s", DenomPrefix, address)
 }
 
+// HasErc20DenomPrefix returns true if the denomination has the module prefix,
+// which is reserved for the representation of ERC20 tokens
+func HasErc20DenomPrefix(denom string) bool {
+	return strings.HasPrefix(denom, DenomPrefix+"/")
+}
+
 // SanitizeERC20Name enforces snake_case and removes all "coin" and "token"
 // strings from the ERC20 name.
 func SanitizeERC20Name(name string) string {
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -55,6 +56,85 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d38f3adc6264ee62, []int{0}
 }
 
+// RegistrationOrigin enumerates how a token pair is registered.
+type RegistrationOrigin int32
+
+const (
+	// REGISTRATION_ORIGIN_UNSPECIFIED defines an unknown origin, e.g., of the
+	// token pairs registered before the origin is recorded.
+	REGISTRATION_ORIGIN_UNSPECIFIED RegistrationOrigin = 0
+	// REGISTRATION_ORIGIN_COIN defines the registration of a native coin.
+	REGISTRATION_ORIGIN_COIN RegistrationOrigin = 1
+	// REGISTRATION_ORIGIN_EVM_HOOK defines the automatic registration of an
+	// ERC20 contract by its emitted Transfer event.
+	REGISTRATION_ORIGIN_EVM_HOOK RegistrationOrigin = 2
+	// REGISTRATION_ORIGIN_MSG defines the registration of an ERC20 contract by
+	// MsgRegisterERC20.
+	REGISTRATION_ORIGIN_MSG RegistrationOrigin = 3
+	// REGISTRATION_ORIGIN_GOVERNANCE defines the registration of a native coin
+	// or an ERC20 contract by a gov proposal.
+	REGISTRATION_ORIGIN_GOVERNANCE RegistrationOrigin = 4
+)
+
+var RegistrationOrigin_name = map[int32]string{
+	0: "REGISTRATION_ORIGIN_UNSPECIFIED",
+	1: "REGISTRATION_ORIGIN_COIN",
+	2: "REGISTRATION_ORIGIN_EVM_HOOK",
+	3: "REGISTRATION_ORIGIN_MSG",
+	4: "REGISTRATION_ORIGIN_GOVERNANCE",
+}
+
+var RegistrationOrigin_value = map[string]int32{
+	"REGISTRATION_ORIGIN_UNSPECIFIED": 0,
+	"REGISTRATION_ORIGIN_COIN":        1,
+	"REGISTRATION_ORIGIN_EVM_HOOK":    2,
+	"REGISTRATION_ORIGIN_MSG":         3,
+	"REGISTRATION_ORIGIN_GOVERNANCE":  4,
+}
+
+func (x RegistrationOrigin) String() string {
+	return proto.EnumName(RegistrationOrigin_name, int32(x))
+}
+
+func (RegistrationOrigin) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d38f3adc6264ee62, []int{1}
+}
+
+// RegistrationPolicy enumerates the policies of registering ERC20 contracts.
+type RegistrationPolicy int32
+
+const (
+	// REGISTRATION_POLICY_OPEN allows any ERC20 contract to be registered, and
+	// automatically by its emitted Transfer event.
+	REGISTRATION_POLICY_OPEN RegistrationPolicy = 0
+	// REGISTRATION_POLICY_ALLOWLIST only allows the ERC20 contracts in the
+	// allowlist to be registered.
+	REGISTRATION_POLICY_ALLOWLIST RegistrationPolicy = 1
+	// REGISTRATION_POLICY_FEE only allows the ERC20 contracts to be registered by
+	// MsgRegisterERC20 paying the registration fee.
+	REGISTRATION_POLICY_FEE RegistrationPolicy = 2
+)
+
+var RegistrationPolicy_name = map[int32]string{
+	0: "REGISTRATION_POLICY_OPEN",
+	1: "REGISTRATION_POLICY_ALLOWLIST",
+	2: "REGISTRATION_POLICY_FEE",
+}
+
+var RegistrationPolicy_value = map[string]int32{
+	"REGISTRATION_POLICY_OPEN":      0,
+	"REGISTRATION_POLICY_ALLOWLIST": 1,
+	"REGISTRATION_POLICY_FEE":       2,
+}
+
+func (x RegistrationPolicy) String() string {
+	return proto.EnumName(RegistrationPolicy_name, int32(x))
+}
+
+func (RegistrationPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d38f3adc6264ee62, []int{2}
+}
+
 // TokenPair defines an instance that records pairing consisting of a Cosmos
 // native Coin and an ERC20 token address.
 type TokenPair struct {
@@ -64,6 +144,10 @@ type TokenPair struct {
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 	// ERC20 owner address ENUM (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,3,opt,name=contract_owner,json=contractOwner,proto3,enum=gridiron.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// allow the conversion between the coin and the ERC20 token
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// how the token pair is registered
+	Origin RegistrationOrigin `protobuf:"varint,5,opt,name=origin,proto3,enum=gridiron.erc20.v1.RegistrationOrigin" json:"origin,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -120,34 +204,470 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TokenPair) GetOrigin() RegistrationOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return REGISTRATION_ORIGIN_UNSPECIFIED
+}
+
+// TokenHolder defines an account holding an ERC20-native token, which is
+// indexed so that the token can be listed in the account's balances.
+type TokenHolder struct {
+	// address of ERC20 contract token
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// bech32 address of the holder account
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *TokenHolder) Reset()         { *m = TokenHolder{} }
+func (m *TokenHolder) String() string { return proto.CompactTextString(m) }
+func (*TokenHolder) ProtoMessage()    {}
+func (*TokenHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d38f3adc6264ee62, []int{1}
+}
+func (m *TokenHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenHolder.Merge(m, src)
+}
+func (m *TokenHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenHolder proto.InternalMessageInfo
+
+func (m *TokenHolder) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// IBCEscrow defines the amount of an ERC20-native token escrowed by the ICS-20
+// transfer module for a channel, which is the amount of the vouchers
+// outstanding on the counterparty chain.
+type IBCEscrow struct {
+	// channel identifier on this chain
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// address of ERC20 contract token
+	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// escrowed amount
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *IBCEscrow) Reset()         { *m = IBCEscrow{} }
+func (m *IBCEscrow) String() string { return proto.CompactTextString(m) }
+func (*IBCEscrow) ProtoMessage()    {}
+func (*IBCEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d38f3adc6264ee62, []int{2}
+}
+func (m *IBCEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCEscrow.Merge(m, src)
+}
+func (m *IBCEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCEscrow proto.InternalMessageInfo
+
+func (m *IBCEscrow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IBCEscrow) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+// RegisterCoinProposal is a gov Content type to register a token pair for a
+// native coin, whose denom metadata must exist.
+type RegisterCoinProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// cosmos base denomination of the native coin
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
+func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterCoinProposal) ProtoMessage()    {}
+func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d38f3adc6264ee62, []int{3}
+}
+func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterCoinProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterCoinProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterCoinProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCoinProposal.Merge(m, src)
+}
+func (m *RegisterCoinProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterCoinProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCoinProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterCoinProposal proto.InternalMessageInfo
+
+func (m *RegisterCoinProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RegisterCoinProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RegisterCoinProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RegisterERC20Proposal is a gov Content type to register a token pair for an
+// ERC20-native token, regardless of the registration policy.
+type RegisterERC20Proposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// hex address of the ERC20 contract
+	Erc20Address string `protobuf:"bytes,3,opt,name=erc20address,proto3" json:"erc20address,omitempty"`
+	// cosmos base denomination of the coin distinct from the ERC20 token, which
+	// are converted to each other by MsgConvertCoin and MsgConvertERC20;
+	// if empty, the coin "erc20/{address}" just represents the ERC20 token
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
+func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterERC20Proposal) ProtoMessage()    {}
+func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d38f3adc6264ee62, []int{4}
+}
+func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterERC20Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterERC20Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterERC20Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterERC20Proposal.Merge(m, src)
+}
+func (m *RegisterERC20Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterERC20Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterERC20Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterERC20Proposal proto.InternalMessageInfo
+
+func (m *RegisterERC20Proposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RegisterERC20Proposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RegisterERC20Proposal) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *RegisterERC20Proposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ToggleTokenConversionProposal is a gov Content type to toggle the conversion
+// of a token pair of ERC20-native token.
+type ToggleTokenConversionProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or
+	// the Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConversionProposal{} }
+func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
+func (*ToggleTokenConversionProposal) ProtoMessage()    {}
+func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d38f3adc6264ee62, []int{5}
+}
+func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ToggleTokenConversionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ToggleTokenConversionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ToggleTokenConversionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ToggleTokenConversionProposal.Merge(m, src)
+}
+func (m *ToggleTokenConversionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ToggleTokenConversionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ToggleTokenConversionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ToggleTokenConversionProposal proto.InternalMessageInfo
+
+func (m *ToggleTokenConversionProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ToggleTokenConversionProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ToggleTokenConversionProposal) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// DeleteTokenPairProposal is a gov Content type to delete a token pair of
+// ERC20-native token, e.g., of a spam contract.
+type DeleteTokenPairProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or
+	// the Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *DeleteTokenPairProposal) Reset()         { *m = DeleteTokenPairProposal{} }
+func (m *DeleteTokenPairProposal) String() string { return proto.CompactTextString(m) }
+func (*DeleteTokenPairProposal) ProtoMessage()    {}
+func (*DeleteTokenPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d38f3adc6264ee62, []int{6}
+}
+func (m *DeleteTokenPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTokenPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTokenPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteTokenPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTokenPairProposal.Merge(m, src)
+}
+func (m *DeleteTokenPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTokenPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTokenPairProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTokenPairProposal proto.InternalMessageInfo
+
+func (m *DeleteTokenPairProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *DeleteTokenPairProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeleteTokenPairProposal) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("gridiron.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("gridiron.erc20.v1.RegistrationOrigin", RegistrationOrigin_name, RegistrationOrigin_value)
+	proto.RegisterEnum("gridiron.erc20.v1.RegistrationPolicy", RegistrationPolicy_name, RegistrationPolicy_value)
 	proto.RegisterType((*TokenPair)(nil), "gridiron.erc20.v1.TokenPair")
+	proto.RegisterType((*TokenHolder)(nil), "gridiron.erc20.v1.TokenHolder")
+	proto.RegisterType((*IBCEscrow)(nil), "gridiron.erc20.v1.IBCEscrow")
+	proto.RegisterType((*RegisterCoinProposal)(nil), "gridiron.erc20.v1.RegisterCoinProposal")
+	proto.RegisterType((*RegisterERC20Proposal)(nil), "gridiron.erc20.v1.RegisterERC20Proposal")
+	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "gridiron.erc20.v1.ToggleTokenConversionProposal")
+	proto.RegisterType((*DeleteTokenPairProposal)(nil), "gridiron.erc20.v1.DeleteTokenPairProposal")
 }
 
 func init() { proto.RegisterFile("gridiron/erc20/v1/erc20.proto", fileDescriptor_d38f3adc6264ee62) }
 
 var fileDescriptor_d38f3adc6264ee62 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x84, 0x30, 0xf4, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xa0, 0xb2, 0x7a, 0x10, 0xc1, 0x32, 0x43, 0x29, 0x91, 0xf4,
-	0xfc, 0xf4, 0x7c, 0xb0, 0xa4, 0x3e, 0x88, 0x05, 0x51, 0xa7, 0xd4, 0xc3, 0xc8, 0xc5, 0x19, 0x92,
-	0x9f, 0x9d, 0x9a, 0x17, 0x90, 0x98, 0x59, 0x24, 0xa4, 0xcc, 0xc5, 0x0b, 0x56, 0x1f, 0x9f, 0x98,
-	0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x03, 0x16, 0x74,
-	0x84, 0x88, 0x09, 0x89, 0x70, 0xb1, 0xa6, 0xa4, 0xe6, 0xe5, 0xe7, 0x4a, 0x30, 0x81, 0x25, 0x21,
-	0x1c, 0x21, 0x3b, 0x2e, 0xbe, 0xe4, 0xfc, 0xbc, 0x92, 0xa2, 0xc4, 0xe4, 0x92, 0xf8, 0xfc, 0xf2,
-	0xbc, 0xd4, 0x22, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x3e, 0x23, 0x71, 0x3d, 0x74, 0x97, 0xe8, 0xf9,
-	0x83, 0xa4, 0x83, 0x78, 0x61, 0xca, 0xc1, 0x5c, 0x2b, 0x96, 0x17, 0x0b, 0xe4, 0x19, 0xb5, 0xbc,
-	0xb8, 0x58, 0xc1, 0x5c, 0x21, 0x51, 0x2e, 0x41, 0xff, 0x70, 0x3f, 0xd7, 0xa0, 0xf8, 0x50, 0xbf,
-	0xe0, 0x00, 0x57, 0x67, 0x4f, 0x37, 0x4f, 0x57, 0x17, 0x01, 0x06, 0x21, 0x01, 0x2e, 0x1e, 0x88,
-	0xb0, 0xaf, 0xbf, 0x4b, 0xa8, 0x8f, 0xab, 0x00, 0xa3, 0x90, 0x10, 0x17, 0x1f, 0x44, 0xc4, 0x35,
-	0x22, 0xc4, 0x35, 0xc8, 0xcf, 0xd1, 0x47, 0x80, 0x49, 0x8a, 0xa5, 0x63, 0xb1, 0x1c, 0x83, 0x93,
-	0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x5d, 0xa7, 0x5b, 0x95, 0x9f, 0x97, 0x0a, 0xe3,
-	0xe8, 0x57, 0x40, 0x03, 0xb5, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x54, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xb7, 0x87, 0xab, 0x72, 0x01, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0xf7, 0x24, 0x01, 0x36, 0x8f, 0x3f, 0x32, 0x23, 0x10, 0x16, 0x4b, 0x9c, 0x6c, 0xd0, 0xae,
+	0x10, 0x12, 0x09, 0xb0, 0xb7, 0x95, 0x56, 0xab, 0x60, 0x4c, 0xf0, 0x36, 0xd8, 0x91, 0x13, 0xa0,
+	0xed, 0x25, 0x32, 0xf6, 0xc8, 0xb8, 0x38, 0x33, 0xd1, 0xd8, 0xfc, 0xeb, 0x27, 0xe8, 0xad, 0xbd,
+	0xf5, 0x5a, 0xa9, 0x5f, 0xa4, 0x47, 0x8e, 0x1c, 0xab, 0x1e, 0x50, 0x05, 0x52, 0xd5, 0x8f, 0x51,
+	0xc5, 0x7f, 0x68, 0x4a, 0x72, 0xa8, 0xd4, 0xf6, 0xe4, 0x79, 0xef, 0xf7, 0xe6, 0xfd, 0xde, 0xfb,
+	0x3d, 0xbf, 0x81, 0x82, 0xcb, 0x3d, 0xc7, 0xe3, 0x8c, 0x56, 0x09, 0xb7, 0x37, 0xd7, 0xab, 0x67,
+	0x1b, 0xf1, 0xa1, 0xd2, 0xe3, 0x2c, 0x64, 0x78, 0x36, 0x85, 0x2b, 0xb1, 0xf7, 0x6c, 0x63, 0x71,
+	0xce, 0x65, 0x2e, 0x8b, 0xd0, 0x6a, 0xff, 0x14, 0x07, 0x96, 0x3f, 0x21, 0xc8, 0xb7, 0xd9, 0x09,
+	0xa1, 0x4d, 0xcb, 0xe3, 0x78, 0x19, 0xa6, 0xa3, 0xf8, 0x8e, 0xe5, 0x38, 0x9c, 0x04, 0x81, 0x84,
+	0x4a, 0x68, 0x25, 0x6f, 0x4e, 0x45, 0xce, 0x5a, 0xec, 0xc3, 0x73, 0x30, 0xe6, 0x10, 0xca, 0xba,
+	0x52, 0x26, 0x02, 0x63, 0x03, 0xff, 0x07, 0x33, 0x36, 0xa3, 0x21, 0xb7, 0xec, 0xb0, 0xc3, 0xce,
+	0x29, 0xe1, 0x52, 0xb6, 0x84, 0x56, 0x66, 0x36, 0xa5, 0xca, 0x50, 0x29, 0x15, 0xa3, 0x8f, 0x9b,
+	0xd3, 0x69, 0x7c, 0x64, 0x62, 0x09, 0x26, 0x08, 0xb5, 0x8e, 0x7c, 0xe2, 0x48, 0xb9, 0x12, 0x5a,
+	0xf9, 0xcd, 0x4c, 0x4d, 0xfc, 0x2f, 0x8c, 0x33, 0xee, 0xb9, 0x1e, 0x95, 0xc6, 0xa2, 0x94, 0x7f,
+	0x8e, 0x48, 0x69, 0x12, 0xd7, 0x0b, 0x42, 0x6e, 0x85, 0x1e, 0xa3, 0x46, 0x14, 0x6c, 0x26, 0x97,
+	0xfe, 0xc9, 0x7d, 0x7e, 0x53, 0x44, 0xe5, 0x06, 0x4c, 0x46, 0x7d, 0xee, 0x32, 0xdf, 0x21, 0xdf,
+	0xd9, 0xa9, 0x04, 0x13, 0x29, 0x1c, 0xf7, 0x9a, 0x9a, 0xe5, 0xd7, 0x08, 0xf2, 0xda, 0x96, 0xa2,
+	0x06, 0x36, 0x67, 0xe7, 0xb8, 0x00, 0x60, 0x1f, 0x5b, 0x94, 0x12, 0xbf, 0xe3, 0x39, 0x49, 0xa6,
+	0x7c, 0xe2, 0xd1, 0x9c, 0x61, 0xae, 0xcc, 0x08, 0xae, 0x1d, 0x18, 0xb7, 0xba, 0xec, 0x94, 0x86,
+	0x91, 0x6e, 0xf9, 0xad, 0xca, 0xd5, 0x4d, 0x51, 0xf8, 0x70, 0x53, 0xfc, 0xcb, 0xf5, 0xc2, 0xe3,
+	0xd3, 0xa3, 0x8a, 0xcd, 0xba, 0x55, 0x9b, 0x05, 0x5d, 0x16, 0x24, 0x9f, 0xb5, 0xc0, 0x39, 0xa9,
+	0x86, 0x97, 0x3d, 0x12, 0x54, 0x34, 0x1a, 0x9a, 0xc9, 0xed, 0xf2, 0x33, 0x98, 0x8b, 0xb5, 0x20,
+	0x5c, 0x61, 0x1e, 0x6d, 0x72, 0xd6, 0x63, 0x81, 0xe5, 0xf7, 0xa7, 0x16, 0x7a, 0xa1, 0x4f, 0x92,
+	0xf2, 0x62, 0x03, 0x97, 0x60, 0xd2, 0x21, 0x81, 0xcd, 0xbd, 0x5e, 0x5f, 0xb8, 0xa4, 0xb0, 0x41,
+	0xd7, 0xd7, 0x69, 0x67, 0x07, 0xa6, 0x1d, 0x69, 0x2a, 0x94, 0x5f, 0x22, 0x98, 0x4f, 0xc9, 0x54,
+	0x53, 0xd9, 0x5c, 0xff, 0x61, 0xb6, 0x32, 0xc4, 0xaa, 0xa4, 0x4a, 0x65, 0x07, 0x94, 0xb2, 0x1e,
+	0xfe, 0x7f, 0xb9, 0xe1, 0x8a, 0x02, 0x28, 0xb4, 0x99, 0xeb, 0xfa, 0x24, 0x9a, 0xb5, 0xc2, 0xe8,
+	0x19, 0xe1, 0x81, 0xc7, 0x7e, 0x8a, 0x0c, 0x61, 0x3f, 0x65, 0x2a, 0x43, 0x64, 0x24, 0xa4, 0x5d,
+	0x58, 0xd8, 0x26, 0x3e, 0x09, 0xc9, 0xfd, 0x22, 0xfd, 0x4a, 0xba, 0xd5, 0xff, 0x61, 0x2c, 0xde,
+	0x98, 0x79, 0x98, 0x35, 0x0e, 0x75, 0xd5, 0xec, 0xec, 0xeb, 0xad, 0xa6, 0xaa, 0x68, 0x3b, 0x9a,
+	0xba, 0x2d, 0x0a, 0x58, 0x84, 0xa9, 0xd8, 0xbd, 0x67, 0x6c, 0xef, 0x37, 0x54, 0x11, 0x61, 0x0c,
+	0x33, 0xb1, 0x47, 0x7d, 0xdc, 0x56, 0x4d, 0xbd, 0xd6, 0x10, 0x33, 0x8b, 0xb9, 0x17, 0x6f, 0x65,
+	0x61, 0xf5, 0x1d, 0x02, 0x3c, 0xbc, 0x3a, 0x78, 0x19, 0x8a, 0xa6, 0x5a, 0xd7, 0x5a, 0x6d, 0xb3,
+	0xd6, 0xd6, 0x0c, 0xbd, 0x63, 0x98, 0x5a, 0x5d, 0xd3, 0x1f, 0xf0, 0x2c, 0x81, 0x34, 0x2a, 0x48,
+	0x31, 0x34, 0x5d, 0x44, 0xb8, 0x04, 0x4b, 0xa3, 0x50, 0xf5, 0x60, 0xaf, 0xb3, 0x6b, 0x18, 0x8f,
+	0xc4, 0x0c, 0xfe, 0x1d, 0x16, 0x46, 0x45, 0xec, 0xb5, 0xea, 0x62, 0x16, 0x97, 0x41, 0x1e, 0x05,
+	0xd6, 0x8d, 0x83, 0x7e, 0x03, 0xba, 0xa2, 0x8a, 0xb9, 0xa4, 0x85, 0x8b, 0x6f, 0x3b, 0x68, 0x32,
+	0xdf, 0xb3, 0x2f, 0x87, 0x8a, 0x6b, 0x1a, 0x0d, 0x4d, 0x79, 0xd2, 0x31, 0x9a, 0xaa, 0x2e, 0x0a,
+	0xf8, 0x0f, 0x28, 0x8c, 0x42, 0x6b, 0x8d, 0x86, 0x71, 0xd8, 0xd0, 0x5a, 0x6d, 0x11, 0x0d, 0x55,
+	0x97, 0x84, 0xec, 0xa8, 0x6a, 0x2a, 0xde, 0x96, 0x76, 0x75, 0x2b, 0xa3, 0xeb, 0x5b, 0x19, 0x7d,
+	0xbc, 0x95, 0xd1, 0xab, 0x3b, 0x59, 0xb8, 0xbe, 0x93, 0x85, 0xf7, 0x77, 0xb2, 0xf0, 0xb4, 0x3a,
+	0xb0, 0xb4, 0xe9, 0x5b, 0xb5, 0xf6, 0x9c, 0x51, 0x72, 0x6f, 0x55, 0x2f, 0x92, 0x87, 0x3b, 0xda,
+	0xe0, 0xa3, 0xf1, 0xe8, 0x35, 0xfe, 0xfb, 0xcb, 0x00, 0x15, 0xa2, 0x1c, 0xc6, 0xd7, 0x05, 0x00,
+	0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -178,6 +698,12 @@ func (this *TokenPair) Equal(that interface{}) bool {
 	if this.ContractOwner != that1.ContractOwner {
 		return false
 	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Origin != that1.Origin {
+		return false
+	}
 	return true
 }
 func (m *TokenPair) Marshal() (dAtA []byte, err error) {
@@ -200,7 +726,22 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.ContractOwner != 0 {
+	if m.Origin != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ContractOwner != 0 {
 		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
 		i--
 		dAtA[i] = 0x18
@@ -219,47 +760,1348 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ToggleTokenConversionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ToggleTokenConversionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ToggleTokenConversionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteTokenPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTokenPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTokenPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.Origin != 0 {
+		n += 1 + sovErc20(uint64(m.Origin))
+	}
+	return n
+}
+
+func (m *TokenHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *ToggleTokenConversionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteTokenPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= RegistrationOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
-	offset -= sovErc20(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *TokenPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	if m.ContractOwner != 0 {
-		n += 1 + sovErc20(uint64(m.ContractOwner))
+func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovErc20(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozErc20(x uint64) (n int) {
-	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
+func (m *DeleteTokenPairProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -282,15 +2124,15 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteTokenPairProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteTokenPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -318,11 +2160,11 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -350,13 +2192,13 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			m.ContractOwner = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -366,11 +2208,24 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractOwner |= Owner(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 // DONTCOVER
 
 import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	erc20types "github.com/tharsis/evmos/v4/x/erc20/types"
 )
 
@@ -11,7 +12,18 @@ var (
 	ErrInternalTokenPair      = erc20types.ErrInternalTokenPair
 	ErrTokenPairNotFound      = erc20types.ErrTokenPairNotFound
 	ErrTokenPairAlreadyExists = erc20types.ErrTokenPairAlreadyExists
+	ErrUndefinedOwner         = erc20types.ErrUndefinedOwner
 	ErrABIPack                = erc20types.ErrABIPack
 	ErrABIUnpack              = erc20types.ErrABIUnpack
 	ErrEVMDenom               = erc20types.ErrEVMDenom
 )
+
+// x/erc20 module sentinel errors not defined by evmos, whose codes are offset
+// to avoid collision within the same codespace
+var (
+	ErrTokenPairDisabled        = sdkerrors.Register(ModuleName, 101, "token pair conversion is disabled")
+	ErrRegistrationNotAllowed   = sdkerrors.Register(ModuleName, 102, "erc20 registration not allowed")
+	ErrInvalidERC20Contract     = sdkerrors.Register(ModuleName, 103, "invalid erc20 contract")
+	ErrInvalidConversionBalance = sdkerrors.Register(ModuleName, 104, "invalid balance after conversion")
+	ErrIBCEscrow                = sdkerrors.Register(ModuleName, 105, "invalid ibc escrow")
+)
@@ -4,3 +4,19 @@ package types
 const (
 	ERC20EventTransfer = "Transfer"
 )
+
+// erc20 module event types
+const (
+	EventTypeRegisterCoin          = "register_coin"
+	EventTypeRegisterERC20         = "register_erc20"
+	EventTypeToggleTokenConversion = "toggle_token_conversion"
+	EventTypeDeleteTokenPair       = "delete_token_pair"
+	EventTypeConvertCoin           = "convert_coin"
+	EventTypeConvertERC20          = "convert_erc20"
+
+	AttributeKeyCosmosCoin = "cosmos_coin"
+	AttributeKeyERC20Token = "erc20_token"
+	AttributeKeyOrigin     = "origin"
+	AttributeKeyEnabled    = "enabled"
+	AttributeKeyReceiver   = "receiver"
+)
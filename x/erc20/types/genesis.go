@@ -1,7 +1,13 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
@@ -20,5 +26,39 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	seenHolders := make(map[string]bool)
+	for _, holder := range gs.TokenHolders {
+		if err := ethermint.ValidateAddress(holder.Erc20Address); err != nil {
+			return err
+		}
+		addr, err := sdk.AccAddressFromBech32(holder.Address)
+		if err != nil {
+			return err
+		}
+		key := string(GetTokenHolderKey(addr, common.HexToAddress(holder.Erc20Address)))
+		if seenHolders[key] {
+			return fmt.Errorf("duplicate holder %s of token %s", holder.Address, holder.Erc20Address)
+		}
+		seenHolders[key] = true
+	}
+
+	seenEscrows := make(map[string]bool)
+	for _, escrow := range gs.IbcEscrows {
+		if err := host.ChannelIdentifierValidator(escrow.ChannelId); err != nil {
+			return err
+		}
+		if err := ethermint.ValidateAddress(escrow.Erc20Address); err != nil {
+			return err
+		}
+		if escrow.Amount.IsNil() || !escrow.Amount.IsPositive() {
+			return fmt.Errorf("escrowed amount of token %s for channel %s must be positive", escrow.Erc20Address, escrow.ChannelId)
+		}
+		key := string(GetIBCEscrowKey(escrow.ChannelId, common.HexToAddress(escrow.Erc20Address)))
+		if seenEscrows[key] {
+			return fmt.Errorf("duplicate escrow of token %s for channel %s", escrow.Erc20Address, escrow.ChannelId)
+		}
+		seenEscrows[key] = true
+	}
+
 	return gs.Params.Validate()
 }
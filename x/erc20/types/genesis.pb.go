@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +31,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// registered token pairs
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// indexed holders of ERC20-native tokens
+	TokenHolders []TokenHolder `protobuf:"bytes,3,rep,name=token_holders,json=tokenHolders,proto3" json:"token_holders"`
+	// amounts of ERC20-native tokens escrowed for IBC transfers
+	IbcEscrows []IBCEscrow `protobuf:"bytes,4,rep,name=ibc_escrows,json=ibcEscrows,proto3" json:"ibc_escrows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,8 +84,32 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetTokenHolders() []TokenHolder {
+	if m != nil {
+		return m.TokenHolders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIbcEscrows() []IBCEscrow {
+	if m != nil {
+		return m.IbcEscrows
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
+	// policy of registering ERC20 contracts
+	RegistrationPolicy RegistrationPolicy `protobuf:"varint,1,opt,name=registration_policy,json=registrationPolicy,proto3,enum=gridiron.erc20.v1.RegistrationPolicy" json:"registration_policy,omitempty" yaml:"registration_policy"`
+	// hex addresses of the ERC20 contracts allowed to be registered under the
+	// allowlist policy
+	RegistrationAllowlist []string `protobuf:"bytes,2,rep,name=registration_allowlist,json=registrationAllowlist,proto3" json:"registration_allowlist,omitempty" yaml:"registration_allowlist"`
+	// fee burned for registering an ERC20 contract under the fee policy
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee" yaml:"registration_fee"`
+	// IBC channels on which the received vouchers of convertible token pairs are
+	// converted to the ERC20 tokens
+	AutoConvertChannels []string `protobuf:"bytes,4,rep,name=auto_convert_channels,json=autoConvertChannels,proto3" json:"auto_convert_channels,omitempty" yaml:"auto_convert_channels"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -114,6 +144,34 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetRegistrationPolicy() RegistrationPolicy {
+	if m != nil {
+		return m.RegistrationPolicy
+	}
+	return REGISTRATION_POLICY_OPEN
+}
+
+func (m *Params) GetRegistrationAllowlist() []string {
+	if m != nil {
+		return m.RegistrationAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *Params) GetAutoConvertChannels() []string {
+	if m != nil {
+		return m.AutoConvertChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gridiron.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "gridiron.erc20.v1.Params")
@@ -122,23 +180,40 @@ func init() {
 func init() { proto.RegisterFile("gridiron/erc20/v1/genesis.proto", fileDescriptor_0ecf63c9f5fc0d6a) }
 
 var fileDescriptor_0ecf63c9f5fc0d6a = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xeb, 0x81,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
-	0x9d, 0x94, 0x0c, 0x86, 0x39, 0x10, 0x0d, 0x60, 0x59, 0xa5, 0x2e, 0x46, 0x2e, 0x1e, 0x77, 0x88,
-	0xb9, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x66, 0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x12, 0x7a, 0xe8, 0xf6, 0xe8, 0x05, 0x80, 0xe5, 0x9d,
-	0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x16, 0x72, 0xe2, 0xe2, 0x2e, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x8b, 0x2f, 0x48, 0xcc, 0x2c, 0x2a, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xc6,
-	0xd4, 0x1c, 0x02, 0x52, 0x14, 0x90, 0x98, 0x59, 0x04, 0xd5, 0xcf, 0x55, 0x02, 0x13, 0x28, 0x56,
-	0xe2, 0xe3, 0x62, 0x83, 0x98, 0x6d, 0xc5, 0x32, 0x63, 0x81, 0x3c, 0x83, 0x93, 0xfb, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
-	0x25, 0xe7, 0xe7, 0xea, 0x43, 0xad, 0xd0, 0xad, 0xca, 0xcf, 0x4b, 0x85, 0x71, 0xf4, 0x2b, 0xa0,
-	0xde, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xd6, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x0a, 0x57, 0x30, 0x41, 0x54, 0x01, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x26, 0x8a, 0xd4, 0x4d, 0xf9, 0x72, 0x29, 0xa4, 0x51, 0x6b, 0x07, 0x4b, 0x48,
+	0xb9, 0xd4, 0x4b, 0xc2, 0x01, 0xa9, 0x37, 0x1c, 0xf1, 0x11, 0x71, 0x89, 0x4c, 0x0f, 0x88, 0x4b,
+	0xb4, 0x76, 0xa6, 0xce, 0xaa, 0xce, 0x6e, 0xb4, 0xbb, 0x4d, 0x09, 0x4f, 0x01, 0x37, 0x0e, 0x1c,
+	0x38, 0xf3, 0x24, 0x15, 0xa7, 0x1e, 0x39, 0x05, 0x94, 0xbc, 0x41, 0x9e, 0x00, 0x65, 0xd7, 0x29,
+	0xa9, 0xe2, 0x9e, 0x6c, 0xff, 0xe7, 0x37, 0xff, 0x19, 0xcf, 0xce, 0x22, 0x37, 0x11, 0xb4, 0x4f,
+	0x05, 0x67, 0x18, 0x44, 0xdc, 0x7a, 0x86, 0xc7, 0x4d, 0x9c, 0x00, 0x03, 0x49, 0xa5, 0x3f, 0x12,
+	0x5c, 0x71, 0xfb, 0xc1, 0x0a, 0xf0, 0x35, 0xe0, 0x8f, 0x9b, 0xb5, 0x87, 0x09, 0x4f, 0xb8, 0x8e,
+	0xe2, 0xe5, 0x9b, 0x01, 0x6b, 0x4e, 0xcc, 0xe5, 0x90, 0x4b, 0x1c, 0x11, 0x09, 0x78, 0xdc, 0x8c,
+	0x40, 0x91, 0x26, 0x8e, 0x39, 0x65, 0x59, 0xfc, 0x70, 0xb3, 0x92, 0x71, 0xd4, 0x61, 0xef, 0xfb,
+	0x16, 0xda, 0x79, 0x63, 0x2a, 0xbf, 0x57, 0x44, 0x81, 0xfd, 0x02, 0x95, 0x47, 0x44, 0x90, 0xa1,
+	0xac, 0x5a, 0x75, 0xab, 0x51, 0x69, 0xed, 0xfb, 0x1b, 0x9d, 0xf8, 0x5d, 0x0d, 0x04, 0xa5, 0xcb,
+	0xa9, 0x5b, 0x08, 0x33, 0xdc, 0x6e, 0xa3, 0x8a, 0xe2, 0x67, 0xc0, 0x7a, 0x23, 0x42, 0x85, 0xac,
+	0x6e, 0xd5, 0x8b, 0x8d, 0x4a, 0xeb, 0x20, 0x27, 0xfb, 0x64, 0x49, 0x75, 0x09, 0x15, 0x99, 0x01,
+	0x52, 0x2b, 0x41, 0xda, 0x1d, 0x74, 0xc7, 0x98, 0x0c, 0x78, 0xda, 0x07, 0x21, 0xab, 0x45, 0x6d,
+	0xe3, 0xdc, 0x66, 0xf3, 0x56, 0x63, 0x99, 0xd1, 0x8e, 0xfa, 0x2f, 0xe9, 0x7e, 0x68, 0x14, 0xf7,
+	0x40, 0xc6, 0x82, 0x5f, 0xc8, 0x6a, 0xe9, 0xd6, 0x7e, 0x3a, 0x41, 0xfb, 0x95, 0x86, 0x56, 0xfd,
+	0xd0, 0x28, 0x36, 0x82, 0xf4, 0x7e, 0x15, 0x51, 0xd9, 0xfc, 0xad, 0x3d, 0x46, 0xbb, 0x02, 0x12,
+	0x2a, 0x95, 0x20, 0x8a, 0x72, 0xd6, 0x1b, 0xf1, 0x94, 0xc6, 0x13, 0x3d, 0xa5, 0xbb, 0xad, 0xa7,
+	0x39, 0xbe, 0xe1, 0x1a, 0xdd, 0xd5, 0x70, 0xe0, 0x2c, 0xa6, 0x6e, 0x6d, 0x42, 0x86, 0xe9, 0xb1,
+	0x97, 0xe3, 0xe5, 0x85, 0xb6, 0xd8, 0xc8, 0xb1, 0x3f, 0xa0, 0x47, 0x37, 0x58, 0x92, 0xa6, 0xfc,
+	0x22, 0xa5, 0x52, 0xe9, 0x11, 0x6f, 0x07, 0x4f, 0x16, 0x53, 0xf7, 0x30, 0xc7, 0xf3, 0x9a, 0xf3,
+	0xc2, 0xbd, 0xf5, 0xc0, 0xcb, 0x95, 0x6e, 0x7f, 0xb5, 0xd0, 0xfd, 0x1b, 0x29, 0xa7, 0x00, 0xd9,
+	0xc0, 0xf7, 0x7d, 0xb3, 0x56, 0xfe, 0x72, 0xad, 0xfc, 0x6c, 0xad, 0xfc, 0x36, 0xa7, 0x2c, 0x78,
+	0xb7, 0x1c, 0xd2, 0x62, 0xea, 0x3e, 0xce, 0xa9, 0x79, 0x0a, 0xe0, 0xfd, 0xfc, 0xe3, 0x36, 0x12,
+	0xaa, 0x06, 0xe7, 0x91, 0x1f, 0xf3, 0x21, 0xce, 0xd6, 0xd3, 0x3c, 0x8e, 0x64, 0xff, 0x0c, 0xab,
+	0xc9, 0x08, 0xa4, 0xf6, 0x92, 0xe1, 0xbd, 0xf5, 0xf4, 0xd7, 0x00, 0xf6, 0x09, 0xda, 0x23, 0xe7,
+	0x8a, 0xf7, 0x62, 0xce, 0xc6, 0x20, 0x54, 0x2f, 0x1e, 0x10, 0xc6, 0x20, 0x35, 0xe7, 0xb7, 0x1d,
+	0xd4, 0x17, 0x53, 0xf7, 0xc0, 0x14, 0xce, 0xc5, 0xbc, 0x70, 0x77, 0xa9, 0xb7, 0x8d, 0xdc, 0xce,
+	0xd4, 0xe3, 0xd2, 0xb7, 0x1f, 0x6e, 0x21, 0xe8, 0x5c, 0xce, 0x1c, 0xeb, 0x6a, 0xe6, 0x58, 0x7f,
+	0x67, 0x8e, 0xf5, 0x65, 0xee, 0x14, 0xae, 0xe6, 0x4e, 0xe1, 0xf7, 0xdc, 0x29, 0x7c, 0xc4, 0x6b,
+	0x0d, 0xaf, 0x0e, 0xf2, 0xe8, 0x33, 0x67, 0x70, 0xfd, 0x85, 0x3f, 0x65, 0xf7, 0x47, 0x77, 0x1f,
+	0x95, 0xf5, 0xed, 0x79, 0xfe, 0x6f, 0x00, 0x90, 0xf7, 0x5c, 0xcb, 0xc8, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -161,6 +236,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IbcEscrows) > 0 {
+		for iNdEx := len(m.IbcEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenHolders) > 0 {
+		for iNdEx := len(m.TokenHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +311,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoConvertChannels) > 0 {
+		for iNdEx := len(m.AutoConvertChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoConvertChannels[iNdEx])
+			copy(dAtA[i:], m.AutoConvertChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoConvertChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RegistrationAllowlist) > 0 {
+		for iNdEx := len(m.RegistrationAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RegistrationAllowlist[iNdEx])
+			copy(dAtA[i:], m.RegistrationAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RegistrationAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RegistrationPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RegistrationPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -236,6 +376,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TokenHolders) > 0 {
+		for _, e := range m.TokenHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcEscrows) > 0 {
+		for _, e := range m.IbcEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -245,6 +397,27 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.RegistrationPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.RegistrationPolicy))
+	}
+	if len(m.RegistrationAllowlist) > 0 {
+		for _, s := range m.RegistrationAllowlist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoConvertChannels) > 0 {
+		for _, s := range m.AutoConvertChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -350,6 +523,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenHolders = append(m.TokenHolders, TokenHolder{})
+			if err := m.TokenHolders[len(m.TokenHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcEscrows = append(m.IbcEscrows, IBCEscrow{})
+			if err := m.IbcEscrows[len(m.IbcEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -400,6 +641,123 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPolicy", wireType)
+			}
+			m.RegistrationPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationPolicy |= RegistrationPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationAllowlist = append(m.RegistrationAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoConvertChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoConvertChannels = append(m.AutoConvertChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,16 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gridiron-zone/gridiron/x/erc20/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	holder := types.TokenHolder{
+		Erc20Address: "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		Address:      sdk.AccAddress([]byte("token_holder________")).String(),
+	}
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +31,30 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid token holders",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				TokenHolders: []types.TokenHolder{holder},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid token holder erc20 address",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				TokenHolders: []types.TokenHolder{{Erc20Address: "0xinvalid", Address: holder.Address}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate token holder",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				TokenHolders: []types.TokenHolder{holder, holder},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
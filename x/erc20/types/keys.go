@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -38,6 +40,8 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixTokenHolder
+	prefixIBCEscrow
 )
 
 // KVStore key prefixes
@@ -45,4 +49,23 @@ var (
 	KeyPrefixTokenPair        = []byte{prefixTokenPair}
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixTokenHolder      = []byte{prefixTokenHolder}
+	KeyPrefixIBCEscrow        = []byte{prefixIBCEscrow}
 )
+
+// GetTokenHolderKey returns the key of the holder of an ERC20-native token
+func GetTokenHolderKey(holder sdk.AccAddress, erc20 common.Address) []byte {
+	return append(GetTokenHolderPrefix(holder), erc20.Bytes()...)
+}
+
+// GetTokenHolderPrefix returns the prefix of the ERC20-native tokens held by
+// the holder
+func GetTokenHolderPrefix(holder sdk.AccAddress) []byte {
+	return append(KeyPrefixTokenHolder, address.MustLengthPrefix(holder)...)
+}
+
+// GetIBCEscrowKey returns the key of the amount of an ERC20-native token
+// escrowed for IBC transfers through the channel
+func GetIBCEscrowKey(channelID string, erc20 common.Address) []byte {
+	return append(append(KeyPrefixIBCEscrow, address.MustLengthPrefix([]byte(channelID))...), erc20.Bytes()...)
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+const (
+	TypeMsgRegisterERC20 = "register_erc20"
+	TypeMsgConvertCoin   = "convert_coin"
+	TypeMsgConvertERC20  = "convert_erc20"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterERC20{}
+	_ sdk.Msg = &MsgConvertCoin{}
+	_ sdk.Msg = &MsgConvertERC20{}
+)
+
+// Route implements sdk.Msg
+func (m *MsgRegisterERC20) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgRegisterERC20) Type() string { return TypeMsgRegisterERC20 }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgRegisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRegisterERC20) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ethermint.ValidateAddress(m.ContractAddress); err != nil {
+		return sdkerrors.Wrapf(err, "invalid contract address %s", m.ContractAddress)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// Route implements sdk.Msg
+func (m *MsgConvertCoin) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgConvertCoin) ValidateBasic() error {
+	if err := m.Coin.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !m.Coin.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot convert non-positive amount")
+	}
+	if err := ethermint.ValidateAddress(m.Receiver); err != nil {
+		return sdkerrors.Wrapf(err, "invalid receiver address %s", m.Receiver)
+	}
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// Route implements sdk.Msg
+func (m *MsgConvertERC20) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgConvertERC20) Type() string { return TypeMsgConvertERC20 }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgConvertERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgConvertERC20) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(m.ContractAddress); err != nil {
+		return sdkerrors.Wrapf(err, "invalid contract address %s", m.ContractAddress)
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot convert non-positive amount")
+	}
+	_, err := sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if err := ethermint.ValidateAddress(m.Sender); err != nil {
+		return sdkerrors.Wrapf(err, "invalid sender address %s", m.Sender)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(m.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
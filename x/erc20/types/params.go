@@ -1,10 +1,30 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	ethermint "github.com/tharsis/ethermint/types"
 	"gopkg.in/yaml.v2"
 )
 
+// Parameter keys
+var (
+	KeyRegistrationPolicy    = []byte("RegistrationPolicy")
+	KeyRegistrationAllowlist = []byte("RegistrationAllowlist")
+	KeyRegistrationFee       = []byte("RegistrationFee")
+	KeyAutoConvertChannels   = []byte("AutoConvertChannels")
+)
+
+// Default parameter values
+var (
+	DefaultRegistrationFee = sdk.NewCoins(sdk.NewCoin(gridiron.AttoIronDenom, sdk.NewIntWithDecimal(100, 18))) // 100 iron
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,23 +33,48 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	registrationPolicy RegistrationPolicy,
+	registrationAllowlist []string,
+	registrationFee sdk.Coins,
+	autoConvertChannels []string,
+) Params {
+	return Params{
+		RegistrationPolicy:    registrationPolicy,
+		RegistrationAllowlist: registrationAllowlist,
+		RegistrationFee:       registrationFee,
+		AutoConvertChannels:   autoConvertChannels,
+	}
 }
 
-// DefaultParams returns a default set of parameters
+// DefaultParams returns a default set of parameters, under which contracts are
+// not registered automatically but only by paying the registration fee
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(REGISTRATION_POLICY_FEE, nil, DefaultRegistrationFee, nil)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRegistrationPolicy, &p.RegistrationPolicy, validateRegistrationPolicy),
+		paramtypes.NewParamSetPair(KeyRegistrationAllowlist, &p.RegistrationAllowlist, validateRegistrationAllowlist),
+		paramtypes.NewParamSetPair(KeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+		paramtypes.NewParamSetPair(KeyAutoConvertChannels, &p.AutoConvertChannels, validateAutoConvertChannels),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateRegistrationPolicy(p.RegistrationPolicy); err != nil {
+		return err
+	}
+	if err := validateRegistrationAllowlist(p.RegistrationAllowlist); err != nil {
+		return err
+	}
+	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
+		return err
+	}
+	return validateAutoConvertChannels(p.AutoConvertChannels)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +82,91 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// IsAllowlisted returns true if the ERC20 contract is in the registration allowlist
+func (p Params) IsAllowlisted(contract common.Address) bool {
+	for _, addr := range p.RegistrationAllowlist {
+		if common.HexToAddress(addr) == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAutoConvertChannel returns true if the vouchers received on the channel
+// are converted to the ERC20 tokens
+func (p Params) IsAutoConvertChannel(channelID string) bool {
+	for _, id := range p.AutoConvertChannels {
+		if id == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+func validateRegistrationPolicy(i interface{}) error {
+	v, ok := i.(RegistrationPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := RegistrationPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid registration policy: %d", v)
+	}
+
+	return nil
+}
+
+func validateRegistrationAllowlist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, addr := range v {
+		if err := ethermint.ValidateAddress(addr); err != nil {
+			return fmt.Errorf("invalid registration allowlist address: %w", err)
+		}
+		contract := common.HexToAddress(addr)
+		if seen[contract] {
+			return fmt.Errorf("duplicate registration allowlist address: %s", addr)
+		}
+		seen[contract] = true
+	}
+
+	return nil
+}
+
+func validateRegistrationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid registration fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateAutoConvertChannels(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, channelID := range v {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid auto convert channel: %w", err)
+		}
+		if seen[channelID] {
+			return fmt.Errorf("duplicate auto convert channel: %s", channelID)
+		}
+		seen[channelID] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gridiron-zone/gridiron/x/erc20/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	contract := "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+	for _, tc := range []struct {
+		desc   string
+		params types.Params
+		valid  bool
+	}{
+		{
+			desc:   "default is valid",
+			params: types.DefaultParams(),
+			valid:  true,
+		},
+		{
+			desc:   "valid allowlist",
+			params: types.NewParams(types.REGISTRATION_POLICY_ALLOWLIST, []string{contract}, nil, nil),
+			valid:  true,
+		},
+		{
+			desc:   "invalid policy",
+			params: types.NewParams(types.RegistrationPolicy(3), nil, nil, nil),
+			valid:  false,
+		},
+		{
+			desc:   "invalid allowlist address",
+			params: types.NewParams(types.REGISTRATION_POLICY_ALLOWLIST, []string{"0xinvalid"}, nil, nil),
+			valid:  false,
+		},
+		{
+			desc:   "duplicate allowlist address",
+			params: types.NewParams(types.REGISTRATION_POLICY_ALLOWLIST, []string{contract, contract}, nil, nil),
+			valid:  false,
+		},
+		{
+			desc:   "invalid fee",
+			params: types.NewParams(types.REGISTRATION_POLICY_FEE, nil, sdk.Coins{sdk.Coin{Denom: "airon", Amount: sdk.NewInt(-1)}}, nil),
+			valid:  false,
+		},
+		{
+			desc:   "valid auto convert channels",
+			params: types.NewParams(types.REGISTRATION_POLICY_OPEN, nil, nil, []string{"channel-0", "channel-1"}),
+			valid:  true,
+		},
+		{
+			desc:   "invalid auto convert channel",
+			params: types.NewParams(types.REGISTRATION_POLICY_OPEN, nil, nil, []string{"channel/0"}),
+			valid:  false,
+		},
+		{
+			desc:   "duplicate auto convert channel",
+			params: types.NewParams(types.REGISTRATION_POLICY_OPEN, nil, nil, []string{"channel-0", "channel-0"}),
+			valid:  false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	params := types.NewParams(types.REGISTRATION_POLICY_ALLOWLIST, []string{contract}, nil, []string{"channel-0"})
+	require.True(t, params.IsAllowlisted(common.HexToAddress(contract)))
+	require.False(t, params.IsAllowlisted(common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")))
+	require.True(t, params.IsAutoConvertChannel("channel-0"))
+	require.False(t, params.IsAutoConvertChannel("channel-1"))
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// Proposal types are distinct from the evmos erc20 ones, which are registered
+// as well since the evmos erc20 types are linked in.
+const (
+	ProposalTypeRegisterCoin          = "GridironRegisterCoin"
+	ProposalTypeRegisterERC20         = "GridironRegisterERC20"
+	ProposalTypeToggleTokenConversion = "GridironToggleTokenConversion"
+	ProposalTypeDeleteTokenPair       = "GridironDeleteTokenPair"
+)
+
+var (
+	_ govtypes.Content = &RegisterCoinProposal{}
+	_ govtypes.Content = &RegisterERC20Proposal{}
+	_ govtypes.Content = &ToggleTokenConversionProposal{}
+	_ govtypes.Content = &DeleteTokenPairProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRegisterCoin)
+	govtypes.RegisterProposalType(ProposalTypeRegisterERC20)
+	govtypes.RegisterProposalType(ProposalTypeToggleTokenConversion)
+	govtypes.RegisterProposalType(ProposalTypeDeleteTokenPair)
+	govtypes.RegisterProposalTypeCodec(&RegisterCoinProposal{}, "gridiron/RegisterCoinProposal")
+	govtypes.RegisterProposalTypeCodec(&RegisterERC20Proposal{}, "gridiron/RegisterERC20Proposal")
+	govtypes.RegisterProposalTypeCodec(&ToggleTokenConversionProposal{}, "gridiron/ToggleTokenConversionProposal")
+	govtypes.RegisterProposalTypeCodec(&DeleteTokenPairProposal{}, "gridiron/DeleteTokenPairProposal")
+}
+
+func (m *RegisterCoinProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *RegisterCoinProposal) ProposalType() string {
+	return ProposalTypeRegisterCoin
+}
+
+func (m *RegisterCoinProposal) ValidateBasic() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+	if HasErc20DenomPrefix(m.Denom) {
+		return fmt.Errorf("invalid denom %s, which represents an ERC20 token", m.Denom)
+	}
+	return nil
+}
+
+func (m *RegisterERC20Proposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *RegisterERC20Proposal) ProposalType() string {
+	return ProposalTypeRegisterERC20
+}
+
+func (m *RegisterERC20Proposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(m.Erc20Address); err != nil {
+		return sdkerrors.Wrapf(err, "invalid ERC20 address %s", m.Erc20Address)
+	}
+	if len(m.Denom) > 0 {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return err
+		}
+		if HasErc20DenomPrefix(m.Denom) {
+			return fmt.Errorf("invalid denom %s, which represents an ERC20 token", m.Denom)
+		}
+	}
+	return nil
+}
+
+func (m *ToggleTokenConversionProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *ToggleTokenConversionProposal) ProposalType() string {
+	return ProposalTypeToggleTokenConversion
+}
+
+func (m *ToggleTokenConversionProposal) ValidateBasic() error {
+	return validateToken(m.Token)
+}
+
+func (m *DeleteTokenPairProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *DeleteTokenPairProposal) ProposalType() string {
+	return ProposalTypeDeleteTokenPair
+}
+
+func (m *DeleteTokenPairProposal) ValidateBasic() error {
+	return validateToken(m.Token)
+}
+
+// validateToken checks the token is either the hex contract address of the
+// ERC20 or the Cosmos base denomination
+func validateToken(token string) error {
+	if err := ethermint.ValidateAddress(token); err != nil {
+		if err := sdk.ValidateDenom(token); err != nil {
+			return fmt.Errorf("invalid token %s, should be either hex ('0x...') address or cosmos denom", token)
+		}
+	}
+	return nil
+}
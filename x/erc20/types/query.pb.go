@@ -35,6 +35,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryTokenPairsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only lists the token pairs of the registration origin if specified
+	Origin RegistrationOrigin `protobuf:"varint,2,opt,name=origin,proto3,enum=gridiron.erc20.v1.RegistrationOrigin" json:"origin,omitempty"`
 }
 
 func (m *QueryTokenPairsRequest) Reset()         { *m = QueryTokenPairsRequest{} }
@@ -77,6 +79,13 @@ func (m *QueryTokenPairsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryTokenPairsRequest) GetOrigin() RegistrationOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return REGISTRATION_ORIGIN_UNSPECIFIED
+}
+
 // QueryTokenPairsResponse is the response type for the Query/TokenPairs RPC
 // method.
 type QueryTokenPairsResponse struct {
@@ -320,39 +329,41 @@ func init() {
 func init() { proto.RegisterFile("gridiron/erc20/v1/query.proto", fileDescriptor_a7c76603598c40e8) }
 
 var fileDescriptor_a7c76603598c40e8 = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xe3, 0x96, 0x46, 0xca, 0xcb, 0x82, 0x4c, 0x80, 0xe8, 0x28, 0xd7, 0xe8, 0x44, 0x48,
-	0x40, 0x8a, 0x4d, 0x82, 0xc4, 0x8c, 0x32, 0xd0, 0x09, 0x29, 0x44, 0x4c, 0x5d, 0xc0, 0x89, 0xac,
-	0xe3, 0x44, 0x63, 0x5f, 0xcf, 0x4e, 0xa0, 0x20, 0x16, 0x26, 0x16, 0x24, 0x24, 0x46, 0x76, 0x3e,
-	0x4b, 0xc7, 0x4a, 0x2c, 0x4c, 0x08, 0x25, 0x7c, 0x10, 0x74, 0xb6, 0xef, 0xd2, 0xeb, 0x41, 0xd3,
-	0xed, 0xfc, 0xfc, 0xde, 0xff, 0xff, 0x7b, 0xef, 0xf9, 0x60, 0x77, 0xc6, 0x93, 0xc3, 0x48, 0x0a,
-	0xca, 0x93, 0xe9, 0xe0, 0x01, 0x5d, 0xf4, 0xe9, 0xd1, 0x9c, 0x27, 0xc7, 0x24, 0x4e, 0xa4, 0x96,
-	0xf8, 0xaa, 0xbb, 0x25, 0xe6, 0x96, 0x2c, 0xfa, 0xde, 0x6e, 0x28, 0x65, 0x78, 0xc8, 0x29, 0x8b,
-	0x23, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x48, 0x0a, 0x65, 0xf3, 0xbd, 0x46, 0x28, 0x43, 0x69, 0x3e,
-	0x69, 0xfa, 0xe5, 0xa2, 0xf7, 0xa7, 0x52, 0xcd, 0xa4, 0xa2, 0x13, 0xa6, 0xb8, 0x95, 0xa7, 0x8b,
-	0xfe, 0x84, 0x6b, 0xd6, 0xa7, 0x31, 0x0b, 0x23, 0x61, 0x24, 0x5c, 0xae, 0x5f, 0xe2, 0x09, 0xb9,
-	0xe0, 0x2a, 0xca, 0x1c, 0xca, 0xbc, 0x16, 0xcd, 0xdc, 0x06, 0x2f, 0xe1, 0xc6, 0xb3, 0x54, 0xff,
-	0xb9, 0x7c, 0xcd, 0xc5, 0x88, 0x45, 0x89, 0x1a, 0xf3, 0xa3, 0x39, 0x57, 0x1a, 0x3f, 0x01, 0x58,
-	0x7b, 0x35, 0x51, 0x0b, 0x75, 0xeb, 0x83, 0xbb, 0xc4, 0x82, 0x91, 0x14, 0x8c, 0xd8, 0xbe, 0x1d,
-	0x18, 0x19, 0xb1, 0x90, 0xbb, 0xda, 0xf1, 0x99, 0xca, 0xe0, 0x3b, 0x82, 0x9b, 0x25, 0x0b, 0x15,
-	0x4b, 0xa1, 0x38, 0x1e, 0x42, 0x5d, 0xa7, 0xd1, 0x17, 0x71, 0x1a, 0x6e, 0xa2, 0xd6, 0x76, 0xb7,
-	0x3e, 0xb8, 0x45, 0xce, 0xcf, 0x90, 0xe4, 0xa5, 0xc3, 0x2b, 0x27, 0xbf, 0xf6, 0x2a, 0x63, 0xd0,
-	0xb9, 0x16, 0xde, 0x2f, 0x70, 0x6e, 0x19, 0xce, 0xce, 0x46, 0x4e, 0x0b, 0x50, 0x00, 0xed, 0xc1,
-	0xf5, 0x22, 0x67, 0x36, 0x89, 0x06, 0xec, 0x18, 0x3f, 0x33, 0x84, 0xda, 0xd8, 0x1e, 0x82, 0x83,
-	0xf3, 0x93, 0xcb, 0xbb, 0x7a, 0x0c, 0xb0, 0xee, 0xca, 0x4d, 0xee, 0x12, 0x4d, 0xd5, 0xf2, 0xa6,
-	0x82, 0x06, 0x60, 0xa3, 0x3d, 0x62, 0x09, 0x9b, 0x65, 0x1b, 0x09, 0x9e, 0xc2, 0xb5, 0x42, 0xd4,
-	0xd9, 0x3d, 0x82, 0x6a, 0x6c, 0x22, 0xce, 0xaa, 0x59, 0xb6, 0xb2, 0x15, 0xce, 0xc7, 0x65, 0x0f,
-	0xbe, 0x6d, 0xc3, 0x8e, 0xd1, 0xc3, 0x9f, 0x10, 0xc0, 0x7a, 0x3b, 0xb8, 0x5b, 0x16, 0xf8, 0xf7,
-	0x1b, 0xf1, 0xee, 0x5d, 0x22, 0xd3, 0x52, 0x06, 0xed, 0x8f, 0x3f, 0xfe, 0x7c, 0xdd, 0xda, 0xc3,
-	0xb7, 0x69, 0xe9, 0x3d, 0x9e, 0x79, 0x02, 0xf8, 0x33, 0x82, 0x5a, 0x5e, 0x8d, 0x3b, 0x9b, 0xf4,
-	0x33, 0x90, 0xee, 0xe6, 0x44, 0xc7, 0xd1, 0x33, 0x1c, 0x1d, 0xdc, 0xbe, 0x90, 0x83, 0xbe, 0x37,
-	0x87, 0x0f, 0xf8, 0x0d, 0x54, 0xed, 0xf0, 0xf0, 0x9d, 0xff, 0x58, 0x14, 0x76, 0xe4, 0xb5, 0x37,
-	0x64, 0x39, 0x8a, 0x96, 0xa1, 0xf0, 0x70, 0xb3, 0x4c, 0x61, 0xb7, 0x33, 0xdc, 0x3f, 0x59, 0xfa,
-	0xe8, 0x74, 0xe9, 0xa3, 0xdf, 0x4b, 0x1f, 0x7d, 0x59, 0xf9, 0x95, 0xd3, 0x95, 0x5f, 0xf9, 0xb9,
-	0xf2, 0x2b, 0x07, 0xbd, 0x30, 0xd2, 0xaf, 0xe6, 0x13, 0x32, 0x95, 0xb3, 0xac, 0xba, 0xf7, 0x4e,
-	0x0a, 0x9e, 0x4b, 0xbd, 0x75, 0x62, 0xfa, 0x38, 0xe6, 0x6a, 0x52, 0x35, 0x3f, 0xfa, 0xc3, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xee, 0x40, 0x91, 0x42, 0xb8, 0x04, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0x13, 0x3f,
+	0x14, 0x8f, 0xd3, 0x6f, 0x23, 0xe5, 0x45, 0xfa, 0x4a, 0x98, 0x00, 0x69, 0x28, 0xd7, 0x70, 0x52,
+	0x43, 0x5a, 0xa9, 0x36, 0x09, 0x03, 0x13, 0x03, 0x45, 0x02, 0xb1, 0x40, 0x38, 0x31, 0xc1, 0x80,
+	0x2e, 0xc1, 0x32, 0x16, 0xe4, 0x7c, 0x3d, 0x3b, 0x11, 0x2d, 0x62, 0x61, 0x65, 0xa9, 0xc4, 0x1f,
+	0xc0, 0x82, 0xc4, 0xbf, 0xd2, 0xb1, 0x12, 0x0b, 0x13, 0x42, 0x09, 0x7f, 0x08, 0x8a, 0xed, 0xbb,
+	0x24, 0xe4, 0x20, 0xd9, 0x7c, 0xcf, 0xef, 0xf3, 0xeb, 0x3d, 0xeb, 0xe0, 0x1a, 0x4f, 0xc4, 0x4b,
+	0x91, 0xc8, 0x88, 0xb2, 0xa4, 0xdf, 0xb9, 0x49, 0x47, 0x6d, 0x7a, 0x34, 0x64, 0xc9, 0x31, 0x89,
+	0x13, 0xa9, 0x25, 0xbe, 0x90, 0x5e, 0x13, 0x73, 0x4d, 0x46, 0xed, 0xfa, 0x36, 0x97, 0x92, 0xbf,
+	0x61, 0x34, 0x8c, 0x05, 0x0d, 0xa3, 0x48, 0xea, 0x50, 0x0b, 0x19, 0x29, 0x0b, 0xa8, 0x57, 0xb9,
+	0xe4, 0xd2, 0x1c, 0xe9, 0xf4, 0xe4, 0xaa, 0xfb, 0x7d, 0xa9, 0x06, 0x52, 0xd1, 0x5e, 0xa8, 0x98,
+	0xe5, 0xa7, 0xa3, 0x76, 0x8f, 0xe9, 0xb0, 0x4d, 0xe3, 0x90, 0x8b, 0xc8, 0x50, 0xb8, 0xde, 0x9d,
+	0x65, 0x47, 0x9c, 0x45, 0x4c, 0x89, 0x54, 0x22, 0xc7, 0xb2, 0x39, 0xd8, 0x6b, 0xff, 0x33, 0x82,
+	0xcb, 0x4f, 0xa6, 0x12, 0x4f, 0xe5, 0x6b, 0x16, 0x75, 0x43, 0x91, 0xa8, 0x80, 0x1d, 0x0d, 0x99,
+	0xd2, 0xf8, 0x3e, 0xc0, 0x4c, 0xae, 0x86, 0x1a, 0xa8, 0x55, 0xe9, 0x34, 0x89, 0xf5, 0x46, 0xa6,
+	0xde, 0x88, 0xcd, 0xee, 0xbc, 0x91, 0x6e, 0xc8, 0x99, 0xc3, 0x06, 0x73, 0x48, 0x7c, 0x07, 0x4a,
+	0x32, 0x11, 0x5c, 0x44, 0xb5, 0x62, 0x03, 0xb5, 0xfe, 0xef, 0xec, 0x92, 0xa5, 0x31, 0x91, 0x80,
+	0x71, 0xa1, 0x74, 0x62, 0x00, 0x8f, 0x4d, 0x73, 0xe0, 0x40, 0xfe, 0x57, 0x04, 0x57, 0x96, 0x1c,
+	0xaa, 0x58, 0x46, 0x8a, 0xe1, 0x7b, 0x50, 0xd1, 0xd3, 0xea, 0x8b, 0x78, 0x5a, 0xae, 0xa1, 0xc6,
+	0x46, 0xab, 0xd2, 0xd9, 0xce, 0xe1, 0xcf, 0xb0, 0x87, 0xff, 0x9d, 0xfd, 0xd8, 0x29, 0x04, 0xa0,
+	0x33, 0x32, 0xfc, 0x60, 0x21, 0x67, 0xd1, 0xe4, 0xbc, 0xb1, 0x32, 0xa7, 0x75, 0x30, 0x1f, 0xd4,
+	0x3f, 0x80, 0x4b, 0x8b, 0x46, 0xd3, 0x49, 0x56, 0x61, 0xd3, 0xe8, 0x99, 0x21, 0x96, 0x03, 0xfb,
+	0xe1, 0x3f, 0xff, 0x73, 0xf2, 0x59, 0xac, 0xbb, 0x00, 0xb3, 0x58, 0x6e, 0xf2, 0xeb, 0xa4, 0x2a,
+	0x67, 0xa9, 0xfc, 0x2a, 0x60, 0x43, 0xde, 0x0d, 0x93, 0x70, 0x90, 0xae, 0xd4, 0x7f, 0x04, 0x17,
+	0x17, 0xaa, 0x4e, 0xef, 0x36, 0x94, 0x62, 0x53, 0x71, 0x5a, 0x5b, 0x39, 0x5a, 0x16, 0xe2, 0x84,
+	0x5c, 0x7b, 0xe7, 0xcb, 0x06, 0x6c, 0x1a, 0x42, 0xfc, 0x11, 0x01, 0xcc, 0x16, 0x84, 0xf7, 0x72,
+	0x18, 0xf2, 0x9f, 0x59, 0x7d, 0x7f, 0x9d, 0x56, 0x6b, 0xd4, 0x6f, 0x7e, 0xf8, 0xf6, 0xeb, 0x53,
+	0xb1, 0x81, 0x3d, 0xba, 0xfc, 0xaa, 0xe7, 0x1e, 0x02, 0x3e, 0x45, 0x50, 0xce, 0xe0, 0xb8, 0xb5,
+	0x52, 0x21, 0xf5, 0xb2, 0xb7, 0x46, 0xa7, 0xb3, 0x42, 0x8c, 0x95, 0x16, 0x6e, 0xfe, 0xdb, 0x0a,
+	0x7d, 0x67, 0x3e, 0xde, 0xe3, 0x13, 0x28, 0xd9, 0x11, 0xe2, 0xdd, 0xbf, 0x89, 0x2c, 0xec, 0xaa,
+	0xde, 0x5c, 0xd5, 0xe6, 0x8c, 0x5c, 0x37, 0x46, 0xae, 0xe2, 0xad, 0x1c, 0x23, 0x76, 0x4d, 0x87,
+	0x0f, 0xcf, 0xc6, 0x1e, 0x3a, 0x1f, 0x7b, 0xe8, 0xe7, 0xd8, 0x43, 0xa7, 0x13, 0xaf, 0x70, 0x3e,
+	0xf1, 0x0a, 0xdf, 0x27, 0x5e, 0xe1, 0x19, 0xe5, 0x42, 0xbf, 0x1a, 0xf6, 0x48, 0x5f, 0x0e, 0x32,
+	0xf8, 0xc1, 0x89, 0x8c, 0xd8, 0x8c, 0xec, 0xad, 0xa3, 0xd3, 0xc7, 0x31, 0x53, 0xbd, 0x92, 0xf9,
+	0x6d, 0xdc, 0xfa, 0x3d, 0x00, 0x39, 0xff, 0xda, 0x1e, 0x0a, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -533,6 +544,11 @@ func (m *QueryTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Origin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -737,6 +753,9 @@ func (m *QueryTokenPairsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Origin != 0 {
+		n += 1 + sovQuery(uint64(m.Origin))
+	}
 	return n
 }
 
@@ -874,6 +893,25 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= RegistrationOrigin(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7,12 +7,13 @@ import (
 	ethermint "github.com/tharsis/ethermint/types"
 )
 
-// NewTokenPair returns an instance of TokenPair
+// NewTokenPair returns an instance of TokenPair, which is enabled
 func NewTokenPair(erc20Address common.Address, denom string, contractOwner Owner) TokenPair {
 	return TokenPair{
 		Erc20Address:  erc20Address.String(),
 		Denom:         denom,
 		ContractOwner: contractOwner,
+		Enabled:       true,
 	}
 }
 
@@ -51,3 +52,10 @@ func (tp TokenPair) IsNativeCoin() bool {
 func (tp TokenPair) IsNativeERC20() bool {
 	return tp.ContractOwner == OWNER_EXTERNAL
 }
+
+// HasDistinctCoin returns true if the coin of the ERC20-native token pair is
+// distinct from the ERC20 token, i.e., they are converted to each other
+// explicitly, rather than the coin just representing the ERC20 token
+func (tp TokenPair) HasDistinctCoin() bool {
+	return tp.IsNativeERC20() && tp.Denom != CreateDenom(tp.Erc20Address)
+}
@@ -177,3 +177,37 @@ func TestTokenPair_IsNativeERC20(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenPair_HasDistinctCoin(t *testing.T) {
+	erc20Address := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	for _, tc := range []struct {
+		desc     string
+		denom    string
+		owner    Owner
+		distinct bool
+	}{
+		{
+			desc:     "distinct coin",
+			denom:    "uusdt",
+			owner:    OWNER_EXTERNAL,
+			distinct: true,
+		},
+		{
+			desc:     "coin representing ERC20",
+			denom:    CreateDenom(erc20Address.String()),
+			owner:    OWNER_EXTERNAL,
+			distinct: false,
+		},
+		{
+			desc:     "native coin",
+			denom:    "uusdt",
+			owner:    OWNER_MODULE,
+			distinct: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			pair := NewTokenPair(erc20Address, tc.denom, tc.owner)
+			require.Equal(t, tc.distinct, pair.HasDistinctCoin())
+		})
+	}
+}
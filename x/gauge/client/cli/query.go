@@ -22,6 +22,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryBoosts())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -50,3 +51,36 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryBoosts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "boosts [pool-denom]",
+		Short: "lists the current and potential boosts of the depositors of a gauge",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Boosts(context.Background(), &types.QueryBoostsRequest{
+				PoolDenom:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "boosts")
+
+	return cmd
+}
@@ -152,13 +152,13 @@ func (b *Base) derivedTickets(ctx sdk.Context, veID uint64) sdk.Int {
 	totalDeposited := b.GetTotalDepositedAmount(ctx)
 	deposited := b.GetDepositedAmountByUser(ctx, veID)
 
-	derived := deposited.MulRaw(40).QuoRaw(100)
+	derived := b.keeper.BaseWeight(ctx).MulInt(deposited).TruncateInt()
 
 	adjusted := sdk.ZeroInt()
 	totalPower := b.keeper.veKeeper.GetTotalVotingPower(ctx, uint64(ctx.BlockTime().Unix()), 0)
 	if totalPower.IsPositive() {
 		power := b.keeper.veKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0)
-		adjusted = totalDeposited.Mul(power).Quo(totalPower).MulRaw(60).QuoRaw(100)
+		adjusted = b.keeper.BoostWeight(ctx).MulInt(totalDeposited.Mul(power).Quo(totalPower)).TruncateInt()
 	}
 
 	tickets := derived.Add(adjusted)
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gridiron-zone/gridiron/contracts"
+	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
+	"github.com/gridiron-zone/gridiron/x/gauge/types"
+)
+
+// PairFeeClaimee claims the trading fees from the EVM AMM pair contract
+// through the erc20 keeper
+type PairFeeClaimee struct {
+	keeper   Keeper
+	contract common.Address
+}
+
+var _ FeeClaimee = PairFeeClaimee{}
+
+// PairContract returns the pair contract of the pool denom, which must be the
+// native ERC20 denom of the pair contract, i.e., the LP token, so that the
+// gauge escrow pool holds the LP tokens earning the trading fees
+func PairContract(poolDenom string) (common.Address, bool) {
+	if !erc20types.HasErc20DenomPrefix(poolDenom) {
+		return common.Address{}, false
+	}
+	addr := strings.TrimPrefix(poolDenom, erc20types.DenomPrefix+"/")
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, false
+	}
+	return common.HexToAddress(addr), true
+}
+
+// PairFeeClaimee returns the fee claimee for the pair contract of the pool denom
+func (k Keeper) PairFeeClaimee(poolDenom string) (PairFeeClaimee, error) {
+	contract, found := PairContract(poolDenom)
+	if !found {
+		return PairFeeClaimee{}, sdkerrors.Wrapf(types.ErrPairNotFound, "pool denom %s", poolDenom)
+	}
+	return PairFeeClaimee{
+		keeper:   k,
+		contract: contract,
+	}, nil
+}
+
+// ClaimFees claims the trading fees of the pair accrued to the claimant, and
+// returns them as the coins of the pair tokens. The claimed fees are measured
+// by the balance changes of the claimant rather than the amounts reported by
+// the pair, which may not be what it actually transferred.
+func (c PairFeeClaimee) ClaimFees(ctx sdk.Context, claimant sdk.AccAddress) (sdk.Coins, error) {
+	pairABI := contracts.IPairContract.ABI
+	from := common.BytesToAddress(claimant)
+
+	// resolve the fee denoms and their balances before claiming, so that no
+	// fees get stuck in the claimant account
+	var balancesBefore []sdk.Coin
+	for _, method := range []string{"token0", "token1"} {
+		res, err := c.keeper.erc20Keeper.CallEVM(ctx, pairABI, from, c.contract, method)
+		if err != nil {
+			return nil, err
+		}
+		out, err := pairABI.Unpack(method, res.Ret)
+		if err != nil {
+			return nil, err
+		}
+		token, ok := out[0].(common.Address)
+		if !ok {
+			return nil, sdkerrors.Wrapf(types.ErrUnsupportedFeeToken, "invalid %s of pair %s", method, c.contract)
+		}
+		denom, err := c.keeper.tokenDenom(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		// the fees of a pair of the same token are counted once
+		if len(balancesBefore) == 0 || balancesBefore[0].Denom != denom {
+			balancesBefore = append(balancesBefore, c.keeper.bankKeeper.GetBalance(ctx, claimant, denom))
+		}
+	}
+
+	_, err := c.keeper.erc20Keeper.CallEVM(ctx, pairABI, from, c.contract, "claimFees")
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := sdk.NewCoins()
+	for _, before := range balancesBefore {
+		after := c.keeper.bankKeeper.GetBalance(ctx, claimant, before.Denom)
+		if after.IsLT(before) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "balance of %s decreased by claiming fees of pair %s", before.Denom, c.contract)
+		}
+		claimed = claimed.Add(after.Sub(before))
+	}
+	return claimed, nil
+}
+
+// tokenDenom returns the coin denom of the ERC20 token, which must be
+// registered as a token pair transferable by the bank
+func (k Keeper) tokenDenom(ctx sdk.Context, token common.Address) (string, error) {
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, token.Hex()))
+	if !found {
+		return "", sdkerrors.Wrapf(types.ErrUnsupportedFeeToken, "token pair with contract %s not found", token)
+	}
+	if !pair.Enabled {
+		return "", sdkerrors.Wrapf(types.ErrUnsupportedFeeToken, "token pair with contract %s is disabled", token)
+	}
+	if pair.HasDistinctCoin() {
+		return "", sdkerrors.Wrapf(types.ErrUnsupportedFeeToken, "token with contract %s must be converted to coin %s", token, pair.Denom)
+	}
+	return pair.Denom, nil
+}
@@ -7,8 +7,10 @@ import (
 	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 )
 
+// FeeClaimee claims the trading fees accrued to the claimant, e.g., the
+// escrow pool of the gauge holding the deposited LP tokens
 type FeeClaimee interface {
-	ClaimFees(ctx sdk.Context, claimant sdk.AccAddress) sdk.Coins
+	ClaimFees(ctx sdk.Context, claimant sdk.AccAddress) (sdk.Coins, error)
 }
 
 type Gauge struct {
@@ -35,6 +37,7 @@ func (k Keeper) Gauge(ctx sdk.Context, depoistDenom string) Gauge {
 			prefixKey:    types.GaugeKey(depoistDenom),
 			isGauge:      true,
 		},
+		bribe: k.Bribe(ctx, depoistDenom),
 	}
 }
 
@@ -104,12 +107,39 @@ func (g Gauge) Withdraw(ctx sdk.Context, veID uint64, amount sdk.Int) (err error
 	return nil
 }
 
+// Kick recomputes the derived amount of the ve with its current voting
+// power, which is only allowed if the derived amount decreases, e.g., due to
+// the decay of the voting power
+func (g Gauge) Kick(ctx sdk.Context, veID uint64) (derivedBefore sdk.Int, derived sdk.Int, err error) {
+	if !g.GetDepositedAmountByUser(ctx, veID).IsPositive() {
+		return sdk.Int{}, sdk.Int{}, types.ErrNoDeposit
+	}
+
+	derivedBefore = g.GetDerivedAmountByUser(ctx, veID)
+	derived = g.derivedTickets(ctx, veID)
+	if derived.GTE(derivedBefore) {
+		return sdk.Int{}, sdk.Int{}, types.ErrNotKickable
+	}
+
+	g.deriveAmountForUser(ctx, veID)
+	return derivedBefore, derived, nil
+}
+
+// PotentialDerivedAmount returns the derived amount of the ve if recomputed
+// with its current voting power
+func (g Gauge) PotentialDerivedAmount(ctx sdk.Context, veID uint64) sdk.Int {
+	return g.derivedTickets(ctx, veID)
+}
+
 func (g Gauge) DepositReward(ctx sdk.Context, sender sdk.AccAddress, rewardDenom string, amount sdk.Int) error {
 	return g.depositReward(ctx, sender, rewardDenom, amount)
 }
 
 func (g Gauge) DepositFees(ctx sdk.Context, feeClaimee FeeClaimee) (err error) {
-	claimed := feeClaimee.ClaimFees(ctx, g.EscrowPool(ctx).GetAddress())
+	claimed, err := feeClaimee.ClaimFees(ctx, g.EscrowPool(ctx).GetAddress())
+	if err != nil {
+		return err
+	}
 
 	acc := g.EscrowPool(ctx).GetAddress()
 	for _, fee := range claimed {
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/x/gauge/keeper"
+	"github.com/gridiron-zone/gridiron/x/gauge/types"
+	vekeeper "github.com/gridiron-zone/gridiron/x/ve/keeper"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestGauge_KickAndBoosts() {
+	require := suite.Require()
+	ctx := suite.ctx
+	k := suite.app.GaugeKeeper
+	epoch0 := uint64(ctx.BlockTime().Unix())
+	impl := keeper.NewMsgServerImpl(k)
+	veImpl := vekeeper.NewMsgServerImpl(suite.app.VeKeeper)
+
+	pool := "upool"
+	suite.app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "pool",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: pool, Exponent: uint32(0)},
+			{Denom: "pool", Exponent: uint32(6)},
+		},
+		Base:    pool,
+		Display: "pool",
+		Name:    "POOL",
+		Symbol:  "POOL",
+	})
+	k.CreateGauge(ctx, pool)
+
+	// ve-1 is locked for the max time, and ve-2 decays soon
+	user1 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	user2 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	kicker := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	lockAmt := sdk.NewCoin("airon", sdk.NewInt(1000*vetypes.MaxLockTime))
+	deposited := sdk.NewInt(1000)
+	for i, user := range []sdk.AccAddress{user1, user2} {
+		lockDuration := uint64(vetypes.MaxLockTime)
+		if i == 1 {
+			lockDuration = 4 * vetypes.RegulatedPeriod
+		}
+		require.NoError(app.FundAccount(suite.app.BankKeeper, ctx, user, sdk.NewCoins(lockAmt, sdk.NewCoin(pool, deposited))))
+		_, err := veImpl.Create(sdk.WrapSDKContext(ctx), &vetypes.MsgCreate{
+			Sender:       user.String(),
+			To:           user.String(),
+			Amount:       lockAmt,
+			LockDuration: lockDuration,
+		})
+		require.NoError(err)
+		require.NoError(k.Gauge(ctx, pool).Deposit(ctx, uint64(i+1), deposited))
+	}
+
+	// derived = min(deposited, base% * deposited + boost% * total deposited * power / total power)
+	derivedWith := func(veID uint64, base, boost int64) sdk.Int {
+		power := suite.app.VeKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0)
+		totalPower := suite.app.VeKeeper.GetTotalVotingPower(ctx, uint64(ctx.BlockTime().Unix()), 0)
+		derived := deposited.MulRaw(base).QuoRaw(100).Add(deposited.MulRaw(2).Mul(power).Quo(totalPower).MulRaw(boost).QuoRaw(100))
+		return sdk.MinInt(derived, deposited)
+	}
+	derivedOf := func(veID uint64) sdk.Int {
+		return derivedWith(veID, 40, 60)
+	}
+	gauge := k.Gauge(ctx, pool)
+	derived1, derived2 := derivedOf(1), derivedOf(2)
+	require.Equal(deposited, derived1)
+	require.True(derived2.LT(deposited))
+	require.Equal(derived1, gauge.GetDerivedAmountByUser(ctx, 1))
+	require.Equal(derived2, gauge.GetDerivedAmountByUser(ctx, 2))
+	require.Equal(derived1.Add(derived2), gauge.GetTotalDerivedAmount(ctx))
+
+	res, err := k.Boosts(sdk.WrapSDKContext(ctx), &types.QueryBoostsRequest{PoolDenom: pool})
+	require.NoError(err)
+	require.Len(res.Boosts, 2)
+	require.Equal("ve-1", res.Boosts[0].VeId)
+	require.Equal(sdk.NewDecWithPrec(25, 1), res.Boosts[0].Boost)
+	require.Equal(res.Boosts[0].Boost, res.Boosts[0].PotentialBoost)
+	require.Equal(derived2.ToDec().QuoInt64(400), res.Boosts[1].Boost)
+	_, err = k.Boosts(sdk.WrapSDKContext(ctx), &types.QueryBoostsRequest{PoolDenom: "pool2"})
+	require.Error(err)
+
+	kick := func(poolDenom, veID string) error {
+		_, err := impl.Kick(sdk.WrapSDKContext(ctx), &types.MsgKick{Sender: kicker.String(), PoolDenom: poolDenom, VeId: veID})
+		return err
+	}
+	require.ErrorIs(kick("pool2", "ve-2"), types.ErrGaugeNotFound)
+	require.ErrorIs(kick(pool, "ve-3"), types.ErrNoDeposit)
+	require.ErrorIs(kick(pool, "ve-2"), types.ErrNotKickable)
+
+	// the voting power of ve-2 decays
+	ctx = ctx.WithBlockTime(time.Unix(int64(epoch0+2*vetypes.RegulatedPeriod), 0).UTC())
+	res, err = k.Boosts(sdk.WrapSDKContext(ctx), &types.QueryBoostsRequest{PoolDenom: pool})
+	require.NoError(err)
+	potential2 := derivedOf(2)
+	require.True(potential2.LT(derived2))
+	require.Equal(derived2, res.Boosts[1].Derived)
+	require.Equal(potential2, res.Boosts[1].PotentialDerived)
+	require.True(res.Boosts[1].PotentialBoost.LT(res.Boosts[1].Boost))
+
+	require.ErrorIs(kick(pool, "ve-1"), types.ErrNotKickable)
+	require.NoError(kick(pool, "ve-2"))
+	require.Equal(potential2, gauge.GetDerivedAmountByUser(ctx, 2))
+	require.Equal(derived1.Add(potential2), gauge.GetTotalDerivedAmount(ctx))
+	require.ErrorIs(kick(pool, "ve-2"), types.ErrNotKickable)
+
+	// the boost weights are governed by params
+	k.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(10, 2)))
+	potential1 := derivedWith(1, 20, 10)
+	require.True(potential1.LT(deposited))
+	require.Equal(potential1, gauge.PotentialDerivedAmount(ctx, 1))
+	require.NoError(kick(pool, "ve-1"))
+	require.Equal(potential1, gauge.GetDerivedAmountByUser(ctx, 1))
+}
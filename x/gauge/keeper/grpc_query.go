@@ -3,8 +3,11 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gridiron-zone/gridiron/x/gauge/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,3 +22,57 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) Boosts(c context.Context, req *types.QueryBoostsRequest) (*types.QueryBoostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge for pool denom %s not found", req.PoolDenom)
+	}
+	gauge := k.Gauge(ctx, req.PoolDenom)
+	baseWeight := k.BaseWeight(ctx)
+
+	var boosts []types.DepositorBoost
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepositedAmountByUserKeyPrefix(types.GaugeKey(req.PoolDenom)))
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		// skip the deposits of other gauges whose pool denoms share the prefix
+		if len(key) != 8 {
+			return false, nil
+		}
+		if accumulate {
+			veID := sdk.BigEndianToUint64(key)
+			deposited := gauge.GetDepositedAmountByUser(ctx, veID)
+			derived := gauge.GetDerivedAmountByUser(ctx, veID)
+			potentialDerived := gauge.PotentialDerivedAmount(ctx, veID)
+			boosts = append(boosts, types.DepositorBoost{
+				VeId:             vetypes.VeIDFromUint64(veID),
+				Deposited:        deposited,
+				Derived:          derived,
+				PotentialDerived: potentialDerived,
+				Boost:            boostOf(derived, deposited, baseWeight),
+				PotentialBoost:   boostOf(potentialDerived, deposited, baseWeight),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBoostsResponse{
+		Boosts:     boosts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// boostOf returns the ratio of the derived amount to the base derived amount
+func boostOf(derived sdk.Int, deposited sdk.Int, baseWeight sdk.Dec) sdk.Dec {
+	base := baseWeight.MulInt(deposited)
+	if !base.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return derived.ToDec().Quo(base)
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gridiron-zone/gridiron/app"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	"github.com/tendermint/tendermint/version"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *app.Gridiron
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	require := suite.Require()
+
+	// consensus key
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	consAddress := sdk.ConsAddress(privCons.PubKey().Address())
+
+	// at the start of the current epoch
+	epochTime := vetypes.RegulatedUnixTime(uint64(time.Now().Unix()))
+
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Version: tmversion.Consensus{
+			Block: version.BlockProtocol,
+		},
+		ChainID:         "gridiron_5000-101",
+		Height:          1,
+		Time:            time.Unix(int64(epochTime), 0).UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	})
+
+	// set validator, which is required for deploying the veNFT contract
+	valAddr := sdk.ValAddress(tests.GenerateAddress().Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr, privCons.PubKey(), stakingtypes.Description{})
+	require.NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper.Keeper, suite.ctx, validator, true)
+	suite.app.StakingKeeper.AfterValidatorCreated(suite.ctx, validator.GetOperator())
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator)
+	require.NoError(err)
+}
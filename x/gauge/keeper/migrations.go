@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gridiron-zone/gridiron/x/gauge/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.setMissingParams(ctx)
+	return nil
+}
+
+// setMissingParams sets the boost weight params, which were not stored before,
+// to their defaults, since reading a missing param panics.
+func (k Keeper) setMissingParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramstore.Has(ctx, pair.Key) {
+			k.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gridiron-zone/gridiron/x/gauge/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 )
 
 type msgServer struct {
@@ -15,3 +20,40 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+func (m msgServer) Kick(c context.Context, msg *types.MsgKick) (*types.MsgKickResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !m.Keeper.HasGauge(ctx, msg.PoolDenom) {
+		return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", msg.PoolDenom)
+	}
+
+	derivedBefore, derived, err := m.Keeper.Gauge(ctx, msg.PoolDenom).Kick(ctx, vetypes.Uint64FromVeID(msg.VeId))
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventKick{
+		Sender:        msg.Sender,
+		PoolDenom:     msg.PoolDenom,
+		VeId:          msg.VeId,
+		DerivedBefore: derivedBefore,
+		Derived:       derived,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgKickResponse{}, nil
+}
+
+func emitMessageEvent(ctx sdk.Context) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+}
@@ -6,11 +6,25 @@ import (
 )
 
 // GetParams get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// BaseWeight returns the weight of the deposited amount that is always derived
+func (k Keeper) BaseWeight(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyBaseWeight, &res)
+	return
+}
+
+// BoostWeight returns the weight of the total deposited amount that is derived
+// pro rata to the voting power share
+func (k Keeper) BoostWeight(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyBoostWeight, &res)
+	return
+}
@@ -12,4 +12,9 @@ var (
 	ErrInvalidAmount        = sdkerrors.Register(ModuleName, 3, "invalid amount")
 	ErrTooSmallRewardAmount = sdkerrors.Register(ModuleName, 4, "too small reward amount")
 	ErrTooLargeAmount       = sdkerrors.Register(ModuleName, 5, "too large amount")
+	ErrPairNotFound         = sdkerrors.Register(ModuleName, 6, "pair contract not found")
+	ErrUnsupportedFeeToken  = sdkerrors.Register(ModuleName, 7, "unsupported fee token")
+	ErrGaugeNotFound        = sdkerrors.Register(ModuleName, 8, "gauge not found")
+	ErrNoDeposit            = sdkerrors.Register(ModuleName, 9, "no deposit")
+	ErrNotKickable          = sdkerrors.Register(ModuleName, 10, "derived amount has not decreased")
 )
@@ -3,6 +3,11 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -31,6 +36,13 @@ type VeKeeper interface {
 	DecVeAttached(ctx sdk.Context, veID uint64)
 }
 
+// Erc20Keeper defines the expected interface needed to call EVM contracts and resolve their token pairs.
+type Erc20Keeper interface {
+	CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+}
+
 type VoterKeeper interface {
 	DistributeReward(ctx sdk.Context, poolDenom string)
 }
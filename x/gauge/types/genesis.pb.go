@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -70,6 +71,11 @@ func (m *GenesisState) GetParams() Params {
 
 // Params defines the parameters for the module.
 type Params struct {
+	// weight of the deposited amount that is always derived as reward tickets
+	BaseWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_weight,json=baseWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_weight" yaml:"base_weight"`
+	// weight of the total deposited amount that is derived as reward tickets
+	// pro rata to the voting power share of the depositor
+	BoostWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=boost_weight,json=boostWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"boost_weight" yaml:"boost_weight"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -112,20 +118,26 @@ func init() {
 func init() { proto.RegisterFile("gridiron/gauge/v1/genesis.proto", fileDescriptor_e4df13545f660d69) }
 
 var fileDescriptor_e4df13545f660d69 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x4f, 0x4f, 0x2c, 0x4d, 0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xeb, 0x81,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
-	0x9d, 0x92, 0x1b, 0x17, 0x8f, 0x3b, 0x44, 0x63, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x19, 0x17,
-	0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e,
-	0xba, 0x41, 0x7a, 0x01, 0x60, 0x79, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xaa, 0x95,
-	0xf8, 0xb8, 0xd8, 0x20, 0xe2, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0xb9, 0x9f, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x6c, 0xdd, 0xaa, 0xfc, 0xbc, 0x54, 0x18, 0x47, 0xbf, 0x02, 0xea,
-	0xa7, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x3b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x14, 0x7c, 0x98, 0x7b, 0xf1, 0x00, 0x00, 0x00,
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2f, 0xca, 0x4c,
+	0xc9, 0x2c, 0xca, 0xcf, 0xd3, 0x4f, 0x4f, 0x2c, 0x4d, 0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f,
+	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x29, 0xd0,
+	0x03, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
+	0x10, 0x85, 0x4a, 0xee, 0x5c, 0x3c, 0xee, 0x10, 0x9d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xe6,
+	0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x92,
+	0x7a, 0x18, 0x26, 0xe9, 0x05, 0x80, 0x15, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55,
+	0xae, 0x74, 0x9f, 0x91, 0x8b, 0x0d, 0x22, 0x21, 0x94, 0xca, 0xc5, 0x9d, 0x94, 0x58, 0x9c, 0x1a,
+	0x5f, 0x9e, 0x9a, 0x99, 0x9e, 0x51, 0x02, 0x36, 0x88, 0xd3, 0xc9, 0x05, 0xa4, 0xfa, 0xd6, 0x3d,
+	0x79, 0xb5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2,
+	0xdc, 0xfc, 0x62, 0x28, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7,
+	0x92, 0x9a, 0xfc, 0xe9, 0x9e, 0xbc, 0x50, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x92, 0x51, 0x4a,
+	0x41, 0x5c, 0x20, 0x5e, 0x38, 0x98, 0x23, 0x94, 0xc1, 0xc5, 0x93, 0x94, 0x9f, 0x5f, 0x5c, 0x02,
+	0xb3, 0x87, 0x09, 0x6c, 0x8f, 0x2b, 0xc9, 0xf6, 0x08, 0x43, 0xed, 0x41, 0x32, 0x4b, 0x29, 0x88,
+	0x1b, 0xcc, 0x85, 0xd8, 0x64, 0xc5, 0x32, 0x63, 0x81, 0x3c, 0x83, 0x93, 0xe7, 0x89, 0x47, 0x72,
+	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7,
+	0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0x23, 0xd9, 0x05, 0x0b, 0x2e, 0xdd, 0xaa, 0xfc,
+	0xbc, 0x54, 0x38, 0x4f, 0xbf, 0x02, 0x1a, 0x53, 0x60, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x6f, 0x0c,
+	0x18, 0x00, 0xf0, 0x2d, 0x3b, 0x18, 0xc8, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -181,6 +193,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BoostWeight.Size()
+		i -= size
+		if _, err := m.BoostWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseWeight.Size()
+		i -= size
+		if _, err := m.BaseWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -212,6 +244,10 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.BaseWeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BoostWeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -333,6 +369,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gridiron-zone/gridiron/x/gauge/types"
 	"github.com/stretchr/testify/require"
 )
@@ -19,13 +20,38 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.NewParams(sdk.OneDec(), sdk.ZeroDec()),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc:     "zero base weight",
+			genState: &types.GenesisState{Params: types.NewParams(sdk.ZeroDec(), types.DefaultBoostWeight)},
+			valid:    false,
+		},
+		{
+			desc:     "too large base weight",
+			genState: &types.GenesisState{Params: types.NewParams(sdk.NewDecWithPrec(11, 1), types.DefaultBoostWeight)},
+			valid:    false,
+		},
+		{
+			desc:     "negative boost weight",
+			genState: &types.GenesisState{Params: types.NewParams(types.DefaultBaseWeight, sdk.NewDec(-1))},
+			valid:    false,
+		},
+		{
+			desc:     "too large boost weight",
+			genState: &types.GenesisState{Params: types.NewParams(types.DefaultBaseWeight, sdk.NewDecWithPrec(11, 1))},
+			valid:    false,
+		},
+		{
+			desc:     "nil params",
+			genState: &types.GenesisState{},
+			valid:    false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -77,9 +77,12 @@ func TotalDepositedAmountKey(gaugeOrBribe []byte) []byte {
 	return append(KeyPrefixTotalDepositedAmount, gaugeOrBribe...)
 }
 
+func DepositedAmountByUserKeyPrefix(gaugeOrBribe []byte) []byte {
+	return append(KeyPrefixDepositedAmountByUser, gaugeOrBribe...)
+}
+
 func DepositedAmountByUserKey(gaugeOrBribe []byte, veID uint64) []byte {
-	prefix := append(KeyPrefixDepositedAmountByUser, gaugeOrBribe...)
-	return append(prefix, sdk.Uint64ToBigEndian(veID)...)
+	return append(DepositedAmountByUserKeyPrefix(gaugeOrBribe), sdk.Uint64ToBigEndian(veID)...)
 }
 
 func TotalDerivedAmountKey(gaugeKey []byte) []byte {
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+const (
+	TypeMsgKick = "kick"
+)
+
+var (
+	_ sdk.Msg = &MsgKick{}
+)
+
+// Route implements sdk.Msg
+func (m *MsgKick) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgKick) Type() string { return TypeMsgKick }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgKick) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgKick) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.PoolDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if vetypes.Uint64FromVeID(m.VeId) == vetypes.EmptyVeID {
+		return vetypes.ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgKick) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/x/gauge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgKick_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	sender := "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy"
+	for _, tc := range []struct {
+		desc  string
+		msg   types.MsgKick
+		valid bool
+	}{
+		{
+			desc: "invalid sender address",
+			msg:  types.MsgKick{Sender: "", PoolDenom: "pool", VeId: "ve-1"},
+		},
+		{
+			desc: "invalid pool denom",
+			msg:  types.MsgKick{Sender: sender, PoolDenom: "", VeId: "ve-1"},
+		},
+		{
+			desc: "invalid ve id",
+			msg:  types.MsgKick{Sender: sender, PoolDenom: "pool", VeId: "ve-0"},
+		},
+		{
+			desc:  "valid",
+			msg:   types.MsgKick{Sender: sender, PoolDenom: "pool", VeId: "ve-1"},
+			valid: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
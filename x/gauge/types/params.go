@@ -1,10 +1,25 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
+// Parameter keys
+var (
+	KeyBaseWeight  = []byte("BaseWeight")
+	KeyBoostWeight = []byte("BoostWeight")
+)
+
+// Default parameter values
+var (
+	DefaultBaseWeight  = sdk.NewDecWithPrec(40, 2) // 40%
+	DefaultBoostWeight = sdk.NewDecWithPrec(60, 2) // 60%
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,23 +28,32 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(baseWeight, boostWeight sdk.Dec) Params {
+	return Params{
+		BaseWeight:  baseWeight,
+		BoostWeight: boostWeight,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultBaseWeight, DefaultBoostWeight)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBaseWeight, &p.BaseWeight, validateBaseWeight),
+		paramtypes.NewParamSetPair(KeyBoostWeight, &p.BoostWeight, validateBoostWeight),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateBaseWeight(p.BaseWeight); err != nil {
+		return err
+	}
+	return validateBoostWeight(p.BoostWeight)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +61,37 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateBaseWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("base weight must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("base weight is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBoostWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("boost weight must be positive or zero: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("boost weight is too large: %s", v)
+	}
+
+	return nil
+}
@@ -6,7 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -113,35 +114,213 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type QueryBoostsRequest struct {
+	PoolDenom  string             `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBoostsRequest) Reset()         { *m = QueryBoostsRequest{} }
+func (m *QueryBoostsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBoostsRequest) ProtoMessage()    {}
+func (*QueryBoostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8fd499a6fa0e7ff, []int{2}
+}
+func (m *QueryBoostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoostsRequest.Merge(m, src)
+}
+func (m *QueryBoostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoostsRequest proto.InternalMessageInfo
+
+func (m *QueryBoostsRequest) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+func (m *QueryBoostsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DepositorBoost represents the boost of the reward tickets of a depositor.
+// The boost is the ratio of the derived amount to the base derived amount,
+// i.e., the deposited amount weighted by the base weight.
+type DepositorBoost struct {
+	VeId      string                                 `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Deposited github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=deposited,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deposited"`
+	// derived amount currently accounted for the rewards
+	Derived github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=derived,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"derived"`
+	// derived amount if recomputed with the current voting power
+	PotentialDerived github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=potential_derived,json=potentialDerived,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"potential_derived"`
+	Boost            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=boost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"boost"`
+	PotentialBoost   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=potential_boost,json=potentialBoost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"potential_boost"`
+}
+
+func (m *DepositorBoost) Reset()         { *m = DepositorBoost{} }
+func (m *DepositorBoost) String() string { return proto.CompactTextString(m) }
+func (*DepositorBoost) ProtoMessage()    {}
+func (*DepositorBoost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8fd499a6fa0e7ff, []int{3}
+}
+func (m *DepositorBoost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositorBoost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositorBoost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositorBoost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositorBoost.Merge(m, src)
+}
+func (m *DepositorBoost) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositorBoost) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositorBoost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositorBoost proto.InternalMessageInfo
+
+func (m *DepositorBoost) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+type QueryBoostsResponse struct {
+	Boosts     []DepositorBoost    `protobuf:"bytes,1,rep,name=boosts,proto3" json:"boosts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBoostsResponse) Reset()         { *m = QueryBoostsResponse{} }
+func (m *QueryBoostsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBoostsResponse) ProtoMessage()    {}
+func (*QueryBoostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8fd499a6fa0e7ff, []int{4}
+}
+func (m *QueryBoostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoostsResponse.Merge(m, src)
+}
+func (m *QueryBoostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoostsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoostsResponse proto.InternalMessageInfo
+
+func (m *QueryBoostsResponse) GetBoosts() []DepositorBoost {
+	if m != nil {
+		return m.Boosts
+	}
+	return nil
+}
+
+func (m *QueryBoostsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "gridiron.gauge.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "gridiron.gauge.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBoostsRequest)(nil), "gridiron.gauge.v1.QueryBoostsRequest")
+	proto.RegisterType((*DepositorBoost)(nil), "gridiron.gauge.v1.DepositorBoost")
+	proto.RegisterType((*QueryBoostsResponse)(nil), "gridiron.gauge.v1.QueryBoostsResponse")
 }
 
 func init() { proto.RegisterFile("gridiron/gauge/v1/query.proto", fileDescriptor_f8fd499a6fa0e7ff) }
 
 var fileDescriptor_f8fd499a6fa0e7ff = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xb1, 0x4b, 0x3b, 0x31,
-	0x14, 0xc7, 0x2f, 0x3f, 0x7e, 0x76, 0x38, 0x17, 0x39, 0x3b, 0x94, 0x52, 0xa2, 0x56, 0x05, 0x15,
-	0x7a, 0xe1, 0x2a, 0xf8, 0x07, 0x74, 0x71, 0x12, 0xb4, 0xa3, 0x5b, 0xae, 0x3c, 0x62, 0xa0, 0x97,
-	0x97, 0x5e, 0x72, 0xc5, 0x3a, 0x89, 0xa3, 0x93, 0xe0, 0x3f, 0xd5, 0xb1, 0xe0, 0xe2, 0x24, 0xd2,
-	0xfa, 0x87, 0x48, 0x93, 0x38, 0xd4, 0x1b, 0xdc, 0xc2, 0xfb, 0x7e, 0xde, 0x87, 0xef, 0x4b, 0xdc,
-	0x29, 0xa0, 0x1c, 0x4b, 0x54, 0x4c, 0xf0, 0x4a, 0x00, 0x9b, 0x66, 0x6c, 0x52, 0x41, 0x39, 0x4b,
-	0x75, 0x89, 0x16, 0x93, 0x9d, 0x90, 0xa6, 0x2e, 0x4d, 0xa7, 0x59, 0xbb, 0x29, 0x50, 0xa0, 0x0b,
-	0xd9, 0xfa, 0xe5, 0xb9, 0x76, 0x47, 0x20, 0x8a, 0x31, 0x30, 0xae, 0x25, 0xe3, 0x4a, 0xa1, 0xe5,
-	0x56, 0xa2, 0x32, 0x21, 0x3d, 0x1b, 0xa1, 0x29, 0xd0, 0xb0, 0x9c, 0x1b, 0xf0, 0x7a, 0x36, 0xcd,
-	0x72, 0xb0, 0x3c, 0x63, 0x9a, 0x0b, 0xa9, 0x1c, 0x1c, 0x58, 0x5a, 0xeb, 0x23, 0x40, 0x81, 0x91,
-	0xc1, 0xd5, 0x6d, 0xc6, 0xc9, 0xcd, 0xda, 0x70, 0xcd, 0x4b, 0x5e, 0x98, 0x21, 0x4c, 0x2a, 0x30,
-	0xb6, 0x7b, 0x15, 0xef, 0x6e, 0x4c, 0x8d, 0x46, 0x65, 0x20, 0xb9, 0x88, 0x1b, 0xda, 0x4d, 0x5a,
-	0x64, 0x9f, 0x9c, 0x6c, 0xf7, 0x5b, 0xe9, 0xef, 0x7b, 0x52, 0xbf, 0x31, 0xf8, 0x3f, 0xff, 0xd8,
-	0x8b, 0x86, 0x81, 0xee, 0x3f, 0x93, 0x78, 0xcb, 0xf9, 0x92, 0x47, 0x12, 0x37, 0x3c, 0x92, 0x1c,
-	0xd5, 0x97, 0xeb, 0x4d, 0xda, 0xc7, 0x7f, 0x50, 0xbe, 0x59, 0xf7, 0xf4, 0xe9, 0xed, 0xeb, 0xf5,
-	0xdf, 0x61, 0x72, 0xc0, 0x02, 0xfe, 0x80, 0x0a, 0xd8, 0xe6, 0xed, 0xbe, 0xcc, 0xe0, 0x72, 0xbe,
-	0xa4, 0x64, 0xb1, 0xa4, 0xe4, 0x73, 0x49, 0xc9, 0xcb, 0x8a, 0x46, 0x8b, 0x15, 0x8d, 0xde, 0x57,
-	0x34, 0xba, 0xed, 0x09, 0x69, 0xef, 0xaa, 0x3c, 0x1d, 0x61, 0xf1, 0xb3, 0xda, 0xdb, 0xf0, 0xdc,
-	0x07, 0x93, 0x9d, 0x69, 0x30, 0x79, 0xc3, 0xfd, 0xe0, 0xf9, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xad, 0xc4, 0xd2, 0x44, 0xf3, 0x01, 0x00, 0x00,
+	// 586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xe6, 0xc7, 0x4a, 0xa6, 0x50, 0xed, 0xb4, 0x87, 0x34, 0xda, 0x8d, 0x5d, 0x69, 0x2c,
+	0x4a, 0x67, 0x48, 0x3c, 0x78, 0x14, 0x42, 0x50, 0x03, 0x22, 0x75, 0x2f, 0x82, 0x1e, 0xc2, 0x26,
+	0x3b, 0xac, 0x8b, 0xc9, 0xbe, 0xed, 0xce, 0x64, 0xb1, 0xf5, 0x26, 0x9e, 0x3c, 0x09, 0xfe, 0x01,
+	0xfe, 0x1d, 0xfe, 0x07, 0x3d, 0x16, 0xbc, 0x88, 0x87, 0x22, 0x89, 0x7f, 0x88, 0xec, 0xcc, 0x6c,
+	0x93, 0x98, 0x48, 0x30, 0xa7, 0x84, 0x37, 0xdf, 0xfb, 0xbe, 0xef, 0xbd, 0xfd, 0x66, 0xd0, 0x9e,
+	0x1f, 0x07, 0x5e, 0x10, 0x43, 0x48, 0x7d, 0x77, 0xe4, 0x33, 0x9a, 0x34, 0xe8, 0xc9, 0x88, 0xc5,
+	0xa7, 0x24, 0x8a, 0x41, 0x00, 0xde, 0xca, 0x8e, 0x89, 0x3c, 0x26, 0x49, 0xa3, 0xba, 0xe3, 0x83,
+	0x0f, 0xf2, 0x94, 0xa6, 0xff, 0x14, 0xb0, 0x7a, 0xcb, 0x07, 0xf0, 0x07, 0x8c, 0xba, 0x51, 0x40,
+	0xdd, 0x30, 0x04, 0xe1, 0x8a, 0x00, 0x42, 0xae, 0x4f, 0xef, 0xf5, 0x81, 0x0f, 0x81, 0xd3, 0x9e,
+	0xcb, 0x99, 0xe2, 0xa7, 0x49, 0xa3, 0xc7, 0x84, 0xdb, 0xa0, 0x91, 0xeb, 0x07, 0xa1, 0x04, 0x6b,
+	0x6c, 0x6d, 0xd1, 0x91, 0xcf, 0x42, 0xc6, 0x03, 0x4d, 0x66, 0xef, 0x20, 0xfc, 0x22, 0xa5, 0x38,
+	0x76, 0x63, 0x77, 0xc8, 0x1d, 0x76, 0x32, 0x62, 0x5c, 0xd8, 0xcf, 0xd1, 0xf6, 0x5c, 0x95, 0x47,
+	0x10, 0x72, 0x86, 0x1f, 0x22, 0x33, 0x92, 0x95, 0x8a, 0x71, 0xdb, 0x38, 0xdc, 0x68, 0xee, 0x92,
+	0x85, 0x89, 0x88, 0x6a, 0x69, 0x15, 0xcf, 0x2f, 0x6b, 0x39, 0x47, 0xc3, 0xed, 0xf7, 0x5a, 0xa5,
+	0x05, 0xc0, 0x45, 0xa6, 0x82, 0xf7, 0x10, 0x8a, 0x00, 0x06, 0x5d, 0x8f, 0x85, 0x30, 0x94, 0x94,
+	0x65, 0xa7, 0x9c, 0x56, 0xda, 0x69, 0x01, 0x3f, 0x46, 0x68, 0x3a, 0x4f, 0x25, 0x2f, 0x15, 0xeb,
+	0x44, 0x0d, 0x4f, 0xd2, 0xe1, 0x89, 0x5a, 0xae, 0x1e, 0x9e, 0x1c, 0xbb, 0x3e, 0xd3, 0xd4, 0xce,
+	0x4c, 0xa7, 0xfd, 0xad, 0x80, 0x36, 0xdb, 0x2c, 0x02, 0x1e, 0x08, 0x88, 0xa5, 0x03, 0xbc, 0x8d,
+	0x4a, 0x09, 0xeb, 0x06, 0x9e, 0x16, 0x2d, 0x26, 0xac, 0xe3, 0xe1, 0x67, 0xa8, 0xec, 0x29, 0x18,
+	0xf3, 0xa4, 0x5c, 0xb9, 0x45, 0xd2, 0x29, 0x7e, 0x5e, 0xd6, 0xea, 0x7e, 0x20, 0xde, 0x8c, 0x7a,
+	0xa4, 0x0f, 0x43, 0xaa, 0xb7, 0xaf, 0x7e, 0x8e, 0xb8, 0xf7, 0x96, 0x8a, 0xd3, 0x88, 0x71, 0xd2,
+	0x09, 0x85, 0x33, 0x25, 0xc0, 0x4f, 0xd1, 0x35, 0x8f, 0xc5, 0x41, 0xc2, 0xbc, 0x4a, 0x61, 0x2d,
+	0xae, 0xac, 0x1d, 0xbf, 0x46, 0x5b, 0x11, 0x08, 0x16, 0x8a, 0xc0, 0x1d, 0x74, 0x75, 0xb1, 0x52,
+	0x5c, 0x8b, 0xf3, 0xc6, 0x15, 0x51, 0x5b, 0x93, 0xb7, 0x51, 0xa9, 0x97, 0xae, 0xa4, 0x52, 0xfa,
+	0x6f, 0xc2, 0x36, 0xeb, 0x3b, 0xaa, 0x19, 0xbf, 0x44, 0xd7, 0xa7, 0x16, 0x15, 0x9f, 0xb9, 0x16,
+	0xdf, 0xe6, 0x15, 0x8d, 0xfc, 0x50, 0xf6, 0x57, 0x43, 0x27, 0x31, 0x4b, 0x8e, 0x4e, 0xe2, 0x23,
+	0x64, 0x4a, 0x99, 0x34, 0x89, 0x85, 0xc3, 0x8d, 0xe6, 0xfe, 0x92, 0x24, 0xce, 0x7f, 0xf3, 0x2c,
+	0x91, 0xaa, 0x0d, 0x3f, 0x59, 0x12, 0xae, 0xbb, 0x2b, 0xc3, 0xa5, 0xd4, 0x67, 0xd3, 0xd5, 0xfc,
+	0x94, 0x47, 0x25, 0xe9, 0x10, 0x7f, 0x34, 0x90, 0xa9, 0xd2, 0x8f, 0x0f, 0x96, 0xd8, 0x59, 0xbc,
+	0x66, 0xd5, 0xfa, 0x2a, 0x98, 0xd2, 0xb3, 0xef, 0x7f, 0xf8, 0xfe, 0xfb, 0x4b, 0xfe, 0x00, 0xdf,
+	0xa1, 0x19, 0xfe, 0x0c, 0x42, 0x46, 0xff, 0xba, 0xdb, 0xea, 0xae, 0xe1, 0x33, 0x64, 0xaa, 0x65,
+	0xfd, 0xdb, 0xc5, 0xdc, 0x35, 0xac, 0xd6, 0x57, 0xc1, 0xb4, 0x8b, 0x7d, 0xe9, 0xe2, 0x26, 0xde,
+	0xa5, 0x8b, 0x8f, 0x8a, 0xda, 0x6a, 0xab, 0x73, 0x3e, 0xb6, 0x8c, 0x8b, 0xb1, 0x65, 0xfc, 0x1a,
+	0x5b, 0xc6, 0xe7, 0x89, 0x95, 0xbb, 0x98, 0x58, 0xb9, 0x1f, 0x13, 0x2b, 0xf7, 0x8a, 0xce, 0x04,
+	0x20, 0x6b, 0x3f, 0x9a, 0x9f, 0xe2, 0x9d, 0xa6, 0x93, 0x69, 0xe8, 0x99, 0xf2, 0x7d, 0x7a, 0xf0,
+	0x67, 0x00, 0xc1, 0xd0, 0x9e, 0x54, 0x54, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +337,9 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Boosts queries the current and potential boosts of the depositors of a
+	// gauge.
+	Boosts(ctx context.Context, in *QueryBoostsRequest, opts ...grpc.CallOption) (*QueryBoostsResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +359,22 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Boosts(ctx context.Context, in *QueryBoostsRequest, opts ...grpc.CallOption) (*QueryBoostsResponse, error) {
+	out := new(QueryBoostsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.gauge.v1.Query/Boosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Boosts queries the current and potential boosts of the depositors of a
+	// gauge.
+	Boosts(context.Context, *QueryBoostsRequest) (*QueryBoostsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +384,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Boosts(ctx context.Context, req *QueryBoostsRequest) (*QueryBoostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Boosts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +410,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Boosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBoostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Boosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.gauge.v1.Query/Boosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Boosts(ctx, req.(*QueryBoostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.gauge.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +436,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Boosts",
+			Handler:    _Query_Boosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/gauge/v1/query.proto",
@@ -282,6 +501,177 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBoostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositorBoost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositorBoost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositorBoost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PotentialBoost.Size()
+		i -= size
+		if _, err := m.PotentialBoost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Boost.Size()
+		i -= size
+		if _, err := m.Boost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PotentialDerived.Size()
+		i -= size
+		if _, err := m.PotentialDerived.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Derived.Size()
+		i -= size
+		if _, err := m.Derived.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Deposited.Size()
+		i -= size
+		if _, err := m.Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBoostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Boosts) > 0 {
+		for iNdEx := len(m.Boosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Boosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -313,6 +703,65 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBoostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositorBoost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Deposited.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Derived.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PotentialDerived.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Boost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PotentialBoost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBoostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Boosts) > 0 {
+		for _, e := range m.Boosts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -452,6 +901,496 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBoostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBoostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBoostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositorBoost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositorBoost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositorBoost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Derived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PotentialDerived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PotentialDerived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Boost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PotentialBoost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PotentialBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBoostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBoostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBoostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Boosts = append(m.Boosts, DepositorBoost{})
+			if err := m.Boosts[len(m.Boosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
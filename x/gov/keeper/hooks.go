@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Hooks records the block heights at which the voting periods of proposals
+// start, at which the ve voting power is counted
+type Hooks struct {
+	k Keeper
+}
+
+var _ govtypes.GovHooks = Hooks{}
+
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+func (h Hooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {}
+
+// AfterProposalDeposit records the block height if the deposit has activated
+// the voting period of the proposal
+func (h Hooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+	proposal, found := h.k.GetProposal(ctx, proposalID)
+	if !found || proposal.Status != govtypes.StatusVotingPeriod {
+		return
+	}
+	if _, found := h.k.GetVotingStartBlock(ctx, proposalID); !found {
+		h.k.SetVotingStartBlock(ctx, proposalID, ctx.BlockHeight())
+	}
+}
+
+func (h Hooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {}
+
+func (h Hooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {}
+
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.k.DeleteVotingStartBlock(ctx, proposalID)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gridiron-zone/gridiron/x/gov/types"
+)
+
+// Keeper wraps the gov keeper, which tallies the proposals with the voting
+// power of the ve locks besides the bonded stake.
+type Keeper struct {
+	govkeeper.Keeper
+	storeKey      sdk.StoreKey
+	stakingKeeper govtypes.StakingKeeper
+	veKeeper      types.VeKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key sdk.StoreKey,
+	paramSpace govtypes.ParamSubspace,
+	ak govtypes.AccountKeeper,
+	bk govtypes.BankKeeper,
+	sk govtypes.StakingKeeper,
+	vk types.VeKeeper,
+	rtr govtypes.Router,
+) Keeper {
+	keeper := Keeper{
+		Keeper:        govkeeper.NewKeeper(cdc, key, paramSpace, ak, bk, sk, rtr),
+		storeKey:      key,
+		stakingKeeper: sk,
+		veKeeper:      vk,
+	}
+	keeper.Keeper = *keeper.Keeper.SetHooks(keeper.Hooks())
+	return keeper
+}
+
+func (k Keeper) SetVotingStartBlock(ctx sdk.Context, proposalID uint64, block int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VotingStartBlockKey(proposalID), sdk.Uint64ToBigEndian(uint64(block)))
+}
+
+// GetVotingStartBlock returns the block height at which the voting period of
+// the proposal started, which is not found for the proposals imported in the
+// voting period.
+func (k Keeper) GetVotingStartBlock(ctx sdk.Context, proposalID uint64) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VotingStartBlockKey(proposalID))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+func (k Keeper) DeleteVotingStartBlock(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VotingStartBlockKey(proposalID))
+}
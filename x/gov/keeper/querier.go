@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// NewQuerier creates a new legacy gov Querier instance, which serves the tally
+// query with the ve voting power
+func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	querier := govkeeper.NewQuerier(k.Keeper, legacyQuerierCdc)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if len(path) > 0 && path[0] == govtypes.QueryTally {
+			return queryTally(ctx, req, k, legacyQuerierCdc)
+		}
+		return querier(ctx, path, req)
+	}
+}
+
+func queryTally(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params govtypes.QueryProposalParams
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, ok := k.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(govtypes.ErrUnknownProposal, "%d", params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.GetTallyResult(ctx, proposal))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Tally iterates over the votes and updates the tally of a proposal based on
+// the voting power of the voters, as the SDK gov keeper does, but also counts
+// the ve voting power of the voters at the block of the voting start. The ve
+// locks delegated to validators are already counted as bonded stake, so only
+// the voting power of the undelegated part of the locks is counted. All of the
+// ve terms are snapshots at the voting start, so that transferring ve NFTs or
+// changing delegations during the voting period does not shift the weight.
+func (k Keeper) Tally(ctx sdk.Context, proposal govtypes.Proposal) (passes bool, burnDeposits bool, tallyResults govtypes.TallyResult) {
+	results := make(map[govtypes.VoteOption]sdk.Dec)
+	results[govtypes.OptionYes] = sdk.ZeroDec()
+	results[govtypes.OptionAbstain] = sdk.ZeroDec()
+	results[govtypes.OptionNo] = sdk.ZeroDec()
+	results[govtypes.OptionNoWithVeto] = sdk.ZeroDec()
+
+	totalVotingPower := sdk.ZeroDec()
+	currValidators := make(map[string]govtypes.ValidatorGovInfo)
+
+	// fetch all the bonded validators, insert them into currValidators
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		currValidators[validator.GetOperator().String()] = govtypes.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			govtypes.WeightedVoteOptions{},
+		)
+
+		return false
+	})
+
+	atTime, atBlock := k.veVotingPowerAt(ctx, proposal)
+	ownedAtBlock := atBlock
+	if ownedAtBlock == 0 {
+		// the ve NFTs owned at the voting start time are unknown
+		ownedAtBlock = ctx.BlockHeight()
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	k.IterateVotes(ctx, proposal.ProposalId, func(vote govtypes.Vote) bool {
+		// if validator, just record it in the map
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		// iterate over all delegations from voter, deduct from any delegated-to validators
+		k.stakingKeeper.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+
+				// delegation shares * bonded / total shares
+				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+				for _, option := range vote.Options {
+					subPower := votingPower.Mul(option.Weight)
+					results[option.Option] = results[option.Option].Add(subPower)
+				}
+				totalVotingPower = totalVotingPower.Add(votingPower)
+			}
+
+			return false
+		})
+
+		// count the ve voting power of the voter
+		votingPower := sdk.ZeroDec()
+		k.veKeeper.IterateVeIDsOwnedAt(ctx, voter, ownedAtBlock, func(veID uint64) (stop bool) {
+			votingPower = votingPower.Add(k.getUndelegatedVotingPower(ctx, veID, atTime, atBlock))
+			return false
+		})
+		for _, option := range vote.Options {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		store.Delete(govtypes.VoteKey(vote.ProposalId, voter))
+		return false
+	})
+
+	// iterate over the validators again to tally their voting power
+	for _, val := range currValidators {
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	tallyParams := k.GetTallyParams(ctx)
+	tallyResults = govtypes.NewTallyResultFromMap(results)
+
+	// The quorum is reached against both the bonded stake and the ve voting power
+	totalPower := k.stakingKeeper.TotalBondedTokens(ctx).ToDec().Add(k.getTotalUndelegatedVotingPower(ctx, atTime, atBlock))
+
+	// If there is no staked coins or ve voting power, the proposal fails
+	if totalPower.IsZero() {
+		return false, false, tallyResults
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(totalPower)
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, true, tallyResults
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[govtypes.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, tallyResults
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[govtypes.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
+		return false, true, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	if results[govtypes.OptionYes].Quo(totalVotingPower.Sub(results[govtypes.OptionAbstain])).GT(tallyParams.Threshold) {
+		return true, false, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, tallyResults
+}
+
+// GetTallyResult returns the final tally result of the proposal, or the
+// current tally result if the proposal is in the voting period.
+func (k Keeper) GetTallyResult(ctx sdk.Context, proposal govtypes.Proposal) govtypes.TallyResult {
+	switch {
+	case proposal.Status == govtypes.StatusDepositPeriod:
+		return govtypes.EmptyTallyResult()
+
+	case proposal.Status == govtypes.StatusPassed || proposal.Status == govtypes.StatusRejected:
+		return proposal.FinalTallyResult
+
+	default:
+		// proposal is in voting period
+		_, _, tallyResult := k.Tally(ctx, proposal)
+		return tallyResult
+	}
+}
+
+// veVotingPowerAt returns the block at which the ve voting power is counted
+// for the proposal, or the time of the voting start if the block is unknown
+func (k Keeper) veVotingPowerAt(ctx sdk.Context, proposal govtypes.Proposal) (atTime uint64, atBlock int64) {
+	if block, found := k.GetVotingStartBlock(ctx, proposal.ProposalId); found {
+		return 0, block
+	}
+	return uint64(proposal.VotingStartTime.Unix()), 0
+}
+
+// getUndelegatedVotingPower returns the voting power of the part of the lock
+// of the ve not delegated to validators
+func (k Keeper) getUndelegatedVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Dec {
+	power := k.veKeeper.GetVotingPower(ctx, veID, atTime, atBlock)
+	delegated := k.veKeeper.GetDelegatedVotingPower(ctx, veID, atTime, atBlock)
+	return sdk.MaxInt(power.Sub(delegated), sdk.ZeroInt()).ToDec()
+}
+
+// getTotalUndelegatedVotingPower returns the total voting power of the parts
+// of the locks not delegated to validators
+func (k Keeper) getTotalUndelegatedVotingPower(ctx sdk.Context, atTime uint64, atBlock int64) sdk.Dec {
+	power := k.veKeeper.GetTotalVotingPower(ctx, atTime, atBlock)
+	delegated := k.veKeeper.GetTotalDelegatedVotingPower(ctx, atTime, atBlock)
+	return sdk.MaxInt(power.Sub(delegated), sdk.ZeroInt()).ToDec()
+}
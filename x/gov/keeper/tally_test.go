@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/contracts"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/gov"
+	stakingkeeper2 "github.com/gridiron-zone/gridiron/x/staking/keeper"
+	customstakingtypes "github.com/gridiron-zone/gridiron/x/staking/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *app.Gridiron
+
+	valAddr sdk.ValAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	require := suite.Require()
+
+	suite.app = app.Setup(false)
+	// the proposer is required by the EVM, where the veNFTs are mirrored
+	privCons := ed25519.GenPrivKey()
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		ChainID:         "gridiron_5000-101",
+		Height:          1,
+		Time:            time.Now().UTC(),
+		ProposerAddress: privCons.PubKey().Address().Bytes(),
+	})
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	suite.valAddr = sdk.ValAddress(priv.PubKey().Address())
+	validator, err := stakingtypes.NewValidator(suite.valAddr, privCons.PubKey(), stakingtypes.Description{})
+	require.NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper.Keeper, suite.ctx, validator, true)
+	suite.app.StakingKeeper.AfterValidatorCreated(suite.ctx, validator.GetOperator())
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator)
+	require.NoError(err)
+
+	// bond the validator by the self-delegation
+	valAccAddr := suite.newAccount()
+	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, valAccAddr, coin(1).Amount, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	_, err = suite.app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(suite.ctx)
+	require.NoError(err)
+
+	suite.app.GovKeeper.SetDepositParams(suite.ctx, govtypes.NewDepositParams(
+		sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 100)), time.Hour,
+	))
+	suite.app.GovKeeper.SetVotingParams(suite.ctx, govtypes.NewVotingParams(24*time.Hour))
+}
+
+func (suite *KeeperTestSuite) newAccount() sdk.AccAddress {
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, addr, sdk.NewCoins(coin(10000)))
+	suite.Require().NoError(err)
+	return addr
+}
+
+// coin returns the amount of whole tokens, since the ve voting power of tiny
+// locks is truncated to zero
+func coin(amount int64) sdk.Coin {
+	return sdk.NewCoin(gridiron.BaseDenom, sdk.NewIntWithDecimal(amount, 18))
+}
+
+func (suite *KeeperTestSuite) nextBlock() {
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+}
+
+func (suite *KeeperTestSuite) TestTallyVeVotingPower() {
+	require := suite.Require()
+	k := suite.app.GovKeeper
+	voter1 := suite.newAccount()
+	voter2 := suite.newAccount()
+
+	// The ve of the first voter is locked before the voting start, and half
+	// of it is delegated to the validator
+	veID1, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, voter1, voter1, coin(2000), vetypes.MaxLockTime)
+	require.NoError(err)
+	_, err = stakingkeeper2.NewMsgServerImpl(suite.app.StakingKeeper).VeDelegate(sdk.WrapSDKContext(suite.ctx), &customstakingtypes.MsgVeDelegate{
+		DelegatorAddress: voter1.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		VeId:             vetypes.VeIDFromUint64(veID1),
+		Amount:           coin(1000),
+	})
+	require.NoError(err)
+
+	// The voting period starts upon the deposit
+	suite.nextBlock()
+	proposal, err := k.SubmitProposal(suite.ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(err)
+	_, found := k.GetVotingStartBlock(suite.ctx, proposal.ProposalId)
+	require.False(found)
+	activated, err := k.AddDeposit(suite.ctx, proposal.ProposalId, voter2, sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 100)))
+	require.NoError(err)
+	require.True(activated)
+	startBlock, found := k.GetVotingStartBlock(suite.ctx, proposal.ProposalId)
+	require.True(found)
+	require.Equal(suite.ctx.BlockHeight(), startBlock)
+
+	// The ve of the second voter is locked after the voting start
+	suite.nextBlock()
+	_, _, err = suite.app.VeKeeper.CreateLock(suite.ctx, voter2, voter2, coin(5000), vetypes.MaxLockTime)
+	require.NoError(err)
+
+	suite.nextBlock()
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, voter1, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, voter2, govtypes.NewNonSplitVoteOption(govtypes.OptionNo)))
+
+	// The first voter votes with the delegation and the voting power of the
+	// undelegated half of the ve at the voting start, while the second voter
+	// has no voting power
+	power := suite.app.VeKeeper.GetVotingPower(suite.ctx, veID1, 0, startBlock)
+	require.True(power.IsPositive())
+	delegatedPower := suite.app.VeKeeper.GetDelegatedVotingPower(suite.ctx, veID1, 0, startBlock)
+	require.True(power.QuoRaw(2).Sub(delegatedPower).Abs().LTE(sdk.OneInt()))
+	proposal, _ = k.GetProposal(suite.ctx, proposal.ProposalId)
+	// the votes are deleted by the tally, so it's done in the cached context
+	cacheCtx, _ := suite.ctx.CacheContext()
+	passes, burnDeposits, tally := k.Tally(cacheCtx, proposal)
+	require.True(passes)
+	require.False(burnDeposits)
+	require.Equal(power.Sub(delegatedPower).Add(coin(1000).Amount), tally.Yes)
+	require.True(tally.No.IsZero())
+	cacheCtx, _ = suite.ctx.CacheContext()
+	require.Equal(tally, k.GetTallyResult(cacheCtx, proposal))
+
+	// The proposal is tallied at the end of the voting period
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(proposal.VotingEndTime)
+	gov.EndBlocker(suite.ctx, k)
+	proposal, _ = k.GetProposal(suite.ctx, proposal.ProposalId)
+	require.Equal(govtypes.StatusPassed, proposal.Status)
+	require.Equal(tally, proposal.FinalTallyResult)
+	require.Empty(k.GetVotes(suite.ctx, proposal.ProposalId))
+	_, found = k.GetVotingStartBlock(suite.ctx, proposal.ProposalId)
+	require.False(found)
+}
+
+func (suite *KeeperTestSuite) TestTallyVeVotingPowerQuorum() {
+	require := suite.Require()
+	k := suite.app.GovKeeper
+	voter1 := suite.newAccount()
+	voter2 := suite.newAccount()
+
+	_, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, voter1, voter1, coin(1000), vetypes.MaxLockTime)
+	require.NoError(err)
+	_, _, err = suite.app.VeKeeper.CreateLock(suite.ctx, voter2, voter2, coin(9000), vetypes.MaxLockTime)
+	require.NoError(err)
+
+	suite.nextBlock()
+	proposal, err := k.SubmitProposal(suite.ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(err)
+	_, err = k.AddDeposit(suite.ctx, proposal.ProposalId, voter1, sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 100)))
+	require.NoError(err)
+
+	// The quorum is not reached by the minor ve voting power
+	suite.nextBlock()
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, voter1, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+	proposal, _ = k.GetProposal(suite.ctx, proposal.ProposalId)
+	passes, burnDeposits, tally := k.Tally(suite.ctx, proposal)
+	require.False(passes)
+	require.True(burnDeposits)
+	require.True(tally.Yes.IsPositive())
+}
+
+func (suite *KeeperTestSuite) TestTallyVeVotingPowerSnapshot() {
+	require := suite.Require()
+	k := suite.app.GovKeeper
+	voter1 := suite.newAccount()
+	voter2 := suite.newAccount()
+
+	veID1, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, voter1, voter1, coin(2000), vetypes.MaxLockTime)
+	require.NoError(err)
+	_, err = stakingkeeper2.NewMsgServerImpl(suite.app.StakingKeeper).VeDelegate(sdk.WrapSDKContext(suite.ctx), &customstakingtypes.MsgVeDelegate{
+		DelegatorAddress: voter1.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		VeId:             vetypes.VeIDFromUint64(veID1),
+		Amount:           coin(1000),
+	})
+	require.NoError(err)
+
+	suite.nextBlock()
+	proposal, err := k.SubmitProposal(suite.ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(err)
+	_, err = k.AddDeposit(suite.ctx, proposal.ProposalId, voter2, sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 100)))
+	require.NoError(err)
+	startBlock, found := k.GetVotingStartBlock(suite.ctx, proposal.ProposalId)
+	require.True(found)
+
+	power := suite.app.VeKeeper.GetVotingPower(suite.ctx, veID1, 0, startBlock)
+	delegatedPower := suite.app.VeKeeper.GetDelegatedVotingPower(suite.ctx, veID1, 0, startBlock)
+	require.True(delegatedPower.IsPositive())
+	require.Equal(power, suite.app.VeKeeper.GetTotalVotingPower(suite.ctx, 0, startBlock))
+	require.Equal(delegatedPower, suite.app.VeKeeper.GetTotalDelegatedVotingPower(suite.ctx, 0, startBlock))
+
+	// After the voting start, the ve is transferred to the second voter and
+	// its delegation is removed, as upon the completion of the unbonding
+	suite.nextBlock()
+	require.NoError(suite.app.NftKeeper.Transfer(suite.ctx, vetypes.VeNftClass.Id, vetypes.VeIDFromUint64(veID1), voter2))
+	suite.app.StakingKeeper.SubVeDelegatedAmount(suite.ctx, veID1, coin(1000).Amount)
+	now := uint64(suite.ctx.BlockTime().Unix())
+	require.True(suite.app.VeKeeper.GetDelegatedVotingPower(suite.ctx, veID1, now, 0).IsZero())
+	require.True(suite.app.VeKeeper.GetTotalDelegatedVotingPower(suite.ctx, now, 0).IsZero())
+
+	suite.nextBlock()
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, voter1, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, voter2, govtypes.NewNonSplitVoteOption(govtypes.OptionNo)))
+
+	// The ve is still counted for the first voter with its delegation at
+	// the voting start
+	proposal, _ = k.GetProposal(suite.ctx, proposal.ProposalId)
+	_, _, tally := k.Tally(suite.ctx, proposal)
+	require.Equal(power.Sub(delegatedPower).Add(coin(1000).Amount), tally.Yes)
+	require.True(tally.No.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestTallyVeVotingPowerMirrorTransfer() {
+	require := suite.Require()
+	k := suite.app.GovKeeper
+	seller := suite.newAccount()
+	buyer := suite.newAccount()
+
+	veID, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, seller, seller, coin(1000), vetypes.MaxLockTime)
+	require.NoError(err)
+
+	// The ve is sold through its ERC-721 mirror token before the voting start
+	suite.nextBlock()
+	contract, found := suite.app.VeKeeper.GetNftContract(suite.ctx)
+	require.True(found)
+	from, to := common.BytesToAddress(seller), common.BytesToAddress(buyer)
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.VeNftContract.ABI, from, contract, "transferFrom", from, to, new(big.Int).SetUint64(veID))
+	require.NoError(err)
+	err = suite.app.NftKeeper.EvmHooks().PostTxProcessing(suite.ctx, nil, &ethtypes.Receipt{Logs: evmtypes.LogsToEthereum(res.Logs)})
+	require.NoError(err)
+	require.Equal(buyer, suite.app.NftKeeper.GetOwner(suite.ctx, vetypes.VeNftClass.Id, vetypes.VeIDFromUint64(veID)))
+
+	suite.nextBlock()
+	proposal, err := k.SubmitProposal(suite.ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(err)
+	_, err = k.AddDeposit(suite.ctx, proposal.ProposalId, seller, sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 100)))
+	require.NoError(err)
+	startBlock, found := k.GetVotingStartBlock(suite.ctx, proposal.ProposalId)
+	require.True(found)
+
+	suite.nextBlock()
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, seller, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)))
+	require.NoError(k.AddVote(suite.ctx, proposal.ProposalId, buyer, govtypes.NewNonSplitVoteOption(govtypes.OptionNo)))
+
+	// The ve is counted for the buyer only
+	power := suite.app.VeKeeper.GetVotingPower(suite.ctx, veID, 0, startBlock)
+	require.True(power.IsPositive())
+	proposal, _ = k.GetProposal(suite.ctx, proposal.ProposalId)
+	_, _, tally := k.Tally(suite.ctx, proposal)
+	require.True(tally.Yes.IsZero())
+	require.Equal(power, tally.No)
+}
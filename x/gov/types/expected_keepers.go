@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VeKeeper defines the expected interface needed to query ve voting power.
+type VeKeeper interface {
+	GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
+	GetDelegatedVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
+	GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int64) sdk.Int
+	GetTotalDelegatedVotingPower(ctx sdk.Context, atTime uint64, atBlock int64) sdk.Int
+	IterateVeIDsOwnedAt(ctx sdk.Context, owner sdk.AccAddress, block int64, cb func(veID uint64) (stop bool))
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Keys for the gov store, which must not collide with the prefixes of the
+// SDK gov module sharing the store
+var (
+	VotingStartBlockKeyPrefix = []byte{0x40}
+)
+
+// VotingStartBlockKey gets the key of the block height at which the voting
+// period of the proposal started
+func VotingStartBlockKey(proposalID uint64) []byte {
+	return append(VotingStartBlockKeyPrefix, govtypes.GetProposalIDBytes(proposalID)...)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // EndBlocker is called at the end of every block
@@ -59,6 +60,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
 		ctx.Logger().Debug("organized ballot by denom", "voteMap", voteMap)
 
+		// Relative deviations of tallied votes from the weighted median by validator
+		validatorDeviationMap := make(map[string][]sdk.Dec)
+
 		if referenceGridb := PickReferenceGridb(ctx, k, voteTargets, voteMap); referenceGridb != "" {
 			// make voteMap of Reference Grid to calculate cross exchange rates
 			ballotRM := voteMap[referenceGridb]
@@ -77,12 +81,17 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 				}
 
 				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				_, rewardBand, _ := k.TargetVotingParams(ctx, denom)
+				exchangeRate := Tally(ctx, ballot, rewardBand, validatorClaimMap)
+
+				for voter, deviation := range ballot.Deviations(exchangeRate) {
+					validatorDeviationMap[voter] = append(validatorDeviationMap[voter], deviation)
+				}
 
 				// Transform into the original form {denom}/uUSD
 				if denom != referenceGridb {
 					if exchangeRate.IsZero() {
-						k.Logger(ctx).Error("invalid tallied cross exchange rate", "denom", denom, "exchangeRate", exchangeRate)
+						reportBallotFailure(ctx, k, denom, sdkerrors.Wrapf(types.ErrInvalidCrossRate, "cross exchange rate %s", exchangeRate))
 						// Do not set exchange rate
 						continue
 					}
@@ -94,6 +103,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			}
 		}
 
+		// Push the new exchange rates to the EVM price feeds
+		k.UpdateFeeds(ctx)
+
 		// ---------------------------
 		// Do miss counting & slashing
 		voteTargetsLen := len(voteTargets)
@@ -107,6 +119,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 			k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
 		}
 
+		// Record the voting statistics of the validators
+		k.UpdateValidatorPerformances(ctx, voteTargetsLen, validatorClaimMap, validatorDeviationMap)
+
 		// Distribute rewards to ballot winners
 		k.RewardBallotWinners(
 			ctx,
@@ -125,6 +140,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// at the last block of slash window
 	if gridiron.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
+		k.ArchiveValidatorPerformances(ctx)
 	}
 
 	return
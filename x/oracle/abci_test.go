@@ -585,6 +585,150 @@ func TestAbstainWithSmallStakingPower(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestValidatorPerformance(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.SlashWindow = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, denom1)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, denom2)
+
+	// Validator 3 deviates from the others by 0.5%
+	deviation := sdk.NewDecWithPrec(5, 3)
+	rates := sdk.DecCoins{{Denom: denom1, Amount: randomExchangeRate}, {Denom: denom2, Amount: anotherRandomExchangeRate}}
+	deviatedRates := sdk.DecCoins{
+		{Denom: denom1, Amount: randomExchangeRate.Mul(sdk.OneDec().Add(deviation))},
+		{Denom: denom2, Amount: anotherRandomExchangeRate.Mul(sdk.OneDec().Add(deviation))},
+	}
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, deviatedRates, 2)
+
+	// The last block of the first slash window
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetPerformanceWindow(input.Ctx))
+
+	for i, expectedDeviation := range []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec(), deviation.QuoInt64(2)} {
+		performance, found := input.OracleKeeper.GetPerformanceHistory(input.Ctx, 1, keeper.ValAddrs[i])
+		require.True(t, found)
+		require.Equal(t, uint64(1), performance.Window)
+		require.Equal(t, int64(1), performance.EndHeight)
+		require.Equal(t, uint64(1), performance.VotePeriods)
+		require.Equal(t, uint64(1), performance.Votes)
+		require.Equal(t, uint64(2), performance.Wins)
+		require.Equal(t, uint64(0), performance.Misses)
+		require.Equal(t, uint64(0), performance.Abstains)
+		require.Equal(t, uint64(2), performance.TalliedVotes)
+		require.True(t, expectedDeviation.Sub(performance.AverageDeviation).Abs().LTE(sdk.NewDecWithPrec(1, 12)))
+	}
+
+	// Validator 1 abstains, validator 2 votes alone and validator 3 does not vote,
+	// so that the ballot fails without tally
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, denom1)
+	makeAggregatePrevoteAndVote(t, input, h, 1, sdk.DecCoins{{Denom: denom1, Amount: sdk.ZeroDec()}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 1, sdk.DecCoins{{Denom: denom1, Amount: randomExchangeRate}}, 1)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(2), input.OracleKeeper)
+
+	performance := input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[0])
+	require.Equal(t, uint64(0), performance.Window)
+	require.Equal(t, uint64(1), performance.VotePeriods)
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(1), performance.Abstains)
+	require.Equal(t, uint64(0), performance.TalliedVotes)
+
+	performance = input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[1])
+	require.Equal(t, uint64(1), performance.Votes)
+	require.Equal(t, uint64(0), performance.Abstains)
+	require.Equal(t, uint64(0), performance.TalliedVotes)
+
+	performance = input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[2])
+	require.Equal(t, uint64(1), performance.VotePeriods)
+	require.Equal(t, uint64(0), performance.Votes)
+
+	// Only the latest window is kept in the history
+	params.PerformanceHistory = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(3), input.OracleKeeper)
+	require.Equal(t, uint64(2), input.OracleKeeper.GetPerformanceWindow(input.Ctx))
+
+	_, found := input.OracleKeeper.GetPerformanceHistory(input.Ctx, 1, keeper.ValAddrs[0])
+	require.False(t, found)
+	performance, found = input.OracleKeeper.GetPerformanceHistory(input.Ctx, 2, keeper.ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, uint64(2), performance.VotePeriods)
+	require.Equal(t, uint64(1), performance.Abstains)
+}
+
+func TestTargetVotingParams(t *testing.T) {
+	input, h := setup(t)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	input.OracleKeeper.SetVoteTarget(input.Ctx, denom1)
+
+	threshold := sdk.NewDecWithPrec(8, 1)
+	input.OracleKeeper.SetTarget(input.Ctx, types.TargetParams{
+		Denom:        denom1,
+		Source:       types.TARGET_SOURCE_VALIDATORS,
+		VotingParams: types.TargetVotingParams{VoteThreshold: &threshold},
+	})
+
+	requireBallotFailure := func(ctx sdk.Context, reason error) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeBallotFailure {
+				continue
+			}
+			require.Equal(t, denom1, string(event.Attributes[0].Value))
+			require.Contains(t, string(event.Attributes[1].Value), reason.Error())
+			return
+		}
+		require.Fail(t, "no ballot failure event")
+	}
+
+	// Case 1.
+	// 2/3 of the voting power passes the module threshold but not the target threshold
+	rates := sdk.DecCoins{{Denom: denom1, Amount: randomExchangeRate}}
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, denom1)
+	require.Error(t, err)
+	requireBallotFailure(ctx, types.ErrBallotThreshold)
+
+	// Case 2.
+	// Passing the vote threshold, but not enough voters
+	input.OracleKeeper.SetTarget(input.Ctx, types.TargetParams{
+		Denom:        denom1,
+		Source:       types.TARGET_SOURCE_VALIDATORS,
+		VotingParams: types.TargetVotingParams{MinVoters: 3},
+	})
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+
+	ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, denom1)
+	require.Error(t, err)
+	requireBallotFailure(ctx, types.ErrBallotMinVoters)
+
+	// Case 3.
+	// Enough voters
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 2)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, denom1)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+}
+
 func makeAggregatePrevoteAndVote(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, rates sdk.DecCoins, idx int) {
 	// Account 1, denom1
 	salt := "1"
@@ -3,11 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/gridiron-zone/gridiron/x/oracle/types"
@@ -32,6 +35,8 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryMissCounter(),
 		CmdQueryAggregatePrevote(),
 		CmdQueryAggregateVote(),
+		CmdQueryFeeds(),
+		CmdQueryPerformance(),
 		CmdQueryParams(),
 	)
 	// this line is used by starport scaffolding # 1
@@ -342,6 +347,133 @@ $ gridirond query oracle aggregate-votes mervaloper...
 	return cmd
 }
 
+// CmdQueryFeeds implements the query price feeds command.
+func CmdQueryFeeds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeds [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the EVM price feed contracts of the oracle",
+		Long: strings.TrimSpace(`
+Query the EVM price feed registry and all the price feed contracts.
+
+$ gridirond query oracle feeds
+
+Or, can filter with denom
+
+$ gridirond query oracle feeds uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.Feeds(context.Background(), &types.QueryFeedsRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.Feed(
+				context.Background(),
+				&types.QueryFeedRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPerformance implements the query validator performance command.
+func CmdQueryPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the oracle voting statistics of validators by slash window",
+		Long: strings.TrimSpace(`
+Query the oracle voting statistics of all validators in a slash window.
+The window defaults to the current one, or specify a past one by its sequence number
+
+$ gridirond query oracle performance --window 42
+
+Or, query the statistics of a validator in the current and the past windows
+
+$ gridirond query oracle performance gridvaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var (
+				res          proto.Message
+				performances []types.ValidatorPerformance
+			)
+			if len(args) == 0 {
+				window, err := cmd.Flags().GetUint64(FlagWindow)
+				if err != nil {
+					return err
+				}
+
+				resp, err := queryClient.WindowPerformances(
+					context.Background(),
+					&types.QueryWindowPerformancesRequest{Window: window},
+				)
+				if err != nil {
+					return err
+				}
+				res, performances = resp, resp.Performances
+			} else {
+				validator, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+
+				resp, err := queryClient.ValidatorPerformance(
+					context.Background(),
+					&types.QueryValidatorPerformanceRequest{ValidatorAddr: validator.String()},
+				)
+				if err != nil {
+					return err
+				}
+				res, performances = resp, append([]types.ValidatorPerformance{resp.Current}, resp.History...)
+			}
+
+			if clientCtx.OutputFormat != "text" {
+				return clientCtx.PrintProto(res)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "WINDOW\tVALIDATOR\tPERIODS\tVOTES\tWINS\tMISSES\tABSTAINS\tAVG DEVIATION")
+			for _, p := range performances {
+				window := strconv.FormatUint(p.Window, 10)
+				if p.Window == 0 {
+					window = "current"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%s\n",
+					window, p.ValidatorAddress, p.VotePeriods, p.Votes, p.Wins, p.Misses, p.Abstains, p.AverageDeviation)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().Uint64(FlagWindow, 0, "Sequence number of the slash window, or 0 for the current window")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
@@ -365,3 +497,7 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+const (
+	FlagWindow = "window"
+)
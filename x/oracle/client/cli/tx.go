@@ -256,6 +256,62 @@ The proposal details must be supplied via a JSON file.`,
 	return cmd
 }
 
+func NewSetTargetVotingParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-oracle-target-voting-params [denom] [params-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to set the voting params of an oracle target",
+		Long: strings.TrimSpace(
+			`Submit a proposal to set the voting params of an oracle target along with an initial deposit.
+The voting params must be supplied via a JSON file, and the omitted ones fall back to the module params.
+
+Example params file:
+{
+  "vote_threshold": "0.667000000000000000",
+  "reward_band": "0.050000000000000000",
+  "min_voters": "5"
+}`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			title, description, deposit, err := getProposalArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			var votingParams types.TargetVotingParams
+			err = parseProposalContent(clientCtx.Codec, args[1], &votingParams)
+			if err != nil {
+				return err
+			}
+
+			content := &types.SetTargetVotingParamsProposal{
+				Title:        title,
+				Description:  description,
+				Denom:        args[0],
+				VotingParams: votingParams,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parseProposalContent(cdc codec.JSONCodec, proposalFile string, proposal proto.Message) error {
 	content, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
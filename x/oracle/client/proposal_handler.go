@@ -7,5 +7,6 @@ import (
 )
 
 var (
-	RegisterTargetProposalHandler = govclient.NewProposalHandler(cli.NewRegisterTargetProposalCmd, rest.RegisterTargetProposalRESTHandler)
+	RegisterTargetProposalHandler        = govclient.NewProposalHandler(cli.NewRegisterTargetProposalCmd, rest.RegisterTargetProposalRESTHandler)
+	SetTargetVotingParamsProposalHandler = govclient.NewProposalHandler(cli.NewSetTargetVotingParamsProposalCmd, rest.SetTargetVotingParamsProposalRESTHandler)
 )
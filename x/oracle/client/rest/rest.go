@@ -20,6 +20,15 @@ type RegisterBackingProposalRequest struct {
 	TargetParams types.TargetParams `json:"target_params" yaml:"target_params"`
 }
 
+type SetTargetVotingParamsProposalRequest struct {
+	BaseReq      rest.BaseReq             `json:"base_req" yaml:"base_req"`
+	Title        string                   `json:"title" yaml:"title"`
+	Description  string                   `json:"description" yaml:"description"`
+	Deposit      sdk.Coins                `json:"deposit" yaml:"deposit"`
+	Denom        string                   `json:"denom" yaml:"denom"`
+	VotingParams types.TargetVotingParams `json:"voting_params" yaml:"voting_params"`
+}
+
 func RegisterTargetProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{
 		SubRoute: types.ModuleName,
@@ -59,3 +68,44 @@ func RegisterTargetProposalRESTHandler(clientCtx client.Context) govrest.Proposa
 		},
 	}
 }
+
+func SetTargetVotingParamsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetTargetVotingParamsProposalRequest
+
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			from, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			content := &types.SetTargetVotingParamsProposal{
+				Title:        req.Title,
+				Description:  req.Description,
+				Denom:        req.Denom,
+				VotingParams: req.VotingParams,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, from)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gridiron-zone/gridiron/x/oracle/keeper"
 	"github.com/gridiron-zone/gridiron/x/oracle/types"
 )
@@ -56,6 +57,29 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	if genState.FeedRegistry != "" {
+		k.SetFeedRegistry(ctx, common.HexToAddress(genState.FeedRegistry))
+	}
+
+	for _, feed := range genState.Feeds {
+		k.SetFeed(ctx, feed.Denom, common.HexToAddress(feed.Address))
+	}
+
+	k.SetPerformanceWindow(ctx, genState.PerformanceWindow)
+
+	for _, vp := range genState.ValidatorPerformances {
+		operator, err := sdk.ValAddressFromBech32(vp.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if vp.Window == 0 {
+			k.SetValidatorPerformance(ctx, operator, vp)
+		} else {
+			k.SetPerformanceHistory(ctx, operator, vp)
+		}
+	}
+
 	k.SetParams(ctx, genState.Params)
 
 	// check if the module account exists
@@ -104,10 +128,35 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	feedRegistry := ""
+	if registry, found := k.GetFeedRegistry(ctx); found {
+		feedRegistry = registry.Hex()
+	}
+
+	feeds := []types.FeedContract{}
+	k.IterateFeeds(ctx, func(denom string, feed common.Address) (stop bool) {
+		feeds = append(feeds, types.FeedContract{Denom: denom, Address: feed.Hex()})
+		return false
+	})
+
+	validatorPerformances := []types.ValidatorPerformance{}
+	k.IterateValidatorPerformances(ctx, func(_ sdk.ValAddress, performance types.ValidatorPerformance) (stop bool) {
+		validatorPerformances = append(validatorPerformances, performance)
+		return false
+	})
+	k.IteratePerformanceHistory(ctx, func(_ sdk.ValAddress, performance types.ValidatorPerformance) (stop bool) {
+		validatorPerformances = append(validatorPerformances, performance)
+		return false
+	})
+
 	return types.NewGenesis(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
 		aggregateExchangeRatePrevotes,
-		aggregateExchangeRateVotes)
+		aggregateExchangeRateVotes,
+		feedRegistry,
+		feeds,
+		k.GetPerformanceWindow(ctx),
+		validatorPerformances)
 }
@@ -18,6 +18,12 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{123}, keeper.ValAddrs[0], uint64(2)))
 	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], 10)
+	performance := types.NewValidatorPerformance(keeper.ValAddrs[0])
+	performance.Votes = 10
+	input.OracleKeeper.SetValidatorPerformance(input.Ctx, keeper.ValAddrs[0], performance)
+	performance.Window = 2
+	input.OracleKeeper.SetPerformanceHistory(input.Ctx, keeper.ValAddrs[0], performance)
+	input.OracleKeeper.SetPerformanceWindow(input.Ctx, 2)
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
 
 	newInput := keeper.CreateTestInput(t)
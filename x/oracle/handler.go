@@ -39,6 +39,8 @@ func NewOracleProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.RegisterTargetProposal:
 			return keeper.HandleRegisterTargetProposal(ctx, k, c)
+		case *types.SetTargetVotingParamsProposal:
+			return keeper.HandleSetTargetVotingParamsProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
 		}
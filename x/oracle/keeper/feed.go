@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gridiron-zone/gridiron/contracts"
+	"github.com/gridiron-zone/gridiron/x/oracle/types"
+)
+
+// -----------------------------------
+// Price feed logic
+
+// GetFeedRegistry gets the address of the EVM price feed registry contract.
+func (k Keeper) GetFeedRegistry(ctx sdk.Context) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeedRegistryKey)
+	if bz == nil {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetFeedRegistry sets the address of the EVM price feed registry contract.
+func (k Keeper) SetFeedRegistry(ctx sdk.Context, registry common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeedRegistryKey, registry.Bytes())
+}
+
+// GetFeed gets the address of the EVM price feed contract of the denom.
+func (k Keeper) GetFeed(ctx sdk.Context, denom string) (common.Address, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeedKey(denom))
+	if bz == nil {
+		return common.Address{}, sdkerrors.Wrap(types.ErrNoFeed, denom)
+	}
+	return common.BytesToAddress(bz), nil
+}
+
+// SetFeed sets the address of the EVM price feed contract of the denom.
+func (k Keeper) SetFeed(ctx sdk.Context, denom string, feed common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeedKey(denom), feed.Bytes())
+}
+
+// IterateFeeds iterates over price feed contracts in the store.
+func (k Keeper) IterateFeeds(ctx sdk.Context, handler func(denom string, feed common.Address) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FeedKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := types.ExtractDenomFromFeedKey(iter.Key())
+
+		if handler(denom, common.BytesToAddress(iter.Value())) {
+			break
+		}
+	}
+}
+
+// DeployFeed deploys the EVM price feed contract of the denom and registers
+// it in the price feed registry, which will be deployed first if not exists.
+// Both contracts are owned by the oracle module account.
+func (k Keeper) DeployFeed(ctx sdk.Context, denom string) (common.Address, error) {
+	if _, err := k.GetFeed(ctx, denom); err == nil {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrExistingFeed, "existing price feed of denom '%s'", denom)
+	}
+
+	// ensure the module account exists, since its sequence is the deployment nonce
+	k.GetOracleAccount(ctx)
+
+	registry, found := k.GetFeedRegistry(ctx)
+	if !found {
+		var err error
+		registry, err = k.erc20Keeper.DeployContract(ctx, types.ModuleAddress, contracts.OracleFeedRegistryContract)
+		if err != nil {
+			return common.Address{}, sdkerrors.Wrap(err, "failed to deploy price feed registry")
+		}
+		k.SetFeedRegistry(ctx, registry)
+	}
+
+	feed, err := k.erc20Keeper.DeployContract(
+		ctx,
+		types.ModuleAddress,
+		contracts.OracleFeedContract,
+		uint8(types.FeedDecimals),
+		types.FeedDescription(denom),
+	)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(err, "failed to deploy price feed of denom '%s'", denom)
+	}
+
+	_, err = k.erc20Keeper.CallEVM(ctx, contracts.OracleFeedRegistryContract.ABI, types.ModuleAddress, registry, "setFeed", denom, feed)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(err, "failed to register price feed of denom '%s'", denom)
+	}
+
+	k.SetFeed(ctx, denom, feed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeDeployFeed,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyFeed, feed.Hex()),
+		),
+	)
+
+	return feed, nil
+}
+
+// UpdateFeeds pushes the current exchange rates to the EVM price feeds as new
+// rounds timestamped with the block time. Feeds of denoms without exchange
+// rate are left untouched, so that consumers can detect the staleness by the
+// updatedAt of the latest round. A failed update is logged and skipped.
+func (k Keeper) UpdateFeeds(ctx sdk.Context) {
+	denoms := []string{}
+	feeds := []common.Address{}
+	k.IterateFeeds(ctx, func(denom string, feed common.Address) (stop bool) {
+		denoms = append(denoms, denom)
+		feeds = append(feeds, feed)
+		return false
+	})
+
+	timestamp := big.NewInt(ctx.BlockTime().Unix())
+	for i, denom := range denoms {
+		exchangeRate, err := k.GetExchangeRate(ctx, denom)
+		if err != nil {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		_, err = k.erc20Keeper.CallEVM(
+			cacheCtx,
+			contracts.OracleFeedContract.ABI,
+			types.ModuleAddress,
+			feeds[i],
+			"updateAnswer",
+			exchangeRate.BigInt(),
+			timestamp,
+		)
+		if err != nil {
+			k.Logger(ctx).Error("failed to update price feed", "denom", denom, "feed", feeds[i].Hex(), "error", err)
+			continue
+		}
+		writeCache()
+	}
+}
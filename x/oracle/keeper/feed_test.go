@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gridiron-zone/gridiron/x/oracle/types"
+)
+
+func TestDeployFeed(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, found := input.OracleKeeper.GetFeedRegistry(input.Ctx)
+	require.False(t, found)
+
+	feed1, err := input.OracleKeeper.DeployFeed(input.Ctx, fooDenom1)
+	require.NoError(t, err)
+
+	// The registry is deployed along with the first feed
+	registry, found := input.OracleKeeper.GetFeedRegistry(input.Ctx)
+	require.True(t, found)
+	require.Equal(t, []common.Address{registry, feed1}, input.Erc20Keeper.Deployed)
+	require.Len(t, input.Erc20Keeper.Calls, 1)
+	require.Equal(t, MockEVMCall{
+		From:     types.ModuleAddress,
+		Contract: registry,
+		Method:   "setFeed",
+		Args:     []interface{}{fooDenom1, feed1},
+	}, input.Erc20Keeper.Calls[0])
+
+	feed, err := input.OracleKeeper.GetFeed(input.Ctx, fooDenom1)
+	require.NoError(t, err)
+	require.Equal(t, feed1, feed)
+
+	feed2, err := input.OracleKeeper.DeployFeed(input.Ctx, fooDenom2)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{registry, feed1, feed2}, input.Erc20Keeper.Deployed)
+
+	_, err = input.OracleKeeper.DeployFeed(input.Ctx, fooDenom2)
+	require.ErrorIs(t, err, types.ErrExistingFeed)
+
+	_, err = input.OracleKeeper.GetFeed(input.Ctx, fooDenom3)
+	require.ErrorIs(t, err, types.ErrNoFeed)
+
+	feeds := map[string]common.Address{}
+	input.OracleKeeper.IterateFeeds(input.Ctx, func(denom string, feed common.Address) (stop bool) {
+		feeds[denom] = feed
+		return false
+	})
+	require.Equal(t, map[string]common.Address{fooDenom1: feed1, fooDenom2: feed2}, feeds)
+}
+
+func TestDeployFeedFailure(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Erc20Keeper.Fail = true
+
+	_, err := input.OracleKeeper.DeployFeed(input.Ctx, fooDenom1)
+	require.Error(t, err)
+
+	_, err = input.OracleKeeper.GetFeed(input.Ctx, fooDenom1)
+	require.ErrorIs(t, err, types.ErrNoFeed)
+}
+
+func TestUpdateFeeds(t *testing.T) {
+	input := CreateTestInput(t)
+
+	feed1, err := input.OracleKeeper.DeployFeed(input.Ctx, fooDenom1)
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.DeployFeed(input.Ctx, fooDenom2)
+	require.NoError(t, err)
+	input.Erc20Keeper.Calls = nil
+
+	// Only the feeds of denoms with exchange rate are updated
+	rate := sdk.NewDecWithPrec(12345, 4)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, fooDenom1, rate)
+	input.OracleKeeper.UpdateFeeds(input.Ctx)
+
+	require.Len(t, input.Erc20Keeper.Calls, 1)
+	call := input.Erc20Keeper.Calls[0]
+	require.Equal(t, feed1, call.Contract)
+	require.Equal(t, "updateAnswer", call.Method)
+	require.Equal(t, rate.BigInt(), call.Args[0])
+	require.Equal(t, big.NewInt(input.Ctx.BlockTime().Unix()), call.Args[1])
+
+	// The answer is the exchange rate scaled by the feed decimals
+	answer := new(big.Int).Mul(big.NewInt(12345), new(big.Int).Exp(big.NewInt(10), big.NewInt(types.FeedDecimals-4), nil))
+	require.Equal(t, answer, call.Args[0])
+
+	// A failed update does not panic
+	input.Erc20Keeper.Fail = true
+	input.OracleKeeper.UpdateFeeds(input.Ctx)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gridiron-zone/gridiron/x/oracle/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -197,3 +198,94 @@ func (k Keeper) AggregateVotes(c context.Context, req *types.QueryAggregateVotes
 		AggregateVotes: votes,
 	}, nil
 }
+
+func (k Keeper) Feeds(c context.Context, req *types.QueryFeedsRequest) (*types.QueryFeedsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var registry string
+	if addr, found := k.GetFeedRegistry(ctx); found {
+		registry = addr.Hex()
+	}
+
+	var feeds []types.FeedContract
+	k.IterateFeeds(ctx, func(denom string, feed common.Address) (stop bool) {
+		feeds = append(feeds, types.FeedContract{Denom: denom, Address: feed.Hex()})
+		return false
+	})
+
+	return &types.QueryFeedsResponse{Registry: registry, Feeds: feeds}, nil
+}
+
+func (k Keeper) Feed(c context.Context, req *types.QueryFeedRequest) (*types.QueryFeedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	feed, err := k.GetFeed(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeedResponse{
+		Feed: types.FeedContract{Denom: req.Denom, Address: feed.Hex()},
+	}, nil
+}
+
+func (k Keeper) ValidatorPerformance(c context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var history []types.ValidatorPerformance
+	latestWindow := k.GetPerformanceWindow(ctx)
+	for window := latestWindow; window > 0 && latestWindow-window < k.PerformanceHistory(ctx); window-- {
+		if performance, found := k.GetPerformanceHistory(ctx, window, valAddr); found {
+			history = append(history, performance)
+		}
+	}
+
+	return &types.QueryValidatorPerformanceResponse{
+		Current: k.GetValidatorPerformance(ctx, valAddr),
+		History: history,
+	}, nil
+}
+
+func (k Keeper) WindowPerformances(c context.Context, req *types.QueryWindowPerformancesRequest) (*types.QueryWindowPerformancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var performances []types.ValidatorPerformance
+	handler := func(_ sdk.ValAddress, performance types.ValidatorPerformance) (stop bool) {
+		performances = append(performances, performance)
+		return false
+	}
+	if req.Window == 0 {
+		k.IterateValidatorPerformances(ctx, handler)
+	} else {
+		k.IterateWindowPerformances(ctx, req.Window, handler)
+	}
+
+	return &types.QueryWindowPerformancesResponse{
+		LatestWindow: k.GetPerformanceWindow(ctx),
+		Performances: performances,
+	}, nil
+}
@@ -255,10 +255,84 @@ func TestQueryTargets(t *testing.T) {
 
 	targets := []string{"denom", "denom2", "denom3"}
 	for _, target := range targets {
-		input.OracleKeeper.SetTarget(input.Ctx, target)
+		input.OracleKeeper.SetTarget(input.Ctx, types.TargetParams{Denom: target, Source: types.TARGET_SOURCE_VALIDATORS})
 	}
 
 	res, err := querier.Targets(ctx, &types.QueryTargetsRequest{})
 	require.NoError(t, err)
 	require.Equal(t, targets, res.Targets)
 }
+
+func TestQueryValidatorPerformance(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	for window := uint64(1); window <= 3; window++ {
+		performance := types.NewValidatorPerformance(ValAddrs[0])
+		performance.Window = window
+		performance.Votes = window
+		input.OracleKeeper.SetPerformanceHistory(input.Ctx, ValAddrs[0], performance)
+	}
+	input.OracleKeeper.SetPerformanceWindow(input.Ctx, 3)
+
+	current := types.NewValidatorPerformance(ValAddrs[0])
+	current.VotePeriods = 1
+	input.OracleKeeper.SetValidatorPerformance(input.Ctx, ValAddrs[0], current)
+
+	// empty request
+	_, err := querier.ValidatorPerformance(ctx, nil)
+	require.Error(t, err)
+
+	// Query to grpc
+	res, err := querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, current, res.Current)
+	require.Len(t, res.History, 3)
+	for i, performance := range res.History {
+		require.Equal(t, uint64(3-i), performance.Window)
+	}
+
+	res, err = querier.ValidatorPerformance(ctx, &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.NewValidatorPerformance(ValAddrs[1]), res.Current)
+	require.Empty(t, res.History)
+}
+
+func TestQueryWindowPerformances(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	var performances []types.ValidatorPerformance
+	for _, valAddr := range ValAddrs[:2] {
+		performance := types.NewValidatorPerformance(valAddr)
+		performance.Window = 1
+		input.OracleKeeper.SetPerformanceHistory(input.Ctx, valAddr, performance)
+		performances = append(performances, performance)
+
+		input.OracleKeeper.SetValidatorPerformance(input.Ctx, valAddr, types.NewValidatorPerformance(valAddr))
+	}
+	input.OracleKeeper.SetPerformanceWindow(input.Ctx, 1)
+
+	// empty request
+	_, err := querier.WindowPerformances(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.WindowPerformances(ctx, &types.QueryWindowPerformancesRequest{Window: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.LatestWindow)
+	require.ElementsMatch(t, performances, res.Performances)
+
+	// current window
+	res, err = querier.WindowPerformances(ctx, &types.QueryWindowPerformancesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Performances, 2)
+	for _, performance := range res.Performances {
+		require.Equal(t, uint64(0), performance.Window)
+	}
+}
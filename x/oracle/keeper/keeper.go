@@ -25,6 +25,7 @@ type (
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
 		stakingKeeper types.StakingKeeper
+		erc20Keeper   types.Erc20Keeper
 
 		distrName string
 	}
@@ -38,6 +39,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
 	stakingKeeper types.StakingKeeper,
+	erc20Keeper types.Erc20Keeper,
 	distrName string,
 ) *Keeper {
 	// Set KeyTable if it has not already been set
@@ -58,6 +60,7 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
 		stakingKeeper: stakingKeeper,
+		erc20Keeper:   erc20Keeper,
 		distrName:     distrName,
 	}
 }
@@ -377,10 +380,46 @@ func (k Keeper) IsTarget(ctx sdk.Context, denom string) bool {
 	return bz != nil
 }
 
-// SetTarget sets target for the denom.
-func (k Keeper) SetTarget(ctx sdk.Context, denom string) {
+// GetTarget retrieves the params of the target for the denom.
+func (k Keeper) GetTarget(ctx sdk.Context, denom string) (types.TargetParams, error) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetTargetKey(denom), []byte(denom))
+	bz := store.Get(types.GetTargetKey(denom))
+	if bz == nil {
+		return types.TargetParams{}, sdkerrors.Wrap(types.ErrNoTarget, denom)
+	}
+
+	var params types.TargetParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, nil
+}
+
+// SetTarget sets target with its params.
+func (k Keeper) SetTarget(ctx sdk.Context, params types.TargetParams) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.GetTargetKey(params.Denom), bz)
+}
+
+// TargetVotingParams returns the effective voting params of the target for the
+// denom, i.e., the overrides of the target merged with the module params.
+func (k Keeper) TargetVotingParams(ctx sdk.Context, denom string) (voteThreshold, rewardBand sdk.Dec, minVoters uint64) {
+	voteThreshold = k.VoteThreshold(ctx)
+	rewardBand = k.RewardBand(ctx)
+
+	target, err := k.GetTarget(ctx, denom)
+	if err != nil {
+		return
+	}
+
+	overrides := target.VotingParams
+	if overrides.VoteThreshold != nil {
+		voteThreshold = *overrides.VoteThreshold
+	}
+	if overrides.RewardBand != nil {
+		rewardBand = *overrides.RewardBand
+	}
+	minVoters = overrides.MinVoters
+	return
 }
 
 // IterateTargets iterates rate over targets in the store.
@@ -120,6 +120,7 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	performanceHistory := uint64(4)
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
@@ -130,6 +131,7 @@ func TestParams(t *testing.T) {
 		SlashFraction:            slashFraction,
 		SlashWindow:              slashWindow,
 		MinValidPerWindow:        minValidPerWindow,
+		PerformanceHistory:       performanceHistory,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -339,7 +341,7 @@ func TestTargets(t *testing.T) {
 
 	expectedTargets := []string{"bar", "foo", "whoowhoo"}
 	for _, target := range expectedTargets {
-		input.OracleKeeper.SetTarget(input.Ctx, target)
+		input.OracleKeeper.SetTarget(input.Ctx, types.TargetParams{Denom: target, Source: types.TARGET_SOURCE_VALIDATORS})
 	}
 
 	for _, target := range expectedTargets {
@@ -357,6 +359,40 @@ func TestTargets(t *testing.T) {
 	require.Equal(t, expectedTargets, targets)
 }
 
+func TestTargetVotingParams(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.GetTarget(input.Ctx, "foo")
+	require.ErrorIs(t, err, types.ErrNoTarget)
+
+	// Unknown target uses the module params
+	voteThreshold, rewardBand, minVoters := input.OracleKeeper.TargetVotingParams(input.Ctx, "foo")
+	require.Equal(t, input.OracleKeeper.VoteThreshold(input.Ctx), voteThreshold)
+	require.Equal(t, input.OracleKeeper.RewardBand(input.Ctx), rewardBand)
+	require.Equal(t, uint64(0), minVoters)
+
+	// Partial overrides
+	threshold := sdk.NewDecWithPrec(67, 2)
+	target := types.TargetParams{
+		Denom:  "foo",
+		Source: types.TARGET_SOURCE_VALIDATORS,
+		VotingParams: types.TargetVotingParams{
+			VoteThreshold: &threshold,
+			MinVoters:     5,
+		},
+	}
+	input.OracleKeeper.SetTarget(input.Ctx, target)
+
+	res, err := input.OracleKeeper.GetTarget(input.Ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, target, res)
+
+	voteThreshold, rewardBand, minVoters = input.OracleKeeper.TargetVotingParams(input.Ctx, "foo")
+	require.Equal(t, threshold, voteThreshold)
+	require.Equal(t, input.OracleKeeper.RewardBand(input.Ctx), rewardBand)
+	require.Equal(t, uint64(5), minVoters)
+}
+
 func TestValidateFeeder(t *testing.T) {
 	// initial setup
 	input := CreateTestInput(t)
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gridiron-zone/gridiron/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.migrateTargets(ctx)
+	m.keeper.setMissingParams(ctx)
+	return m.keeper.deployMissingFeeds(ctx)
+}
+
+// migrateTargets rewrites the targets stored as their raw denoms into the
+// target params, whose voting params fall back to the module params.
+func (k Keeper) migrateTargets(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range k.GetTargets(ctx) {
+		if !bytes.Equal(store.Get(types.GetTargetKey(denom)), []byte(denom)) {
+			continue
+		}
+
+		source := types.TARGET_SOURCE_UNSPECIFIED
+		if k.IsVoteTarget(ctx, denom) {
+			source = types.TARGET_SOURCE_VALIDATORS
+		}
+		k.SetTarget(ctx, types.TargetParams{Denom: denom, Source: source})
+	}
+}
+
+// setMissingParams sets the params introduced after the genesis to their
+// defaults, since reading a missing param panics.
+func (k Keeper) setMissingParams(ctx sdk.Context) {
+	if !k.paramstore.Has(ctx, types.KeyPerformanceHistory) {
+		k.paramstore.Set(ctx, types.KeyPerformanceHistory, uint64(types.DefaultPerformanceHistory))
+	}
+}
+
+// deployMissingFeeds deploys the EVM price feeds of the targets registered
+// before the price feeds were introduced.
+func (k Keeper) deployMissingFeeds(ctx sdk.Context) error {
+	for _, denom := range k.GetTargets(ctx) {
+		if _, err := k.GetFeed(ctx, denom); err == nil {
+			continue
+		}
+		if _, err := k.DeployFeed(ctx, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gridiron-zone/gridiron/x/oracle/types"
+)
+
+func TestMigrate2to3DeploysFeeds(t *testing.T) {
+	input := CreateTestInput(t)
+
+	for _, denom := range []string{fooDenom1, fooDenom2, fooDenom3} {
+		input.OracleKeeper.SetTarget(input.Ctx, types.TargetParams{Denom: denom, Source: types.TARGET_SOURCE_VALIDATORS})
+	}
+	feed1, err := input.OracleKeeper.DeployFeed(input.Ctx, fooDenom1)
+	require.NoError(t, err)
+
+	// The targets registered before the price feeds get their feeds deployed
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate2to3(input.Ctx))
+	feeds := map[string]common.Address{}
+	input.OracleKeeper.IterateFeeds(input.Ctx, func(denom string, feed common.Address) (stop bool) {
+		feeds[denom] = feed
+		return false
+	})
+	require.Len(t, feeds, 3)
+	require.Equal(t, feed1, feeds[fooDenom1])
+	registry, found := input.OracleKeeper.GetFeedRegistry(input.Ctx)
+	require.True(t, found)
+	require.Equal(t, []common.Address{registry, feed1, feeds[fooDenom2], feeds[fooDenom3]}, input.Erc20Keeper.Deployed)
+
+	// A failed deployment fails the migration
+	input.OracleKeeper.SetTarget(input.Ctx, types.TargetParams{Denom: "foo4", Source: types.TARGET_SOURCE_VALIDATORS})
+	input.Erc20Keeper.Fail = true
+	require.Error(t, NewMigrator(input.OracleKeeper).Migrate2to3(input.Ctx))
+}
+
+func TestMigrate2to3MigratesTargets(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// The targets were stored as their raw denoms
+	store := input.Ctx.KVStore(input.OracleKeeper.storeKey)
+	store.Set(types.GetTargetKey(fooDenom1), []byte(fooDenom1))
+	store.Set(types.GetTargetKey(fooDenom2), []byte(fooDenom2))
+	input.OracleKeeper.SetVoteTarget(input.Ctx, fooDenom1)
+	require.Panics(t, func() { input.OracleKeeper.TargetVotingParams(input.Ctx, fooDenom1) })
+
+	threshold := sdk.NewDecWithPrec(60, 2)
+	input.OracleKeeper.SetTarget(input.Ctx, types.TargetParams{
+		Denom:        fooDenom3,
+		Source:       types.TARGET_SOURCE_VALIDATORS,
+		VotingParams: types.TargetVotingParams{VoteThreshold: &threshold, MinVoters: 2},
+	})
+
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate2to3(input.Ctx))
+
+	target, err := input.OracleKeeper.GetTarget(input.Ctx, fooDenom1)
+	require.NoError(t, err)
+	require.Equal(t, types.TargetParams{Denom: fooDenom1, Source: types.TARGET_SOURCE_VALIDATORS}, target)
+	target, err = input.OracleKeeper.GetTarget(input.Ctx, fooDenom2)
+	require.NoError(t, err)
+	require.Equal(t, types.TargetParams{Denom: fooDenom2, Source: types.TARGET_SOURCE_UNSPECIFIED}, target)
+
+	// The legacy targets fall back to the module params
+	voteThreshold, rewardBand, minVoters := input.OracleKeeper.TargetVotingParams(input.Ctx, fooDenom1)
+	require.Equal(t, input.OracleKeeper.VoteThreshold(input.Ctx), voteThreshold)
+	require.Equal(t, input.OracleKeeper.RewardBand(input.Ctx), rewardBand)
+	require.Zero(t, minVoters)
+
+	// The migrated targets are kept
+	voteThreshold, _, minVoters = input.OracleKeeper.TargetVotingParams(input.Ctx, fooDenom3)
+	require.Equal(t, threshold, voteThreshold)
+	require.Equal(t, uint64(2), minVoters)
+}
+
+func TestMigrate2to3SetsParams(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// The params introduced after the genesis are set to their defaults
+	paramStore := prefix.NewStore(input.Ctx.KVStore(input.ParamsKey), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeyPerformanceHistory)
+	require.Panics(t, func() { input.OracleKeeper.PerformanceHistory(input.Ctx) })
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate2to3(input.Ctx))
+	require.Equal(t, uint64(types.DefaultPerformanceHistory), input.OracleKeeper.PerformanceHistory(input.Ctx))
+
+	// The existing params are kept
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.PerformanceHistory = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate2to3(input.Ctx))
+	require.Equal(t, uint64(3), input.OracleKeeper.PerformanceHistory(input.Ctx))
+}
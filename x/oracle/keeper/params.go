@@ -57,3 +57,9 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyMinValidPerWindow, &res)
 	return
 }
+
+// PerformanceHistory returns # of past slash windows of which the validator performances are kept.
+func (k Keeper) PerformanceHistory(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPerformanceHistory, &res)
+	return
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/gridiron-zone/gridiron/x/oracle/types"
+)
+
+// -----------------------------------
+// Validator performance logic
+
+// GetValidatorPerformance retrieves the performance of the validator in the current slash window.
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorPerformance {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPerformanceKey(operator))
+	if bz == nil {
+		// By default, the statistics are empty
+		return types.NewValidatorPerformance(operator)
+	}
+
+	var performance types.ValidatorPerformance
+	k.cdc.MustUnmarshal(bz, &performance)
+	return performance
+}
+
+// SetValidatorPerformance sets the performance of the validator in the current slash window.
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress, performance types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetValidatorPerformanceKey(operator), bz)
+}
+
+// DeleteValidatorPerformance removes the performance of the validator in the current slash window.
+func (k Keeper) DeleteValidatorPerformance(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorPerformanceKey(operator))
+}
+
+// IterateValidatorPerformances iterates over the performances in the current slash window.
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, performance types.ValidatorPerformance) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorPerformanceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+
+		if handler(operator, performance) {
+			break
+		}
+	}
+}
+
+// GetPerformanceWindow returns the sequence number of the last completed slash window.
+func (k Keeper) GetPerformanceWindow(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PerformanceWindowKey)
+	if bz == nil {
+		return 0
+	}
+
+	var window gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &window)
+	return window.Value
+}
+
+// SetPerformanceWindow sets the sequence number of the last completed slash window.
+func (k Keeper) SetPerformanceWindow(ctx sdk.Context, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: window})
+	store.Set(types.PerformanceWindowKey, bz)
+}
+
+// GetPerformanceHistory retrieves the performance of the validator in the past slash window.
+func (k Keeper) GetPerformanceHistory(ctx sdk.Context, window uint64, operator sdk.ValAddress) (performance types.ValidatorPerformance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPerformanceHistoryKey(window, operator))
+	if bz == nil {
+		return performance, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &performance)
+	return performance, true
+}
+
+// SetPerformanceHistory sets the performance of the validator in the past slash window.
+func (k Keeper) SetPerformanceHistory(ctx sdk.Context, operator sdk.ValAddress, performance types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&performance)
+	store.Set(types.GetPerformanceHistoryKey(performance.Window, operator), bz)
+}
+
+// IterateWindowPerformances iterates over the performances of all validators in the past slash window.
+func (k Keeper) IterateWindowPerformances(ctx sdk.Context, window uint64,
+	handler func(operator sdk.ValAddress, performance types.ValidatorPerformance) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetPerformanceHistoryWindowKey(window)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[len(prefix)+1:])
+
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+
+		if handler(operator, performance) {
+			break
+		}
+	}
+}
+
+// IteratePerformanceHistory iterates over the performances in all the past slash windows.
+func (k Keeper) IteratePerformanceHistory(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, performance types.ValidatorPerformance) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PerformanceHistoryKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[len(types.PerformanceHistoryKey)+8+1:])
+
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshal(iter.Value(), &performance)
+
+		if handler(operator, performance) {
+			break
+		}
+	}
+}
+
+// PrunePerformanceHistory removes the performances in the slash windows before the given one.
+func (k Keeper) PrunePerformanceHistory(ctx sdk.Context, beforeWindow uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.PerformanceHistoryKey, types.GetPerformanceHistoryWindowKey(beforeWindow))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// UpdateValidatorPerformances counts the results of the vote period into the
+// performances of the validators in the current slash window.
+// CONTRACT: must be called before the votes are cleared
+func (k Keeper) UpdateValidatorPerformances(
+	ctx sdk.Context,
+	voteTargetsLen int,
+	validatorClaimMap map[string]types.Claim,
+	validatorDeviationMap map[string][]sdk.Dec,
+) {
+	for key, claim := range validatorClaimMap {
+		performance := k.GetValidatorPerformance(ctx, claim.Recipient)
+		performance.VotePeriods++
+		performance.Wins += uint64(claim.WinCount)
+
+		if vote, err := k.GetAggregateExchangeRateVote(ctx, claim.Recipient); err == nil {
+			performance.Votes++
+
+			abstain := true
+			for _, tuple := range vote.ExchangeRateTuples {
+				if tuple.ExchangeRate.IsPositive() {
+					abstain = false
+					break
+				}
+			}
+			if abstain {
+				performance.Abstains++
+			}
+		}
+
+		// Same criteria as the miss counting
+		if int(claim.WinCount) != voteTargetsLen {
+			performance.Misses++
+		}
+
+		performance.AddDeviations(validatorDeviationMap[key])
+
+		k.SetValidatorPerformance(ctx, claim.Recipient, performance)
+	}
+}
+
+// ArchiveValidatorPerformances moves the performances of the current slash
+// window into the history, and prunes the history exceeding the
+// PerformanceHistory param. It is called at the last block of slash window.
+func (k Keeper) ArchiveValidatorPerformances(ctx sdk.Context) {
+	window := k.GetPerformanceWindow(ctx) + 1
+	k.SetPerformanceWindow(ctx, window)
+
+	history := k.PerformanceHistory(ctx)
+
+	k.IterateValidatorPerformances(ctx, func(operator sdk.ValAddress, performance types.ValidatorPerformance) (stop bool) {
+		performance.Window = window
+		performance.EndHeight = ctx.BlockHeight()
+
+		if history > 0 {
+			k.SetPerformanceHistory(ctx, operator, performance)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeWindowPerformance,
+				sdk.NewAttribute(types.AttributeKeyOperator, performance.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyWindow, strconv.FormatUint(window, 10)),
+				sdk.NewAttribute(types.AttributeKeyVotePeriods, strconv.FormatUint(performance.VotePeriods, 10)),
+				sdk.NewAttribute(types.AttributeKeyVotes, strconv.FormatUint(performance.Votes, 10)),
+				sdk.NewAttribute(types.AttributeKeyWins, strconv.FormatUint(performance.Wins, 10)),
+				sdk.NewAttribute(types.AttributeKeyMisses, strconv.FormatUint(performance.Misses, 10)),
+				sdk.NewAttribute(types.AttributeKeyAbstains, strconv.FormatUint(performance.Abstains, 10)),
+				sdk.NewAttribute(types.AttributeKeyDeviation, performance.AverageDeviation.String()),
+			),
+		)
+
+		k.DeleteValidatorPerformance(ctx, operator)
+		return false
+	})
+
+	// Keep the performances of the latest `history` windows only
+	if window >= history {
+		k.PrunePerformanceHistory(ctx, window-history+1)
+	}
+}
@@ -22,7 +22,7 @@ func HandleRegisterTargetProposal(ctx sdk.Context, k Keeper, p *types.RegisterTa
 		)
 	}
 
-	k.SetTarget(ctx, params.Denom)
+	k.SetTarget(ctx, params)
 
 	switch params.Source {
 	case types.TARGET_SOURCE_VALIDATORS:
@@ -31,5 +31,22 @@ func HandleRegisterTargetProposal(ctx sdk.Context, k Keeper, p *types.RegisterTa
 		// TODO
 	}
 
+	// Expose the exchange rate of the target to the EVM
+	if _, err := k.DeployFeed(ctx, params.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func HandleSetTargetVotingParamsProposal(ctx sdk.Context, k Keeper, p *types.SetTargetVotingParamsProposal) error {
+	target, err := k.GetTarget(ctx, p.Denom)
+	if err != nil {
+		return err
+	}
+
+	target.VotingParams = p.VotingParams
+	k.SetTarget(ctx, target)
+
 	return nil
 }
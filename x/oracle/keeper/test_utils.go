@@ -2,6 +2,8 @@
 package keeper
 
 import (
+	"errors"
+	"math/big"
 	"testing"
 	"time"
 
@@ -38,6 +40,10 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 const faucetAccountName = "faucet"
@@ -121,6 +127,42 @@ type TestInput struct {
 	OracleKeeper  Keeper
 	StakingKeeper stakingkeeper.Keeper
 	DistrKeeper   distrkeeper.Keeper
+	Erc20Keeper   *MockErc20Keeper
+	ParamsKey     *sdk.KVStoreKey
+}
+
+// MockEVMCall records a contract call made through MockErc20Keeper
+type MockEVMCall struct {
+	From     common.Address
+	Contract common.Address
+	Method   string
+	Args     []interface{}
+}
+
+// MockErc20Keeper nolint
+type MockErc20Keeper struct {
+	Deployed []common.Address
+	Calls    []MockEVMCall
+	Fail     bool
+}
+
+// DeployContract nolint
+func (k *MockErc20Keeper) DeployContract(ctx sdk.Context, from common.Address, contract evmtypes.CompiledContract, args ...interface{}) (common.Address, error) {
+	if k.Fail {
+		return common.Address{}, errors.New("mock evm failure")
+	}
+	addr := common.BigToAddress(big.NewInt(int64(len(k.Deployed) + 1)))
+	k.Deployed = append(k.Deployed, addr)
+	return addr, nil
+}
+
+// CallEVM nolint
+func (k *MockErc20Keeper) CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	if k.Fail {
+		return nil, errors.New("mock evm failure")
+	}
+	k.Calls = append(k.Calls, MockEVMCall{From: from, Contract: contract, Method: method, Args: args})
+	return &evmtypes.MsgEthereumTxResponse{}, nil
 }
 
 // CreateTestInput nolint
@@ -219,6 +261,8 @@ func CreateTestInput(t *testing.T) TestInput {
 		require.NoError(t, err)
 	}
 
+	erc20Keeper := &MockErc20Keeper{}
+
 	keeper := NewKeeper(
 		appCodec,
 		keyOracle,
@@ -227,6 +271,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		bankKeeper,
 		distrKeeper,
 		stakingKeeper,
+		erc20Keeper,
 		distrtypes.ModuleName,
 	)
 
@@ -236,7 +281,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keeper.SetVoteTarget(ctx, gridiron.AttoIronDenom)
 	keeper.SetVoteTarget(ctx, gridiron.MicroUSMDenom)
 
-	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, *keeper, stakingKeeper, distrKeeper}
+	return TestInput{ctx, legacyAmino, accountKeeper, bankKeeper, *keeper, stakingKeeper, distrKeeper, erc20Keeper, keyParams}
 }
 
 // NewTestMsgCreateValidator test msg creator
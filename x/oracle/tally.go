@@ -2,6 +2,7 @@ package oracle
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/gridiron-zone/gridiron/x/oracle/keeper"
 	"github.com/gridiron-zone/gridiron/x/oracle/types"
@@ -37,10 +38,25 @@ func Tally(ctx sdk.Context, pb types.ExchangeRateBallot, rewardBand sdk.Dec, val
 	return
 }
 
-// ballotIsPassing returns the ballot power and whether the power is passing the threshold amount of voting power.
-func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int) (sdk.Int, bool) {
+// ballotIsPassing returns the ballot power and, if the ballot fails, the reason why it fails, i.e.,
+// the power is not passing the threshold amount of voting power, or there are not enough voters.
+func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int, minVoters uint64) (sdk.Int, error) {
 	ballotPower := sdk.NewInt(ballot.Power())
-	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes)
+	if ballotPower.IsZero() || ballotPower.LT(thresholdVotes) {
+		return ballotPower, sdkerrors.Wrapf(types.ErrBallotThreshold, "ballot power %s, threshold %s", ballotPower, thresholdVotes)
+	}
+
+	voters := uint64(0)
+	for _, vote := range ballot {
+		if vote.ExchangeRate.IsPositive() {
+			voters++
+		}
+	}
+	if voters < minVoters {
+		return ballotPower, sdkerrors.Wrapf(types.ErrBallotMinVoters, "voters %d, min voters %d", voters, minVoters)
+	}
+
+	return ballotPower, nil
 }
 
 // PickReferenceGridb chooses Reference Grid with the highest voter turnout.
@@ -52,8 +68,6 @@ func PickReferenceGridb(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 	stakingKeeper := k.StakingKeeper()
 	totalBondedPower := sdk.TokensToConsensusPower(stakingKeeper.TotalBondedTokens(ctx), stakingKeeper.PowerReduction(ctx))
-	voteThreshold := k.VoteThreshold(ctx)
-	thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
 
 	for denom, ballot := range voteMap {
 		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
@@ -65,11 +79,15 @@ func PickReferenceGridb(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 		ballotPower := int64(0)
 
+		voteThreshold, _, minVoters := k.TargetVotingParams(ctx, denom)
+		thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
+
 		// If the ballot is not passed, remove it from the voteTargets array
 		// to prevent slashing validators who did valid vote.
-		if power, ok := ballotIsPassing(ballot, thresholdVotes); ok {
+		if power, err := ballotIsPassing(ballot, thresholdVotes, minVoters); err == nil {
 			ballotPower = power.Int64()
 		} else {
+			reportBallotFailure(ctx, k, denom, err)
 			delete(voteTargets, denom)
 			delete(voteMap, denom)
 			continue
@@ -85,3 +103,15 @@ func PickReferenceGridb(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 	return referenceGridb
 }
+
+// reportBallotFailure logs and emits the reason why the ballot for the denom failed.
+func reportBallotFailure(ctx sdk.Context, k keeper.Keeper, denom string, err error) {
+	k.Logger(ctx).Info("ballot failed", "denom", denom, "reason", err.Error())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBallotFailure,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		),
+	)
+}
@@ -133,6 +133,22 @@ func (pb ExchangeRateBallot) StandardDeviation(median sdk.Dec) (standardDeviatio
 	return
 }
 
+// Deviations returns the relative deviations of the non-abstain votes from the median, by validator.
+func (pb ExchangeRateBallot) Deviations(median sdk.Dec) map[string]sdk.Dec {
+	deviations := make(map[string]sdk.Dec)
+	if !median.IsPositive() {
+		return deviations
+	}
+
+	for _, vote := range pb {
+		if vote.ExchangeRate.IsPositive() {
+			deviations[vote.Voter.String()] = vote.ExchangeRate.Sub(median).Abs().Quo(median)
+		}
+	}
+
+	return deviations
+}
+
 // Len implements sort.Interface.
 func (pb ExchangeRateBallot) Len() int {
 	return len(pb)
@@ -18,6 +18,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&RegisterTargetProposal{},
+		&SetTargetVotingParamsProposal{},
 	)
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
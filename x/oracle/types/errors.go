@@ -25,4 +25,10 @@ var (
 	ErrNoVoteTarget          = sdkerrors.Register(ModuleName, 13, "no vote target")
 	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 14, "unknown denom")
 	ErrExistingTarget        = sdkerrors.Register(ModuleName, 15, "existing denom")
+	ErrNoFeed                = sdkerrors.Register(ModuleName, 16, "no price feed")
+	ErrExistingFeed          = sdkerrors.Register(ModuleName, 17, "existing price feed")
+	ErrNoTarget              = sdkerrors.Register(ModuleName, 18, "no target")
+	ErrBallotThreshold       = sdkerrors.Register(ModuleName, 19, "ballot power below vote threshold")
+	ErrBallotMinVoters       = sdkerrors.Register(ModuleName, 20, "not enough voters in ballot")
+	ErrInvalidCrossRate      = sdkerrors.Register(ModuleName, 21, "invalid tallied cross exchange rate")
 )
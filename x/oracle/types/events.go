@@ -8,6 +8,9 @@ const (
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeDeployFeed         = "deploy_feed"
+	EventTypeWindowPerformance  = "window_performance"
+	EventTypeBallotFailure      = "ballot_failure"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
@@ -15,6 +18,15 @@ const (
 	AttributeKeyExchangeRates = "exchange_rates"
 	AttributeKeyOperator      = "operator"
 	AttributeKeyFeeder        = "feeder"
+	AttributeKeyFeed          = "feed"
+	AttributeKeyWindow        = "window"
+	AttributeKeyVotePeriods   = "vote_periods"
+	AttributeKeyVotes         = "votes"
+	AttributeKeyWins          = "wins"
+	AttributeKeyMisses        = "misses"
+	AttributeKeyAbstains      = "abstains"
+	AttributeKeyDeviation     = "average_deviation"
+	AttributeKeyReason        = "reason"
 
 	AttributeValueCategory = ModuleName
 )
@@ -6,6 +6,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 type DistrKeeper interface {
@@ -42,3 +45,9 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
+
+// Erc20Keeper defines the expected interface needed to deploy and call EVM contracts.
+type Erc20Keeper interface {
+	DeployContract(ctx sdk.Context, from common.Address, contract evmtypes.CompiledContract, args ...interface{}) (common.Address, error)
+	CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+}
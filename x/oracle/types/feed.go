@@ -0,0 +1,16 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeedDecimals is the decimals of the answers of the EVM price feeds, which
+// keeps the full precision of the exchange rates.
+const FeedDecimals = sdk.Precision
+
+// FeedDescription returns the description of the price feed of the denom.
+func FeedDescription(denom string) string {
+	return fmt.Sprintf("%s / uUSD", denom)
+}
@@ -1,11 +1,19 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // NewGenesis creates a new genesis state.
 func NewGenesis(
 	params Params, rates []ExchangeRateTuple,
 	feederDelegations []FeederDelegation, missCounters []MissCounter,
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
+	feedRegistry string, feeds []FeedContract,
+	performanceWindow uint64, validatorPerformances []ValidatorPerformance,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -14,6 +22,10 @@ func NewGenesis(
 		MissCounters:                  missCounters,
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		FeedRegistry:                  feedRegistry,
+		Feeds:                         feeds,
+		PerformanceWindow:             performanceWindow,
+		ValidatorPerformances:         validatorPerformances,
 	}
 }
 
@@ -30,5 +42,28 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	if gs.FeedRegistry != "" && !common.IsHexAddress(gs.FeedRegistry) {
+		return fmt.Errorf("invalid price feed registry address: %s", gs.FeedRegistry)
+	}
+	if len(gs.Feeds) != 0 && gs.FeedRegistry == "" {
+		return fmt.Errorf("price feeds without price feed registry")
+	}
+	seenFeeds := make(map[string]bool)
+	for _, feed := range gs.Feeds {
+		if seenFeeds[feed.Denom] {
+			return fmt.Errorf("duplicated price feed of denom %s", feed.Denom)
+		}
+		if !common.IsHexAddress(feed.Address) {
+			return fmt.Errorf("invalid price feed address of denom %s: %s", feed.Denom, feed.Address)
+		}
+		seenFeeds[feed.Denom] = true
+	}
+
+	for _, vp := range gs.ValidatorPerformances {
+		if vp.Window > gs.PerformanceWindow {
+			return fmt.Errorf("validator performance window %d is after the last window %d", vp.Window, gs.PerformanceWindow)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -31,6 +31,12 @@ type GenesisState struct {
 	MissCounters                  []MissCounter                  `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	// hex address of the EVM price feed registry contract
+	FeedRegistry string         `protobuf:"bytes,7,opt,name=feed_registry,json=feedRegistry,proto3" json:"feed_registry,omitempty"`
+	Feeds        []FeedContract `protobuf:"bytes,8,rep,name=feeds,proto3" json:"feeds"`
+	// sequence number of the last completed slash window
+	PerformanceWindow     uint64                 `protobuf:"varint,9,opt,name=performance_window,json=performanceWindow,proto3" json:"performance_window,omitempty"`
+	ValidatorPerformances []ValidatorPerformance `protobuf:"bytes,10,rep,name=validator_performances,json=validatorPerformances,proto3" json:"validator_performances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +114,34 @@ func (m *GenesisState) GetAggregateExchangeRateVotes() []AggregateExchangeRateVo
 	return nil
 }
 
+func (m *GenesisState) GetFeedRegistry() string {
+	if m != nil {
+		return m.FeedRegistry
+	}
+	return ""
+}
+
+func (m *GenesisState) GetFeeds() []FeedContract {
+	if m != nil {
+		return m.Feeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPerformanceWindow() uint64 {
+	if m != nil {
+		return m.PerformanceWindow
+	}
+	return 0
+}
+
+func (m *GenesisState) GetValidatorPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.ValidatorPerformances
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -226,37 +260,43 @@ func init() {
 func init() { proto.RegisterFile("gridiron/oracle/v1/genesis.proto", fileDescriptor_c4f7ec516d500fe6) }
 
 var fileDescriptor_c4f7ec516d500fe6 = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x36, 0x44, 0x62, 0x93, 0x54, 0xcd, 0x8a, 0x43, 0x88, 0x54, 0x27, 0x04, 0x90,
-	0x2a, 0x01, 0xb6, 0x5a, 0x0e, 0x9c, 0x1b, 0xfe, 0x1f, 0x90, 0x2a, 0x83, 0x10, 0x42, 0x42, 0xd6,
-	0x36, 0x9e, 0x6c, 0x2d, 0xd9, 0x5e, 0x6b, 0x67, 0x13, 0x15, 0x0e, 0x3c, 0x03, 0xcf, 0xc1, 0x93,
-	0xf4, 0xc0, 0xa1, 0x47, 0x4e, 0x80, 0x92, 0x17, 0x41, 0xde, 0xdd, 0x34, 0xa6, 0x71, 0x50, 0x6f,
-	0xc9, 0xcc, 0xef, 0xfb, 0xbe, 0x59, 0xcf, 0x2e, 0xe9, 0xa7, 0x20, 0x93, 0x58, 0x64, 0xbe, 0x90,
-	0x6c, 0x9c, 0x80, 0x3f, 0x3b, 0xf0, 0x39, 0x64, 0x80, 0x31, 0x7a, 0xb9, 0x14, 0x4a, 0xd0, 0x8e,
-	0x05, 0x3c, 0x03, 0x78, 0xb3, 0x83, 0xde, 0x2d, 0x2e, 0xb8, 0xd0, 0x5d, 0xbf, 0xf8, 0x65, 0xc0,
-	0x9e, 0xbb, 0xee, 0x64, 0x25, 0xba, 0x3f, 0xfc, 0x51, 0x27, 0xad, 0x97, 0xc6, 0xfa, 0xad, 0x62,
-	0x0a, 0xe8, 0x13, 0xd2, 0xc8, 0x99, 0x64, 0x29, 0x76, 0x9d, 0x81, 0xb3, 0xdf, 0x3c, 0xbc, 0xed,
-	0xad, 0x45, 0x79, 0xc7, 0x1a, 0x18, 0xd5, 0xcf, 0x7f, 0xf5, 0x6b, 0x81, 0xc5, 0xe9, 0x07, 0x42,
-	0x27, 0x00, 0x11, 0xc8, 0x30, 0x82, 0x04, 0x38, 0x53, 0xb1, 0xc8, 0xb0, 0xbb, 0x35, 0xd8, 0xde,
-	0x6f, 0x1e, 0xde, 0xad, 0x30, 0x79, 0xa1, 0xe1, 0x67, 0x97, 0xac, 0xb5, 0xeb, 0x4c, 0xae, 0xd4,
-	0x91, 0x72, 0xb2, 0x03, 0x67, 0xe3, 0x53, 0x96, 0x71, 0x08, 0x25, 0x53, 0x80, 0xdd, 0x6d, 0xed,
-	0x7a, 0xaf, 0xc2, 0xf5, 0xb9, 0x05, 0x03, 0xa6, 0xe0, 0xdd, 0x34, 0x4f, 0x60, 0xd4, 0x2b, 0x6c,
-	0xbf, 0xff, 0xee, 0xd3, 0xb5, 0x16, 0x06, 0x6d, 0x28, 0xd5, 0x90, 0xbe, 0x26, 0xed, 0x34, 0x46,
-	0x0c, 0xc7, 0x62, 0x9a, 0x29, 0x90, 0xd8, 0xad, 0xeb, 0x1c, 0xb7, 0x22, 0xe7, 0x4d, 0x8c, 0xf8,
-	0xd4, 0x60, 0x76, 0xf0, 0x56, 0xba, 0x2a, 0x21, 0xfd, 0x4a, 0x06, 0x8c, 0x73, 0x59, 0x9c, 0x01,
-	0xc2, 0x7f, 0xa6, 0x0f, 0x73, 0x09, 0x33, 0x51, 0x9c, 0xe2, 0x86, 0x76, 0xf7, 0x2b, 0xdc, 0x8f,
-	0x96, 0xd2, 0xf2, 0xcc, 0xc7, 0x46, 0x67, 0xe3, 0xf6, 0xd8, 0x7f, 0x18, 0xa4, 0x53, 0xb2, 0xb7,
-	0x29, 0xdf, 0x84, 0x37, 0x74, 0xf8, 0xc3, 0xeb, 0x86, 0xbf, 0x5f, 0x25, 0xf7, 0xd8, 0x26, 0x00,
-	0x87, 0x13, 0xb2, 0x7b, 0x75, 0xaf, 0xf4, 0x3e, 0xd9, 0xb1, 0x17, 0x83, 0x45, 0x91, 0x04, 0x34,
-	0x37, 0xeb, 0x66, 0xd0, 0x36, 0xd5, 0x23, 0x53, 0xa4, 0x0f, 0x48, 0x67, 0xc6, 0x92, 0x38, 0x62,
-	0x4a, 0xac, 0xc8, 0x2d, 0x4d, 0xee, 0x5e, 0x36, 0x2c, 0x3c, 0xfc, 0x44, 0x9a, 0xa5, 0x0d, 0x54,
-	0x6b, 0x9d, 0x6a, 0x2d, 0xbd, 0x43, 0x5a, 0xe5, 0x2d, 0xeb, 0x8c, 0x7a, 0xd0, 0x2c, 0xad, 0x6f,
-	0xf4, 0xea, 0x7c, 0xee, 0x3a, 0x17, 0x73, 0xd7, 0xf9, 0x33, 0x77, 0x9d, 0x6f, 0x0b, 0xb7, 0x76,
-	0xb1, 0x70, 0x6b, 0x3f, 0x17, 0x6e, 0xed, 0xa3, 0xc7, 0x63, 0x75, 0x3a, 0x3d, 0xf1, 0xc6, 0x22,
-	0xf5, 0xed, 0xa7, 0x7b, 0xf4, 0x45, 0x64, 0xb0, 0xfc, 0xe3, 0x9f, 0x2d, 0x5f, 0x9a, 0xfa, 0x9c,
-	0x03, 0x9e, 0x34, 0xf4, 0x33, 0x7b, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x26, 0x0e, 0x41, 0x47,
-	0xd2, 0x03, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x36, 0x0d, 0x74, 0x93, 0x54, 0xed, 0x0a, 0x90, 0x15, 0xa9, 0x8e, 0x09, 0x54,
+	0x8a, 0x84, 0x62, 0xb7, 0xe5, 0xc2, 0x81, 0x4b, 0x53, 0xfe, 0x48, 0x95, 0x90, 0x22, 0x83, 0x8a,
+	0x40, 0x42, 0xd6, 0xd6, 0x9e, 0x38, 0x96, 0x12, 0xaf, 0xb5, 0xbb, 0x71, 0x5b, 0x2e, 0xbc, 0x02,
+	0xcf, 0xc1, 0x93, 0x54, 0xe2, 0xd2, 0x23, 0x27, 0x40, 0xc9, 0x8b, 0x20, 0xaf, 0xd7, 0x89, 0x69,
+	0x1d, 0xc4, 0x2d, 0x9e, 0xf9, 0x7d, 0xdf, 0x37, 0x8e, 0x67, 0x17, 0x99, 0x01, 0x0b, 0xfd, 0x90,
+	0xd1, 0xc8, 0xa6, 0x8c, 0x78, 0x63, 0xb0, 0x93, 0x03, 0x3b, 0x80, 0x08, 0x78, 0xc8, 0xad, 0x98,
+	0x51, 0x41, 0x31, 0xce, 0x09, 0x2b, 0x23, 0xac, 0xe4, 0xa0, 0x75, 0x2f, 0xa0, 0x01, 0x95, 0x6d,
+	0x3b, 0xfd, 0x95, 0x91, 0xad, 0x76, 0x89, 0x97, 0xd2, 0x48, 0xa0, 0xf3, 0xbd, 0x86, 0x1a, 0xaf,
+	0x33, 0xf3, 0xb7, 0x82, 0x08, 0xc0, 0xcf, 0x50, 0x2d, 0x26, 0x8c, 0x4c, 0xb8, 0xae, 0x99, 0x5a,
+	0xb7, 0x7e, 0xd8, 0xb2, 0x6e, 0x87, 0x59, 0x03, 0x49, 0xf4, 0xab, 0x57, 0x3f, 0xdb, 0x15, 0x47,
+	0xf1, 0xf8, 0x03, 0xc2, 0x43, 0x00, 0x1f, 0x98, 0xeb, 0xc3, 0x18, 0x02, 0x22, 0x42, 0x1a, 0x71,
+	0x7d, 0xcd, 0x5c, 0xef, 0xd6, 0x0f, 0x1f, 0x97, 0xb9, 0xbc, 0x92, 0xf4, 0x8b, 0x05, 0xac, 0xfc,
+	0x76, 0x86, 0x37, 0xea, 0x1c, 0x8f, 0xd0, 0x16, 0x5c, 0x78, 0x23, 0x12, 0x05, 0xe0, 0x32, 0x22,
+	0x80, 0xeb, 0xeb, 0xd2, 0x76, 0xaf, 0xcc, 0xf6, 0xa5, 0x22, 0x1d, 0x22, 0xe0, 0xdd, 0x34, 0x1e,
+	0x43, 0xbf, 0x95, 0xfa, 0x7e, 0xfb, 0xd5, 0xc6, 0xb7, 0x5a, 0xdc, 0x69, 0x42, 0xa1, 0xc6, 0xf1,
+	0x09, 0x6a, 0x4e, 0x42, 0xce, 0x5d, 0x8f, 0x4e, 0x23, 0x01, 0x8c, 0xeb, 0x55, 0x19, 0xd4, 0x2e,
+	0x0b, 0x7a, 0x13, 0x72, 0x7e, 0x9c, 0x71, 0x6a, 0xf4, 0xc6, 0x64, 0x59, 0xe2, 0xf8, 0x0b, 0x32,
+	0x49, 0x10, 0xb0, 0xf4, 0x2d, 0xc0, 0xfd, 0x6b, 0x7e, 0x37, 0x66, 0x90, 0xd0, 0xf4, 0x3d, 0x36,
+	0xa4, 0xfd, 0x7e, 0x99, 0xfd, 0x51, 0xae, 0x2d, 0x4e, 0x3d, 0xc8, 0x84, 0x2a, 0x6f, 0x97, 0xfc,
+	0x83, 0xe1, 0x38, 0x41, 0xbb, 0xab, 0x06, 0xc8, 0xd2, 0x6b, 0x32, 0xbd, 0xf7, 0xdf, 0xe9, 0xa7,
+	0xcb, 0xe8, 0x16, 0x59, 0x05, 0x70, 0xfc, 0x08, 0x35, 0xd3, 0x6f, 0xe8, 0x32, 0x08, 0x42, 0x2e,
+	0xd8, 0xa5, 0x7e, 0xc7, 0xd4, 0xba, 0x9b, 0x4e, 0x23, 0x2d, 0x3a, 0xaa, 0x86, 0x9f, 0xa3, 0x8d,
+	0xf4, 0x99, 0xeb, 0x77, 0xe5, 0x10, 0xe6, 0xaa, 0x0d, 0x39, 0xa6, 0x91, 0x60, 0xc4, 0x13, 0x2a,
+	0x37, 0x13, 0xe1, 0x1e, 0xc2, 0x31, 0xb0, 0x21, 0x65, 0x13, 0x12, 0x79, 0xe0, 0x9e, 0x87, 0x91,
+	0x4f, 0xcf, 0xf5, 0x4d, 0x53, 0xeb, 0x56, 0x9d, 0x9d, 0x42, 0xe7, 0xbd, 0x6c, 0x60, 0x40, 0x0f,
+	0x12, 0x32, 0x0e, 0x7d, 0x22, 0x28, 0x73, 0x0b, 0x6d, 0xae, 0x23, 0x99, 0xde, 0x2d, 0x4b, 0x3f,
+	0xcd, 0x15, 0x83, 0xa5, 0x40, 0x4d, 0x71, 0x3f, 0x29, 0xe9, 0xf1, 0xce, 0x10, 0x6d, 0xdf, 0x5c,
+	0x6a, 0xbc, 0x87, 0xb6, 0xd4, 0xb1, 0x20, 0xbe, 0xcf, 0x80, 0x67, 0x07, 0x6b, 0xd3, 0x69, 0x66,
+	0xd5, 0xa3, 0xac, 0x88, 0x9f, 0xa0, 0x9d, 0xe5, 0x84, 0x39, 0xb9, 0x26, 0xc9, 0xed, 0x45, 0x43,
+	0xc1, 0x9d, 0x4f, 0xa8, 0x5e, 0x58, 0xbe, 0x72, 0xad, 0x56, 0xae, 0xc5, 0x0f, 0x51, 0xa3, 0xb8,
+	0xe1, 0x32, 0xa3, 0xea, 0xd4, 0x0b, 0x9b, 0xdb, 0x3f, 0xb9, 0x9a, 0x19, 0xda, 0xf5, 0xcc, 0xd0,
+	0x7e, 0xcf, 0x0c, 0xed, 0xeb, 0xdc, 0xa8, 0x5c, 0xcf, 0x8d, 0xca, 0x8f, 0xb9, 0x51, 0xf9, 0xb8,
+	0x1f, 0x84, 0x62, 0x34, 0x3d, 0xb3, 0x3c, 0x3a, 0xb1, 0xf3, 0x7f, 0xac, 0xf7, 0x99, 0x46, 0xb0,
+	0x78, 0xb2, 0x2f, 0xf2, 0xab, 0x46, 0x5c, 0xc6, 0xc0, 0xcf, 0x6a, 0xf2, 0x9e, 0x79, 0xfa, 0x67,
+	0x00, 0x5a, 0x55, 0x7a, 0x2c, 0xd6, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -279,6 +319,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorPerformances) > 0 {
+		for iNdEx := len(m.ValidatorPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.PerformanceWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PerformanceWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Feeds) > 0 {
+		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FeedRegistry) > 0 {
+		i -= len(m.FeedRegistry)
+		copy(dAtA[i:], m.FeedRegistry)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeedRegistry)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.AggregateExchangeRateVotes) > 0 {
 		for iNdEx := len(m.AggregateExchangeRateVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -483,6 +563,25 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.FeedRegistry)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Feeds) > 0 {
+		for _, e := range m.Feeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PerformanceWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.PerformanceWindow))
+	}
+	if len(m.ValidatorPerformances) > 0 {
+		for _, e := range m.ValidatorPerformances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -757,6 +856,125 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedRegistry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedRegistry = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeds = append(m.Feeds, FeedContract{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindow", wireType)
+			}
+			m.PerformanceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPerformances = append(m.ValidatorPerformances, ValidatorPerformance{})
+			if err := m.ValidatorPerformances[len(m.ValidatorPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
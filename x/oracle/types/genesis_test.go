@@ -14,3 +14,21 @@ func TestGenesisValidation(t *testing.T) {
 	genState.Params.VotePeriod = 0
 	require.Error(t, genState.Validate())
 }
+
+func TestGenesisValidationFeeds(t *testing.T) {
+	genState := types.DefaultGenesis()
+	genState.Feeds = []types.FeedContract{{Denom: "uatom", Address: "0x1111111111111111111111111111111111111111"}}
+	require.Error(t, genState.Validate())
+
+	genState.FeedRegistry = "invalid"
+	require.Error(t, genState.Validate())
+
+	genState.FeedRegistry = "0x2222222222222222222222222222222222222222"
+	require.NoError(t, genState.Validate())
+
+	genState.Feeds = append(genState.Feeds, types.FeedContract{Denom: "uatom", Address: "0x3333333333333333333333333333333333333333"})
+	require.Error(t, genState.Validate())
+
+	genState.Feeds[1] = types.FeedContract{Denom: "ueth", Address: "invalid"}
+	require.Error(t, genState.Validate())
+}
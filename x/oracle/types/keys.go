@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -22,6 +24,13 @@ const (
 	MemStoreKey = "mem_oracle"
 )
 
+// ModuleAddress is the native module address for EVM
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
 // Prefix keys for oracle module store
 var (
 	ExchangeRateKey                 = []byte{0x01} // prefix for each key to a rate
@@ -31,6 +40,11 @@ var (
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
 	VoteTargetKey                   = []byte{0x06} // prefix for each key to a vote target
 	TargetKey                       = []byte{0x07} // prefix for each key to a target
+	FeedKey                         = []byte{0x08} // prefix for each key to a price feed contract
+	FeedRegistryKey                 = []byte{0x09} // key for the price feed registry contract
+	ValidatorPerformanceKey         = []byte{0x0A} // prefix for each key to a performance in the current slash window
+	PerformanceHistoryKey           = []byte{0x0B} // prefix for each key to a performance in the past slash windows
+	PerformanceWindowKey            = []byte{0x0C} // key for the sequence number of the last completed slash window
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -68,6 +82,26 @@ func GetTargetKey(d string) []byte {
 	return append(TargetKey, []byte(d)...)
 }
 
+// GetFeedKey - stored by *denom* bytes
+func GetFeedKey(d string) []byte {
+	return append(FeedKey, []byte(d)...)
+}
+
+// GetValidatorPerformanceKey - stored by *Validator* address
+func GetValidatorPerformanceKey(v sdk.ValAddress) []byte {
+	return append(ValidatorPerformanceKey, address.MustLengthPrefix(v)...)
+}
+
+// GetPerformanceHistoryWindowKey - stored by *window* sequence number
+func GetPerformanceHistoryWindowKey(window uint64) []byte {
+	return append(PerformanceHistoryKey, sdk.Uint64ToBigEndian(window)...)
+}
+
+// GetPerformanceHistoryKey - stored by *window* sequence number and *Validator* address
+func GetPerformanceHistoryKey(window uint64, v sdk.ValAddress) []byte {
+	return append(GetPerformanceHistoryWindowKey(window), address.MustLengthPrefix(v)...)
+}
+
 // ExtractDenomFromVoteTargetKey - split denom from the vote target key
 func ExtractDenomFromVoteTargetKey(key []byte) (denom string) {
 	denom = string(key[1:])
@@ -79,3 +113,9 @@ func ExtractDenomFromTargetKey(key []byte) (denom string) {
 	denom = string(key[1:])
 	return
 }
+
+// ExtractDenomFromFeedKey - split denom from the feed key
+func ExtractDenomFromFeedKey(key []byte) (denom string) {
+	denom = string(key[1:])
+	return
+}
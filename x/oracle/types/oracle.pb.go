@@ -75,6 +75,8 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,6,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// number of past slash windows of which the validator performances are kept
+	PerformanceHistory uint64 `protobuf:"varint,8,opt,name=performance_history,json=performanceHistory,proto3" json:"performance_history,omitempty" yaml:"performance_history"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -130,6 +132,13 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetPerformanceHistory() uint64 {
+	if m != nil {
+		return m.PerformanceHistory
+	}
+	return 0
+}
+
 // AggregateExchangeRatePrevote represents the aggregate prevoting on the
 // ExchangeRateVote. The purpose of aggregate prevoting is to hide vote exchange
 // rates with hash which is formatted as hex string in SHA256("{salt}:{exchange
@@ -321,6 +330,8 @@ type TargetParams struct {
 	Source TargetSource `protobuf:"varint,2,opt,name=source,proto3,enum=gridiron.oracle.v1.TargetSource" json:"source,omitempty"`
 	// quotation source DEX contract address
 	SourceDexContract string `protobuf:"bytes,3,opt,name=source_dex_contract,json=sourceDexContract,proto3" json:"source_dex_contract,omitempty"`
+	// voting params overriding the module params for the target
+	VotingParams TargetVotingParams `protobuf:"bytes,4,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
 }
 
 func (m *TargetParams) Reset()         { *m = TargetParams{} }
@@ -377,6 +388,251 @@ func (m *TargetParams) GetSourceDexContract() string {
 	return ""
 }
 
+func (m *TargetParams) GetVotingParams() TargetVotingParams {
+	if m != nil {
+		return m.VotingParams
+	}
+	return TargetVotingParams{}
+}
+
+// TargetVotingParams defines the voting params of a target. The unset ones
+// fall back to the module params.
+type TargetVotingParams struct {
+	// minimum percentage of voting power required for the ballot to pass
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold"`
+	// tolerated spread around the weighted median for the ballot winners
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band"`
+	// minimum number of non-abstain voters required for the ballot to pass
+	MinVoters uint64 `protobuf:"varint,3,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
+}
+
+func (m *TargetVotingParams) Reset()         { *m = TargetVotingParams{} }
+func (m *TargetVotingParams) String() string { return proto.CompactTextString(m) }
+func (*TargetVotingParams) ProtoMessage()    {}
+func (*TargetVotingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b7e916587bd39, []int{6}
+}
+func (m *TargetVotingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TargetVotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TargetVotingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TargetVotingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetVotingParams.Merge(m, src)
+}
+func (m *TargetVotingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TargetVotingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetVotingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetVotingParams proto.InternalMessageInfo
+
+func (m *TargetVotingParams) GetMinVoters() uint64 {
+	if m != nil {
+		return m.MinVoters
+	}
+	return 0
+}
+
+// SetTargetVotingParamsProposal is a gov Content type to update the voting
+// params of a registered target.
+type SetTargetVotingParamsProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// coin denom of the target
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// new voting params of the target
+	VotingParams TargetVotingParams `protobuf:"bytes,4,opt,name=voting_params,json=votingParams,proto3" json:"voting_params"`
+}
+
+func (m *SetTargetVotingParamsProposal) Reset()         { *m = SetTargetVotingParamsProposal{} }
+func (m *SetTargetVotingParamsProposal) String() string { return proto.CompactTextString(m) }
+func (*SetTargetVotingParamsProposal) ProtoMessage()    {}
+func (*SetTargetVotingParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b7e916587bd39, []int{7}
+}
+func (m *SetTargetVotingParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTargetVotingParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTargetVotingParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetTargetVotingParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTargetVotingParamsProposal.Merge(m, src)
+}
+func (m *SetTargetVotingParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTargetVotingParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTargetVotingParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTargetVotingParamsProposal proto.InternalMessageInfo
+
+func (m *SetTargetVotingParamsProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetTargetVotingParamsProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetTargetVotingParamsProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SetTargetVotingParamsProposal) GetVotingParams() TargetVotingParams {
+	if m != nil {
+		return m.VotingParams
+	}
+	return TargetVotingParams{}
+}
+
+// FeedContract is the EVM price feed contract of a target, which is compatible
+// with Chainlink's AggregatorV3Interface.
+type FeedContract struct {
+	// coin denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// hex address of the feed contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *FeedContract) Reset()         { *m = FeedContract{} }
+func (m *FeedContract) String() string { return proto.CompactTextString(m) }
+func (*FeedContract) ProtoMessage()    {}
+func (*FeedContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b7e916587bd39, []int{8}
+}
+func (m *FeedContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedContract.Merge(m, src)
+}
+func (m *FeedContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedContract proto.InternalMessageInfo
+
+func (m *FeedContract) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeedContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ValidatorPerformance defines the oracle voting statistics of a validator
+// over a slash window.
+type ValidatorPerformance struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// sequence number of the slash window, or zero for the current window
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty" yaml:"window"`
+	// block height at which the slash window ended
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// number of vote periods in which the validator was in the active set
+	VotePeriods uint64 `protobuf:"varint,4,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	// number of vote periods in which the validator submitted a vote
+	Votes uint64 `protobuf:"varint,5,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+	// number of ballots won by the validator
+	Wins uint64 `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty" yaml:"wins"`
+	// number of vote periods counted as missed
+	Misses uint64 `protobuf:"varint,7,opt,name=misses,proto3" json:"misses,omitempty" yaml:"misses"`
+	// number of votes abstaining from all denoms
+	Abstains uint64 `protobuf:"varint,8,opt,name=abstains,proto3" json:"abstains,omitempty" yaml:"abstains"`
+	// number of non-abstain votes in the tallied ballots
+	TalliedVotes uint64 `protobuf:"varint,9,opt,name=tallied_votes,json=talliedVotes,proto3" json:"tallied_votes,omitempty" yaml:"tallied_votes"`
+	// average relative deviation of the tallied votes from the weighted median
+	AverageDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=average_deviation,json=averageDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_deviation" yaml:"average_deviation"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b7e916587bd39, []int{9}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("gridiron.oracle.v1.TargetSource", TargetSource_name, TargetSource_value)
 	proto.RegisterType((*Params)(nil), "gridiron.oracle.v1.Params")
@@ -385,69 +641,97 @@ func init() {
 	proto.RegisterType((*ExchangeRateTuple)(nil), "gridiron.oracle.v1.ExchangeRateTuple")
 	proto.RegisterType((*RegisterTargetProposal)(nil), "gridiron.oracle.v1.RegisterTargetProposal")
 	proto.RegisterType((*TargetParams)(nil), "gridiron.oracle.v1.TargetParams")
+	proto.RegisterType((*TargetVotingParams)(nil), "gridiron.oracle.v1.TargetVotingParams")
+	proto.RegisterType((*SetTargetVotingParamsProposal)(nil), "gridiron.oracle.v1.SetTargetVotingParamsProposal")
+	proto.RegisterType((*FeedContract)(nil), "gridiron.oracle.v1.FeedContract")
+	proto.RegisterType((*ValidatorPerformance)(nil), "gridiron.oracle.v1.ValidatorPerformance")
 }
 
 func init() { proto.RegisterFile("gridiron/oracle/v1/oracle.proto", fileDescriptor_968b7e916587bd39) }
 
 var fileDescriptor_968b7e916587bd39 = []byte{
-	// 897 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x18, 0xdd, 0x6d, 0x9c, 0x40, 0xc7, 0x4e, 0xb1, 0xa7, 0x6e, 0x71, 0x43, 0xf0, 0x9a, 0x2d, 0x54,
-	0x11, 0x52, 0x6d, 0xb5, 0x1c, 0x2a, 0x7c, 0xf3, 0xaf, 0xb4, 0x46, 0x25, 0xb1, 0x26, 0x6e, 0x40,
-	0x5c, 0x56, 0xe3, 0xdd, 0x61, 0xbd, 0xca, 0xee, 0x8e, 0x35, 0x3b, 0x4e, 0x52, 0x0e, 0x9c, 0x7b,
-	0x04, 0x71, 0xe1, 0x18, 0xc1, 0x8d, 0x0b, 0x07, 0x04, 0x7f, 0x43, 0x8f, 0x3d, 0x22, 0x0e, 0x0b,
-	0x4a, 0x84, 0xc4, 0xd9, 0x7f, 0x01, 0x9a, 0x1f, 0x6e, 0xd7, 0x89, 0x41, 0x44, 0x9c, 0xbc, 0xdf,
-	0x7b, 0x9f, 0xdf, 0xf7, 0xe6, 0xed, 0xb7, 0xbb, 0xa0, 0x1a, 0x11, 0x16, 0x06, 0x34, 0x6e, 0x50,
-	0x86, 0xdd, 0x90, 0x34, 0x0e, 0xef, 0xe9, 0xab, 0xfa, 0x84, 0x51, 0x4e, 0x61, 0x49, 0xf3, 0x75,
-	0x8d, 0x1e, 0xde, 0xdb, 0x28, 0xfb, 0xd4, 0xa7, 0x92, 0x6d, 0x88, 0x2b, 0xd5, 0x68, 0xff, 0xb4,
-	0x0a, 0xd6, 0x06, 0x98, 0xe1, 0x28, 0x81, 0x0f, 0x40, 0xfe, 0x90, 0x72, 0xe2, 0x4c, 0x08, 0x0b,
-	0xa8, 0x57, 0x31, 0x6b, 0xe6, 0x56, 0xae, 0x7d, 0x73, 0x96, 0x5a, 0xf0, 0x29, 0x8e, 0xc2, 0xa6,
-	0x9d, 0x21, 0x6d, 0x04, 0x44, 0x35, 0x90, 0x05, 0x8c, 0xc1, 0x35, 0xc9, 0xf1, 0x31, 0x23, 0xc9,
-	0x98, 0x86, 0x5e, 0xe5, 0x4a, 0xcd, 0xdc, 0xba, 0xda, 0x7e, 0xf8, 0x3c, 0xb5, 0x8c, 0xdf, 0x52,
-	0xeb, 0x8e, 0x1f, 0xf0, 0xf1, 0x74, 0x54, 0x77, 0x69, 0xd4, 0x70, 0x69, 0x12, 0xd1, 0x44, 0xff,
-	0xdc, 0x4d, 0xbc, 0x83, 0x06, 0x7f, 0x3a, 0x21, 0x49, 0xbd, 0x4b, 0xdc, 0x59, 0x6a, 0xdd, 0xc8,
-	0x4c, 0x7a, 0xa9, 0x66, 0xa3, 0x75, 0x01, 0x0c, 0xe7, 0x35, 0x24, 0x20, 0xcf, 0xc8, 0x11, 0x66,
-	0x9e, 0x33, 0xc2, 0xb1, 0x57, 0x59, 0x91, 0xc3, 0xba, 0x97, 0x1e, 0xa6, 0x8f, 0x95, 0x91, 0xb2,
-	0x11, 0x50, 0x55, 0x1b, 0xc7, 0x1e, 0x74, 0xc1, 0x86, 0xe6, 0xbc, 0x20, 0xe1, 0x2c, 0x18, 0x4d,
-	0x79, 0x40, 0x63, 0xe7, 0x28, 0x88, 0x3d, 0x7a, 0x54, 0xc9, 0xc9, 0x78, 0xde, 0x9b, 0xa5, 0xd6,
-	0x3b, 0x0b, 0x3a, 0x4b, 0x7a, 0x6d, 0x54, 0x51, 0x64, 0x37, 0xc3, 0x7d, 0x22, 0x29, 0x91, 0x5d,
-	0x12, 0xe2, 0x64, 0xec, 0x7c, 0xce, 0xb0, 0x2b, 0xf0, 0xca, 0xea, 0xff, 0xcb, 0x6e, 0x51, 0xcd,
-	0x46, 0xeb, 0x12, 0xd8, 0xd6, 0x35, 0x6c, 0x82, 0x82, 0xea, 0xd0, 0xc7, 0x58, 0x93, 0xc7, 0x78,
-	0x73, 0x96, 0x5a, 0xd7, 0xb3, 0xff, 0x9f, 0x1b, 0xcf, 0xcb, 0x52, 0x7b, 0xfd, 0x12, 0x94, 0xa3,
-	0x20, 0x76, 0x0e, 0x71, 0x18, 0x78, 0x62, 0x11, 0xe6, 0x1a, 0xaf, 0x49, 0xc7, 0x1f, 0x5f, 0xda,
-	0xf1, 0x5b, 0x6a, 0xe2, 0x32, 0x4d, 0x1b, 0x95, 0xa2, 0x20, 0xde, 0x17, 0xe8, 0x80, 0x30, 0x35,
-	0xbf, 0xf9, 0xfa, 0xb7, 0x27, 0x96, 0xf1, 0xd7, 0x89, 0x65, 0xda, 0x3f, 0x9b, 0x60, 0xb3, 0xe5,
-	0xfb, 0x8c, 0xf8, 0x98, 0x93, 0xde, 0xb1, 0x3b, 0xc6, 0xb1, 0x4f, 0x10, 0xe6, 0x64, 0xc0, 0x88,
-	0xd8, 0x15, 0x78, 0x1b, 0xe4, 0xc6, 0x38, 0x19, 0xcb, 0x25, 0xbe, 0xda, 0x7e, 0x63, 0x96, 0x5a,
-	0x79, 0x35, 0x4c, 0xa0, 0x36, 0x92, 0x24, 0xbc, 0x03, 0x56, 0x45, 0x33, 0xd3, 0xeb, 0x5a, 0x9c,
-	0xa5, 0x56, 0xe1, 0xd5, 0x02, 0x32, 0x1b, 0x29, 0x5a, 0x66, 0x36, 0x1d, 0x45, 0x01, 0x77, 0x46,
-	0x21, 0x75, 0x0f, 0xe4, 0xc2, 0x2d, 0x66, 0x96, 0x61, 0x45, 0x66, 0xb2, 0x6c, 0x8b, 0xaa, 0x59,
-	0x78, 0x76, 0x62, 0x19, 0xda, 0xb7, 0x61, 0xff, 0x69, 0x82, 0x5b, 0x4b, 0x7d, 0xef, 0x0b, 0xd3,
-	0x5f, 0x9b, 0xa0, 0x4c, 0x34, 0xe8, 0x30, 0x2c, 0x9e, 0x81, 0xe9, 0x24, 0x24, 0x49, 0xc5, 0xac,
-	0xad, 0x6c, 0xe5, 0xef, 0xbf, 0x5b, 0xbf, 0xf0, 0x50, 0xd7, 0xb3, 0x1a, 0x43, 0xd1, 0xdc, 0xfe,
-	0x50, 0xdc, 0x86, 0x57, 0xe1, 0x2e, 0xd3, 0xb3, 0x7f, 0xf8, 0xdd, 0x82, 0x17, 0xfe, 0x99, 0x20,
-	0x48, 0x2e, 0x60, 0xff, 0x35, 0xa3, 0x73, 0xe7, 0xfc, 0xc5, 0x04, 0xa5, 0x0b, 0x03, 0x84, 0x96,
-	0x47, 0x62, 0x1a, 0xe9, 0xbb, 0x92, 0xd1, 0x92, 0xb0, 0x8d, 0x14, 0x0d, 0x0f, 0xc0, 0xfa, 0x82,
-	0x6d, 0x3d, 0x7b, 0xfb, 0xd2, 0x0b, 0x56, 0x5e, 0x92, 0x81, 0x8d, 0x0a, 0xd9, 0x63, 0x9e, 0x33,
-	0xfe, 0x9d, 0x09, 0x6e, 0x22, 0xe2, 0x07, 0x09, 0x27, 0x6c, 0x88, 0x99, 0x4f, 0xf8, 0x80, 0xd1,
-	0x09, 0x4d, 0x70, 0x08, 0xcb, 0x60, 0x95, 0x07, 0x3c, 0x24, 0xca, 0x3d, 0x52, 0x05, 0xac, 0x81,
-	0xbc, 0x47, 0x12, 0x97, 0x05, 0x13, 0xf9, 0xf0, 0x4a, 0xa7, 0x28, 0x0b, 0xc1, 0x8f, 0xc0, 0x3a,
-	0x97, 0x4a, 0xce, 0x44, 0xbe, 0x67, 0xe5, 0xfa, 0xe4, 0xef, 0x5b, 0x4b, 0xee, 0xa6, 0x9e, 0x28,
-	0xdb, 0xda, 0x39, 0x71, 0x5c, 0x54, 0xe0, 0x19, 0xac, 0x99, 0x93, 0x26, 0xbf, 0x31, 0x41, 0x21,
-	0xdb, 0x2a, 0xac, 0x65, 0x82, 0x9d, 0xc7, 0xf8, 0x00, 0xac, 0x25, 0x74, 0xca, 0x5c, 0x95, 0xdf,
-	0xb5, 0x7f, 0x99, 0xb8, 0x27, 0xdb, 0x90, 0x6e, 0x87, 0x75, 0x70, 0x5d, 0x5d, 0x39, 0x1e, 0x39,
-	0x76, 0x5c, 0x1a, 0x73, 0xf1, 0xf6, 0x50, 0xef, 0x59, 0x54, 0x52, 0x54, 0x97, 0x1c, 0x77, 0x34,
-	0xa1, 0x5c, 0xbd, 0xff, 0xe3, 0x4b, 0x57, 0x4a, 0x0e, 0xbe, 0x0d, 0x6e, 0x0d, 0x5b, 0xe8, 0x61,
-	0x6f, 0xe8, 0xec, 0xed, 0x3e, 0x41, 0x9d, 0x9e, 0xf3, 0x64, 0x67, 0x6f, 0xd0, 0xeb, 0xf4, 0xb7,
-	0xfb, 0xbd, 0x6e, 0xd1, 0x80, 0x9b, 0xa0, 0xb2, 0x48, 0xef, 0xb7, 0x1e, 0xf7, 0xbb, 0xad, 0xe1,
-	0x2e, 0xda, 0x2b, 0x9a, 0xf0, 0x06, 0x28, 0x2d, 0xb2, 0xdd, 0xde, 0xa7, 0xc5, 0x2b, 0xb0, 0x06,
-	0x36, 0x17, 0xe1, 0xfe, 0xce, 0xb0, 0x87, 0x3a, 0x8f, 0x5a, 0xfd, 0x1d, 0xd9, 0xb1, 0x02, 0x6f,
-	0x03, 0xeb, 0x1f, 0x3b, 0x76, 0x51, 0xab, 0xf3, 0xb8, 0x57, 0xcc, 0x6d, 0xe4, 0x9e, 0x7d, 0x5f,
-	0x35, 0xda, 0x8f, 0x9e, 0x9f, 0x56, 0xcd, 0x17, 0xa7, 0x55, 0xf3, 0x8f, 0xd3, 0xaa, 0xf9, 0xd5,
-	0x59, 0xd5, 0x78, 0x71, 0x56, 0x35, 0x7e, 0x3d, 0xab, 0x1a, 0x9f, 0xd5, 0x33, 0x2b, 0xa6, 0x43,
-	0xbb, 0xfb, 0x05, 0x8d, 0xc9, 0xbc, 0x68, 0x1c, 0xcf, 0x3f, 0xbc, 0x72, 0xdd, 0x46, 0x6b, 0xf2,
-	0x63, 0xfa, 0xc1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xd2, 0xad, 0xed, 0x97, 0x07, 0x00,
-	0x00,
+	// 1296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xf7, 0x26, 0x6e, 0x9a, 0x8c, 0x9d, 0xd6, 0x9e, 0xb8, 0x7d, 0xb7, 0x79, 0x53, 0x6f, 0xde,
+	0xa9, 0x5a, 0xf5, 0x45, 0xaa, 0x4d, 0x0b, 0x12, 0x10, 0x09, 0x24, 0x3b, 0x76, 0xda, 0x94, 0xd2,
+	0x98, 0x89, 0x1b, 0x10, 0x97, 0xd5, 0xd8, 0x3b, 0x5d, 0xaf, 0x6a, 0xef, 0x5a, 0x33, 0x1b, 0x27,
+	0xe5, 0xc0, 0x89, 0x43, 0x8f, 0x88, 0x13, 0xc7, 0x4a, 0x3d, 0x20, 0x71, 0xe1, 0x04, 0x7f, 0x02,
+	0xea, 0xb1, 0x47, 0xc4, 0x61, 0x41, 0xad, 0x84, 0xe0, 0xea, 0x7f, 0x00, 0x34, 0x1f, 0x6b, 0xaf,
+	0x3f, 0x8a, 0x88, 0x0a, 0x27, 0xef, 0xf3, 0xfb, 0x3d, 0xfb, 0xcc, 0xf3, 0x35, 0xcf, 0xb3, 0x06,
+	0x96, 0xcb, 0x3c, 0xc7, 0x63, 0x81, 0x5f, 0x0e, 0x18, 0x69, 0x77, 0x69, 0x79, 0x70, 0x5d, 0x3f,
+	0x95, 0xfa, 0x2c, 0x08, 0x03, 0x08, 0x63, 0x85, 0x92, 0x86, 0x07, 0xd7, 0xd7, 0x0b, 0x6e, 0xe0,
+	0x06, 0x92, 0x2e, 0x8b, 0x27, 0xa5, 0x89, 0x3e, 0x5f, 0x02, 0x4b, 0x0d, 0xc2, 0x48, 0x8f, 0xc3,
+	0xb7, 0x40, 0x66, 0x10, 0x84, 0xd4, 0xee, 0x53, 0xe6, 0x05, 0x8e, 0x69, 0x6c, 0x1a, 0x57, 0xd3,
+	0xd5, 0xf3, 0xc3, 0xc8, 0x82, 0x0f, 0x49, 0xaf, 0xbb, 0x85, 0x12, 0x24, 0xc2, 0x40, 0x48, 0x0d,
+	0x29, 0x40, 0x1f, 0x9c, 0x91, 0x5c, 0xd8, 0x61, 0x94, 0x77, 0x82, 0xae, 0x63, 0x2e, 0x6c, 0x1a,
+	0x57, 0x57, 0xaa, 0x37, 0x9f, 0x46, 0x56, 0xea, 0xa7, 0xc8, 0xba, 0xe2, 0x7a, 0x61, 0xe7, 0xb0,
+	0x55, 0x6a, 0x07, 0xbd, 0x72, 0x3b, 0xe0, 0xbd, 0x80, 0xeb, 0x9f, 0x6b, 0xdc, 0x79, 0x50, 0x0e,
+	0x1f, 0xf6, 0x29, 0x2f, 0xd5, 0x68, 0x7b, 0x18, 0x59, 0xe7, 0x12, 0x27, 0x8d, 0xac, 0x21, 0xbc,
+	0x2a, 0x80, 0x66, 0x2c, 0x43, 0x0a, 0x32, 0x8c, 0x1e, 0x11, 0xe6, 0xd8, 0x2d, 0xe2, 0x3b, 0xe6,
+	0xa2, 0x3c, 0xac, 0x76, 0xe2, 0xc3, 0x74, 0x58, 0x09, 0x53, 0x08, 0x03, 0x25, 0x55, 0x89, 0xef,
+	0xc0, 0x36, 0x58, 0xd7, 0x9c, 0xe3, 0xf1, 0x90, 0x79, 0xad, 0xc3, 0xd0, 0x0b, 0x7c, 0xfb, 0xc8,
+	0xf3, 0x9d, 0xe0, 0xc8, 0x4c, 0xcb, 0xf4, 0x5c, 0x1e, 0x46, 0xd6, 0xff, 0x26, 0xec, 0xcc, 0xd1,
+	0x45, 0xd8, 0x54, 0x64, 0x2d, 0xc1, 0x7d, 0x24, 0x29, 0x91, 0x3b, 0xde, 0x25, 0xbc, 0x63, 0xdf,
+	0x67, 0xa4, 0x2d, 0x70, 0xf3, 0xd4, 0xab, 0xe5, 0x6e, 0xd2, 0x1a, 0xc2, 0xab, 0x12, 0xd8, 0xd1,
+	0x32, 0xdc, 0x02, 0x59, 0xa5, 0xa1, 0xc3, 0x58, 0x92, 0x61, 0xfc, 0x67, 0x18, 0x59, 0x6b, 0xc9,
+	0xf7, 0x63, 0xc7, 0x33, 0x52, 0xd4, 0xbe, 0x7e, 0x06, 0x0a, 0x3d, 0xcf, 0xb7, 0x07, 0xa4, 0xeb,
+	0x39, 0xa2, 0x11, 0x62, 0x1b, 0xa7, 0xa5, 0xc7, 0x1f, 0x9c, 0xd8, 0xe3, 0xff, 0xaa, 0x13, 0xe7,
+	0xd9, 0x44, 0x38, 0xdf, 0xf3, 0xfc, 0x03, 0x81, 0x36, 0x28, 0xd3, 0xe7, 0xef, 0x81, 0xb5, 0x3e,
+	0x65, 0xf7, 0x03, 0xd6, 0x23, 0x7e, 0x9b, 0xda, 0x1d, 0x8f, 0x87, 0x01, 0x7b, 0x68, 0x2e, 0xcb,
+	0x10, 0x8a, 0xc3, 0xc8, 0x5a, 0x57, 0x06, 0xe7, 0x28, 0x21, 0x0c, 0x13, 0xe8, 0x2d, 0x05, 0x6e,
+	0x2d, 0x7f, 0xf5, 0xd8, 0x4a, 0xfd, 0xf6, 0xd8, 0x32, 0xd0, 0x77, 0x06, 0xd8, 0xa8, 0xb8, 0x2e,
+	0xa3, 0x2e, 0x09, 0x69, 0xfd, 0xb8, 0xdd, 0x21, 0xbe, 0x4b, 0x31, 0x09, 0x69, 0x83, 0x51, 0xd1,
+	0x7c, 0xf0, 0x12, 0x48, 0x77, 0x08, 0xef, 0xc8, 0x5b, 0xb1, 0x52, 0x3d, 0x3b, 0x8c, 0xac, 0x8c,
+	0x3a, 0x4c, 0xa0, 0x08, 0x4b, 0x12, 0x5e, 0x01, 0xa7, 0x84, 0x32, 0xd3, 0xfd, 0x9f, 0x1b, 0x46,
+	0x56, 0x76, 0xdc, 0xd1, 0x0c, 0x61, 0x45, 0xcb, 0x22, 0x1c, 0xb6, 0x7a, 0x5e, 0x68, 0xb7, 0xba,
+	0x41, 0xfb, 0x81, 0xb9, 0x38, 0x53, 0x84, 0x04, 0x2b, 0x8a, 0x20, 0xc5, 0xaa, 0x90, 0xb6, 0xb2,
+	0x8f, 0x1e, 0x5b, 0x29, 0xed, 0x77, 0x0a, 0xfd, 0x6a, 0x80, 0x0b, 0x73, 0xfd, 0x3e, 0x10, 0x4e,
+	0x7f, 0x69, 0x80, 0x02, 0xd5, 0xa0, 0xcd, 0x88, 0xb8, 0x54, 0x87, 0xfd, 0x2e, 0xe5, 0xa6, 0xb1,
+	0xb9, 0x78, 0x35, 0x73, 0xe3, 0x72, 0x69, 0x76, 0x4c, 0x94, 0x92, 0x46, 0x9a, 0x42, 0xbb, 0xfa,
+	0x8e, 0x28, 0xec, 0xb8, 0x5c, 0xf3, 0x0c, 0xa2, 0x6f, 0x7e, 0xb6, 0xe0, 0xcc, 0x9b, 0x1c, 0x43,
+	0x3a, 0x83, 0xfd, 0xdd, 0x24, 0x4d, 0x05, 0xfa, 0xbd, 0x01, 0xf2, 0x33, 0x07, 0x08, 0x5b, 0x0e,
+	0xf5, 0x83, 0x9e, 0x69, 0x4c, 0xdb, 0x92, 0x30, 0xc2, 0x8a, 0x86, 0x0f, 0xc0, 0xea, 0x84, 0xdb,
+	0xfa, 0xec, 0x9d, 0x13, 0xb7, 0x6c, 0x61, 0x4e, 0x0e, 0x10, 0xce, 0x26, 0xc3, 0x9c, 0x72, 0xfc,
+	0x89, 0x01, 0xce, 0x63, 0xea, 0x7a, 0x3c, 0xa4, 0xac, 0x49, 0x98, 0x4b, 0xc3, 0x06, 0x0b, 0xfa,
+	0x01, 0x27, 0x5d, 0x58, 0x00, 0xa7, 0x42, 0x2f, 0xec, 0x52, 0xe5, 0x3d, 0x56, 0x02, 0xdc, 0x04,
+	0x19, 0x87, 0xf2, 0x36, 0xf3, 0xfa, 0x72, 0x1c, 0x48, 0x4f, 0x71, 0x12, 0x82, 0xef, 0x83, 0xd5,
+	0x50, 0x5a, 0xb2, 0xfb, 0x72, 0x72, 0xcb, 0xfe, 0xc9, 0xdc, 0xd8, 0x9c, 0x57, 0x4e, 0x7d, 0xa4,
+	0xd4, 0xab, 0xa6, 0x45, 0xbc, 0x38, 0x1b, 0x26, 0xb0, 0xad, 0xb4, 0xf4, 0xf2, 0x0f, 0x03, 0x64,
+	0x93, 0xaa, 0xc2, 0xb7, 0x44, 0x66, 0xe3, 0x3c, 0xbe, 0x0d, 0x96, 0x78, 0x70, 0xc8, 0xda, 0x2a,
+	0x81, 0x67, 0xfe, 0xea, 0xc8, 0x7d, 0xa9, 0x87, 0xb5, 0x3e, 0x2c, 0x81, 0x35, 0xf5, 0x64, 0x3b,
+	0xf4, 0xd8, 0x6e, 0x07, 0x7e, 0x28, 0x26, 0x92, 0x9a, 0xdd, 0x38, 0xaf, 0xa8, 0x1a, 0x3d, 0xde,
+	0xd6, 0x04, 0xf4, 0x80, 0x18, 0xfa, 0x9e, 0xef, 0xc6, 0x31, 0xa6, 0x65, 0x8c, 0x57, 0x5e, 0x7e,
+	0xe0, 0x81, 0x54, 0xd7, 0x91, 0x6e, 0xe8, 0x9e, 0x2d, 0x8c, 0x3a, 0x6b, 0x6c, 0x0a, 0xe1, 0xec,
+	0x20, 0xa1, 0xab, 0x33, 0xf0, 0xf5, 0x02, 0x80, 0xb3, 0x86, 0xe6, 0xec, 0x36, 0x63, 0x34, 0x9f,
+	0x8d, 0x7f, 0x7f, 0xb7, 0x2d, 0x8c, 0x76, 0x9b, 0xf1, 0x8f, 0xee, 0xb6, 0x37, 0x01, 0x90, 0x63,
+	0x57, 0xdc, 0x34, 0xae, 0xe7, 0xcf, 0xb9, 0x61, 0x64, 0xe5, 0x13, 0x23, 0x59, 0x72, 0x08, 0xaf,
+	0x88, 0x41, 0x2c, 0x9f, 0x75, 0xa6, 0x7e, 0x30, 0xc0, 0xc5, 0x7d, 0x1a, 0xce, 0x26, 0xeb, 0x95,
+	0x1b, 0x7b, 0xd4, 0x74, 0x8b, 0xc9, 0xa6, 0xfb, 0xf0, 0xd5, 0x5a, 0x41, 0x37, 0xfd, 0x9c, 0x92,
+	0xbf, 0x07, 0xb2, 0x3b, 0x94, 0x3a, 0xa3, 0x9e, 0x9b, 0xdf, 0xf3, 0x26, 0x38, 0x4d, 0x1c, 0x87,
+	0x51, 0xce, 0xb5, 0xcb, 0xb1, 0x88, 0x7e, 0x4f, 0x83, 0x82, 0x5c, 0x51, 0x24, 0x0c, 0x58, 0x63,
+	0xbc, 0x5e, 0xe0, 0x2e, 0xc8, 0x0f, 0x62, 0xdc, 0x8e, 0x5f, 0x56, 0x7d, 0xb3, 0x31, 0x8c, 0x2c,
+	0x53, 0x77, 0xc2, 0xb4, 0x0a, 0xc2, 0xb9, 0x11, 0x56, 0x51, 0x10, 0xfc, 0x3f, 0x58, 0xd2, 0x5b,
+	0x76, 0x41, 0x16, 0x29, 0x3f, 0x8c, 0xac, 0x55, 0xf5, 0x7e, 0xbc, 0x29, 0xb5, 0x82, 0xa8, 0x29,
+	0xf5, 0x1d, 0xbb, 0x43, 0x3d, 0xb7, 0xa3, 0x6e, 0xd6, 0x62, 0xb2, 0xa6, 0x63, 0x0e, 0xe1, 0x15,
+	0xea, 0x3b, 0xb7, 0xe4, 0xb3, 0xd8, 0x45, 0x89, 0x0f, 0x3b, 0x6e, 0xa6, 0xa7, 0x77, 0x51, 0x92,
+	0x45, 0x38, 0x33, 0xfe, 0xee, 0x1b, 0x8d, 0x72, 0x2e, 0xbf, 0x59, 0xd2, 0xd3, 0xa3, 0x9c, 0xeb,
+	0x51, 0xce, 0xc5, 0xf2, 0x3c, 0xf2, 0x7c, 0xae, 0x3f, 0x36, 0x12, 0xcb, 0x53, 0xa0, 0x08, 0x4b,
+	0x52, 0x44, 0xda, 0xf3, 0x38, 0xa7, 0xdc, 0x3c, 0x3d, 0x1d, 0xa9, 0xc2, 0x11, 0xd6, 0x0a, 0xb0,
+	0x0c, 0x96, 0x49, 0x8b, 0x87, 0x44, 0xd8, 0x54, 0xdb, 0x7f, 0x6d, 0x18, 0x59, 0x67, 0x95, 0x72,
+	0xcc, 0x20, 0x3c, 0x52, 0x82, 0xef, 0x8a, 0x89, 0xd9, 0xed, 0x7a, 0xd4, 0xb1, 0x95, 0xc3, 0x2b,
+	0xf2, 0x2d, 0x73, 0x3c, 0x21, 0x26, 0x68, 0x84, 0xb3, 0x5a, 0x3e, 0x90, 0xfe, 0x1f, 0x81, 0x3c,
+	0x19, 0x50, 0x46, 0x5c, 0x31, 0xbd, 0x06, 0x1e, 0x91, 0xfd, 0x0b, 0x64, 0x3d, 0x6f, 0x9f, 0x78,
+	0x85, 0xe8, 0xea, 0xcf, 0x18, 0x44, 0x38, 0xa7, 0xb1, 0x5a, 0x0c, 0x6d, 0x2d, 0x3f, 0xd2, 0x6b,
+	0xe4, 0xb5, 0x6f, 0x47, 0x03, 0x5a, 0x0d, 0x56, 0x78, 0x11, 0x5c, 0x68, 0x56, 0xf0, 0xcd, 0x7a,
+	0xd3, 0xde, 0xdf, 0xbb, 0x87, 0xb7, 0xeb, 0xf6, 0xbd, 0xbb, 0xfb, 0x8d, 0xfa, 0xf6, 0xee, 0xce,
+	0x6e, 0xbd, 0x96, 0x4b, 0xc1, 0x0d, 0x60, 0x4e, 0xd2, 0x07, 0x95, 0x3b, 0xbb, 0xb5, 0x4a, 0x73,
+	0x0f, 0xef, 0xe7, 0x0c, 0x78, 0x0e, 0xe4, 0x27, 0xd9, 0x5a, 0xfd, 0xe3, 0xdc, 0x02, 0xdc, 0x04,
+	0x1b, 0x93, 0xf0, 0xee, 0xdd, 0x66, 0x1d, 0x6f, 0xdf, 0xaa, 0xec, 0xde, 0x95, 0x1a, 0x8b, 0xf0,
+	0x12, 0xb0, 0x5e, 0xaa, 0xb1, 0x87, 0x2b, 0xdb, 0x77, 0xea, 0xb9, 0xf4, 0x7a, 0xfa, 0xd1, 0x93,
+	0x62, 0xaa, 0x7a, 0xfb, 0xe9, 0xf3, 0xa2, 0xf1, 0xec, 0x79, 0xd1, 0xf8, 0xe5, 0x79, 0xd1, 0xf8,
+	0xe2, 0x45, 0x31, 0xf5, 0xec, 0x45, 0x31, 0xf5, 0xe3, 0x8b, 0x62, 0xea, 0x93, 0xd7, 0x13, 0xb9,
+	0x8a, 0xef, 0xf0, 0xb5, 0x4f, 0x03, 0x9f, 0x8e, 0xa4, 0xf2, 0x71, 0xfc, 0xcf, 0x46, 0x66, 0xae,
+	0xb5, 0x24, 0xff, 0xac, 0xbc, 0xf1, 0xe7, 0x00, 0xa1, 0x75, 0xf5, 0x3e, 0xf9, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -490,6 +774,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if this.PerformanceHistory != that1.PerformanceHistory {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -512,6 +799,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PerformanceHistory != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PerformanceHistory))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -763,6 +1055,16 @@ func (m *TargetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.SourceDexContract) > 0 {
 		i -= len(m.SourceDexContract)
 		copy(dAtA[i:], m.SourceDexContract)
@@ -785,93 +1087,319 @@ func (m *TargetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TargetVotingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
-	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
-	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
-	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+
+func (m *TargetVotingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TargetVotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SubmitBlock != 0 {
-		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+func (m *SetTargetVotingParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetTargetVotingParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetTargetVotingParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.ExchangeRate.Size()
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageDeviation.Size()
+		i -= size
+		if _, err := m.AverageDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.TalliedVotes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TalliedVotes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Abstains != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Abstains))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Misses != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Wins != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Wins))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Votes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.PerformanceHistory != 0 {
+		n += 1 + sovOracle(uint64(m.PerformanceHistory))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
 	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
@@ -912,16 +1440,628 @@ func (m *TargetParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	l = m.VotingParams.Size()
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *TargetVotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	return n
 }
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SetTargetVotingParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.VotingParams.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+
+func (m *FeedContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovOracle(uint64(m.Window))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovOracle(uint64(m.EndHeight))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovOracle(uint64(m.Votes))
+	}
+	if m.Wins != 0 {
+		n += 1 + sovOracle(uint64(m.Wins))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovOracle(uint64(m.Misses))
+	}
+	if m.Abstains != 0 {
+		n += 1 + sovOracle(uint64(m.Abstains))
+	}
+	if m.TalliedVotes != 0 {
+		n += 1 + sovOracle(uint64(m.TalliedVotes))
+	}
+	l = m.AverageDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceHistory", wireType)
+			}
+			m.PerformanceHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -944,17 +2084,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.VotePeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -964,14 +2104,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -999,13 +2152,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterTargetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterTargetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1033,15 +2236,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1051,14 +2284,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TargetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1086,15 +2383,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.SlashWindow = 0
+			m.Source = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1104,14 +2399,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				m.Source |= TargetSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDexContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1139,7 +2434,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SourceDexContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1164,7 +2490,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *TargetVotingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1187,15 +2513,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: TargetVotingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TargetVotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1223,11 +2549,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1255,13 +2585,17 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
 			}
-			m.SubmitBlock = 0
+			m.MinVoters = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1271,7 +2605,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
+				m.MinVoters |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1297,7 +2631,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *SetTargetVotingParamsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1320,17 +2654,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetTargetVotingParamsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetTargetVotingParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1340,29 +2674,27 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1390,59 +2722,9 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -1474,11 +2756,11 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1488,23 +2770,22 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1529,7 +2810,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
+func (m *FeedContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1552,15 +2833,15 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterTargetProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterTargetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1588,11 +2869,11 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1620,40 +2901,7 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetParams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TargetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1676,7 +2924,7 @@ func (m *RegisterTargetProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TargetParams) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1699,15 +2947,15 @@ func (m *TargetParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TargetParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TargetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1735,13 +2983,13 @@ func (m *TargetParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.Source = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1751,14 +2999,147 @@ func (m *TargetParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= TargetSource(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wins", wireType)
+			}
+			m.Wins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wins |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstains", wireType)
+			}
+			m.Abstains = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstains |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TalliedVotes", wireType)
+			}
+			m.TalliedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TalliedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDexContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1786,7 +3167,9 @@ func (m *TargetParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceDexContract = string(dAtA[iNdEx:postIndex])
+			if err := m.AverageDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18,6 +18,7 @@ var (
 	KeySlashFraction            = []byte("SlashFraction")
 	KeySlashWindow              = []byte("SlashWindow")
 	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyPerformanceHistory       = []byte("PerformanceHistory")
 )
 
 // Default parameter values
@@ -25,6 +26,7 @@ const (
 	DefaultVotePeriod               = types.BlocksPerMinute // 60 seconds
 	DefaultSlashWindow              = types.BlocksPerWeek   // slash window for a week
 	DefaultRewardDistributionWindow = types.BlocksPerYear   // reward distribution window for a year
+	DefaultPerformanceHistory       = 12                    // performances of the past 12 slash windows
 )
 
 // Default parameter values
@@ -57,6 +59,7 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		PerformanceHistory:       DefaultPerformanceHistory,
 	}
 }
 
@@ -70,6 +73,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramtypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramtypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramtypes.NewParamSetPair(KeyPerformanceHistory, &p.PerformanceHistory, validatePerformanceHistory),
 	}
 }
 
@@ -217,3 +221,12 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validatePerformanceHistory(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -83,6 +83,9 @@ func TestParamsValidate(t *testing.T) {
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 2)))
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
+		case bytes.Compare(types.KeyPerformanceHistory, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.Error(t, pair.ValidatorFn("invalid"))
 		}
 	}
 }
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorPerformance creates a ValidatorPerformance instance with empty statistics.
+func NewValidatorPerformance(validator sdk.ValAddress) ValidatorPerformance {
+	return ValidatorPerformance{
+		ValidatorAddress: validator.String(),
+		AverageDeviation: sdk.ZeroDec(),
+	}
+}
+
+// AddDeviations folds the relative deviations of the tallied votes into the
+// average deviation.
+func (p *ValidatorPerformance) AddDeviations(deviations []sdk.Dec) {
+	if len(deviations) == 0 {
+		return
+	}
+
+	sum := p.AverageDeviation.MulInt64(int64(p.TalliedVotes))
+	for _, deviation := range deviations {
+		sum = sum.Add(deviation)
+	}
+
+	p.TalliedVotes += uint64(len(deviations))
+	p.AverageDeviation = sum.QuoInt64(int64(p.TalliedVotes))
+}
@@ -8,16 +8,20 @@ import (
 )
 
 const (
-	ProposalTypeRegisterTarget = "RegisterTarget"
+	ProposalTypeRegisterTarget        = "RegisterTarget"
+	ProposalTypeSetTargetVotingParams = "SetTargetVotingParams"
 )
 
 var (
 	_ govtypes.Content = &RegisterTargetProposal{}
+	_ govtypes.Content = &SetTargetVotingParamsProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeRegisterTarget)
+	govtypes.RegisterProposalType(ProposalTypeSetTargetVotingParams)
 	govtypes.RegisterProposalTypeCodec(&RegisterTargetProposal{}, "oracle/RegisterTargetProposal")
+	govtypes.RegisterProposalTypeCodec(&SetTargetVotingParamsProposal{}, "oracle/SetTargetVotingParamsProposal")
 }
 
 func (m *RegisterTargetProposal) ProposalRoute() string {
@@ -41,5 +45,35 @@ func validateTargetParams(params *TargetParams) error {
 		return fmt.Errorf("target source must be specified")
 	}
 	// TODO
+	return validateTargetVotingParams(&params.VotingParams)
+}
+
+func (m *SetTargetVotingParamsProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SetTargetVotingParamsProposal) ProposalType() string {
+	return ProposalTypeSetTargetVotingParams
+}
+
+func (m *SetTargetVotingParamsProposal) ValidateBasic() error {
+	err := sdk.ValidateDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+	return validateTargetVotingParams(&m.VotingParams)
+}
+
+func validateTargetVotingParams(params *TargetVotingParams) error {
+	if params.VoteThreshold != nil {
+		if err := validateVoteThreshold(*params.VoteThreshold); err != nil {
+			return err
+		}
+	}
+	if params.RewardBand != nil {
+		if err := validateRewardBand(*params.RewardBand); err != nil {
+			return err
+		}
+	}
 	return nil
 }
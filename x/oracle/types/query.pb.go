@@ -977,6 +977,377 @@ func (m *QueryAggregateVotesResponse) GetAggregateVotes() []AggregateExchangeRat
 	return nil
 }
 
+// QueryValidatorPerformanceRequest is the request type for the
+// Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorPerformanceRequest) Reset()         { *m = QueryValidatorPerformanceRequest{} }
+func (m *QueryValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceRequest) ProtoMessage()    {}
+func (*QueryValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a44d78ace854082, []int{22}
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorPerformanceRequest proto.InternalMessageInfo
+
+// QueryValidatorPerformanceResponse is response type for the
+// Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceResponse struct {
+	// current defines the statistics in the current slash window.
+	Current ValidatorPerformance `protobuf:"bytes,1,opt,name=current,proto3" json:"current"`
+	// history defines the statistics in the past slash windows, latest first.
+	History []ValidatorPerformance `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
+}
+
+func (m *QueryValidatorPerformanceResponse) Reset()         { *m = QueryValidatorPerformanceResponse{} }
+func (m *QueryValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceResponse) ProtoMessage()    {}
+func (*QueryValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a44d78ace854082, []int{23}
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPerformanceResponse) GetCurrent() ValidatorPerformance {
+	if m != nil {
+		return m.Current
+	}
+	return ValidatorPerformance{}
+}
+
+func (m *QueryValidatorPerformanceResponse) GetHistory() []ValidatorPerformance {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+// QueryWindowPerformancesRequest is the request type for the
+// Query/WindowPerformances RPC method.
+type QueryWindowPerformancesRequest struct {
+	// window defines the sequence number of the slash window to query for, or
+	// zero for the current window.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryWindowPerformancesRequest) Reset()         { *m = QueryWindowPerformancesRequest{} }
+func (m *QueryWindowPerformancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWindowPerformancesRequest) ProtoMessage()    {}
+func (*QueryWindowPerformancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a44d78ace854082, []int{24}
+}
+func (m *QueryWindowPerformancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWindowPerformancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWindowPerformancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWindowPerformancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWindowPerformancesRequest.Merge(m, src)
+}
+func (m *QueryWindowPerformancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWindowPerformancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWindowPerformancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWindowPerformancesRequest proto.InternalMessageInfo
+
+// QueryWindowPerformancesResponse is response type for the
+// Query/WindowPerformances RPC method.
+type QueryWindowPerformancesResponse struct {
+	// latest_window defines the sequence number of the last completed slash
+	// window.
+	LatestWindow uint64 `protobuf:"varint,1,opt,name=latest_window,json=latestWindow,proto3" json:"latest_window,omitempty"`
+	// performances defines the statistics of all validators in the window.
+	Performances []ValidatorPerformance `protobuf:"bytes,2,rep,name=performances,proto3" json:"performances"`
+}
+
+func (m *QueryWindowPerformancesResponse) Reset()         { *m = QueryWindowPerformancesResponse{} }
+func (m *QueryWindowPerformancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWindowPerformancesResponse) ProtoMessage()    {}
+func (*QueryWindowPerformancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a44d78ace854082, []int{25}
+}
+func (m *QueryWindowPerformancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWindowPerformancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWindowPerformancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWindowPerformancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWindowPerformancesResponse.Merge(m, src)
+}
+func (m *QueryWindowPerformancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWindowPerformancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWindowPerformancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWindowPerformancesResponse proto.InternalMessageInfo
+
+func (m *QueryWindowPerformancesResponse) GetLatestWindow() uint64 {
+	if m != nil {
+		return m.LatestWindow
+	}
+	return 0
+}
+
+func (m *QueryWindowPerformancesResponse) GetPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.Performances
+	}
+	return nil
+}
+
+// QueryFeedsRequest is the request type for the Query/Feeds RPC method.
+type QueryFeedsRequest struct {
+}
+
+func (m *QueryFeedsRequest) Reset()         { *m = QueryFeedsRequest{} }
+func (m *QueryFeedsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedsRequest) ProtoMessage()    {}
+func (*QueryFeedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a44d78ace854082, []int{26}
+}
+func (m *QueryFeedsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedsRequest.Merge(m, src)
+}
+func (m *QueryFeedsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedsRequest proto.InternalMessageInfo
+
+// QueryFeedsResponse is response type for the Query/Feeds RPC method.
+type QueryFeedsResponse struct {
+	// registry defines the hex address of the price feed registry contract.
+	Registry string `protobuf:"bytes,1,opt,name=registry,proto3" json:"registry,omitempty"`
+	// feeds defines the price feed contracts of all targets.
+	Feeds []FeedContract `protobuf:"bytes,2,rep,name=feeds,proto3" json:"feeds"`
+}
+
+func (m *QueryFeedsResponse) Reset()         { *m = QueryFeedsResponse{} }
+func (m *QueryFeedsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedsResponse) ProtoMessage()    {}
+func (*QueryFeedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a44d78ace854082, []int{27}
+}
+func (m *QueryFeedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedsResponse.Merge(m, src)
+}
+func (m *QueryFeedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedsResponse proto.InternalMessageInfo
+
+func (m *QueryFeedsResponse) GetRegistry() string {
+	if m != nil {
+		return m.Registry
+	}
+	return ""
+}
+
+func (m *QueryFeedsResponse) GetFeeds() []FeedContract {
+	if m != nil {
+		return m.Feeds
+	}
+	return nil
+}
+
+// QueryFeedRequest is the request type for the Query/Feed RPC method.
+type QueryFeedRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFeedRequest) Reset()         { *m = QueryFeedRequest{} }
+func (m *QueryFeedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedRequest) ProtoMessage()    {}
+func (*QueryFeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a44d78ace854082, []int{28}
+}
+func (m *QueryFeedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedRequest.Merge(m, src)
+}
+func (m *QueryFeedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedRequest proto.InternalMessageInfo
+
+// QueryFeedResponse is response type for the Query/Feed RPC method.
+type QueryFeedResponse struct {
+	// feed defines the price feed contract of the denom.
+	Feed FeedContract `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed"`
+}
+
+func (m *QueryFeedResponse) Reset()         { *m = QueryFeedResponse{} }
+func (m *QueryFeedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedResponse) ProtoMessage()    {}
+func (*QueryFeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a44d78ace854082, []int{29}
+}
+func (m *QueryFeedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedResponse.Merge(m, src)
+}
+func (m *QueryFeedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedResponse proto.InternalMessageInfo
+
+func (m *QueryFeedResponse) GetFeed() FeedContract {
+	if m != nil {
+		return m.Feed
+	}
+	return FeedContract{}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -985,7 +1356,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{22}
+	return fileDescriptor_4a44d78ace854082, []int{30}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1395,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a44d78ace854082, []int{23}
+	return fileDescriptor_4a44d78ace854082, []int{31}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,6 +1454,14 @@ func init() {
 	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "gridiron.oracle.v1.QueryAggregateVoteResponse")
 	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "gridiron.oracle.v1.QueryAggregateVotesRequest")
 	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "gridiron.oracle.v1.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryValidatorPerformanceRequest)(nil), "gridiron.oracle.v1.QueryValidatorPerformanceRequest")
+	proto.RegisterType((*QueryValidatorPerformanceResponse)(nil), "gridiron.oracle.v1.QueryValidatorPerformanceResponse")
+	proto.RegisterType((*QueryWindowPerformancesRequest)(nil), "gridiron.oracle.v1.QueryWindowPerformancesRequest")
+	proto.RegisterType((*QueryWindowPerformancesResponse)(nil), "gridiron.oracle.v1.QueryWindowPerformancesResponse")
+	proto.RegisterType((*QueryFeedsRequest)(nil), "gridiron.oracle.v1.QueryFeedsRequest")
+	proto.RegisterType((*QueryFeedsResponse)(nil), "gridiron.oracle.v1.QueryFeedsResponse")
+	proto.RegisterType((*QueryFeedRequest)(nil), "gridiron.oracle.v1.QueryFeedRequest")
+	proto.RegisterType((*QueryFeedResponse)(nil), "gridiron.oracle.v1.QueryFeedResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "gridiron.oracle.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "gridiron.oracle.v1.QueryParamsResponse")
 }
@@ -1090,77 +1469,96 @@ func init() {
 func init() { proto.RegisterFile("gridiron/oracle/v1/query.proto", fileDescriptor_4a44d78ace854082) }
 
 var fileDescriptor_4a44d78ace854082 = []byte{
-	// 1113 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc6, 0x3d, 0xdf, 0x6f, 0x9b, 0xd0, 0x71, 0xec, 0x26, 0x43, 0x10, 0x8e, 0x9b, 0xda, 0xed,
-	0x42, 0xd3, 0x90, 0x34, 0xbb, 0x71, 0xa2, 0x28, 0xa8, 0x28, 0xa2, 0x71, 0x5b, 0x54, 0x21, 0x7e,
-	0x14, 0x83, 0x22, 0x01, 0x07, 0x6b, 0xec, 0x1d, 0xb6, 0x2b, 0xec, 0x1d, 0x77, 0x67, 0x63, 0xd2,
-	0x56, 0xbd, 0x50, 0x81, 0x38, 0x22, 0x21, 0xf5, 0x88, 0x2a, 0x90, 0x38, 0x70, 0x41, 0x02, 0xee,
-	0x5c, 0x7b, 0xac, 0xc4, 0x05, 0x71, 0x28, 0x28, 0xe1, 0xc0, 0x9f, 0x81, 0x76, 0xf6, 0xdd, 0xf5,
-	0xae, 0x77, 0x87, 0x6c, 0xc2, 0x29, 0xf1, 0xbc, 0xef, 0x3c, 0xef, 0x67, 0x9e, 0x5d, 0xcf, 0x23,
-	0xe3, 0xb3, 0x7d, 0xe6, 0xf6, 0x6c, 0xee, 0x18, 0xdc, 0xa5, 0xdd, 0x1e, 0x33, 0x86, 0x0d, 0xe3,
-	0xf6, 0x2e, 0x73, 0xef, 0xe8, 0x03, 0x97, 0x7b, 0x9c, 0xcc, 0x40, 0x59, 0x0f, 0xca, 0xfa, 0xb0,
-	0x51, 0x9d, 0xb5, 0xb8, 0xc5, 0x65, 0xd5, 0xf0, 0xff, 0x0b, 0x1a, 0xab, 0xf3, 0x16, 0xe7, 0x56,
-	0x8f, 0x19, 0x74, 0x60, 0x1b, 0xd4, 0x71, 0xb8, 0x47, 0x3d, 0x9b, 0x3b, 0x02, 0xaa, 0xb5, 0x2e,
-	0x17, 0x7d, 0x2e, 0x8c, 0x0e, 0x15, 0xfe, 0x88, 0x0e, 0xf3, 0x68, 0xc3, 0xe8, 0x72, 0xdb, 0x09,
-	0xeb, 0x69, 0x0a, 0x18, 0x28, 0xeb, 0xda, 0x65, 0x5c, 0x79, 0xc7, 0xa7, 0xba, 0xbe, 0xd7, 0xbd,
-	0x45, 0x1d, 0x8b, 0xb5, 0xa8, 0xc7, 0x5a, 0xec, 0xf6, 0x2e, 0x13, 0x1e, 0x99, 0xc5, 0x27, 0x4d,
-	0xe6, 0xf0, 0x7e, 0x05, 0x9d, 0x43, 0x8b, 0xa7, 0x5a, 0xc1, 0x87, 0xcb, 0xcf, 0x7c, 0xf1, 0xa8,
-	0x5e, 0xf8, 0xfb, 0x51, 0xbd, 0xa0, 0x0d, 0xf0, 0x5c, 0xc6, 0x5e, 0x31, 0xe0, 0x8e, 0x60, 0xe4,
-	0x5d, 0x5c, 0x62, 0xb0, 0xde, 0x76, 0xa9, 0xc7, 0x02, 0x91, 0xa6, 0xfe, 0xf8, 0x69, 0xbd, 0xf0,
-	0xfb, 0xd3, 0xfa, 0x82, 0x65, 0x7b, 0xb7, 0x76, 0x3b, 0x7a, 0x97, 0xf7, 0x0d, 0x38, 0x42, 0xf0,
-	0x67, 0x45, 0x98, 0x1f, 0x1b, 0xde, 0x9d, 0x01, 0x13, 0xfa, 0x35, 0xd6, 0x6d, 0x4d, 0xb1, 0x98,
-	0xb8, 0x76, 0x26, 0x63, 0xa2, 0x00, 0x5c, 0xed, 0x21, 0xc2, 0xd5, 0xac, 0x2a, 0x00, 0xed, 0xe1,
-	0x72, 0x02, 0x48, 0x54, 0xd0, 0xb9, 0xff, 0x2f, 0x16, 0xd7, 0xe6, 0xf5, 0x60, 0xb0, 0xee, 0x5b,
-	0xa8, 0x83, 0x85, 0xfe, 0xec, 0xab, 0xdc, 0x76, 0x9a, 0xeb, 0x3e, 0xef, 0xf7, 0x7f, 0xd4, 0x97,
-	0xf3, 0xf1, 0xfa, 0x7b, 0x44, 0xab, 0x14, 0x87, 0x16, 0xda, 0x73, 0xf8, 0x59, 0xc9, 0xb5, 0xdd,
-	0xf5, 0xec, 0xe1, 0x88, 0x77, 0x15, 0xcf, 0x26, 0x97, 0x01, 0xb4, 0x82, 0x27, 0x69, 0xb0, 0x24,
-	0x09, 0x4f, 0xb5, 0xc2, 0x8f, 0xda, 0x1c, 0x7e, 0x5e, 0xee, 0xd8, 0xe1, 0x1e, 0x7b, 0x8f, 0xba,
-	0x16, 0xf3, 0x22, 0xb1, 0x2d, 0x78, 0x8e, 0x89, 0x12, 0x08, 0x9e, 0xc7, 0x53, 0x43, 0xee, 0xb1,
-	0xb6, 0x17, 0xac, 0x83, 0x6a, 0x71, 0x38, 0x6a, 0x8d, 0x10, 0xc7, 0x54, 0x43, 0xc4, 0x71, 0xc5,
-	0x0a, 0x9e, 0x4c, 0x8a, 0x85, 0x1f, 0xb5, 0xb7, 0xf1, 0xbc, 0xdc, 0xf1, 0x1a, 0x63, 0x26, 0x73,
-	0xaf, 0xb1, 0x1e, 0xb3, 0xe4, 0xfb, 0x1a, 0xbe, 0x53, 0x17, 0x70, 0x79, 0x48, 0x7b, 0xb6, 0x49,
-	0x3d, 0xee, 0xb6, 0xa9, 0x69, 0xba, 0xf0, 0x72, 0x95, 0xa2, 0xd5, 0x6d, 0xd3, 0x74, 0x63, 0x2f,
-	0xd9, 0x15, 0x7c, 0x56, 0x21, 0x08, 0x2c, 0x75, 0x5c, 0xfc, 0x48, 0xd6, 0xe2, 0x72, 0x38, 0x58,
-	0xf2, 0xb5, 0xb4, 0xd7, 0xc1, 0xb5, 0x37, 0x6d, 0x21, 0xae, 0xf2, 0x5d, 0xc7, 0x63, 0xee, 0xb1,
-	0x69, 0x42, 0x9b, 0x13, 0x5a, 0x23, 0x9b, 0xfb, 0xb6, 0x10, 0xed, 0x6e, 0xb0, 0x2e, 0xa5, 0x4e,
-	0xb4, 0x8a, 0xfd, 0x51, 0x6b, 0xe4, 0xce, 0xb6, 0x65, 0xb9, 0xfe, 0x39, 0xd8, 0x4d, 0x97, 0xf9,
-	0x8f, 0xe1, 0xd8, 0x3c, 0x0f, 0x10, 0xd8, 0x93, 0x56, 0x04, 0xaa, 0x0e, 0x9e, 0xa1, 0x61, 0xad,
-	0x3d, 0x08, 0x8a, 0x52, 0xb5, 0xb8, 0x66, 0xe8, 0xa9, 0x3b, 0x48, 0x8f, 0x74, 0xe2, 0x5f, 0x22,
-	0xd0, 0x6c, 0x9e, 0xf0, 0xbf, 0x0c, 0xad, 0x69, 0x3a, 0x36, 0x4b, 0xab, 0x2b, 0x20, 0xa2, 0xf7,
-	0xe8, 0x73, 0x84, 0x6b, 0xaa, 0x0e, 0xe0, 0x34, 0x31, 0x49, 0x71, 0x86, 0x5f, 0xd1, 0x63, 0x82,
-	0xce, 0x8c, 0x83, 0x0a, 0xed, 0x0d, 0xb8, 0x40, 0xa2, 0xdd, 0x3b, 0xff, 0xc5, 0xfd, 0x4f, 0xe0,
-	0xc2, 0x19, 0x53, 0x83, 0x13, 0xbd, 0x8f, 0xcb, 0xa3, 0x13, 0xc5, 0x6c, 0xbf, 0x94, 0xf7, 0x34,
-	0x3b, 0xa3, 0xa3, 0x94, 0x68, 0x7c, 0x84, 0x36, 0x9f, 0x35, 0x38, 0x72, 0xfb, 0x2e, 0x3e, 0x93,
-	0x59, 0x05, 0xae, 0x0f, 0xf1, 0xe9, 0x24, 0x57, 0x68, 0xf3, 0x71, 0xc0, 0xca, 0x09, 0x30, 0xa1,
-	0xcd, 0x62, 0x22, 0x67, 0xdf, 0xa4, 0x2e, 0xed, 0x47, 0x44, 0x6f, 0xc1, 0xf5, 0x12, 0xae, 0x02,
-	0xc9, 0x26, 0x9e, 0x18, 0xc8, 0x15, 0x70, 0x66, 0x2e, 0x03, 0x20, 0xd8, 0x02, 0xd3, 0xa0, 0x7d,
-	0xed, 0x87, 0xd3, 0xf8, 0xa4, 0x14, 0x24, 0xdf, 0x20, 0x3c, 0x15, 0x47, 0x23, 0xcb, 0x19, 0x1a,
-	0xaa, 0x84, 0xab, 0x5e, 0xca, 0xd7, 0x1c, 0xe0, 0x6a, 0x9b, 0x9f, 0xfe, 0xfa, 0xd7, 0x57, 0xff,
-	0x6b, 0x10, 0xc3, 0x48, 0x87, 0xaa, 0xcc, 0x46, 0x61, 0xdc, 0x93, 0x7f, 0xef, 0x1b, 0x89, 0xa4,
-	0x21, 0x5f, 0x23, 0x5c, 0x4a, 0x84, 0x12, 0xc9, 0x35, 0x38, 0xb4, 0xaf, 0xba, 0x92, 0xb3, 0x1b,
-	0x38, 0x57, 0x25, 0xe7, 0x12, 0x59, 0x54, 0x73, 0x26, 0x93, 0x90, 0x7c, 0x86, 0xf0, 0x24, 0xc4,
-	0x10, 0x59, 0x50, 0x0d, 0x4b, 0xc6, 0x57, 0xf5, 0xe2, 0xa1, 0x7d, 0x80, 0xf3, 0x92, 0xc4, 0x79,
-	0x81, 0x9c, 0x57, 0xe3, 0x40, 0xc0, 0x91, 0x87, 0x08, 0x17, 0x63, 0x09, 0x46, 0x96, 0x54, 0x33,
-	0xd2, 0x09, 0x58, 0x5d, 0xce, 0xd5, 0x0b, 0x4c, 0xba, 0x64, 0x5a, 0x24, 0x0b, 0x6a, 0xa6, 0x78,
-	0x64, 0x4a, 0x83, 0x42, 0x28, 0xa5, 0x41, 0x63, 0x40, 0x17, 0x0f, 0xed, 0xcb, 0x6f, 0x50, 0xc8,
-	0xf1, 0x33, 0xc2, 0xd3, 0xe3, 0x49, 0x48, 0x0c, 0xd5, 0x20, 0x45, 0x08, 0x57, 0x57, 0xf3, 0x6f,
-	0x00, 0xc4, 0x2d, 0x89, 0xb8, 0x49, 0x36, 0x32, 0x10, 0xa3, 0xdb, 0x51, 0x18, 0xf7, 0x92, 0xf7,
-	0xe7, 0x7d, 0x23, 0x88, 0x61, 0xf2, 0x2d, 0xc2, 0xc5, 0x58, 0x64, 0xaa, 0x9f, 0x6b, 0x3a, 0xa3,
-	0xd5, 0xcf, 0x35, 0x23, 0x83, 0xb5, 0x57, 0x24, 0xe7, 0x06, 0x59, 0x3f, 0x22, 0xa7, 0x1f, 0xd2,
-	0xe4, 0x17, 0x84, 0xa7, 0xc7, 0x03, 0x4a, 0x6d, 0xae, 0x22, 0xc3, 0xd5, 0xe6, 0xaa, 0x22, 0x5a,
-	0xbb, 0x21, 0xa1, 0x9b, 0xe4, 0xca, 0x11, 0xa1, 0x53, 0x79, 0x49, 0x7e, 0x44, 0x78, 0x26, 0x15,
-	0xb1, 0x24, 0x37, 0x51, 0xf4, 0xea, 0x36, 0x8e, 0xb0, 0x03, 0x0e, 0xf1, 0xb2, 0x3c, 0xc4, 0x1a,
-	0x59, 0xfd, 0xf7, 0x43, 0xa4, 0x33, 0x9e, 0xfc, 0x84, 0x70, 0x29, 0x11, 0x55, 0xea, 0xdb, 0x31,
-	0x2b, 0xb6, 0xd5, 0xb7, 0x63, 0x66, 0x2c, 0x6b, 0xd7, 0x25, 0xe8, 0xab, 0x64, 0x2b, 0x1b, 0xd4,
-	0xb4, 0x0f, 0x75, 0x5b, 0x5a, 0xfd, 0x1d, 0xc2, 0xe5, 0x64, 0xc0, 0x92, 0x7c, 0x20, 0x91, 0xc9,
-	0x7a, 0xde, 0x76, 0x00, 0xdf, 0x90, 0xe0, 0x06, 0x59, 0xc9, 0xeb, 0x70, 0x60, 0xef, 0x03, 0x84,
-	0x27, 0x82, 0x10, 0x25, 0x17, 0x54, 0x13, 0x13, 0x69, 0x5d, 0x5d, 0x38, 0xac, 0x0d, 0x80, 0x96,
-	0x24, 0xd0, 0x8b, 0x44, 0x0b, 0x81, 0xee, 0x72, 0x87, 0x8d, 0xc3, 0x05, 0x89, 0xdd, 0xbc, 0xf1,
-	0x78, 0xbf, 0x86, 0x9e, 0xec, 0xd7, 0xd0, 0x9f, 0xfb, 0x35, 0xf4, 0xe5, 0x41, 0xad, 0xf0, 0xe4,
-	0xa0, 0x56, 0xf8, 0xed, 0xa0, 0x56, 0xf8, 0x40, 0x8f, 0xfd, 0xb2, 0x82, 0xbd, 0x2b, 0x09, 0xa1,
-	0xbd, 0x50, 0x4a, 0xfe, 0xca, 0xea, 0x4c, 0xc8, 0x1f, 0xae, 0xeb, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe5, 0xa2, 0xd1, 0xa9, 0x60, 0x0f, 0x00, 0x00,
+	// 1412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x98, 0xcd, 0x6f, 0xdc, 0x54,
+	0x17, 0xc6, 0x73, 0xdb, 0x7c, 0xb4, 0x67, 0x32, 0x79, 0x93, 0xdb, 0xbc, 0x30, 0x75, 0xd3, 0x99,
+	0xd4, 0xf4, 0x23, 0xa5, 0x89, 0x9d, 0x49, 0x4a, 0x55, 0x4a, 0x5b, 0x35, 0x49, 0xa9, 0xa0, 0x02,
+	0xb5, 0x4c, 0x51, 0x91, 0x2a, 0xa4, 0xc8, 0xf1, 0xdc, 0xba, 0x16, 0x19, 0xdf, 0xa9, 0xaf, 0x93,
+	0x26, 0x44, 0xdd, 0xa0, 0x4a, 0xed, 0x12, 0x09, 0x09, 0x09, 0x56, 0x65, 0x01, 0x12, 0xac, 0xd8,
+	0x40, 0xf7, 0xac, 0xba, 0xac, 0x04, 0x0b, 0xc4, 0xa2, 0xa0, 0x96, 0x05, 0x7f, 0x06, 0xf2, 0xf5,
+	0xb1, 0x63, 0xcf, 0xd8, 0x33, 0x9e, 0x61, 0x95, 0xf8, 0xde, 0x73, 0x9e, 0xf3, 0x3b, 0x67, 0xee,
+	0x5c, 0x3f, 0x1a, 0x28, 0x5b, 0xae, 0x5d, 0xb7, 0x5d, 0xee, 0xe8, 0xdc, 0x35, 0xcc, 0x75, 0xa6,
+	0x6f, 0x56, 0xf5, 0xbb, 0x1b, 0xcc, 0xdd, 0xd6, 0x9a, 0x2e, 0xf7, 0x38, 0xa5, 0xe1, 0xbe, 0x16,
+	0xec, 0x6b, 0x9b, 0x55, 0x65, 0xd2, 0xe2, 0x16, 0x97, 0xdb, 0xba, 0xff, 0x5f, 0x10, 0xa9, 0x4c,
+	0x59, 0x9c, 0x5b, 0xeb, 0x4c, 0x37, 0x9a, 0xb6, 0x6e, 0x38, 0x0e, 0xf7, 0x0c, 0xcf, 0xe6, 0x8e,
+	0xc0, 0xdd, 0xb2, 0xc9, 0x45, 0x83, 0x0b, 0x7d, 0xcd, 0x10, 0x7e, 0x8d, 0x35, 0xe6, 0x19, 0x55,
+	0xdd, 0xe4, 0xb6, 0x83, 0xfb, 0x95, 0x14, 0x0e, 0xac, 0x28, 0x03, 0xd4, 0x73, 0x50, 0xfa, 0xc0,
+	0xe7, 0x7a, 0x7b, 0xcb, 0xbc, 0x63, 0x38, 0x16, 0xab, 0x19, 0x1e, 0xab, 0xb1, 0xbb, 0x1b, 0x4c,
+	0x78, 0x74, 0x12, 0x86, 0xea, 0xcc, 0xe1, 0x8d, 0x12, 0x99, 0x26, 0x33, 0xfb, 0x6b, 0xc1, 0xc3,
+	0xb9, 0x7d, 0x8f, 0x1e, 0x57, 0x06, 0xfe, 0x79, 0x5c, 0x19, 0x50, 0x9b, 0x70, 0x30, 0x25, 0x57,
+	0x34, 0xb9, 0x23, 0x18, 0xbd, 0x01, 0x45, 0x86, 0xeb, 0xab, 0xae, 0xe1, 0xb1, 0x40, 0x64, 0x59,
+	0x7b, 0xfa, 0xbc, 0x32, 0xf0, 0xc7, 0xf3, 0xca, 0x71, 0xcb, 0xf6, 0xee, 0x6c, 0xac, 0x69, 0x26,
+	0x6f, 0xe8, 0xd8, 0x43, 0xf0, 0x67, 0x4e, 0xd4, 0x3f, 0xd1, 0xbd, 0xed, 0x26, 0x13, 0xda, 0x65,
+	0x66, 0xd6, 0x46, 0x59, 0x4c, 0x5c, 0x3d, 0x94, 0x52, 0x51, 0x20, 0xae, 0xfa, 0x25, 0x01, 0x25,
+	0x6d, 0x17, 0x81, 0xb6, 0x60, 0x2c, 0x01, 0x24, 0x4a, 0x64, 0x7a, 0xef, 0x4c, 0x61, 0x61, 0x4a,
+	0x0b, 0x0a, 0x6b, 0xfe, 0x0c, 0x35, 0x9c, 0xa1, 0x5f, 0x7b, 0x85, 0xdb, 0xce, 0xf2, 0xa2, 0xcf,
+	0xfb, 0xc3, 0x9f, 0x95, 0x53, 0xf9, 0x78, 0xfd, 0x1c, 0x51, 0x2b, 0xc6, 0xa1, 0x85, 0xfa, 0x7f,
+	0x38, 0x20, 0xb9, 0x96, 0x4c, 0xcf, 0xde, 0xdc, 0xe5, 0x9d, 0x87, 0xc9, 0xe4, 0x32, 0x82, 0x96,
+	0x60, 0xc4, 0x08, 0x96, 0x24, 0xe1, 0xfe, 0x5a, 0xf8, 0xa8, 0x1e, 0x84, 0x57, 0x65, 0xc6, 0x4d,
+	0xee, 0xb1, 0x0f, 0x0d, 0xd7, 0x62, 0x5e, 0x24, 0x76, 0x01, 0x4a, 0xed, 0x5b, 0x28, 0x78, 0x04,
+	0x46, 0x37, 0xb9, 0xc7, 0x56, 0xbd, 0x60, 0x1d, 0x55, 0x0b, 0x9b, 0xbb, 0xa1, 0x11, 0x62, 0x8b,
+	0x6a, 0x88, 0xd8, 0xaa, 0x58, 0x82, 0x91, 0xa4, 0x58, 0xf8, 0xa8, 0x5e, 0x83, 0x29, 0x99, 0x71,
+	0x85, 0xb1, 0x3a, 0x73, 0x2f, 0xb3, 0x75, 0x66, 0xc9, 0x03, 0x1b, 0x9e, 0xa9, 0x63, 0x30, 0xb6,
+	0x69, 0xac, 0xdb, 0x75, 0xc3, 0xe3, 0xee, 0xaa, 0x51, 0xaf, 0xbb, 0x78, 0xb8, 0x8a, 0xd1, 0xea,
+	0x52, 0xbd, 0xee, 0xc6, 0x0e, 0xd9, 0x25, 0x38, 0x9c, 0x21, 0x88, 0x2c, 0x15, 0x28, 0xdc, 0x96,
+	0x7b, 0x71, 0x39, 0x08, 0x96, 0x7c, 0x2d, 0xf5, 0x2a, 0x4e, 0xed, 0x7d, 0x5b, 0x88, 0x15, 0xbe,
+	0xe1, 0x78, 0xcc, 0xed, 0x9b, 0x26, 0x1c, 0x73, 0x42, 0x6b, 0x77, 0xcc, 0x0d, 0x5b, 0x88, 0x55,
+	0x33, 0x58, 0x97, 0x52, 0x83, 0xb5, 0x42, 0x63, 0x37, 0x34, 0x9a, 0xce, 0x92, 0x65, 0xb9, 0x7e,
+	0x1f, 0xec, 0xba, 0xcb, 0xfc, 0x8f, 0xa1, 0x6f, 0x9e, 0x07, 0x04, 0x0e, 0x67, 0x28, 0x22, 0x95,
+	0x09, 0x13, 0x46, 0xb8, 0xb7, 0xda, 0x0c, 0x36, 0xa5, 0x6a, 0x61, 0x61, 0x5e, 0x6b, 0xbf, 0x85,
+	0xb4, 0x48, 0x28, 0xfe, 0x2d, 0x42, 0xd1, 0xe5, 0x41, 0xff, 0xdb, 0x50, 0x1b, 0x37, 0x5a, 0x8a,
+	0xa9, 0x95, 0x0c, 0x8a, 0xe8, 0x20, 0x3d, 0x24, 0x50, 0xce, 0x8a, 0x40, 0x50, 0x06, 0xb4, 0x0d,
+	0x34, 0xfc, 0x8e, 0xf6, 0x4b, 0x3a, 0xd1, 0x4a, 0x2a, 0xd4, 0xf7, 0xf0, 0x0a, 0x89, 0xb2, 0x6f,
+	0xfe, 0x97, 0xf9, 0x6f, 0x81, 0x92, 0xa6, 0x86, 0x2d, 0xdd, 0x82, 0xb1, 0xdd, 0x96, 0x62, 0x83,
+	0x9f, 0xcb, 0xdd, 0xce, 0xcd, 0xdd, 0x5e, 0x8a, 0x46, 0xbc, 0x86, 0x3a, 0x95, 0x56, 0x39, 0x9a,
+	0xf7, 0x0e, 0x1c, 0x4a, 0xdd, 0x45, 0xb0, 0x8f, 0xe1, 0x7f, 0x49, 0xb0, 0x70, 0xd0, 0x7d, 0x91,
+	0x8d, 0x25, 0xc8, 0x84, 0x7a, 0x03, 0xa6, 0x83, 0xbb, 0x28, 0x1c, 0xda, 0x75, 0xe6, 0xde, 0xe6,
+	0x6e, 0xc3, 0x70, 0xcc, 0xfe, 0x27, 0xfd, 0x84, 0xc0, 0x91, 0x0e, 0xaa, 0xd8, 0xd8, 0x3b, 0x30,
+	0x62, 0x6e, 0xb8, 0x2e, 0x73, 0x3c, 0x1c, 0xf5, 0x4c, 0x5a, 0x43, 0x69, 0x12, 0xd8, 0x4b, 0x98,
+	0xee, 0x2b, 0xdd, 0xb1, 0x85, 0xc7, 0xdd, 0xed, 0xd2, 0x9e, 0xe9, 0xbd, 0xfd, 0x28, 0x61, 0xba,
+	0xba, 0x8c, 0x47, 0xff, 0x23, 0xdb, 0xa9, 0xf3, 0x7b, 0xb1, 0xc0, 0xf0, 0xd3, 0xa2, 0xaf, 0xc0,
+	0xf0, 0x3d, 0xb9, 0x89, 0x77, 0x06, 0x3e, 0xc5, 0xba, 0xff, 0x9a, 0x40, 0x25, 0x53, 0x04, 0x7b,
+	0x7f, 0x0d, 0x8a, 0xeb, 0x86, 0xc7, 0x84, 0xb7, 0x9a, 0x10, 0x1b, 0x0d, 0x16, 0x83, 0x44, 0x5a,
+	0x83, 0xd1, 0x66, 0x2c, 0xb9, 0xcf, 0xde, 0x12, 0x1a, 0xea, 0x01, 0x98, 0x88, 0xae, 0xe8, 0xe8,
+	0x04, 0x3a, 0x40, 0xe3, 0x8b, 0xc8, 0xa8, 0xc0, 0x3e, 0x97, 0x59, 0xb6, 0xf0, 0xdc, 0x6d, 0xfc,
+	0xc0, 0xa3, 0x67, 0x7a, 0x1e, 0x86, 0xfc, 0x5b, 0x3b, 0x64, 0x9a, 0x4e, 0x63, 0xf2, 0xd5, 0x56,
+	0xb8, 0xe3, 0xb9, 0x86, 0xe9, 0x21, 0x4b, 0x90, 0xa4, 0x2e, 0xc0, 0x78, 0x54, 0x2f, 0xaf, 0x81,
+	0xb9, 0x16, 0x03, 0x8f, 0x10, 0xcf, 0xc1, 0xa0, 0xaf, 0x88, 0xe7, 0x27, 0x2f, 0x85, 0xcc, 0x51,
+	0x27, 0xb1, 0xe9, 0xeb, 0x86, 0x6b, 0x34, 0xa2, 0x51, 0x5c, 0x83, 0x03, 0x89, 0x55, 0x2c, 0x74,
+	0x16, 0x86, 0x9b, 0x72, 0x05, 0x4b, 0x29, 0x69, 0xa5, 0x82, 0x1c, 0x2c, 0x82, 0xf1, 0x0b, 0xbf,
+	0x4d, 0xc2, 0x90, 0x54, 0xa4, 0xdf, 0x12, 0x18, 0x8d, 0x7f, 0x2b, 0xe9, 0x6c, 0x9a, 0x48, 0x96,
+	0xc3, 0x53, 0xe6, 0x72, 0x46, 0x07, 0xc4, 0xea, 0xd9, 0xcf, 0x7e, 0xfd, 0xfb, 0x8b, 0x3d, 0x0b,
+	0x74, 0x5e, 0x4f, 0xb1, 0x95, 0x72, 0xb8, 0x42, 0xdf, 0x91, 0x7f, 0xef, 0xeb, 0x09, 0xaf, 0x45,
+	0xbf, 0x21, 0x50, 0x8c, 0x4b, 0x0a, 0x9a, 0xaf, 0x74, 0x38, 0x43, 0x45, 0xcb, 0x1b, 0x8e, 0xa8,
+	0x55, 0x89, 0x7a, 0x8a, 0x9e, 0xec, 0x80, 0x9a, 0xb4, 0x83, 0xf4, 0x11, 0x81, 0x11, 0xf4, 0x62,
+	0xf4, 0x44, 0x66, 0xb9, 0xa4, 0x89, 0x53, 0x66, 0xba, 0x07, 0x22, 0xd1, 0xeb, 0x92, 0xe8, 0x28,
+	0x55, 0x3b, 0x10, 0xa1, 0xd1, 0xa3, 0x5f, 0x11, 0x28, 0xc4, 0x9c, 0x1c, 0x3d, 0x95, 0x59, 0xa5,
+	0xdd, 0x0a, 0x2a, 0xb3, 0xf9, 0x82, 0x11, 0x4b, 0x97, 0x58, 0x27, 0xe9, 0x89, 0x0e, 0x58, 0x71,
+	0xf7, 0x28, 0xc7, 0x14, 0x72, 0x65, 0x8f, 0xa9, 0x85, 0x69, 0xa6, 0x7b, 0x60, 0x0f, 0x63, 0x0a,
+	0x51, 0x9e, 0x10, 0x18, 0x6f, 0xf5, 0x85, 0x74, 0x3e, 0xb3, 0x54, 0x86, 0x27, 0x55, 0xaa, 0x3d,
+	0x64, 0x20, 0xe5, 0x45, 0x49, 0x79, 0x96, 0x9e, 0x49, 0xa3, 0x8c, 0x5e, 0x61, 0x42, 0xdf, 0x49,
+	0xbe, 0xe4, 0xee, 0xeb, 0x81, 0x2f, 0xa5, 0xdf, 0x11, 0x28, 0xc4, 0x3c, 0x64, 0x87, 0x0f, 0xb8,
+	0xdd, 0xb5, 0x2a, 0xb3, 0xf9, 0x82, 0x11, 0xf5, 0xbc, 0x44, 0x3d, 0x43, 0x4f, 0xf7, 0x8a, 0xea,
+	0x1b, 0x57, 0xfa, 0x0b, 0x81, 0xf1, 0x56, 0xcf, 0xd6, 0x61, 0xc4, 0x19, 0xc6, 0x56, 0xa9, 0xf6,
+	0x90, 0x81, 0xdc, 0xef, 0x4a, 0xee, 0x15, 0xba, 0xd4, 0x2b, 0x77, 0x9b, 0x8b, 0xa4, 0x3f, 0x11,
+	0x98, 0x68, 0xad, 0x23, 0x68, 0x7e, 0xa6, 0xe8, 0x18, 0x2f, 0xf4, 0x92, 0x82, 0x7d, 0xbc, 0x29,
+	0xfb, 0x58, 0xa4, 0xd5, 0x2e, 0x7d, 0xb4, 0x9b, 0x5f, 0xfa, 0x33, 0x81, 0x62, 0xc2, 0xc1, 0x75,
+	0xb8, 0x35, 0xd3, 0xfc, 0xac, 0xa2, 0xe5, 0x0d, 0x47, 0xd6, 0x2b, 0x92, 0xf5, 0x12, 0xbd, 0x98,
+	0xc1, 0x5a, 0xb7, 0xbb, 0xce, 0x5c, 0x0e, 0xfc, 0x7b, 0x02, 0x63, 0x89, 0x0a, 0x82, 0xe6, 0x44,
+	0x89, 0x46, 0xad, 0xe7, 0x8e, 0x47, 0xf6, 0x33, 0x92, 0x7d, 0x9e, 0x6a, 0xb9, 0xe7, 0x1c, 0x0c,
+	0xf9, 0x29, 0x81, 0xc9, 0x34, 0xab, 0x43, 0x4f, 0x67, 0xdf, 0xa3, 0xd9, 0xc6, 0x56, 0x79, 0xa3,
+	0xc7, 0x2c, 0xa4, 0x5f, 0x91, 0xf4, 0x17, 0xe8, 0x5b, 0xbd, 0x9e, 0xf6, 0x98, 0x13, 0xa3, 0x3f,
+	0x12, 0xa0, 0xed, 0x06, 0x91, 0x66, 0x9f, 0xda, 0x4c, 0x4b, 0xaa, 0x2c, 0xf6, 0x94, 0x93, 0xe7,
+	0xa5, 0x1b, 0xb7, 0x8c, 0xfa, 0x4e, 0x60, 0x51, 0xef, 0xd3, 0x6d, 0x18, 0x92, 0x0e, 0x91, 0x1e,
+	0xeb, 0x78, 0x09, 0x47, 0x5c, 0xc7, 0xbb, 0x85, 0x21, 0xca, 0x11, 0x89, 0x72, 0x88, 0x1e, 0x4c,
+	0x43, 0x91, 0x8e, 0x91, 0x3e, 0x20, 0x30, 0xe8, 0x27, 0xd1, 0xa3, 0x1d, 0x35, 0xc3, 0xca, 0xc7,
+	0xba, 0x44, 0xf5, 0xf0, 0x3e, 0x0d, 0x3d, 0x92, 0xcf, 0x41, 0x1f, 0x12, 0x18, 0x0e, 0x5c, 0x1e,
+	0xcd, 0x6e, 0x2e, 0x61, 0x28, 0x95, 0x13, 0x5d, 0xe3, 0x10, 0x66, 0x56, 0xc2, 0x1c, 0xa7, 0x47,
+	0x23, 0x98, 0x4f, 0xb9, 0xc3, 0xda, 0xc8, 0x02, 0x5b, 0xb9, 0x7c, 0xf5, 0xe9, 0x8b, 0x32, 0x79,
+	0xf6, 0xa2, 0x4c, 0xfe, 0x7a, 0x51, 0x26, 0x9f, 0xbf, 0x2c, 0x0f, 0x3c, 0x7b, 0x59, 0x1e, 0xf8,
+	0xfd, 0x65, 0x79, 0xe0, 0xd6, 0x7c, 0xec, 0xd7, 0xaf, 0x30, 0x79, 0x2e, 0x29, 0xb5, 0x15, 0x8a,
+	0xc9, 0xdf, 0xc2, 0xd6, 0x86, 0xe5, 0xcf, 0x8b, 0x8b, 0xff, 0x0e, 0x00, 0xb5, 0xab, 0x28, 0xa8,
+	0x09, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1197,6 +1595,17 @@ type QueryClient interface {
 	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
 	// AggregateVotes returns aggregate votes of all validators.
 	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// ValidatorPerformance returns the oracle voting statistics of a validator
+	// in the current and the past slash windows.
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// WindowPerformances returns the oracle voting statistics of all validators
+	// in a slash window.
+	WindowPerformances(ctx context.Context, in *QueryWindowPerformancesRequest, opts ...grpc.CallOption) (*QueryWindowPerformancesResponse, error)
+	// Feeds returns the EVM price feed registry and the price feeds of all
+	// targets.
+	Feeds(ctx context.Context, in *QueryFeedsRequest, opts ...grpc.CallOption) (*QueryFeedsResponse, error)
+	// Feed returns the EVM price feed of a denom.
+	Feed(ctx context.Context, in *QueryFeedRequest, opts ...grpc.CallOption) (*QueryFeedResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1308,6 +1717,42 @@ func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVote
 	return out, nil
 }
 
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.oracle.v1.Query/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WindowPerformances(ctx context.Context, in *QueryWindowPerformancesRequest, opts ...grpc.CallOption) (*QueryWindowPerformancesResponse, error) {
+	out := new(QueryWindowPerformancesResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.oracle.v1.Query/WindowPerformances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Feeds(ctx context.Context, in *QueryFeedsRequest, opts ...grpc.CallOption) (*QueryFeedsResponse, error) {
+	out := new(QueryFeedsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.oracle.v1.Query/Feeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Feed(ctx context.Context, in *QueryFeedRequest, opts ...grpc.CallOption) (*QueryFeedResponse, error) {
+	out := new(QueryFeedResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.oracle.v1.Query/Feed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/gridiron.oracle.v1.Query/Params", in, out, opts...)
@@ -1341,6 +1786,17 @@ type QueryServer interface {
 	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
 	// AggregateVotes returns aggregate votes of all validators.
 	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
+	// ValidatorPerformance returns the oracle voting statistics of a validator
+	// in the current and the past slash windows.
+	ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error)
+	// WindowPerformances returns the oracle voting statistics of all validators
+	// in a slash window.
+	WindowPerformances(context.Context, *QueryWindowPerformancesRequest) (*QueryWindowPerformancesResponse, error)
+	// Feeds returns the EVM price feed registry and the price feeds of all
+	// targets.
+	Feeds(context.Context, *QueryFeedsRequest) (*QueryFeedsResponse, error)
+	// Feed returns the EVM price feed of a denom.
+	Feed(context.Context, *QueryFeedRequest) (*QueryFeedResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -1382,6 +1838,18 @@ func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAg
 func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorPerformance(ctx context.Context, req *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedQueryServer) WindowPerformances(ctx context.Context, req *QueryWindowPerformancesRequest) (*QueryWindowPerformancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WindowPerformances not implemented")
+}
+func (*UnimplementedQueryServer) Feeds(ctx context.Context, req *QueryFeedsRequest) (*QueryFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feeds not implemented")
+}
+func (*UnimplementedQueryServer) Feed(ctx context.Context, req *QueryFeedRequest) (*QueryFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feed not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1588,38 +2056,110 @@ func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gridiron.oracle.v1.Query/Params",
+		FullMethod: "/gridiron.oracle.v1.Query/ValidatorPerformance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gridiron.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-		{
-			MethodName: "ExchangeRates",
-			Handler:    _Query_ExchangeRates_Handler,
-		},
-		{
-			MethodName: "Actives",
+func _Query_WindowPerformances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWindowPerformancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WindowPerformances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.oracle.v1.Query/WindowPerformances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WindowPerformances(ctx, req.(*QueryWindowPerformancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Feeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Feeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.oracle.v1.Query/Feeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Feeds(ctx, req.(*QueryFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Feed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Feed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.oracle.v1.Query/Feed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Feed(ctx, req.(*QueryFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.oracle.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gridiron.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "Actives",
 			Handler:    _Query_Actives_Handler,
 		},
 		{
@@ -1654,6 +2194,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AggregateVotes",
 			Handler:    _Query_AggregateVotes_Handler,
 		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "WindowPerformances",
+			Handler:    _Query_WindowPerformances_Handler,
+		},
+		{
+			MethodName: "Feeds",
+			Handler:    _Query_Feeds_Handler,
+		},
+		{
+			MethodName: "Feed",
+			Handler:    _Query_Feed_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2315,7 +2871,7 @@ func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2325,20 +2881,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2348,18 +2911,32 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2371,134 +2948,390 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryWindowPerformancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryWindowPerformancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWindowPerformancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWindowPerformancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryWindowPerformancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWindowPerformancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Performances) > 0 {
+		for iNdEx := len(m.Performances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Performances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.LatestWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryActivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for _, s := range m.Actives {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryFeedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteTargetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteTargetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for _, s := range m.VoteTargets {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryFeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTargetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTargetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Targets) > 0 {
-		for _, s := range m.Targets {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	if len(m.Feeds) > 0 {
+		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Registry) > 0 {
+		i -= len(m.Registry)
+		copy(dAtA[i:], m.Registry)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Registry)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Feed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for _, s := range m.Actives {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteTargetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVoteTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for _, s := range m.VoteTargets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTargetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for _, s := range m.Targets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2649,6 +3482,118 @@ func (m *QueryAggregateVotesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Current.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWindowPerformancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryWindowPerformancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestWindow != 0 {
+		n += 1 + sovQuery(uint64(m.LatestWindow))
+	}
+	if len(m.Performances) > 0 {
+		for _, e := range m.Performances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Registry)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Feeds) > 0 {
+		for _, e := range m.Feeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Feed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2669,13 +3614,627 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2698,15 +4257,15 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTargetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2734,7 +4293,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2757,7 +4316,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2780,15 +4339,15 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2816,9 +4375,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2841,7 +4398,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2864,12 +4421,44 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2891,7 +4480,7 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2914,17 +4503,17 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2934,25 +4523,23 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2975,7 +4562,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2998,12 +4585,31 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3025,7 +4631,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3048,15 +4654,15 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3084,7 +4690,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3107,57 +4713,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3180,17 +4736,17 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3200,23 +4756,24 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3239,7 +4796,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3262,10 +4819,10 @@ func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3289,7 +4846,7 @@ func (m *QueryTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3312,17 +4869,17 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3332,23 +4889,25 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Targets = append(m.Targets, string(dAtA[iNdEx:postIndex]))
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3371,7 +4930,7 @@ func (m *QueryTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3394,10 +4953,10 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3453,7 +5012,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3476,17 +5035,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3496,23 +5055,24 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3535,7 +5095,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3558,44 +5118,12 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3617,7 +5145,7 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3640,17 +5168,17 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
 			}
-			m.MissCounter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3660,11 +5188,26 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3686,7 +5229,7 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3709,10 +5252,10 @@ func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3768,7 +5311,7 @@ func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3791,15 +5334,15 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3826,7 +5369,41 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ValidatorPerformance{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3851,7 +5428,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWindowPerformancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3874,12 +5451,31 @@ func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWindowPerformancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWindowPerformancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3901,7 +5497,7 @@ func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWindowPerformancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3924,15 +5520,34 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWindowPerformancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWindowPerformancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestWindow", wireType)
+			}
+			m.LatestWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3959,8 +5574,8 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Performances = append(m.Performances, ValidatorPerformance{})
+			if err := m.Performances[len(m.Performances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3985,7 +5600,7 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeedsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4008,44 +5623,12 @@ func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeedsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4067,7 +5650,7 @@ func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeedsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4090,15 +5673,47 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeedsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Registry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registry = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4125,7 +5740,8 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Feeds = append(m.Feeds, FeedContract{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4150,7 +5766,7 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4173,12 +5789,44 @@ func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4200,7 +5848,7 @@ func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4223,15 +5871,15 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4258,8 +5906,7 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Feed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
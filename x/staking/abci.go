@@ -13,5 +13,9 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(stakingtypes.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// compound before the validator updates, so that the compounded delegations
+	// are reflected in the validator powers
+	k.CompoundVeRewards(ctx)
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -0,0 +1,393 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for the staking module, along
+// with the ve staking queries
+func GetQueryCmd() *cobra.Command {
+	cmd := stakingcli.GetQueryCmd()
+
+	cmd.AddCommand(
+		CmdQueryVeValidator(),
+		CmdQueryVeDelegation(),
+		CmdQueryDelegatorVeDelegations(),
+		CmdQueryValidatorVeDelegations(),
+		CmdQueryVeDelegationsByVe(),
+		CmdQueryVeUnbondingDelegation(),
+		CmdQueryDelegatorVeUnbondingDelegations(),
+		CmdQueryValidatorVeUnbondingDelegations(),
+		CmdQueryVeUnbondingDelegationsByVe(),
+		CmdQueryVeRedelegations(),
+		CmdQueryVeRedelegationsByVe(),
+	)
+
+	return cmd
+}
+
+func CmdQueryVeValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-validator [validator-addr]",
+		Short: "shows the ve delegator shares of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeValidator(context.Background(), &types.QueryVeValidatorRequest{ValidatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVeDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-delegation [delegator-addr] [validator-addr]",
+		Short: "shows the ve delegation of a delegator to a validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeDelegation(context.Background(), &types.QueryVeDelegationRequest{
+				DelegatorAddr: args[0],
+				ValidatorAddr: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDelegatorVeDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-delegations [delegator-addr]",
+		Short: "shows all ve delegations of a delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorVeDelegations(context.Background(), &types.QueryDelegatorVeDelegationsRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-delegations")
+
+	return cmd
+}
+
+func CmdQueryValidatorVeDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-delegations-to [validator-addr]",
+		Short: "shows all ve delegations to a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorVeDelegations(context.Background(), &types.QueryValidatorVeDelegationsRequest{
+				ValidatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-delegations-to")
+
+	return cmd
+}
+
+func CmdQueryVeDelegationsByVe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-delegations-by-ve [ve-id]",
+		Short: "shows all delegations and the delegated amount of a ve",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VeDelegationsByVe(context.Background(), &types.QueryVeDelegationsByVeRequest{
+				VeId:       args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-delegations-by-ve")
+
+	return cmd
+}
+
+func CmdQueryVeUnbondingDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-unbonding-delegation [delegator-addr] [validator-addr]",
+		Short: "shows the ve unbonding delegation of a delegator from a validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeUnbondingDelegation(context.Background(), &types.QueryVeUnbondingDelegationRequest{
+				DelegatorAddr: args[0],
+				ValidatorAddr: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDelegatorVeUnbondingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-unbonding-delegations [delegator-addr]",
+		Short: "shows all ve unbonding delegations of a delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorVeUnbondingDelegations(context.Background(), &types.QueryDelegatorVeUnbondingDelegationsRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-unbonding-delegations")
+
+	return cmd
+}
+
+func CmdQueryValidatorVeUnbondingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-unbonding-delegations-from [validator-addr]",
+		Short: "shows all ve unbonding delegations from a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorVeUnbondingDelegations(context.Background(), &types.QueryValidatorVeUnbondingDelegationsRequest{
+				ValidatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-unbonding-delegations-from")
+
+	return cmd
+}
+
+func CmdQueryVeUnbondingDelegationsByVe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-unbonding-delegations-by-ve [ve-id]",
+		Short: "shows all unbonding delegations of a ve",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VeUnbondingDelegationsByVe(context.Background(), &types.QueryVeUnbondingDelegationsByVeRequest{
+				VeId:       args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-unbonding-delegations-by-ve")
+
+	return cmd
+}
+
+func CmdQueryVeRedelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-redelegations [delegator-addr]",
+		Short: "shows all ve redelegations of a delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			srcValAddr, err := cmd.Flags().GetString(FlagSrcValidator)
+			if err != nil {
+				return err
+			}
+			dstValAddr, err := cmd.Flags().GetString(FlagDstValidator)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VeRedelegations(context.Background(), &types.QueryVeRedelegationsRequest{
+				DelegatorAddr:    args[0],
+				SrcValidatorAddr: srcValAddr,
+				DstValidatorAddr: dstValAddr,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSrcValidator, "", "Filter by the source validator address")
+	cmd.Flags().String(FlagDstValidator, "", "Filter by the destination validator address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-redelegations")
+
+	return cmd
+}
+
+func CmdQueryVeRedelegationsByVe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-redelegations-by-ve [ve-id]",
+		Short: "shows all redelegations of a ve",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VeRedelegationsByVe(context.Background(), &types.QueryVeRedelegationsByVeRequest{
+				VeId:       args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-redelegations-by-ve")
+
+	return cmd
+}
+
+const (
+	FlagSrcValidator = "src-validator"
+	FlagDstValidator = "dst-validator"
+)
@@ -1,21 +1,311 @@
 package staking
 
 import (
+	"fmt"
+	"log"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/gridiron-zone/gridiron/x/staking/keeper"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// InitGenesis sets the pool and parameters for the provided keeper, along with
+// the ve-specific records. It follows the cosmos staking InitGenesis, except that
+// the tokens of the ve delegations are locked in x/ve instead of the pools.
 func InitGenesis(
 	ctx sdk.Context, keeper keeper.Keeper, accountKeeper stakingtypes.AccountKeeper,
-	bankKeeper stakingtypes.BankKeeper, data *stakingtypes.GenesisState,
+	bankKeeper stakingtypes.BankKeeper, data *types.GenesisState,
 ) (res []abci.ValidatorUpdate) {
-	// TODO: replace keeper.Keeper with keeper
-	res = staking.InitGenesis(ctx, keeper.Keeper, accountKeeper, bankKeeper, data)
+	bondedTokens := sdk.ZeroInt()
+	notBondedTokens := sdk.ZeroInt()
+	bondedVeTokens := sdk.ZeroInt()
+	notBondedVeTokens := sdk.ZeroInt()
+
+	veValidators := make(map[string]types.VeValidator)
+	for _, veValidator := range data.VeValidators {
+		veValidators[veValidator.OperatorAddress] = veValidator
+	}
+	veUbds := make(map[string]types.VeUnbondingDelegation)
+	for _, veUbd := range data.VeUnbondingDelegations {
+		veUbds[veUbd.DelegatorAddress+"/"+veUbd.ValidatorAddress] = veUbd
+	}
+
+	// We need to pretend to be "n blocks before genesis", where "n" is the
+	// validator update delay, so that e.g. slashing periods are correctly
+	// initialized for the validator set e.g. with a one-block offset - the
+	// first TM block is at height 1, so state updates applied from
+	// genesis.json are in block 0.
+	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
+
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
+
+	for _, validator := range data.Validators {
+		keeper.SetValidator(ctx, validator)
+
+		// Manually set indices for the first time
+		keeper.SetValidatorByConsAddr(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+
+		// Call the creation hook if not exported
+		if !data.Exported {
+			keeper.AfterValidatorCreated(ctx, validator.GetOperator())
+		}
+
+		// update timeslice if necessary
+		if validator.IsUnbonding() {
+			keeper.InsertUnbondingValidatorQueue(ctx, validator)
+		}
+
+		// ve tokens are rounded up, since the burning of slashed tokens may leave
+		// dust in the pools
+		veTokens := sdk.ZeroInt()
+		if veValidator, ok := veValidators[validator.OperatorAddress]; ok && validator.DelegatorShares.IsPositive() {
+			veTokens = validator.TokensFromShares(veValidator.VeDelegatorShares).Ceil().TruncateInt()
+			veTokens = sdk.MinInt(veTokens, validator.Tokens)
+		}
+
+		switch validator.GetStatus() {
+		case stakingtypes.Bonded:
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+			bondedVeTokens = bondedVeTokens.Add(veTokens)
+		case stakingtypes.Unbonding, stakingtypes.Unbonded:
+			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+			notBondedVeTokens = notBondedVeTokens.Add(veTokens)
+		default:
+			panic("invalid validator status")
+		}
+	}
+
+	for _, delegation := range data.Delegations {
+		delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		// Call the before-creation hook if not exported
+		if !data.Exported {
+			keeper.BeforeDelegationCreated(ctx, delegatorAddress, delegation.GetValidatorAddr())
+		}
+
+		keeper.SetDelegation(ctx, delegation)
+		// Call the after-modification hook if not exported
+		if !data.Exported {
+			keeper.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
+		}
+	}
+
+	for _, ubd := range data.UnbondingDelegations {
+		keeper.SetUnbondingDelegation(ctx, ubd)
+
+		veUbd, hasVeUbd := veUbds[ubd.DelegatorAddress+"/"+ubd.ValidatorAddress]
+		for i, entry := range ubd.Entries {
+			keeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
+			if hasVeUbd && i < len(veUbd.Entries) {
+				notBondedVeTokens = notBondedVeTokens.Add(veUbd.Entries[i].Balance())
+			}
+		}
+	}
+
+	for _, red := range data.Redelegations {
+		keeper.SetRedelegation(ctx, red)
+
+		for _, entry := range red.Entries {
+			keeper.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+		}
+	}
+
+	// check if the unbonded and bonded pools accounts exists
+	bondedPool := keeper.GetBondedPool(ctx)
+	if bondedPool == nil {
+		panic(fmt.Sprintf("%s module account has not been set", stakingtypes.BondedPoolName))
+	}
+	bondedBalance := bankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+	if bondedBalance.IsZero() {
+		accountKeeper.SetModuleAccount(ctx, bondedPool)
+	}
+	// if balance is not within the non-ve bonded tokens panic because genesis is most likely malformed
+	checkPoolBalance(stakingtypes.BondedPoolName, bondedBalance, data.Params.BondDenom, bondedTokens, bondedVeTokens)
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	if notBondedPool == nil {
+		panic(fmt.Sprintf("%s module account has not been set", stakingtypes.NotBondedPoolName))
+	}
+	notBondedBalance := bankKeeper.GetAllBalances(ctx, notBondedPool.GetAddress())
+	if notBondedBalance.IsZero() {
+		accountKeeper.SetModuleAccount(ctx, notBondedPool)
+	}
+	// if balance is not within the non-ve not bonded tokens panic because genesis is most likely malformed
+	checkPoolBalance(stakingtypes.NotBondedPoolName, notBondedBalance, data.Params.BondDenom, notBondedTokens, notBondedVeTokens)
+
+	// don't need to run Tendermint updates if we exported
+	if data.Exported {
+		for _, lv := range data.LastValidatorPowers {
+			valAddr, err := sdk.ValAddressFromBech32(lv.Address)
+			if err != nil {
+				panic(err)
+			}
+			keeper.SetLastValidatorPower(ctx, valAddr, lv.Power)
+			validator, found := keeper.GetValidator(ctx, valAddr)
+
+			if !found {
+				panic(fmt.Sprintf("validator %s not found", lv.Address))
+			}
+
+			update := validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx))
+			update.Power = lv.Power // keep the next-val-set offset, use the last power for the first block
+			res = append(res, update)
+		}
+	} else {
+		var err error
+		res, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	keeper.CheckDenom(ctx)
 
+	for _, veValidator := range data.VeValidators {
+		keeper.SetVeValidator(ctx, veValidator)
+	}
+	for _, veDelegation := range data.VeDelegations {
+		keeper.SetVeDelegation(ctx, veDelegation)
+	}
+	for _, veUbd := range data.VeUnbondingDelegations {
+		keeper.SetVeUnbondingDelegation(ctx, veUbd)
+	}
+	for _, veRed := range data.VeRedelegations {
+		keeper.SetVeRedelegation(ctx, veRed)
+	}
+
+	// The entries of the ve unbonding delegations and ve redelegations must be
+	// one-to-one with the cosmos ones, so fill the ones without ve tokens.
+	for _, ubd := range data.UnbondingDelegations {
+		delAddr, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if _, found := keeper.GetVeUnbondingDelegation(ctx, delAddr, valAddr); !found {
+			veUbd := types.NewVeUnbondingDelegation(delAddr, valAddr)
+			for range ubd.Entries {
+				veUbd.AddEntry(nil)
+			}
+			keeper.SetVeUnbondingDelegation(ctx, veUbd)
+		}
+	}
+	for _, red := range data.Redelegations {
+		delAddr, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+		valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+		if err != nil {
+			panic(err)
+		}
+		if _, found := keeper.GetVeRedelegation(ctx, delAddr, valSrcAddr, valDstAddr); !found {
+			veRed := types.NewVeRedelegation(delAddr, valSrcAddr, valDstAddr)
+			for _, entry := range red.Entries {
+				veRed.AddEntry(nil, entry.InitialBalance, entry.SharesDst)
+			}
+			keeper.SetVeRedelegation(ctx, veRed)
+		}
+	}
+
+	for _, amount := range data.VeDelegatedAmounts {
+		keeper.CheckVeDelegatedAmount(ctx, amount.VeId, amount.Tokens)
+		keeper.SetVeDelegatedAmount(ctx, amount.VeId, amount.Tokens)
+	}
+
+	for _, veID := range data.VeAutoCompounds {
+		keeper.SetVeAutoCompound(ctx, veID, true)
+	}
+	for _, entry := range data.VeAutoCompoundEntries {
+		keeper.EnqueueVeAutoCompoundEntry(ctx, entry)
+	}
+
 	return
 }
+
+// checkPoolBalance panics unless the pool holds the tokens which are not locked
+// in x/ve, i.e., between tokens minus ve tokens and tokens.
+func checkPoolBalance(poolName string, balance sdk.Coins, bondDenom string, tokens, veTokens sdk.Int) {
+	amount := balance.AmountOf(bondDenom)
+	if !balance.IsEqual(sdk.NewCoins(sdk.NewCoin(bondDenom, amount))) ||
+		amount.LT(tokens.Sub(veTokens)) || amount.GT(tokens) {
+		panic(fmt.Sprintf("%s pool balance is different from the coins not locked in ve: %s <-> %s%s (ve %s%s)",
+			poolName, balance, tokens, bondDenom, veTokens, bondDenom))
+	}
+}
+
+// ExportGenesis returns the staking module's exported genesis, along with the
+// ve-specific records.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	var veValidators []types.VeValidator
+	keeper.IterateVeValidators(ctx, func(validator types.VeValidator) (stop bool) {
+		veValidators = append(veValidators, validator)
+		return false
+	})
+
+	var veDelegations []types.VeDelegation
+	keeper.IterateAllVeDelegations(ctx, func(delegation types.VeDelegation) (stop bool) {
+		veDelegations = append(veDelegations, delegation)
+		return false
+	})
+
+	var veUnbondingDelegations []types.VeUnbondingDelegation
+	keeper.IterateVeUnbondingDelegations(ctx, func(ubd types.VeUnbondingDelegation) (stop bool) {
+		veUnbondingDelegations = append(veUnbondingDelegations, ubd)
+		return false
+	})
+
+	var veRedelegations []types.VeRedelegation
+	keeper.IterateVeRedelegations(ctx, func(red types.VeRedelegation) (stop bool) {
+		veRedelegations = append(veRedelegations, red)
+		return false
+	})
+
+	var veDelegatedAmounts []types.VeTokens
+	keeper.IterateVeDelegatedAmounts(ctx, func(veID uint64, amount sdk.Int) (stop bool) {
+		veDelegatedAmounts = append(veDelegatedAmounts, types.VeTokens{
+			VeId:   veID,
+			Tokens: amount,
+		})
+		return false
+	})
+
+	var veAutoCompounds []uint64
+	keeper.IterateVeAutoCompounds(ctx, func(veID uint64) (stop bool) {
+		veAutoCompounds = append(veAutoCompounds, veID)
+		return false
+	})
+
+	var veAutoCompoundEntries []types.VeAutoCompoundEntry
+	keeper.IterateVeAutoCompoundQueue(ctx, func(_ uint64, entry types.VeAutoCompoundEntry) (stop bool) {
+		veAutoCompoundEntries = append(veAutoCompoundEntries, entry)
+		return false
+	})
+
+	return types.NewGenesisState(
+		staking.ExportGenesis(ctx, keeper.Keeper),
+		veValidators,
+		veDelegations,
+		veUnbondingDelegations,
+		veRedelegations,
+		veDelegatedAmounts,
+		veAutoCompounds,
+		veAutoCompoundEntries,
+	)
+}
@@ -0,0 +1,266 @@
+package staking_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/encoding"
+
+	"github.com/gridiron-zone/gridiron/app"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/staking"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *app.Gridiron
+
+	delAddr sdk.AccAddress
+	valAddr sdk.ValAddress
+	veID    uint64
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	require := suite.Require()
+
+	suite.app = app.Setup(false)
+	// the proposer is required by the EVM, where the veNFTs are mirrored
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		ChainID:         "gridiron_5000-101",
+		Height:          1,
+		Time:            time.Now().UTC(),
+		ProposerAddress: privCons.PubKey().Address().Bytes(),
+	})
+	k := suite.app.StakingKeeper
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	suite.delAddr = sdk.AccAddress(priv.PubKey().Address())
+
+	suite.valAddr = sdk.ValAddress(suite.delAddr)
+	validator, err := stakingtypes.NewValidator(suite.valAddr, privCons.PubKey(), stakingtypes.Description{})
+	require.NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(k.Keeper, suite.ctx, validator, true)
+	k.AfterValidatorCreated(suite.ctx, validator.GetOperator())
+	err = k.SetValidatorByConsAddr(suite.ctx, validator)
+	require.NoError(err)
+
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, suite.delAddr, sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 10000)))
+	require.NoError(err)
+
+	suite.veID, _, err = suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 5000), vetypes.MaxLockTime)
+	require.NoError(err)
+
+	validator, found := k.GetValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	_, err = k.VeDelegate(suite.ctx, suite.delAddr, sdk.NewInt(3000), types.VeTokensSlice{{VeId: suite.veID, Tokens: sdk.NewInt(3000)}}, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+
+	delegation, found := k.GetDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	_, err = k.Undelegate(suite.ctx, suite.delAddr, suite.valAddr, delegation.Shares.QuoInt64(3))
+	require.NoError(err)
+}
+
+func (suite *GenesisTestSuite) TestExportInitGenesis() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+
+	genState := staking.ExportGenesis(suite.ctx, k)
+	require.NoError(genState.Validate())
+	require.Len(genState.VeValidators, 1)
+	require.Len(genState.VeDelegations, 1)
+	require.Len(genState.VeUnbondingDelegations, 1)
+	require.Empty(genState.VeRedelegations)
+	require.Equal([]types.VeTokens{{VeId: suite.veID, Tokens: sdk.NewInt(3000)}}, genState.VeDelegatedAmounts)
+
+	// The json of the genesis state is a superset of the cosmos staking one
+	bz := suite.app.AppCodec().MustMarshalJSON(genState)
+	var decoded types.GenesisState
+	suite.app.AppCodec().MustUnmarshalJSON(bz, &decoded)
+	require.NoError(decoded.Validate())
+
+	ctx, _ := suite.ctx.CacheContext()
+	staking.InitGenesis(ctx, k, suite.app.AccountKeeper, suite.app.BankKeeper, &decoded)
+	require.Equal(bz, suite.app.AppCodec().MustMarshalJSON(staking.ExportGenesis(ctx, k)))
+	require.Equal(sdk.NewInt(3000), k.GetVeDelegatedAmount(ctx, suite.veID))
+
+	// Unbonding delegations without ve records get empty ve entries
+	ctx, _ = suite.ctx.CacheContext()
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	err = app.FundAccount(suite.app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 1000)))
+	require.NoError(err)
+	validator, found := k.GetValidator(ctx, suite.valAddr)
+	require.True(found)
+	shares, err := k.Keeper.Delegate(ctx, addr, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	_, err = k.Keeper.Undelegate(ctx, addr, suite.valAddr, shares)
+	require.NoError(err)
+	_, found = k.GetVeUnbondingDelegation(ctx, addr, suite.valAddr)
+	require.False(found)
+
+	genState = staking.ExportGenesis(ctx, k)
+	require.NoError(genState.Validate())
+	staking.InitGenesis(ctx, k, suite.app.AccountKeeper, suite.app.BankKeeper, genState)
+	veUbd, found := k.GetVeUnbondingDelegation(ctx, addr, suite.valAddr)
+	require.True(found)
+	require.Len(veUbd.Entries, 1)
+	require.Empty(veUbd.Entries[0].VeBalances)
+
+	// Delegated amount must not exceed the locked amount of the ve
+	decoded.VeDelegatedAmounts[0].Tokens = sdk.NewInt(6000)
+	ctx, _ = suite.ctx.CacheContext()
+	require.Panics(func() {
+		staking.InitGenesis(ctx, k, suite.app.AccountKeeper, suite.app.BankKeeper, &decoded)
+	})
+}
+
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+func (suite *GenesisTestSuite) TestExportInitChain() {
+	require := suite.Require()
+
+	// Export the whole app state and start another chain from it
+	suite.app.Commit()
+	exported, err := suite.app.ExportAppStateAndValidators(false, nil)
+	require.NoError(err)
+
+	// The module account invariant of the cosmos staking module does not hold
+	// for the ve delegated tokens, which are kept by the ve module
+	newApp := app.NewGridiron(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 5,
+		encoding.MakeConfig(app.ModuleBasics), appOptions{crisis.FlagSkipGenesisInvariants: true},
+	).(*app.Gridiron)
+	require.NotPanics(func() {
+		newApp.InitChain(abci.RequestInitChain{
+			ChainId:         "gridiron_5000-101",
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: simapp.DefaultConsensusParams,
+			AppStateBytes:   exported.AppState,
+			InitialHeight:   exported.Height,
+		})
+	})
+	newApp.Commit()
+
+	header := tmproto.Header{Height: newApp.LastBlockHeight(), Time: suite.ctx.BlockTime()}
+	oldCtx := suite.app.NewContext(true, header)
+	newCtx := newApp.NewContext(true, header)
+
+	require.Equal(sdk.NewInt(3000), newApp.StakingKeeper.GetVeDelegatedAmount(newCtx, suite.veID))
+	require.Equal(
+		suite.app.VeKeeper.GetLockedAmountByUser(oldCtx, suite.veID),
+		newApp.VeKeeper.GetLockedAmountByUser(newCtx, suite.veID),
+	)
+	now := uint64(header.Time.Unix())
+	require.Equal(
+		suite.app.VeKeeper.GetVotingPower(oldCtx, suite.veID, now, header.Height),
+		newApp.VeKeeper.GetVotingPower(newCtx, suite.veID, now, header.Height),
+	)
+	require.Equal(
+		suite.app.VeKeeper.GetDelegatedVotingPower(oldCtx, suite.veID, now, header.Height),
+		newApp.VeKeeper.GetDelegatedVotingPower(newCtx, suite.veID, now, header.Height),
+	)
+	require.Equal(suite.delAddr, newApp.VeKeeper.GetVeOwnerAt(newCtx, suite.veID, header.Height))
+
+	storeA := oldCtx.KVStore(suite.app.GetKey(vetypes.StoreKey))
+	storeB := newCtx.KVStore(newApp.GetKey(vetypes.StoreKey))
+	kvAs, kvBs := sdk.DiffKVStores(storeA, storeB, nil)
+	require.Empty(kvAs, "ve store differs after import")
+	require.Empty(kvBs, "ve store differs after import")
+}
+
+func (suite *GenesisTestSuite) TestGenesisValidate() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+
+	require.NoError(types.DefaultGenesis().Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(genState *types.GenesisState)
+	}{
+		{
+			"ve validator without validator",
+			func(genState *types.GenesisState) {
+				genState.VeValidators[0].OperatorAddress = sdk.ValAddress(suite.delAddr.Bytes()[1:]).String()
+			},
+		},
+		{
+			"duplicate ve validator",
+			func(genState *types.GenesisState) {
+				genState.VeValidators = append(genState.VeValidators, genState.VeValidators[0])
+			},
+		},
+		{
+			"mismatched ve delegator shares",
+			func(genState *types.GenesisState) {
+				genState.VeValidators[0].VeDelegatorShares = genState.VeValidators[0].VeDelegatorShares.MulInt64(2)
+			},
+		},
+		{
+			"ve delegation without delegation",
+			func(genState *types.GenesisState) {
+				genState.Delegations = genState.Delegations[:0]
+			},
+		},
+		{
+			"ve delegation with more shares than delegation",
+			func(genState *types.GenesisState) {
+				genState.VeDelegations[0].VeShares[0].Shares = genState.VeDelegations[0].VeShares[0].Shares.MulInt64(2)
+			},
+		},
+		{
+			"inconsistent ve unbonding delegation entries",
+			func(genState *types.GenesisState) {
+				genState.VeUnbondingDelegations[0].Entries = append(genState.VeUnbondingDelegations[0].Entries, genState.VeUnbondingDelegations[0].Entries[0])
+			},
+		},
+		{
+			"mismatched delegated amount",
+			func(genState *types.GenesisState) {
+				genState.VeDelegatedAmounts[0].Tokens = sdk.NewInt(2999)
+			},
+		},
+		{
+			"missing delegated amount",
+			func(genState *types.GenesisState) {
+				genState.VeDelegatedAmounts = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			genState := staking.ExportGenesis(suite.ctx, k)
+			require.NoError(genState.Validate())
+			tc.malleate(genState)
+			require.Error(genState.Validate())
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+func (k Keeper) SetVeAutoCompound(ctx sdk.Context, veID uint64, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetVeAutoCompoundKey(veID), []byte{1})
+	} else {
+		store.Delete(types.GetVeAutoCompoundKey(veID))
+	}
+}
+
+func (k Keeper) IsVeAutoCompound(ctx sdk.Context, veID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetVeAutoCompoundKey(veID))
+}
+
+func (k Keeper) IterateVeAutoCompounds(ctx sdk.Context, handler func(veID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeAutoCompoundKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.VeAutoCompoundKey):])
+		if handler(veID) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetNextVeAutoCompoundSeq(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VeAutoCompoundSeqKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextVeAutoCompoundSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VeAutoCompoundSeqKey, sdk.Uint64ToBigEndian(seq))
+}
+
+// EnqueueVeAutoCompoundEntry appends the pending entry to the auto-compound queue.
+func (k Keeper) EnqueueVeAutoCompoundEntry(ctx sdk.Context, entry types.VeAutoCompoundEntry) {
+	seq := k.GetNextVeAutoCompoundSeq(ctx)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVeAutoCompoundQueueKey(seq), k.cdc.MustMarshal(&entry))
+	k.SetNextVeAutoCompoundSeq(ctx, seq+1)
+}
+
+func (k Keeper) IterateVeAutoCompoundQueue(ctx sdk.Context, handler func(seq uint64, entry types.VeAutoCompoundEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeAutoCompoundQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		seq := sdk.BigEndianToUint64(iterator.Key()[len(types.VeAutoCompoundQueueKey):])
+		var entry types.VeAutoCompoundEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		if handler(seq, entry) {
+			break
+		}
+	}
+}
+
+func (k Keeper) RemoveVeAutoCompoundEntry(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVeAutoCompoundQueueKey(seq))
+}
+
+// withdrawVeAutoCompoundRewards withdraws the rewards of the delegation if it
+// has any auto-compound ve, and enqueues the rewards of those ves for
+// compounding in the EndBlocker.
+func (k Keeper) withdrawVeAutoCompoundRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if k.distrKeeper == nil {
+		return
+	}
+
+	veDelegation, found := k.GetVeDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return
+	}
+	var autoCompoundShares []types.VeShares
+	for _, shares := range veDelegation.VeShares {
+		if k.IsVeAutoCompound(ctx, shares.VeId) {
+			autoCompoundShares = append(autoCompoundShares, shares)
+		}
+	}
+	if len(autoCompoundShares) == 0 {
+		return
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found || !delegation.Shares.IsPositive() {
+		return
+	}
+
+	rewards, err := k.distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		k.Logger(ctx).Error("failed to withdraw rewards for ve auto-compounding", "delegator", delAddr.String(), "validator", valAddr.String(), "error", err)
+		return
+	}
+	amount := rewards.AmountOf(k.BondDenom(ctx))
+	if !amount.IsPositive() {
+		return
+	}
+
+	withdrawAddr := k.distrKeeper.GetDelegatorWithdrawAddr(ctx, delAddr)
+	for _, shares := range autoCompoundShares {
+		veAmount := amount.ToDec().Mul(shares.Shares).Quo(delegation.Shares).TruncateInt()
+		if !veAmount.IsPositive() {
+			continue
+		}
+		k.EnqueueVeAutoCompoundEntry(ctx, types.VeAutoCompoundEntry{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			WithdrawAddress:  withdrawAddr.String(),
+			VeId:             shares.VeId,
+			Amount:           veAmount,
+		})
+	}
+}
+
+// CompoundVeRewards compounds at most VeAutoCompoundBatchSize pending entries.
+// A failed entry is dropped, leaving the withdrawn rewards in the withdraw
+// address.
+func (k Keeper) CompoundVeRewards(ctx sdk.Context) {
+	var seqs []uint64
+	var entries []types.VeAutoCompoundEntry
+	k.IterateVeAutoCompoundQueue(ctx, func(seq uint64, entry types.VeAutoCompoundEntry) (stop bool) {
+		seqs = append(seqs, seq)
+		entries = append(entries, entry)
+		return len(entries) >= types.VeAutoCompoundBatchSize
+	})
+
+	for i, entry := range entries {
+		k.RemoveVeAutoCompoundEntry(ctx, seqs[i])
+
+		cacheCtx, write := ctx.CacheContext()
+		err := k.compoundVeRewards(cacheCtx, entry)
+		if err != nil {
+			k.Logger(ctx).Error("failed to auto-compound ve rewards", "ve", entry.VeId, "error", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeVeAutoCompoundError,
+				sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, entry.DelegatorAddress),
+				sdk.NewAttribute(stakingtypes.AttributeKeyValidator, entry.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyVeID, vetypes.VeIDFromUint64(entry.VeId)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+func (k Keeper) compoundVeRewards(ctx sdk.Context, entry types.VeAutoCompoundEntry) error {
+	if !k.IsVeAutoCompound(ctx, entry.VeId) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auto-compounding disabled")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(entry.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	withdrawAddr, err := sdk.AccAddressFromBech32(entry.WithdrawAddress)
+	if err != nil {
+		return err
+	}
+
+	owner := k.nftKeeper.GetOwner(ctx, vetypes.VeNftClass.Id, vetypes.VeIDFromUint64(entry.VeId))
+	if !owner.Equals(delAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve not owned by delegator")
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	locked := k.veKeeper.GetLockedAmountByUser(ctx, entry.VeId)
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
+		return sdkerrors.Wrapf(vetypes.ErrLockExpired, "ve expired")
+	}
+
+	err = k.veKeeper.DepositFor(ctx, withdrawAddr, entry.VeId, entry.Amount, 0, locked, true)
+	if err != nil {
+		return err
+	}
+
+	newShares, err := k.VeDelegate(ctx, delAddr, entry.Amount, types.VeTokensSlice{{VeId: entry.VeId, Tokens: entry.Amount}}, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVeAutoCompound,
+		sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, entry.DelegatorAddress),
+		sdk.NewAttribute(stakingtypes.AttributeKeyValidator, entry.ValidatorAddress),
+		sdk.NewAttribute(types.AttributeKeyVeID, vetypes.VeIDFromUint64(entry.VeId)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.BondDenom(ctx), entry.Amount).String()),
+		sdk.NewAttribute(stakingtypes.AttributeKeyNewShares, newShares.String()),
+	))
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/gridiron-zone/gridiron/app"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/staking/keeper"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) allocateRewards(amount int64) {
+	require := suite.Require()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, amount))
+	err := app.FundModuleAccount(suite.app.BankKeeper, suite.ctx, distrtypes.ModuleName, coins)
+	require.NoError(err)
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, sdk.NewDecCoinsFromCoins(coins...))
+}
+
+func (suite *KeeperTestSuite) TestVeAutoCompound() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	veID, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 5000), vetypes.MaxLockTime)
+	require.NoError(err)
+	veIDStr := vetypes.VeIDFromUint64(veID)
+
+	validator, found := k.GetValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	_, err = k.VeDelegate(suite.ctx, suite.delAddr, sdk.NewInt(3000), types.VeTokensSlice{{VeId: veID, Tokens: sdk.NewInt(3000)}}, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+
+	// Only the ve owner can set
+	_, err = msgServer.SetVeAutoCompound(ctx, &types.MsgSetVeAutoCompound{
+		Sender:  sdk.AccAddress(suite.valAddr.Bytes()[1:]).String(),
+		VeId:    veIDStr,
+		Enabled: true,
+	})
+	require.Error(err)
+	_, err = msgServer.SetVeAutoCompound(ctx, &types.MsgSetVeAutoCompound{
+		Sender:  suite.delAddr.String(),
+		VeId:    veIDStr,
+		Enabled: true,
+	})
+	require.NoError(err)
+	require.True(k.IsVeAutoCompound(suite.ctx, veID))
+
+	// Rewards are withdrawn and enqueued when the delegation is modified
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	suite.allocateRewards(1000)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.delAddr, gridiron.BaseDenom).Amount
+	validator, _ = k.GetValidator(suite.ctx, suite.valAddr)
+	_, err = k.VeDelegate(suite.ctx, suite.delAddr, sdk.NewInt(1000), types.VeTokensSlice{{VeId: veID, Tokens: sdk.NewInt(1000)}}, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+
+	var entries []types.VeAutoCompoundEntry
+	k.IterateVeAutoCompoundQueue(suite.ctx, func(_ uint64, entry types.VeAutoCompoundEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+	require.Len(entries, 1)
+	require.Equal(veID, entries[0].VeId)
+	require.Equal(suite.delAddr.String(), entries[0].WithdrawAddress)
+	rewards := entries[0].Amount
+	require.True(rewards.IsPositive())
+	require.Equal(balance.Add(rewards), suite.app.BankKeeper.GetBalance(suite.ctx, suite.delAddr, gridiron.BaseDenom).Amount)
+
+	// Rewards are deposited into the ve and delegated in the EndBlocker
+	k.CompoundVeRewards(suite.ctx)
+	require.Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, suite.delAddr, gridiron.BaseDenom).Amount)
+	require.Equal(sdk.NewInt(5000).Add(rewards), suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, veID).Amount)
+	require.Equal(sdk.NewInt(4000).Add(rewards), k.GetVeDelegatedAmount(suite.ctx, veID))
+	veDelegation, found := k.GetVeDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	require.Equal(sdk.NewInt(4000).Add(rewards), veDelegation.Tokens())
+	k.IterateVeAutoCompoundQueue(suite.ctx, func(_ uint64, _ types.VeAutoCompoundEntry) (stop bool) {
+		require.Fail("queue not empty")
+		return true
+	})
+
+	// Disabled ve no longer compounds
+	_, err = msgServer.SetVeAutoCompound(ctx, &types.MsgSetVeAutoCompound{
+		Sender:  suite.delAddr.String(),
+		VeId:    veIDStr,
+		Enabled: false,
+	})
+	require.NoError(err)
+	require.False(k.IsVeAutoCompound(suite.ctx, veID))
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	suite.allocateRewards(1000)
+	delegation, found := k.GetDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	_, err = k.Undelegate(suite.ctx, suite.delAddr, suite.valAddr, delegation.Shares.QuoInt64(10))
+	require.NoError(err)
+	k.IterateVeAutoCompoundQueue(suite.ctx, func(_ uint64, _ types.VeAutoCompoundEntry) (stop bool) {
+		require.Fail("queue not empty")
+		return true
+	})
+}
+
+func (suite *KeeperTestSuite) TestVeAutoCompoundWithdrawDelegatorReward() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+
+	veID, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 5000), vetypes.MaxLockTime)
+	require.NoError(err)
+	validator, found := k.GetValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	_, err = k.VeDelegate(suite.ctx, suite.delAddr, sdk.NewInt(3000), types.VeTokensSlice{{VeId: veID, Tokens: sdk.NewInt(3000)}}, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	k.SetVeAutoCompound(suite.ctx, veID, true)
+
+	// Rewards withdrawn through the distribution msg server are enqueued
+	// without the delegation being modified
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	suite.allocateRewards(1000)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.delAddr, gridiron.BaseDenom).Amount
+	msg := distrtypes.NewMsgWithdrawDelegatorReward(suite.delAddr, suite.valAddr)
+	_, err = suite.app.MsgServiceRouter().Handler(msg)(suite.ctx, msg)
+	require.NoError(err)
+
+	var entries []types.VeAutoCompoundEntry
+	k.IterateVeAutoCompoundQueue(suite.ctx, func(_ uint64, entry types.VeAutoCompoundEntry) (stop bool) {
+		entries = append(entries, entry)
+		return false
+	})
+	require.Len(entries, 1)
+	require.Equal(veID, entries[0].VeId)
+	rewards := entries[0].Amount
+	require.True(rewards.IsPositive())
+	require.Equal(balance.Add(rewards), suite.app.BankKeeper.GetBalance(suite.ctx, suite.delAddr, gridiron.BaseDenom).Amount)
+
+	k.CompoundVeRewards(suite.ctx)
+	require.Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, suite.delAddr, gridiron.BaseDenom).Amount)
+	require.Equal(sdk.NewInt(5000).Add(rewards), suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, veID).Amount)
+	require.Equal(sdk.NewInt(3000).Add(rewards), k.GetVeDelegatedAmount(suite.ctx, veID))
+}
@@ -87,6 +87,8 @@ func (k Keeper) SetVeDelegatedAmount(ctx sdk.Context, veID uint64, amount sdk.In
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&sdk.IntProto{amount})
 	store.Set(types.GetVeTokensKey(veID), bz)
+
+	k.veKeeper.RegulateDelegatedCheckpoint(ctx, veID)
 }
 
 func (k Keeper) GetVeDelegatedAmount(ctx sdk.Context, veID uint64) sdk.Int {
@@ -103,6 +105,8 @@ func (k Keeper) GetVeDelegatedAmount(ctx sdk.Context, veID uint64) sdk.Int {
 func (k Keeper) RemoveVeDelegatedAmount(ctx sdk.Context, veID uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetVeTokensKey(veID))
+
+	k.veKeeper.RegulateDelegatedCheckpoint(ctx, veID)
 }
 
 func (k Keeper) SubVeDelegatedAmount(ctx sdk.Context, veID uint64, subAmount sdk.Int) {
@@ -282,7 +286,7 @@ func (k Keeper) VeDelegate(
 	if !got {
 		veDelegation = types.VeDelegation{
 			DelegatorAddress: delAddr.String(),
-			ValidatorAddress: validator.String(),
+			ValidatorAddress: validator.OperatorAddress,
 		}
 	} else {
 		veDelegation = k.SettleVeDelegation(ctx, veDelegation, validator)
@@ -358,7 +362,7 @@ func (k Keeper) VeDelegate(
 		}
 
 		locked := k.veKeeper.GetLockedAmountByUser(ctx, veID)
-		if locked.End <= uint64(ctx.BlockTime().Unix()) {
+		if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
 			return sdk.ZeroDec(), sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve expired due to unlocking time %s", time.Unix(int64(locked.End), 0))
 		}
 
@@ -386,7 +390,7 @@ func (k Keeper) VeDelegate(
 		totalNewVeShares = totalNewVeShares.Add(newShares)
 	}
 
-	if totalNewShares.IsPositive() {
+	if totalNewVeShares.IsPositive() {
 		veValidator, found := k.GetVeValidator(ctx, validator.GetOperator())
 		if !found {
 			veValidator = types.VeValidator{
@@ -394,7 +398,7 @@ func (k Keeper) VeDelegate(
 				VeDelegatorShares: sdk.ZeroDec(),
 			}
 		}
-		veValidator.VeDelegatorShares = veValidator.VeDelegatorShares.Add(totalNewShares)
+		veValidator.VeDelegatorShares = veValidator.VeDelegatorShares.Add(totalNewVeShares)
 		k.SetVeValidator(ctx, veValidator)
 	}
 
@@ -406,36 +410,76 @@ func (k Keeper) VeDelegate(
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
 ) (completionTime time.Time, err error) {
+	srcValidator, dstValidator, err := k.validateRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	returnAmount, veTokens, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount, true)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return k.redelegate(ctx, delAddr, srcValidator, dstValidator, sharesAmount, returnAmount, veTokens)
+}
+
+// BeginVeRedelegation redelegates the specified amounts of ve tokens from the
+// source validator to the destination validator, rather than consuming the
+// unconstrained and ve shares of the delegation in order.
+func (k Keeper) BeginVeRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, veAmounts types.VeTokensSlice,
+) (completionTime time.Time, err error) {
+	srcValidator, dstValidator, err := k.validateRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	sharesAmount, returnAmount, veTokens, err := k.UnbondVe(ctx, delAddr, valSrcAddr, veAmounts, true)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return k.redelegate(ctx, delAddr, srcValidator, dstValidator, sharesAmount, returnAmount, veTokens)
+}
+
+func (k Keeper) validateRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
+) (srcValidator, dstValidator stakingtypes.Validator, err error) {
 	if bytes.Equal(valSrcAddr, valDstAddr) {
-		return time.Time{}, stakingtypes.ErrSelfRedelegation
+		err = stakingtypes.ErrSelfRedelegation
+		return
 	}
 
 	dstValidator, found := k.GetValidator(ctx, valDstAddr)
 	if !found {
-		return time.Time{}, stakingtypes.ErrBadRedelegationDst
+		err = stakingtypes.ErrBadRedelegationDst
+		return
 	}
-	_ = dstValidator
 
-	srcValidator, found := k.GetValidator(ctx, valSrcAddr)
+	srcValidator, found = k.GetValidator(ctx, valSrcAddr)
 	if !found {
-		return time.Time{}, stakingtypes.ErrBadRedelegationDst
+		err = stakingtypes.ErrBadRedelegationDst
+		return
 	}
-	_ = srcValidator
 
 	// check if this is a transitive redelegation
 	if k.HasReceivingRedelegation(ctx, delAddr, valSrcAddr) {
-		return time.Time{}, stakingtypes.ErrTransitiveRedelegation
+		err = stakingtypes.ErrTransitiveRedelegation
+		return
 	}
 
 	if k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
-		return time.Time{}, stakingtypes.ErrMaxRedelegationEntries
+		err = stakingtypes.ErrMaxRedelegationEntries
+		return
 	}
 
-	returnAmount, veTokens, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount, true)
-	if err != nil {
-		return time.Time{}, err
-	}
+	return
+}
 
+func (k Keeper) redelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, srcValidator, dstValidator stakingtypes.Validator,
+	sharesAmount sdk.Dec, returnAmount sdk.Int, veTokens types.VeTokensSlice,
+) (completionTime time.Time, err error) {
 	if returnAmount.IsZero() {
 		return time.Time{}, stakingtypes.ErrTinyRedelegationAmount
 	}
@@ -445,6 +489,9 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, err
 	}
 
+	valSrcAddr := srcValidator.GetOperator()
+	valDstAddr := dstValidator.GetOperator()
+
 	// create the unbonding delegation
 	completionTime, height, completeNow := k.GetBeginInfo(ctx, valSrcAddr)
 
@@ -482,6 +529,38 @@ func (k Keeper) Undelegate(
 	if err != nil {
 		return time.Time{}, err
 	}
+
+	return k.undelegate(ctx, delAddr, validator, returnAmount, veTokens), nil
+}
+
+// VeUndelegate undelegates the specified amounts of ve tokens from the
+// validator, rather than consuming the unconstrained and ve shares of the
+// delegation in order. The ve tokens keep being delegated until the unbonding
+// completes.
+func (k Keeper) VeUndelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, veAmounts types.VeTokensSlice,
+) (time.Time, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return time.Time{}, stakingtypes.ErrNoDelegatorForAddress
+	}
+
+	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+		return time.Time{}, stakingtypes.ErrMaxUnbondingDelegationEntries
+	}
+
+	_, returnAmount, veTokens, err := k.UnbondVe(ctx, delAddr, valAddr, veAmounts, false)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return k.undelegate(ctx, delAddr, validator, returnAmount, veTokens), nil
+}
+
+func (k Keeper) undelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, validator stakingtypes.Validator,
+	returnAmount sdk.Int, veTokens types.VeTokensSlice,
+) time.Time {
 	unconstrainedAmt := returnAmount.Sub(veTokens.Tokens())
 
 	// transfer the validator tokens to the not bonded pool
@@ -489,6 +568,8 @@ func (k Keeper) Undelegate(
 		k.BondedTokensToNotBonded(ctx, unconstrainedAmt)
 	}
 
+	valAddr := validator.GetOperator()
+
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
 	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
@@ -498,7 +579,7 @@ func (k Keeper) Undelegate(
 		panic("inconsistent ve unbonding delegation entries")
 	}
 
-	return completionTime, nil
+	return completionTime
 }
 
 func (k Keeper) Unbond(
@@ -538,6 +619,116 @@ func (k Keeper) Unbond(
 
 	unconstrainedShares := delegation.Shares.Sub(veDelegation.Shares())
 
+	// unconstrained shares are unbonded first, then ve shares in order
+	remainingShares := sdk.MaxDec(shares.Sub(unconstrainedShares), sdk.ZeroDec())
+	var unbondVeShares []types.VeShares
+	for _, veShares := range veDelegation.VeShares {
+		if remainingShares.IsZero() {
+			break
+		}
+
+		minShares := sdk.MinDec(remainingShares, veShares.Shares)
+		remainingShares = remainingShares.Sub(minShares)
+
+		if minShares.IsPositive() {
+			unbondVeShares = append(unbondVeShares, types.VeShares{
+				VeId:   veShares.VeId,
+				Shares: minShares,
+			})
+		}
+	}
+
+	if !remainingShares.IsZero() {
+		panic("inconsistent shares")
+	}
+
+	return k.unbond(ctx, delegation, veDelegation, validator, shares, unbondVeShares, updateVeAmt)
+}
+
+// UnbondVe unbonds the specified amounts of ve tokens from a delegation.
+// It returns the unbonded shares besides the unbonded amount.
+func (k Keeper) UnbondVe(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, veAmounts types.VeTokensSlice, updateVeAmt bool,
+) (shares sdk.Dec, amount sdk.Int, veTokens types.VeTokensSlice, err error) {
+	// check if a delegation object exists in the store
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		err = stakingtypes.ErrNoDelegatorForAddress
+		return
+	}
+
+	veDelegation, found := k.GetVeDelegation(ctx, delAddr, valAddr)
+	if !found {
+		err = sdkerrors.Wrap(stakingtypes.ErrNoDelegation, "no ve delegation")
+		return
+	}
+
+	// get validator
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		err = stakingtypes.ErrNoValidatorFound
+		return
+	}
+
+	shares = sdk.ZeroDec()
+	unbondVeShares := make([]types.VeShares, 0, len(veAmounts))
+	for _, va := range veAmounts {
+		veShares, found := veDelegation.GetSharesByVeID(va.VeId)
+		if !found {
+			err = sdkerrors.Wrapf(stakingtypes.ErrNoDelegation, "ve %s not delegated", vetypes.VeIDFromUint64(va.VeId))
+			return
+		}
+		for _, unbonded := range unbondVeShares {
+			if unbonded.VeId == va.VeId {
+				err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate ve %s", vetypes.VeIDFromUint64(va.VeId))
+				return
+			}
+		}
+
+		// check against the settled tokens, i.e., the ones after being slashed
+		settledTokens := validator.TokensFromShares(veShares.Shares).TruncateInt()
+		if !va.Tokens.IsPositive() {
+			err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount for ve %s", vetypes.VeIDFromUint64(va.VeId))
+			return
+		}
+		if va.Tokens.GT(settledTokens) {
+			err = sdkerrors.Wrapf(stakingtypes.ErrNotEnoughDelegationShares, "ve %s delegated %s", vetypes.VeIDFromUint64(va.VeId), settledTokens)
+			return
+		}
+
+		var veSharesAmt sdk.Dec
+		veSharesAmt, err = validator.SharesFromTokens(va.Tokens)
+		if err != nil {
+			return
+		}
+		// unbond all the shares of the ve when all its tokens are requested,
+		// so that no dust shares are left
+		if va.Tokens.Equal(settledTokens) || veSharesAmt.GT(veShares.Shares) {
+			veSharesAmt = veShares.Shares
+		}
+
+		unbondVeShares = append(unbondVeShares, types.VeShares{
+			VeId:   va.VeId,
+			Shares: veSharesAmt,
+		})
+		shares = shares.Add(veSharesAmt)
+	}
+
+	// call the before-delegation-modified hook
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+
+	veDelegation = k.SettleVeDelegation(ctx, veDelegation, validator)
+
+	amount, veTokens, err = k.unbond(ctx, delegation, veDelegation, validator, shares, unbondVeShares, updateVeAmt)
+	return
+}
+
+// unbond removes the shares from the delegation, of which the unbondVeShares
+// are removed from the corresponding ve shares of the settled ve delegation.
+func (k Keeper) unbond(
+	ctx sdk.Context, delegation stakingtypes.Delegation, veDelegation types.VeDelegation, validator stakingtypes.Validator,
+	shares sdk.Dec, unbondVeShares []types.VeShares, updateVeAmt bool,
+) (amount sdk.Int, veTokens types.VeTokensSlice, err error) {
 	// subtract shares from delegation
 	delegation.Shares = delegation.Shares.Sub(shares)
 
@@ -556,51 +747,38 @@ func (k Keeper) Unbond(
 		validator = k.MustGetValidator(ctx, validator.GetOperator())
 	}
 
-	totalVeShares := sdk.MaxDec(shares.Sub(unconstrainedShares), sdk.ZeroDec())
-	remainingShares := totalVeShares
-	for i := 0; i < len(veDelegation.VeShares); i++ {
-		if remainingShares.IsZero() {
-			break
+	totalVeShares := sdk.ZeroDec()
+	for _, unbondShares := range unbondVeShares {
+		veShares, found := veDelegation.GetSharesByVeID(unbondShares.VeId)
+		if !found {
+			panic("inconsistent ve delegation")
 		}
 
-		veShares := veDelegation.VeShares[i]
-
-		veDelegatedAmt := k.GetVeDelegatedAmount(ctx, veShares.VeId)
-
-		minShares := sdk.MinDec(remainingShares, veShares.Shares)
-		veShares.Shares = veShares.Shares.Sub(minShares)
-		remainingShares = remainingShares.Sub(minShares)
-
-		if minShares.IsPositive() {
-			amt := validator.TokensFromShares(minShares).TruncateInt()
-			veTokens = append(veTokens, types.VeTokens{
-				VeId:   veShares.VeId,
-				Tokens: amt,
-			})
+		veShares.Shares = veShares.Shares.Sub(unbondShares.Shares)
+		if veShares.Shares.IsNegative() {
+			panic("inconsistent ve shares")
+		}
+		totalVeShares = totalVeShares.Add(unbondShares.Shares)
 
-			veShares.TokensMayUnsettled = veShares.TokensMayUnsettled.Sub(amt)
-			if veShares.TokensMayUnsettled.IsNegative() {
-				panic("inconsistent tokens and shares")
-			}
+		amt := validator.TokensFromShares(unbondShares.Shares).TruncateInt()
+		veTokens = append(veTokens, types.VeTokens{
+			VeId:   veShares.VeId,
+			Tokens: amt,
+		})
 
-			if updateVeAmt {
-				veDelegatedAmt = veDelegatedAmt.Sub(amt)
-			}
+		veShares.TokensMayUnsettled = veShares.TokensMayUnsettled.Sub(amt)
+		if veShares.TokensMayUnsettled.IsNegative() {
+			panic("inconsistent tokens and shares")
 		}
 
 		if veShares.Shares.IsZero() {
-			veDelegation.RemoveSharesByIndex(i)
-			i--
+			veDelegation.RemoveSharesByVeID(veShares.VeId)
 		} else {
 			veDelegation.SetSharesByVeID(veShares)
 		}
 
 		if updateVeAmt {
-			if veDelegatedAmt.IsZero() {
-				k.RemoveVeDelegatedAmount(ctx, veShares.VeId)
-			} else {
-				k.SetVeDelegatedAmount(ctx, veShares.VeId, veDelegatedAmt)
-			}
+			k.SubVeDelegatedAmount(ctx, veShares.VeId, amt)
 		}
 	}
 
@@ -610,10 +788,6 @@ func (k Keeper) Unbond(
 		k.RemoveVeDelegation(ctx, veDelegation)
 	}
 
-	if !remainingShares.IsZero() {
-		panic("inconsistent shares")
-	}
-
 	// remove the delegation
 	if delegation.Shares.IsZero() {
 		k.RemoveDelegation(ctx, delegation)
@@ -752,3 +926,74 @@ func (k Keeper) CompleteRedelegation(
 
 	return balances, nil
 }
+
+func (k Keeper) IterateVeValidators(ctx sdk.Context, handler func(validator types.VeValidator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var validator types.VeValidator
+		k.cdc.MustUnmarshal(iterator.Value(), &validator)
+		if handler(validator) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateAllVeDelegations(ctx sdk.Context, handler func(delegation types.VeDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VeDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if handler(delegation) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateVeUnbondingDelegations(ctx sdk.Context, handler func(ubd types.VeUnbondingDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeUnbondingDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ubd types.VeUnbondingDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &ubd)
+		if handler(ubd) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateVeRedelegations(ctx sdk.Context, handler func(red types.VeRedelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeRedelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var red types.VeRedelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &red)
+		if handler(red) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateVeDelegatedAmounts(ctx sdk.Context, handler func(veID uint64, amount sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeTokensKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.VeTokensKey):])
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		if handler(veID, amount.Int) {
+			break
+		}
+	}
+}
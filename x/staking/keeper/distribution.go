@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// DistrAppModule wraps the distribution module to customize its msg server
+// for ve auto-compounding
+type DistrAppModule struct {
+	distr.AppModule
+	distrKeeper distrkeeper.Keeper
+	keeper      Keeper
+}
+
+func NewDistrAppModule(module distr.AppModule, distrKeeper distrkeeper.Keeper, keeper Keeper) DistrAppModule {
+	return DistrAppModule{AppModule: module, distrKeeper: distrKeeper, keeper: keeper}
+}
+
+func (am DistrAppModule) RegisterServices(cfg module.Configurator) {
+	distrtypes.RegisterMsgServer(cfg.MsgServer(), distrMsgServer{
+		MsgServer: distrkeeper.NewMsgServerImpl(am.distrKeeper),
+		keeper:    am.keeper,
+	})
+	distrtypes.RegisterQueryServer(cfg.QueryServer(), am.distrKeeper)
+
+	m := distrkeeper.NewMigrator(am.distrKeeper)
+	err := cfg.RegisterMigration(distrtypes.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
+}
+
+type distrMsgServer struct {
+	distrtypes.MsgServer
+	keeper Keeper
+}
+
+// WithdrawDelegatorReward implements WithdrawDelegatorReward method of the
+// types.MsgServer of the distribution module.
+// Here we customize it by withdrawing the rewards for the auto-compound ves
+// of the delegation first, which are compounded in the EndBlocker, while the
+// rest of the rewards are withdrawn as usual.
+func (m distrMsgServer) WithdrawDelegatorReward(c context.Context, msg *distrtypes.MsgWithdrawDelegatorReward) (*distrtypes.MsgWithdrawDelegatorRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	m.keeper.withdrawVeAutoCompoundRewards(ctx, delAddr, valAddr)
+	return m.MsgServer.WithdrawDelegatorReward(c, msg)
+}
@@ -0,0 +1,462 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (k Querier) VeValidator(c context.Context, req *types.QueryVeValidatorRequest) (*types.QueryVeValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	veValidator, found := k.GetVeValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "ve validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryVeValidatorResponse{VeValidator: veValidator}, nil
+}
+
+func (k Querier) VeDelegation(c context.Context, req *types.QueryVeDelegationRequest) (*types.QueryVeDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delegation, found := k.GetVeDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "ve delegation with delegator %s not found for validator %s", req.DelegatorAddr, req.ValidatorAddr)
+	}
+
+	return &types.QueryVeDelegationResponse{
+		VeDelegationResponse: k.veDelegationResponse(ctx, delegation),
+	}, nil
+}
+
+func (k Querier) DelegatorVeDelegations(c context.Context, req *types.QueryDelegatorVeDelegationsRequest) (*types.QueryDelegatorVeDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	responses, pageRes, err := k.paginateVeDelegations(ctx, types.GetVeDelegationsKey(delAddr), req.Pagination, func(types.VeDelegation) bool {
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorVeDelegationsResponse{
+		VeDelegationResponses: responses,
+		Pagination:            pageRes,
+	}, nil
+}
+
+func (k Querier) ValidatorVeDelegations(c context.Context, req *types.QueryValidatorVeDelegationsRequest) (*types.QueryValidatorVeDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.ValAddressFromBech32(req.ValidatorAddr); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	responses, pageRes, err := k.paginateVeDelegations(ctx, types.VeDelegationKey, req.Pagination, func(delegation types.VeDelegation) bool {
+		return delegation.ValidatorAddress == req.ValidatorAddr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorVeDelegationsResponse{
+		VeDelegationResponses: responses,
+		Pagination:            pageRes,
+	}, nil
+}
+
+func (k Querier) VeDelegationsByVe(c context.Context, req *types.QueryVeDelegationsByVeRequest) (*types.QueryVeDelegationsByVeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	veID, err := parseVeID(req.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	responses, pageRes, err := k.paginateVeDelegations(ctx, types.VeDelegationKey, req.Pagination, func(delegation types.VeDelegation) bool {
+		return delegation.HasVeID(veID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVeDelegationsByVeResponse{
+		DelegatedAmount:       k.settledVeDelegatedAmount(ctx, veID),
+		VeDelegationResponses: responses,
+		Pagination:            pageRes,
+	}, nil
+}
+
+func (k Querier) VeUnbondingDelegation(c context.Context, req *types.QueryVeUnbondingDelegationRequest) (*types.QueryVeUnbondingDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ubd, found := k.GetVeUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "ve unbonding delegation with delegator %s not found for validator %s", req.DelegatorAddr, req.ValidatorAddr)
+	}
+
+	return &types.QueryVeUnbondingDelegationResponse{
+		Unbond: k.veUnbondingDelegationResponse(ctx, ubd),
+	}, nil
+}
+
+func (k Querier) DelegatorVeUnbondingDelegations(c context.Context, req *types.QueryDelegatorVeUnbondingDelegationsRequest) (*types.QueryDelegatorVeUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	responses, pageRes, err := k.paginateVeUnbondingDelegations(ctx, types.GetVeUBDsKey(delAddr), req.Pagination, func(types.VeUnbondingDelegation) bool {
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorVeUnbondingDelegationsResponse{
+		UnbondingResponses: responses,
+		Pagination:         pageRes,
+	}, nil
+}
+
+func (k Querier) ValidatorVeUnbondingDelegations(c context.Context, req *types.QueryValidatorVeUnbondingDelegationsRequest) (*types.QueryValidatorVeUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.ValAddressFromBech32(req.ValidatorAddr); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	responses, pageRes, err := k.paginateVeUnbondingDelegations(ctx, types.VeUnbondingDelegationKey, req.Pagination, func(ubd types.VeUnbondingDelegation) bool {
+		return ubd.ValidatorAddress == req.ValidatorAddr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorVeUnbondingDelegationsResponse{
+		UnbondingResponses: responses,
+		Pagination:         pageRes,
+	}, nil
+}
+
+func (k Querier) VeUnbondingDelegationsByVe(c context.Context, req *types.QueryVeUnbondingDelegationsByVeRequest) (*types.QueryVeUnbondingDelegationsByVeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	veID, err := parseVeID(req.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	responses, pageRes, err := k.paginateVeUnbondingDelegations(ctx, types.VeUnbondingDelegationKey, req.Pagination, func(ubd types.VeUnbondingDelegation) bool {
+		return ubd.HasVeID(veID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVeUnbondingDelegationsByVeResponse{
+		UnbondingResponses: responses,
+		Pagination:         pageRes,
+	}, nil
+}
+
+func (k Querier) VeRedelegations(c context.Context, req *types.QueryVeRedelegationsRequest) (*types.QueryVeRedelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.SrcValidatorAddr != "" {
+		if _, err := sdk.ValAddressFromBech32(req.SrcValidatorAddr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.DstValidatorAddr != "" {
+		if _, err := sdk.ValAddressFromBech32(req.DstValidatorAddr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	responses, pageRes, err := k.paginateVeRedelegations(ctx, types.GetVeREDsKey(delAddr), req.Pagination, func(red types.VeRedelegation) bool {
+		return (req.SrcValidatorAddr == "" || red.ValidatorSrcAddress == req.SrcValidatorAddr) &&
+			(req.DstValidatorAddr == "" || red.ValidatorDstAddress == req.DstValidatorAddr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVeRedelegationsResponse{
+		RedelegationResponses: responses,
+		Pagination:            pageRes,
+	}, nil
+}
+
+func (k Querier) VeRedelegationsByVe(c context.Context, req *types.QueryVeRedelegationsByVeRequest) (*types.QueryVeRedelegationsByVeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	veID, err := parseVeID(req.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	responses, pageRes, err := k.paginateVeRedelegations(ctx, types.VeRedelegationKey, req.Pagination, func(red types.VeRedelegation) bool {
+		return red.HasVeID(veID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVeRedelegationsByVeResponse{
+		RedelegationResponses: responses,
+		Pagination:            pageRes,
+	}, nil
+}
+
+func (k Querier) paginateVeDelegations(
+	ctx sdk.Context, keyPrefix []byte, pagination *query.PageRequest, filter func(types.VeDelegation) bool,
+) ([]types.VeDelegationResponse, *query.PageResponse, error) {
+	var responses []types.VeDelegationResponse
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var delegation types.VeDelegation
+		k.cdc.MustUnmarshal(value, &delegation)
+		if !filter(delegation) {
+			return false, nil
+		}
+
+		if accumulate {
+			responses = append(responses, k.veDelegationResponse(ctx, delegation))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return responses, pageRes, nil
+}
+
+func (k Querier) paginateVeUnbondingDelegations(
+	ctx sdk.Context, keyPrefix []byte, pagination *query.PageRequest, filter func(types.VeUnbondingDelegation) bool,
+) ([]types.VeUnbondingDelegationResponse, *query.PageResponse, error) {
+	var responses []types.VeUnbondingDelegationResponse
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var ubd types.VeUnbondingDelegation
+		k.cdc.MustUnmarshal(value, &ubd)
+		if !filter(ubd) {
+			return false, nil
+		}
+
+		if accumulate {
+			responses = append(responses, k.veUnbondingDelegationResponse(ctx, ubd))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return responses, pageRes, nil
+}
+
+func (k Querier) paginateVeRedelegations(
+	ctx sdk.Context, keyPrefix []byte, pagination *query.PageRequest, filter func(types.VeRedelegation) bool,
+) ([]types.VeRedelegationResponse, *query.PageResponse, error) {
+	var responses []types.VeRedelegationResponse
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var red types.VeRedelegation
+		k.cdc.MustUnmarshal(value, &red)
+		if !filter(red) {
+			return false, nil
+		}
+
+		if accumulate {
+			responses = append(responses, k.veRedelegationResponse(ctx, red))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return responses, pageRes, nil
+}
+
+// veDelegationResponse settles the ve delegation against the current
+// validator tokens, without writing the settlement into the store.
+func (k Querier) veDelegationResponse(ctx sdk.Context, delegation types.VeDelegation) types.VeDelegationResponse {
+	cacheCtx, _ := ctx.CacheContext()
+
+	valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	if validator, found := k.GetValidator(cacheCtx, valAddr); found {
+		delegation = k.SettleVeDelegation(cacheCtx, delegation, validator)
+	}
+
+	amounts := make([]types.VeTokens, 0, len(delegation.VeShares))
+	for _, shares := range delegation.VeShares {
+		amounts = append(amounts, types.VeTokens{
+			VeId:   shares.VeId,
+			Tokens: k.GetVeDelegatedAmount(cacheCtx, shares.VeId),
+		})
+	}
+
+	return types.VeDelegationResponse{
+		VeDelegation:       delegation,
+		VeDelegatedAmounts: amounts,
+	}
+}
+
+// settledVeDelegatedAmount returns the delegated amount of the ve after
+// settling all of its ve delegations, without writing into the store.
+func (k Querier) settledVeDelegatedAmount(ctx sdk.Context, veID uint64) sdk.Int {
+	cacheCtx, _ := ctx.CacheContext()
+
+	var delegations []types.VeDelegation
+	iterator := sdk.KVStorePrefixIterator(cacheCtx.KVStore(k.storeKey), types.VeDelegationKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VeDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if delegation.HasVeID(veID) {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	for _, delegation := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if validator, found := k.GetValidator(cacheCtx, valAddr); found {
+			k.SettleVeDelegation(cacheCtx, delegation, validator)
+		}
+	}
+
+	return k.GetVeDelegatedAmount(cacheCtx, veID)
+}
+
+func (k Querier) veUnbondingDelegationResponse(ctx sdk.Context, veUbd types.VeUnbondingDelegation) types.VeUnbondingDelegationResponse {
+	delAddr, err := sdk.AccAddressFromBech32(veUbd.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(veUbd.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	ubd, _ := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	return types.VeUnbondingDelegationResponse{
+		VeUnbondingDelegation: veUbd,
+		UnbondingDelegation:   ubd,
+	}
+}
+
+func (k Querier) veRedelegationResponse(ctx sdk.Context, veRed types.VeRedelegation) types.VeRedelegationResponse {
+	delAddr, err := sdk.AccAddressFromBech32(veRed.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valSrcAddr, err := sdk.ValAddressFromBech32(veRed.ValidatorSrcAddress)
+	if err != nil {
+		panic(err)
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(veRed.ValidatorDstAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	red, _ := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	return types.VeRedelegationResponse{
+		VeRedelegation: veRed,
+		Redelegation:   red,
+	}
+}
+
+func parseVeID(veID string) (uint64, error) {
+	id := vetypes.Uint64FromVeID(veID)
+	if id == vetypes.EmptyVeID {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid ve id %s", veID)
+	}
+	return id, nil
+}
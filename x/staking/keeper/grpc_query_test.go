@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+
+	"github.com/gridiron-zone/gridiron/app"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/staking/keeper"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *app.Gridiron
+
+	delAddr sdk.AccAddress
+	valAddr sdk.ValAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	require := suite.Require()
+
+	suite.app = app.Setup(false)
+	// the proposer is required by the EVM, where the veNFTs are mirrored
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		ChainID:         "gridiron_5000-101",
+		Height:          1,
+		Time:            time.Now().UTC(),
+		ProposerAddress: privCons.PubKey().Address().Bytes(),
+	})
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	suite.delAddr = sdk.AccAddress(priv.PubKey().Address())
+
+	suite.valAddr = sdk.ValAddress(suite.delAddr)
+	validator, err := stakingtypes.NewValidator(suite.valAddr, privCons.PubKey(), stakingtypes.Description{})
+	require.NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper.Keeper, suite.ctx, validator, true)
+	suite.app.StakingKeeper.AfterValidatorCreated(suite.ctx, validator.GetOperator())
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator)
+	require.NoError(err)
+
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, suite.delAddr, sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 10000)))
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryVeDelegations() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+	querier := keeper.Querier{Keeper: k}
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	veID, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 5000), vetypes.MaxLockTime)
+	require.NoError(err)
+	veIDStr := vetypes.VeIDFromUint64(veID)
+
+	validator, found := k.GetValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	_, err = k.VeDelegate(suite.ctx, suite.delAddr, sdk.NewInt(3000), types.VeTokensSlice{{VeId: veID, Tokens: sdk.NewInt(3000)}}, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+
+	_, err = querier.VeValidator(ctx, &types.QueryVeValidatorRequest{ValidatorAddr: "invalid"})
+	require.Error(err)
+	valRes, err := querier.VeValidator(ctx, &types.QueryVeValidatorRequest{ValidatorAddr: suite.valAddr.String()})
+	require.NoError(err)
+	require.True(valRes.VeValidator.VeDelegatorShares.IsPositive())
+
+	delRes, err := querier.VeDelegation(ctx, &types.QueryVeDelegationRequest{
+		DelegatorAddr: suite.delAddr.String(),
+		ValidatorAddr: suite.valAddr.String(),
+	})
+	require.NoError(err)
+	require.Len(delRes.VeDelegationResponse.VeDelegation.VeShares, 1)
+	require.Equal(sdk.NewInt(3000), delRes.VeDelegationResponse.VeDelegation.VeShares[0].TokensMayUnsettled)
+	require.Equal([]types.VeTokens{{VeId: veID, Tokens: sdk.NewInt(3000)}}, delRes.VeDelegationResponse.VeDelegatedAmounts)
+
+	delegatorRes, err := querier.DelegatorVeDelegations(ctx, &types.QueryDelegatorVeDelegationsRequest{DelegatorAddr: suite.delAddr.String()})
+	require.NoError(err)
+	require.Len(delegatorRes.VeDelegationResponses, 1)
+
+	validatorRes, err := querier.ValidatorVeDelegations(ctx, &types.QueryValidatorVeDelegationsRequest{ValidatorAddr: suite.valAddr.String()})
+	require.NoError(err)
+	require.Len(validatorRes.VeDelegationResponses, 1)
+
+	_, err = querier.VeDelegationsByVe(ctx, &types.QueryVeDelegationsByVeRequest{VeId: "invalid"})
+	require.Error(err)
+	veRes, err := querier.VeDelegationsByVe(ctx, &types.QueryVeDelegationsByVeRequest{VeId: veIDStr})
+	require.NoError(err)
+	require.Equal(sdk.NewInt(3000), veRes.DelegatedAmount)
+	require.Len(veRes.VeDelegationResponses, 1)
+
+	veRes, err = querier.VeDelegationsByVe(ctx, &types.QueryVeDelegationsByVeRequest{VeId: vetypes.VeIDFromUint64(veID + 1)})
+	require.NoError(err)
+	require.True(veRes.DelegatedAmount.IsZero())
+	require.Empty(veRes.VeDelegationResponses)
+
+	// Settlement of the slashed validator is reflected but not written
+	validator, _ = k.GetValidator(suite.ctx, suite.valAddr)
+	k.RemoveValidatorTokens(suite.ctx, validator, sdk.NewInt(1000))
+	veRes, err = querier.VeDelegationsByVe(ctx, &types.QueryVeDelegationsByVeRequest{VeId: veIDStr})
+	require.NoError(err)
+	require.Equal(sdk.NewInt(2000), veRes.DelegatedAmount)
+	require.Equal(sdk.NewInt(2000), veRes.VeDelegationResponses[0].VeDelegation.VeShares[0].TokensMayUnsettled)
+	require.Equal(sdk.NewInt(3000), k.GetVeDelegatedAmount(suite.ctx, veID))
+
+	// Unbonding
+	delegation, found := k.GetDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	_, err = k.Undelegate(suite.ctx, suite.delAddr, suite.valAddr, delegation.Shares.QuoInt64(2))
+	require.NoError(err)
+
+	ubdRes, err := querier.VeUnbondingDelegation(ctx, &types.QueryVeUnbondingDelegationRequest{
+		DelegatorAddr: suite.delAddr.String(),
+		ValidatorAddr: suite.valAddr.String(),
+	})
+	require.NoError(err)
+	require.Len(ubdRes.Unbond.VeUnbondingDelegation.Entries, 1)
+	require.Len(ubdRes.Unbond.UnbondingDelegation.Entries, 1)
+	require.Equal(sdk.NewInt(1000), ubdRes.Unbond.VeUnbondingDelegation.Entries[0].VeBalances[0].Balance)
+
+	delegatorUbdRes, err := querier.DelegatorVeUnbondingDelegations(ctx, &types.QueryDelegatorVeUnbondingDelegationsRequest{DelegatorAddr: suite.delAddr.String()})
+	require.NoError(err)
+	require.Len(delegatorUbdRes.UnbondingResponses, 1)
+
+	validatorUbdRes, err := querier.ValidatorVeUnbondingDelegations(ctx, &types.QueryValidatorVeUnbondingDelegationsRequest{ValidatorAddr: suite.valAddr.String()})
+	require.NoError(err)
+	require.Len(validatorUbdRes.UnbondingResponses, 1)
+
+	veUbdRes, err := querier.VeUnbondingDelegationsByVe(ctx, &types.QueryVeUnbondingDelegationsByVeRequest{VeId: veIDStr})
+	require.NoError(err)
+	require.Len(veUbdRes.UnbondingResponses, 1)
+
+	redRes, err := querier.VeRedelegations(ctx, &types.QueryVeRedelegationsRequest{DelegatorAddr: suite.delAddr.String()})
+	require.NoError(err)
+	require.Empty(redRes.RedelegationResponses)
+
+	veRedRes, err := querier.VeRedelegationsByVe(ctx, &types.QueryVeRedelegationsByVeRequest{VeId: veIDStr})
+	require.NoError(err)
+	require.Empty(veRedRes.RedelegationResponses)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -11,11 +13,12 @@ import (
 
 type Keeper struct {
 	stakingkeeper.Keeper
-	storeKey   sdk.StoreKey
-	cdc        codec.BinaryCodec
-	bankKeeper stakingtypes.BankKeeper
-	nftKeeper  types.NftKeeper
-	veKeeper   types.VeKeeper
+	storeKey    sdk.StoreKey
+	cdc         codec.BinaryCodec
+	bankKeeper  stakingtypes.BankKeeper
+	nftKeeper   types.NftKeeper
+	veKeeper    types.VeKeeper
+	distrKeeper types.DistrKeeper
 }
 
 func NewKeeper(
@@ -48,8 +51,22 @@ func (k *Keeper) SetHooks(sh stakingtypes.StakingHooks) *Keeper {
 	return k
 }
 
+// SetDistrKeeper sets the distribution keeper, which depends on the staking
+// keeper and thus can only be set after construction.
+func (k *Keeper) SetDistrKeeper(dk types.DistrKeeper) *Keeper {
+	k.distrKeeper = dk
+	return k
+}
+
 func (k *Keeper) CheckDenom(ctx sdk.Context) {
 	if k.veKeeper.LockDenom(ctx) != k.BondDenom(ctx) {
 		panic("bond denom is different from ve lock denom")
 	}
 }
+
+func (k *Keeper) CheckVeDelegatedAmount(ctx sdk.Context, veID uint64, amount sdk.Int) {
+	locked := k.veKeeper.GetLockedAmountByUser(ctx, veID)
+	if amount.GT(locked.Amount) {
+		panic(fmt.Sprintf("delegated amount %s of ve %d exceeds its locked amount %s", amount, veID, locked.Amount))
+	}
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.migrateVeDelegationValidators(ctx)
+	m.keeper.recomputeVeValidatorShares(ctx)
+	return nil
+}
+
+// migrateVeDelegationValidators rewrites the validator of the ve delegations
+// stored before it was recorded as the operator address, recovering the
+// operator address from the store key.
+func (k Keeper) migrateVeDelegationValidators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		delLen := int(key[len(types.VeDelegationKey)])
+		valAddr := sdk.ValAddress(key[len(types.VeDelegationKey)+1+delLen+1:])
+
+		var delegation types.VeDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if delegation.ValidatorAddress == valAddr.String() {
+			continue
+		}
+		delegation.ValidatorAddress = valAddr.String()
+		store.Set(key, k.cdc.MustMarshal(&delegation))
+	}
+}
+
+// recomputeVeValidatorShares recomputes the ve delegator shares of the ve
+// validators from their ve delegations, since the shares delegated without ve
+// used to be accumulated into them as well.
+func (k Keeper) recomputeVeValidatorShares(ctx sdk.Context) {
+	veShares := make(map[string]sdk.Dec)
+	k.IterateAllVeDelegations(ctx, func(delegation types.VeDelegation) (stop bool) {
+		if shares, ok := veShares[delegation.ValidatorAddress]; ok {
+			veShares[delegation.ValidatorAddress] = shares.Add(delegation.Shares())
+		} else {
+			veShares[delegation.ValidatorAddress] = delegation.Shares()
+		}
+		return false
+	})
+
+	var veValidators []types.VeValidator
+	k.IterateVeValidators(ctx, func(validator types.VeValidator) (stop bool) {
+		veValidators = append(veValidators, validator)
+		return false
+	})
+	for _, veValidator := range veValidators {
+		shares, ok := veShares[veValidator.OperatorAddress]
+		if !ok {
+			shares = sdk.ZeroDec()
+		}
+		veValidator.VeDelegatorShares = shares
+		k.SetVeValidator(ctx, veValidator)
+	}
+}
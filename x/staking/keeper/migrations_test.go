@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/staking/keeper"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3VeDelegationValidators() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+	querier := keeper.Querier{Keeper: k}
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	veID, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 5000), vetypes.MaxLockTime)
+	require.NoError(err)
+	validator, found := k.GetValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	_, err = k.VeDelegate(suite.ctx, suite.delAddr, sdk.NewInt(3000), types.VeTokensSlice{{VeId: veID, Tokens: sdk.NewInt(3000)}}, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	veDelegation, found := k.GetVeDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+
+	// The ve delegation was stored with the validator in its legacy format
+	legacy := veDelegation
+	legacy.ValidatorAddress = validator.String()
+	store := suite.ctx.KVStore(suite.app.GetKey(stakingtypes.StoreKey))
+	store.Set(types.GetVeDelegationKey(suite.delAddr, suite.valAddr), suite.app.AppCodec().MustMarshal(&legacy))
+
+	res, err := querier.ValidatorVeDelegations(ctx, &types.QueryValidatorVeDelegationsRequest{ValidatorAddr: suite.valAddr.String()})
+	require.NoError(err)
+	require.Empty(res.VeDelegationResponses)
+
+	err = keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	require.NoError(err)
+
+	migrated, found := k.GetVeDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	require.Equal(veDelegation, migrated)
+	res, err = querier.ValidatorVeDelegations(ctx, &types.QueryValidatorVeDelegationsRequest{ValidatorAddr: suite.valAddr.String()})
+	require.NoError(err)
+	require.Len(res.VeDelegationResponses, 1)
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3VeValidatorShares() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+
+	veID, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 5000), vetypes.MaxLockTime)
+	require.NoError(err)
+	validator, found := k.GetValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+
+	// Only the shares delegated by the ve count as ve delegator shares
+	_, err = k.VeDelegate(suite.ctx, suite.delAddr, sdk.NewInt(4000), types.VeTokensSlice{{VeId: veID, Tokens: sdk.NewInt(3000)}}, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	veDelegation, found := k.GetVeDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	delegation, found := k.GetDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	veValidator, found := k.GetVeValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	require.True(veDelegation.Shares().LT(delegation.Shares))
+	require.Equal(veDelegation.Shares(), veValidator.VeDelegatorShares)
+
+	// The ve validator accumulated all the delegated shares before
+	veValidator.VeDelegatorShares = delegation.Shares
+	k.SetVeValidator(suite.ctx, veValidator)
+
+	err = keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	require.NoError(err)
+
+	veValidator, found = k.GetVeValidator(suite.ctx, suite.valAddr)
+	require.True(found)
+	require.Equal(veDelegation.Shares(), veValidator.VeDelegatorShares)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -57,8 +58,8 @@ func (k MsgServer) VeDelegate(goCtx context.Context, msg *types.MsgVeDelegate) (
 	}
 
 	owner := k.Keeper.nftKeeper.GetOwner(ctx, vetypes.VeNftClass.Id, msg.VeId)
-	if owner.Equals(delegatorAddress) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve %s not owned by delegator", msg.VeId)
+	if !owner.Equals(delegatorAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "ve %s not owned by delegator", msg.VeId)
 	}
 
 	veID := vetypes.Uint64FromVeID(msg.VeId)
@@ -96,6 +97,177 @@ func (k MsgServer) VeDelegate(goCtx context.Context, msg *types.MsgVeDelegate) (
 	return &types.MsgVeDelegateResponse{}, nil
 }
 
+func (k MsgServer) SetVeAutoCompound(goCtx context.Context, msg *types.MsgSetVeAutoCompound) (*types.MsgSetVeAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.Keeper.nftKeeper.GetOwner(ctx, vetypes.VeNftClass.Id, msg.VeId)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "ve %s not owned by sender", msg.VeId)
+	}
+
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+	k.Keeper.SetVeAutoCompound(ctx, veID, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetVeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyVeID, msg.VeId),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, stakingtypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetVeAutoCompoundResponse{}, nil
+}
+
+func (k MsgServer) VeUndelegate(goCtx context.Context, msg *types.MsgVeUndelegate) (*types.MsgVeUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the ve is not required to be owned by the delegator, since the
+	// undelegated tokens always go back into the ve lock
+	veTokens, amount, err := k.veTokensFromAmounts(ctx, msg.Amounts)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := k.Keeper.VeUndelegate(ctx, delegatorAddress, addr, veTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, stakingtypes.ModuleName, "undelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVeUnbond,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyVeAmounts, veTokens.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, stakingtypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgVeUndelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+func (k MsgServer) VeRedelegate(goCtx context.Context, msg *types.MsgVeRedelegate) (*types.MsgVeRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valSrcAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
+	if err != nil {
+		return nil, err
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, va := range msg.Amounts {
+		owner := k.Keeper.nftKeeper.GetOwner(ctx, vetypes.VeNftClass.Id, va.VeId)
+		if !owner.Equals(delegatorAddress) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "ve %s not owned by delegator", va.VeId)
+		}
+	}
+
+	veTokens, amount, err := k.veTokensFromAmounts(ctx, msg.Amounts)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := k.Keeper.BeginVeRedelegation(ctx, delegatorAddress, valSrcAddr, valDstAddr, veTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, stakingtypes.ModuleName, "redelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVeRedelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeySrcValidator, msg.ValidatorSrcAddress),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDstValidator, msg.ValidatorDstAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyVeAmounts, veTokens.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, stakingtypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgVeRedelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+func (k MsgServer) veTokensFromAmounts(ctx sdk.Context, amounts []types.VeAmount) (types.VeTokensSlice, sdk.Coin, error) {
+	bondDenom := k.BondDenom(ctx)
+	total := sdk.NewCoin(bondDenom, sdk.ZeroInt())
+	veTokens := make(types.VeTokensSlice, 0, len(amounts))
+	for _, va := range amounts {
+		if va.Amount.Denom != bondDenom {
+			return nil, total, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", va.Amount.Denom, bondDenom,
+			)
+		}
+		veTokens = append(veTokens, types.VeTokens{
+			VeId:   vetypes.Uint64FromVeID(va.VeId),
+			Tokens: va.Amount.Amount,
+		})
+		total = total.Add(va.Amount)
+	}
+	return veTokens, total, nil
+}
+
 func (k MsgServer) CreateValidator(goCtx context.Context, msg *stakingtypes.MsgCreateValidator) (*stakingtypes.MsgCreateValidatorResponse, error) {
 	return k.MsgServer.CreateValidator(goCtx, msg)
 }
@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+
+	"github.com/gridiron-zone/gridiron/app"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/staking/keeper"
+	"github.com/gridiron-zone/gridiron/x/staking/types"
+	vekeeper "github.com/gridiron-zone/gridiron/x/ve/keeper"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+func newVeAmount(veID uint64, amount int64) types.VeAmount {
+	return types.VeAmount{
+		VeId:   vetypes.VeIDFromUint64(veID),
+		Amount: sdk.NewInt64Coin(gridiron.BaseDenom, amount),
+	}
+}
+
+// advanceBlockTime advances the block time weekly until the given time, with
+// the ve checkpoints regulated as in the end blockers.
+func (suite *KeeperTestSuite) advanceBlockTime(ctx sdk.Context, t time.Time) sdk.Context {
+	for ctx.BlockTime().Before(t) {
+		next := ctx.BlockTime().Add(gridiron.SecondsPerWeek * time.Second)
+		if next.After(t) {
+			next = t
+		}
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(next)
+		suite.app.VeKeeper.RegulateCheckpoint(ctx)
+	}
+	return ctx
+}
+
+func (suite *KeeperTestSuite) TestVeUndelegateAndRedelegate() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	veMsgServer := vekeeper.NewMsgServerImpl(suite.app.VeKeeper)
+
+	veID1, unlockTime1, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 2000), 2*gridiron.SecondsPerWeek)
+	require.NoError(err)
+	veID2, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 3000), vetypes.MaxLockTime)
+	require.NoError(err)
+	veID3, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 1000), vetypes.MaxLockTime)
+	require.NoError(err)
+
+	// Only the ve owner can delegate
+	_, err = msgServer.VeDelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeDelegate{
+		DelegatorAddress: sdk.AccAddress(suite.valAddr.Bytes()[1:]).String(),
+		ValidatorAddress: suite.valAddr.String(),
+		VeId:             vetypes.VeIDFromUint64(veID1),
+		Amount:           sdk.NewInt64Coin(gridiron.BaseDenom, 2000),
+	})
+	require.Error(err)
+	for _, va := range []types.VeAmount{newVeAmount(veID1, 2000), newVeAmount(veID2, 3000)} {
+		_, err = msgServer.VeDelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeDelegate{
+			DelegatorAddress: suite.delAddr.String(),
+			ValidatorAddress: suite.valAddr.String(),
+			VeId:             va.VeId,
+			Amount:           va.Amount,
+		})
+		require.NoError(err)
+	}
+
+	// Undelegate from the second ve, though the first ve is delegated earlier
+	_, err = msgServer.VeUndelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeUndelegate{
+		DelegatorAddress: suite.delAddr.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		Amounts:          []types.VeAmount{newVeAmount(veID3, 100)},
+	})
+	require.Error(err)
+	_, err = msgServer.VeUndelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeUndelegate{
+		DelegatorAddress: suite.delAddr.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		Amounts:          []types.VeAmount{newVeAmount(veID2, 3001)},
+	})
+	require.Error(err)
+	_, err = msgServer.VeUndelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeUndelegate{
+		DelegatorAddress: suite.delAddr.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		Amounts:          []types.VeAmount{newVeAmount(veID2, 1000)},
+	})
+	require.NoError(err)
+
+	veDelegation, found := k.GetVeDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	veShares, _ := veDelegation.GetSharesByVeID(veID1)
+	require.Equal(sdk.NewInt(2000), veShares.Tokens())
+	veShares, _ = veDelegation.GetSharesByVeID(veID2)
+	require.Equal(sdk.NewInt(2000), veShares.Tokens())
+
+	veUbd, found := k.GetVeUnbondingDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	require.Len(veUbd.Entries, 1)
+	require.Len(veUbd.Entries[0].VeBalances, 1)
+	require.Equal(veID2, veUbd.Entries[0].VeBalances[0].VeId)
+	require.Equal(sdk.NewInt(1000), veUbd.Entries[0].VeBalances[0].Balance)
+	require.Equal(sdk.NewInt(3000), k.GetVeDelegatedAmount(suite.ctx, veID2))
+
+	// Redelegate from the second ve to another validator
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	valAddr2 := sdk.ValAddress(priv.PubKey().Address())
+	validator2, err := stakingtypes.NewValidator(valAddr2, priv.PubKey(), stakingtypes.Description{})
+	require.NoError(err)
+	validator2 = stakingkeeper.TestingUpdateValidator(k.Keeper, suite.ctx, validator2, true)
+	k.AfterValidatorCreated(suite.ctx, validator2.GetOperator())
+
+	_, err = msgServer.VeRedelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeRedelegate{
+		DelegatorAddress:    sdk.AccAddress(suite.valAddr.Bytes()[1:]).String(),
+		ValidatorSrcAddress: suite.valAddr.String(),
+		ValidatorDstAddress: valAddr2.String(),
+		Amounts:             []types.VeAmount{newVeAmount(veID2, 500)},
+	})
+	require.Error(err)
+	_, err = msgServer.VeRedelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeRedelegate{
+		DelegatorAddress:    suite.delAddr.String(),
+		ValidatorSrcAddress: suite.valAddr.String(),
+		ValidatorDstAddress: valAddr2.String(),
+		Amounts:             []types.VeAmount{newVeAmount(veID2, 500)},
+	})
+	require.NoError(err)
+
+	veDelegation, found = k.GetVeDelegation(suite.ctx, suite.delAddr, valAddr2)
+	require.True(found)
+	require.Len(veDelegation.VeShares, 1)
+	require.Equal(veID2, veDelegation.VeShares[0].VeId)
+	require.Equal(sdk.NewInt(500), veDelegation.VeShares[0].Tokens())
+	// Redelegation from an unbonded validator completes immediately
+	_, found = k.GetVeRedelegation(suite.ctx, suite.delAddr, suite.valAddr, valAddr2)
+	require.False(found)
+	require.Equal(sdk.NewInt(3000), k.GetVeDelegatedAmount(suite.ctx, veID2))
+
+	// Undelegate all of the first ve, which stays unavailable until the unbonding completes
+	completion, err := msgServer.VeUndelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeUndelegate{
+		DelegatorAddress: suite.delAddr.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		Amounts:          []types.VeAmount{newVeAmount(veID1, 2000)},
+	})
+	require.NoError(err)
+	veDelegation, found = k.GetVeDelegation(suite.ctx, suite.delAddr, suite.valAddr)
+	require.True(found)
+	require.False(veDelegation.HasVeID(veID1))
+	require.Equal(sdk.NewInt(2000), suite.app.VeKeeper.GetDelegatedAmountByUser(suite.ctx, veID1))
+
+	_, err = veMsgServer.Merge(sdk.WrapSDKContext(suite.ctx), &vetypes.MsgMerge{
+		Sender:   suite.delAddr.String(),
+		FromVeId: vetypes.VeIDFromUint64(veID1),
+		ToVeId:   vetypes.VeIDFromUint64(veID3),
+	})
+	require.Error(err)
+
+	require.True(int64(unlockTime1) < completion.CompletionTime.Unix())
+	ctx := suite.advanceBlockTime(suite.ctx, time.Unix(int64(unlockTime1), 0))
+	_, err = veMsgServer.Withdraw(sdk.WrapSDKContext(ctx), &vetypes.MsgWithdraw{
+		Sender: suite.delAddr.String(),
+		VeId:   vetypes.VeIDFromUint64(veID1),
+	})
+	require.Error(err)
+
+	ctx = suite.advanceBlockTime(ctx, completion.CompletionTime)
+	_, err = k.CompleteUnbonding(ctx, suite.delAddr, suite.valAddr)
+	require.NoError(err)
+	require.True(k.GetVeDelegatedAmount(ctx, veID1).IsZero())
+	require.Equal(sdk.NewInt(2000), k.GetVeDelegatedAmount(ctx, veID2))
+	_, err = veMsgServer.Withdraw(sdk.WrapSDKContext(ctx), &vetypes.MsgWithdraw{
+		Sender: suite.delAddr.String(),
+		VeId:   vetypes.VeIDFromUint64(veID1),
+	})
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestVeDelegateNotOwner() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	otherAddr := sdk.AccAddress(priv.PubKey().Address())
+	err = app.FundAccount(suite.app.BankKeeper, suite.ctx, otherAddr, sdk.NewCoins(sdk.NewInt64Coin(gridiron.BaseDenom, 10000)))
+	require.NoError(err)
+
+	veID, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 2000), vetypes.MaxLockTime)
+	require.NoError(err)
+	otherVeID, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, otherAddr, otherAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 2000), vetypes.MaxLockTime)
+	require.NoError(err)
+
+	// Another account cannot delegate the ve, even though it owns a ve itself
+	_, err = msgServer.VeDelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeDelegate{
+		DelegatorAddress: otherAddr.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		VeId:             vetypes.VeIDFromUint64(veID),
+		Amount:           sdk.NewInt64Coin(gridiron.BaseDenom, 2000),
+	})
+	require.Error(err)
+	require.True(k.GetVeDelegatedAmount(suite.ctx, veID).IsZero())
+	_, found := k.GetVeDelegation(suite.ctx, otherAddr, suite.valAddr)
+	require.False(found)
+
+	_, err = msgServer.VeDelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeDelegate{
+		DelegatorAddress: otherAddr.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		VeId:             vetypes.VeIDFromUint64(otherVeID),
+		Amount:           sdk.NewInt64Coin(gridiron.BaseDenom, 2000),
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt(2000), k.GetVeDelegatedAmount(suite.ctx, otherVeID))
+}
+
+func (suite *KeeperTestSuite) TestVeDelegatedLockGuards() {
+	require := suite.Require()
+	k := suite.app.StakingKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	veMsgServer := vekeeper.NewMsgServerImpl(suite.app.VeKeeper)
+
+	veID1, unlockTime1, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 2000), 2*gridiron.SecondsPerWeek)
+	require.NoError(err)
+	veID2, _, err := suite.app.VeKeeper.CreateLock(suite.ctx, suite.delAddr, suite.delAddr, sdk.NewInt64Coin(gridiron.BaseDenom, 1000), vetypes.MaxLockTime)
+	require.NoError(err)
+
+	_, err = msgServer.VeDelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgVeDelegate{
+		DelegatorAddress: suite.delAddr.String(),
+		ValidatorAddress: suite.valAddr.String(),
+		VeId:             vetypes.VeIDFromUint64(veID1),
+		Amount:           sdk.NewInt64Coin(gridiron.BaseDenom, 1500),
+	})
+	require.NoError(err)
+
+	// The ve keeper sees the delegated amount registered by the staking keeper
+	require.Equal(sdk.NewInt(1500), suite.app.VeKeeper.GetDelegatedAmountByUser(suite.ctx, veID1))
+	require.True(suite.app.VeKeeper.GetDelegatedAmountByUser(suite.ctx, veID2).IsZero())
+
+	_, err = veMsgServer.Merge(sdk.WrapSDKContext(suite.ctx), &vetypes.MsgMerge{
+		Sender:   suite.delAddr.String(),
+		FromVeId: vetypes.VeIDFromUint64(veID1),
+		ToVeId:   vetypes.VeIDFromUint64(veID2),
+	})
+	require.Error(err)
+	_, err = veMsgServer.EarlyWithdraw(sdk.WrapSDKContext(suite.ctx), &vetypes.MsgEarlyWithdraw{
+		Sender: suite.delAddr.String(),
+		VeId:   vetypes.VeIDFromUint64(veID1),
+	})
+	require.Error(err)
+
+	ctx := suite.advanceBlockTime(suite.ctx, time.Unix(int64(unlockTime1), 0))
+	_, err = veMsgServer.Withdraw(sdk.WrapSDKContext(ctx), &vetypes.MsgWithdraw{
+		Sender: suite.delAddr.String(),
+		VeId:   vetypes.VeIDFromUint64(veID1),
+	})
+	require.Error(err)
+	require.Equal(sdk.NewInt(2000), suite.app.VeKeeper.GetLockedAmountByUser(ctx, veID1).Amount)
+
+	// The ve that is not delegated is unaffected
+	_, err = veMsgServer.Merge(sdk.WrapSDKContext(ctx), &vetypes.MsgMerge{
+		Sender:   suite.delAddr.String(),
+		FromVeId: vetypes.VeIDFromUint64(veID2),
+		ToVeId:   vetypes.VeIDFromUint64(veID1),
+	})
+	require.NoError(err)
+}
@@ -1,8 +1,11 @@
 package staking
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,8 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gridiron-zone/gridiron/x/staking/client/cli"
 	"github.com/gridiron-zone/gridiron/x/staking/keeper"
 	"github.com/gridiron-zone/gridiron/x/staking/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -31,6 +37,16 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
+// ValidateGenesis performs genesis state validation for the staking module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingtypes.ModuleName, err)
+	}
+
+	return data.Validate()
+}
+
 // RegisterLegacyAminoCodec registers the staking module's types on the given LegacyAmino codec.
 func (b AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	b.AppModuleBasic.RegisterLegacyAminoCodec(cdc)
@@ -43,6 +59,19 @@ func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry)
 	types.RegisterInterfaces(registry)
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the staking module.
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetQueryCmd returns the root query command for the staking module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 type AppModule struct {
 	staking.AppModule
 
@@ -67,17 +96,40 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	querier := stakingkeeper.Querier{Keeper: am.keeper.Keeper}
 	stakingtypes.RegisterQueryServer(cfg.QueryServer(), querier)
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.Querier{Keeper: am.keeper})
 
 	m := stakingkeeper.NewMigrator(am.keeper.Keeper)
 	cfg.RegisterMigration(stakingtypes.ModuleName, 1, m.Migrate1to2)
+
+	veMigrator := keeper.NewMigrator(am.keeper)
+	err := cfg.RegisterMigration(stakingtypes.ModuleName, 2, veMigrator.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
 }
 
+// ConsensusVersion implements ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
 // InitGenesis performs genesis initialization for the staking module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState stakingtypes.GenesisState
+	var genesisState types.GenesisState
 
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
 	return InitGenesis(ctx, am.keeper, am.accountKeeper, am.bankKeeper, &genesisState)
 }
+
+// EndBlock returns the end blocker for the staking module. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return EndBlocker(ctx, am.keeper)
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the staking
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(gs)
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 )
 
 func (m *VeDelegation) GetSharesByVeID(veID uint64) (VeShares, bool) {
@@ -17,6 +21,11 @@ func (m *VeDelegation) GetSharesByVeID(veID uint64) (VeShares, bool) {
 	}, false
 }
 
+func (m *VeDelegation) HasVeID(veID uint64) bool {
+	_, found := m.GetSharesByVeID(veID)
+	return found
+}
+
 func (m *VeDelegation) SetSharesByVeID(veShares VeShares) (new bool) {
 	for i, shares := range m.VeShares {
 		if shares.VeId == veShares.VeId {
@@ -32,6 +41,15 @@ func (m *VeDelegation) RemoveSharesByIndex(i int) {
 	m.VeShares = append(m.VeShares[:i], m.VeShares[i+1:]...)
 }
 
+func (m *VeDelegation) RemoveSharesByVeID(veID uint64) {
+	for i, shares := range m.VeShares {
+		if shares.VeId == veID {
+			m.RemoveSharesByIndex(i)
+			return
+		}
+	}
+}
+
 func (m *VeDelegation) Shares() sdk.Dec {
 	total := sdk.ZeroDec()
 	for _, shares := range m.VeShares {
@@ -80,6 +98,17 @@ func (ubd *VeUnbondingDelegation) AddEntry(veTokens VeTokensSlice) {
 	})
 }
 
+func (ubd *VeUnbondingDelegation) HasVeID(veID uint64) bool {
+	for _, entry := range ubd.Entries {
+		for _, b := range entry.VeBalances {
+			if b.VeId == veID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (ubd *VeUnbondingDelegation) RemoveEntry(i int) {
 	ubd.Entries = append(ubd.Entries[:i], ubd.Entries[i+1:]...)
 }
@@ -131,6 +160,17 @@ func (red *VeRedelegation) AddEntry(veTokens VeTokensSlice, totalAmt sdk.Int, to
 	})
 }
 
+func (red *VeRedelegation) HasVeID(veID uint64) bool {
+	for _, entry := range red.Entries {
+		for _, b := range entry.VeShares {
+			if b.VeId == veID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (red *VeRedelegation) RemoveEntry(i int) {
 	red.Entries = append(red.Entries[:i], red.Entries[i+1:]...)
 }
@@ -153,6 +193,15 @@ func (s VeTokensSlice) Tokens() sdk.Int {
 	return total
 }
 
+// String returns the ve amounts in the form of "ve-1:100,ve-2:200".
+func (s VeTokensSlice) String() string {
+	strs := make([]string, 0, len(s))
+	for _, vt := range s {
+		strs = append(strs, fmt.Sprintf("%s:%s", vetypes.VeIDFromUint64(vt.VeId), vt.Tokens))
+	}
+	return strings.Join(strs, ",")
+}
+
 func (s VeTokensSlice) AddToMap(veAmounts map[uint64]sdk.Int) map[uint64]sdk.Int {
 	for _, vt := range s {
 		if veAmt, ok := veAmounts[vt.VeId]; ok {
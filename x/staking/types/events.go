@@ -1,5 +1,16 @@
 package types
 
 const (
-	EventTypeVeDelegate = "ve_delegate"
+	EventTypeVeDelegate          = "ve_delegate"
+	EventTypeSetVeAutoCompound   = "set_ve_auto_compound"
+	EventTypeVeAutoCompound      = "ve_auto_compound"
+	EventTypeVeAutoCompoundError = "ve_auto_compound_error"
+	EventTypeVeUnbond            = "ve_unbond"
+	EventTypeVeRedelegate        = "ve_redelegate"
+
+	AttributeKeyVeID    = "ve_id"
+	AttributeKeyEnabled = "enabled"
+	AttributeKeyError   = "error"
+
+	AttributeKeyVeAmounts = "ve_amounts"
 )
@@ -15,4 +15,12 @@ type VeKeeper interface {
 	GetLockedAmountByUser(ctx sdk.Context, veID uint64) vetypes.LockedBalance
 	SlashLockedAmountByUser(ctx sdk.Context, veID uint64, amount sdk.Int)
 	SetGetDelegatedAmountByUser(getDelegatedAmount func(ctx sdk.Context, veID uint64) sdk.Int)
+	RegulateDelegatedCheckpoint(ctx sdk.Context, veID uint64)
+	DepositFor(ctx sdk.Context, sender sdk.AccAddress, veID uint64, amount sdk.Int, unlockTime uint64, locked vetypes.LockedBalance, sendCoins bool) error
+}
+
+// DistrKeeper defines the expected distribution keeper used for auto-compounding.
+type DistrKeeper interface {
+	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+	GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress
 }
@@ -1,15 +1,268 @@
 package types
 
 import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gridiron "github.com/gridiron-zone/gridiron/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 )
 
+// NewGenesisState creates a new genesis state from the cosmos staking genesis
+// state and the ve-specific records.
+func NewGenesisState(
+	data *stakingtypes.GenesisState,
+	veValidators []VeValidator,
+	veDelegations []VeDelegation,
+	veUnbondingDelegations []VeUnbondingDelegation,
+	veRedelegations []VeRedelegation,
+	veDelegatedAmounts []VeTokens,
+	veAutoCompounds []uint64,
+	veAutoCompoundEntries []VeAutoCompoundEntry,
+) *GenesisState {
+	return &GenesisState{
+		Params:                 data.Params,
+		LastTotalPower:         data.LastTotalPower,
+		LastValidatorPowers:    data.LastValidatorPowers,
+		Validators:             data.Validators,
+		Delegations:            data.Delegations,
+		UnbondingDelegations:   data.UnbondingDelegations,
+		Redelegations:          data.Redelegations,
+		Exported:               data.Exported,
+		VeValidators:           veValidators,
+		VeDelegations:          veDelegations,
+		VeUnbondingDelegations: veUnbondingDelegations,
+		VeRedelegations:        veRedelegations,
+		VeDelegatedAmounts:     veDelegatedAmounts,
+		VeAutoCompounds:        veAutoCompounds,
+		VeAutoCompoundEntries:  veAutoCompoundEntries,
+	}
+}
+
 // DefaultGenesis gets the raw genesis raw message for testing
-func DefaultGenesis() *stakingtypes.GenesisState {
+func DefaultGenesis() *GenesisState {
 	params := stakingtypes.DefaultParams()
 	params.BondDenom = gridiron.BaseDenom
+	return NewGenesisState(&stakingtypes.GenesisState{
+		Params:         params,
+		LastTotalPower: sdk.ZeroInt(),
+	}, nil, nil, nil, nil, nil, nil, nil)
+}
+
+// StakingGenesisState returns the wrapped cosmos staking genesis state.
+func (gs GenesisState) StakingGenesisState() *stakingtypes.GenesisState {
 	return &stakingtypes.GenesisState{
-		Params: params,
+		Params:               gs.Params,
+		LastTotalPower:       gs.LastTotalPower,
+		LastValidatorPowers:  gs.LastValidatorPowers,
+		Validators:           gs.Validators,
+		Delegations:          gs.Delegations,
+		UnbondingDelegations: gs.UnbondingDelegations,
+		Redelegations:        gs.Redelegations,
+		Exported:             gs.Exported,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
+	for i := range gs.Validators {
+		if err := gs.Validators[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. The ve-specific records must agree with the cosmos staking ones.
+func (gs GenesisState) Validate() error {
+	if err := staking.ValidateGenesis(gs.StakingGenesisState()); err != nil {
+		return err
+	}
+
+	validators := make(map[string]stakingtypes.Validator)
+	for _, validator := range gs.Validators {
+		validators[validator.OperatorAddress] = validator
+	}
+	delegations := make(map[string]stakingtypes.Delegation)
+	for _, delegation := range gs.Delegations {
+		delegations[delegation.DelegatorAddress+"/"+delegation.ValidatorAddress] = delegation
+	}
+	unbondingDelegations := make(map[string]stakingtypes.UnbondingDelegation)
+	for _, ubd := range gs.UnbondingDelegations {
+		unbondingDelegations[ubd.DelegatorAddress+"/"+ubd.ValidatorAddress] = ubd
+	}
+	redelegations := make(map[string]stakingtypes.Redelegation)
+	for _, red := range gs.Redelegations {
+		redelegations[red.DelegatorAddress+"/"+red.ValidatorSrcAddress+"/"+red.ValidatorDstAddress] = red
+	}
+
+	veValidators := make(map[string]VeValidator)
+	for _, veValidator := range gs.VeValidators {
+		validator, ok := validators[veValidator.OperatorAddress]
+		if !ok {
+			return fmt.Errorf("validator of ve validator %s not found", veValidator.OperatorAddress)
+		}
+		if _, ok := veValidators[veValidator.OperatorAddress]; ok {
+			return fmt.Errorf("duplicate ve validator %s", veValidator.OperatorAddress)
+		}
+		if veValidator.VeDelegatorShares.IsNil() || veValidator.VeDelegatorShares.IsNegative() ||
+			veValidator.VeDelegatorShares.GT(validator.DelegatorShares) {
+			return fmt.Errorf("invalid ve delegator shares of ve validator %s: %s", veValidator.OperatorAddress, veValidator.VeDelegatorShares)
+		}
+		veValidators[veValidator.OperatorAddress] = veValidator
+	}
+
+	// tokens of the ves in delegations and unbonding delegations
+	veTokens := make(map[uint64]sdk.Int)
+	// ve shares of the validators in delegations
+	veValidatorShares := make(map[string]sdk.Dec)
+
+	veDelegations := make(map[string]bool)
+	for _, veDelegation := range gs.VeDelegations {
+		key := veDelegation.DelegatorAddress + "/" + veDelegation.ValidatorAddress
+		delegation, ok := delegations[key]
+		if !ok {
+			return fmt.Errorf("delegation of ve delegation %s not found", key)
+		}
+		if veDelegations[key] {
+			return fmt.Errorf("duplicate ve delegation %s", key)
+		}
+		veDelegations[key] = true
+		if _, ok := veValidators[veDelegation.ValidatorAddress]; !ok {
+			return fmt.Errorf("ve validator of ve delegation %s not found", key)
+		}
+		if len(veDelegation.VeShares) == 0 {
+			return fmt.Errorf("empty ve delegation %s", key)
+		}
+
+		veIDs := make(map[uint64]bool)
+		for _, shares := range veDelegation.VeShares {
+			if shares.VeId == vetypes.EmptyVeID || veIDs[shares.VeId] {
+				return fmt.Errorf("invalid or duplicate ve id %d in ve delegation %s", shares.VeId, key)
+			}
+			veIDs[shares.VeId] = true
+			if shares.Shares.IsNil() || !shares.Shares.IsPositive() ||
+				shares.TokensMayUnsettled.IsNil() || shares.TokensMayUnsettled.IsNegative() {
+				return fmt.Errorf("invalid shares of ve %d in ve delegation %s", shares.VeId, key)
+			}
+			veTokens = VeTokensSlice{{VeId: shares.VeId, Tokens: shares.TokensMayUnsettled}}.AddToMap(veTokens)
+		}
+		if veDelegation.Shares().GT(delegation.Shares) {
+			return fmt.Errorf("ve delegation %s has more shares than the delegation", key)
+		}
+		if shares, ok := veValidatorShares[veDelegation.ValidatorAddress]; ok {
+			veValidatorShares[veDelegation.ValidatorAddress] = shares.Add(veDelegation.Shares())
+		} else {
+			veValidatorShares[veDelegation.ValidatorAddress] = veDelegation.Shares()
+		}
+	}
+	for _, veValidator := range gs.VeValidators {
+		shares, ok := veValidatorShares[veValidator.OperatorAddress]
+		if !ok {
+			shares = sdk.ZeroDec()
+		}
+		if !veValidator.VeDelegatorShares.Equal(shares) {
+			return fmt.Errorf("ve delegator shares of ve validator %s is %s, but its ve delegations have %s", veValidator.OperatorAddress, veValidator.VeDelegatorShares, shares)
+		}
+	}
+
+	veUnbondingDelegations := make(map[string]bool)
+	for _, veUbd := range gs.VeUnbondingDelegations {
+		key := veUbd.DelegatorAddress + "/" + veUbd.ValidatorAddress
+		ubd, ok := unbondingDelegations[key]
+		if !ok {
+			return fmt.Errorf("unbonding delegation of ve unbonding delegation %s not found", key)
+		}
+		if veUnbondingDelegations[key] {
+			return fmt.Errorf("duplicate ve unbonding delegation %s", key)
+		}
+		veUnbondingDelegations[key] = true
+		if len(veUbd.Entries) != len(ubd.Entries) {
+			return fmt.Errorf("inconsistent entries of ve unbonding delegation %s", key)
+		}
+		for i, entry := range veUbd.Entries {
+			for _, b := range entry.VeBalances {
+				if b.VeId == vetypes.EmptyVeID || b.Balance.IsNil() || b.Balance.IsNegative() {
+					return fmt.Errorf("invalid balance of ve %d in ve unbonding delegation %s", b.VeId, key)
+				}
+				veTokens = VeTokensSlice{{VeId: b.VeId, Tokens: b.Balance}}.AddToMap(veTokens)
+			}
+			if entry.Balance().GT(ubd.Entries[i].Balance) {
+				return fmt.Errorf("ve unbonding delegation %s has more balance than the unbonding delegation", key)
+			}
+		}
+	}
+
+	veRedelegations := make(map[string]bool)
+	for _, veRed := range gs.VeRedelegations {
+		key := veRed.DelegatorAddress + "/" + veRed.ValidatorSrcAddress + "/" + veRed.ValidatorDstAddress
+		red, ok := redelegations[key]
+		if !ok {
+			return fmt.Errorf("redelegation of ve redelegation %s not found", key)
+		}
+		if veRedelegations[key] {
+			return fmt.Errorf("duplicate ve redelegation %s", key)
+		}
+		veRedelegations[key] = true
+		if len(veRed.Entries) != len(red.Entries) {
+			return fmt.Errorf("inconsistent entries of ve redelegation %s", key)
+		}
+		for i, entry := range veRed.Entries {
+			if entry.InitialBalance().GT(red.Entries[i].InitialBalance) {
+				return fmt.Errorf("ve redelegation %s has more balance than the redelegation", key)
+			}
+		}
+	}
+
+	// delegated amount of a ve is tracked until its unbonding completes
+	veDelegatedAmounts := make(map[uint64]bool)
+	for _, amount := range gs.VeDelegatedAmounts {
+		if veDelegatedAmounts[amount.VeId] {
+			return fmt.Errorf("duplicate delegated amount of ve %d", amount.VeId)
+		}
+		veDelegatedAmounts[amount.VeId] = true
+		tokens, ok := veTokens[amount.VeId]
+		if !ok {
+			tokens = sdk.ZeroInt()
+		}
+		if amount.Tokens.IsNil() || !amount.Tokens.Equal(tokens) {
+			return fmt.Errorf("delegated amount of ve %d is %s, but its delegations and unbonding delegations have %s", amount.VeId, amount.Tokens, tokens)
+		}
+	}
+	for veID, tokens := range veTokens {
+		if !veDelegatedAmounts[veID] && tokens.IsPositive() {
+			return fmt.Errorf("delegated amount of ve %d not found", veID)
+		}
+	}
+
+	veAutoCompounds := make(map[uint64]bool)
+	for _, veID := range gs.VeAutoCompounds {
+		if veID == vetypes.EmptyVeID || veAutoCompounds[veID] {
+			return fmt.Errorf("invalid or duplicate auto-compound ve id %d", veID)
+		}
+		veAutoCompounds[veID] = true
+	}
+	for _, entry := range gs.VeAutoCompoundEntries {
+		if _, err := sdk.AccAddressFromBech32(entry.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator address of auto-compound entry: %w", err)
+		}
+		if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address of auto-compound entry: %w", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.WithdrawAddress); err != nil {
+			return fmt.Errorf("invalid withdraw address of auto-compound entry: %w", err)
+		}
+		if entry.VeId == vetypes.EmptyVeID {
+			return fmt.Errorf("invalid ve id of auto-compound entry")
+		}
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return fmt.Errorf("invalid amount of auto-compound entry of ve %d", entry.VeId)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,1141 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gridiron/staking/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the staking module's genesis state. It wraps the
+// fields of the cosmos staking genesis state, so that the latter remains
+// importable, along with the ve-specific records.
+type GenesisState struct {
+	Params                 types.Params                           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastTotalPower         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=last_total_power,json=lastTotalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_total_power" yaml:"last_total_power"`
+	LastValidatorPowers    []types.LastValidatorPower             `protobuf:"bytes,3,rep,name=last_validator_powers,json=lastValidatorPowers,proto3" json:"last_validator_powers" yaml:"last_validator_powers"`
+	Validators             []types.Validator                      `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
+	Delegations            []types.Delegation                     `protobuf:"bytes,5,rep,name=delegations,proto3" json:"delegations"`
+	UnbondingDelegations   []types.UnbondingDelegation            `protobuf:"bytes,6,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Redelegations          []types.Redelegation                   `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
+	Exported               bool                                   `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	VeValidators           []VeValidator                          `protobuf:"bytes,9,rep,name=ve_validators,json=veValidators,proto3" json:"ve_validators" yaml:"ve_validators"`
+	VeDelegations          []VeDelegation                         `protobuf:"bytes,10,rep,name=ve_delegations,json=veDelegations,proto3" json:"ve_delegations" yaml:"ve_delegations"`
+	VeUnbondingDelegations []VeUnbondingDelegation                `protobuf:"bytes,11,rep,name=ve_unbonding_delegations,json=veUnbondingDelegations,proto3" json:"ve_unbonding_delegations" yaml:"ve_unbonding_delegations"`
+	VeRedelegations        []VeRedelegation                       `protobuf:"bytes,12,rep,name=ve_redelegations,json=veRedelegations,proto3" json:"ve_redelegations" yaml:"ve_redelegations"`
+	// total delegated amounts of the ves, including the amounts still unbonding
+	VeDelegatedAmounts []VeTokens `protobuf:"bytes,13,rep,name=ve_delegated_amounts,json=veDelegatedAmounts,proto3" json:"ve_delegated_amounts" yaml:"ve_delegated_amounts"`
+	// ves of which the staking rewards are auto-compounded
+	VeAutoCompounds []uint64 `protobuf:"varint,14,rep,packed,name=ve_auto_compounds,json=veAutoCompounds,proto3" json:"ve_auto_compounds,omitempty" yaml:"ve_auto_compounds"`
+	// withdrawn rewards pending to be compounded
+	VeAutoCompoundEntries []VeAutoCompoundEntry `protobuf:"bytes,15,rep,name=ve_auto_compound_entries,json=veAutoCompoundEntries,proto3" json:"ve_auto_compound_entries" yaml:"ve_auto_compound_entries"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a18b1e5a99e9ffc, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gridiron.staking.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("gridiron/staking/v1/genesis.proto", fileDescriptor_3a18b1e5a99e9ffc) }
+
+var fileDescriptor_3a18b1e5a99e9ffc = []byte{
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x95, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x1c, 0xc6, 0xe3, 0xb7, 0x7d, 0xfb, 0xe6, 0xbd, 0x24, 0x6d, 0xb9, 0x26, 0xd4, 0x2a, 0xad, 0x9d,
+	0xba, 0x15, 0x44, 0xa0, 0xda, 0x6a, 0xd9, 0x2a, 0x96, 0x06, 0x50, 0x29, 0x30, 0x54, 0xa6, 0x74,
+	0x60, 0xb1, 0x2e, 0xf1, 0xc9, 0x58, 0x4d, 0xee, 0x22, 0xdf, 0xd9, 0xb4, 0xcc, 0x08, 0x21, 0x24,
+	0x24, 0xfe, 0x84, 0xfe, 0x39, 0x1d, 0x3b, 0x22, 0x86, 0x08, 0xb5, 0x0b, 0x73, 0x47, 0x26, 0x94,
+	0xb3, 0xe3, 0x9c, 0x53, 0x7b, 0x4a, 0x7c, 0x79, 0x9e, 0xcf, 0xf3, 0xfd, 0x11, 0xdb, 0x60, 0xdd,
+	0x0b, 0x7c, 0xd7, 0x0f, 0x28, 0xb1, 0x18, 0x47, 0x27, 0x3e, 0xf1, 0xac, 0x68, 0xdb, 0xf2, 0x30,
+	0xc1, 0xcc, 0x67, 0xe6, 0x20, 0xa0, 0x9c, 0xc2, 0xa5, 0xb1, 0xc4, 0x4c, 0x24, 0x66, 0xb4, 0xbd,
+	0x52, 0xf7, 0xa8, 0x47, 0xc5, 0xef, 0xd6, 0xe8, 0x5b, 0x2c, 0x5d, 0xd9, 0xec, 0x52, 0xd6, 0xa7,
+	0x4c, 0x62, 0x75, 0x30, 0x47, 0x53, 0xc0, 0x42, 0xd5, 0x18, 0x1f, 0xab, 0x72, 0x2b, 0xcb, 0x48,
+	0x8c, 0x3f, 0x15, 0x50, 0xdd, 0x8f, 0xd1, 0x6f, 0x38, 0xe2, 0x18, 0x3e, 0x01, 0x73, 0x03, 0x14,
+	0xa0, 0x3e, 0x53, 0x95, 0xa6, 0xd2, 0xaa, 0xec, 0x68, 0x66, 0x1c, 0x25, 0x55, 0x2e, 0xa2, 0xcc,
+	0x43, 0xa1, 0x6a, 0xcf, 0x5e, 0x0c, 0xf5, 0x92, 0x9d, 0x78, 0x20, 0x03, 0x8b, 0x3d, 0xc4, 0xb8,
+	0xc3, 0x29, 0x47, 0x3d, 0x67, 0x40, 0x3f, 0xe0, 0x40, 0xfd, 0xa7, 0xa9, 0xb4, 0xaa, 0xed, 0x83,
+	0x91, 0xee, 0xe7, 0x50, 0xbf, 0xef, 0xf9, 0xfc, 0x7d, 0xd8, 0x31, 0xbb, 0xb4, 0x6f, 0x25, 0x4d,
+	0xc4, 0x1f, 0x5b, 0xcc, 0x3d, 0xb1, 0xf8, 0xd9, 0x00, 0x33, 0xf3, 0x80, 0xf0, 0x9b, 0xa1, 0xbe,
+	0x7c, 0x86, 0xfa, 0xbd, 0x5d, 0x63, 0x9a, 0x67, 0xd8, 0xf3, 0xa3, 0xa3, 0xa3, 0xd1, 0xc9, 0xe1,
+	0xe8, 0x00, 0x7e, 0x52, 0x40, 0x43, 0xa8, 0x22, 0xd4, 0xf3, 0x5d, 0xc4, 0x69, 0x10, 0x2b, 0x99,
+	0x3a, 0xd3, 0x9c, 0x69, 0x55, 0x76, 0x1e, 0x16, 0xb5, 0xf0, 0x1a, 0x31, 0x7e, 0x3c, 0xf6, 0x08,
+	0x56, 0x7b, 0x73, 0x54, 0xe6, 0xcd, 0x50, 0x5f, 0x95, 0xc2, 0xa7, 0xb1, 0x86, 0xbd, 0xd4, 0xbb,
+	0xe5, 0x64, 0x70, 0x1f, 0x80, 0x54, 0xc9, 0xd4, 0x59, 0x11, 0xbd, 0x5e, 0x14, 0x9d, 0x9a, 0x93,
+	0x01, 0x4a, 0x56, 0xf8, 0x12, 0x54, 0x5c, 0xdc, 0xc3, 0x1e, 0xe2, 0x3e, 0x25, 0x4c, 0xfd, 0x57,
+	0x90, 0x8c, 0x22, 0xd2, 0xb3, 0x54, 0x9a, 0xa0, 0x64, 0x33, 0xfc, 0xac, 0x80, 0x46, 0x48, 0x3a,
+	0x94, 0xb8, 0x3e, 0xf1, 0x1c, 0x19, 0x3b, 0x27, 0xb0, 0x8f, 0x8a, 0xb0, 0x6f, 0xc7, 0x26, 0x89,
+	0x3f, 0x35, 0x9c, 0x5c, 0xae, 0x61, 0xd7, 0xc3, 0xdb, 0x56, 0x06, 0x0f, 0x41, 0x2d, 0xc0, 0x72,
+	0xfe, 0x7f, 0x22, 0x7f, 0xb3, 0x28, 0xdf, 0xc6, 0xee, 0x74, 0x63, 0x59, 0x00, 0x5c, 0x01, 0x65,
+	0x7c, 0x3a, 0xa0, 0x01, 0xc7, 0xae, 0x5a, 0x6e, 0x2a, 0xad, 0xb2, 0x9d, 0x5e, 0xc3, 0x2e, 0xa8,
+	0x45, 0xd8, 0x91, 0xd6, 0xf1, 0xbf, 0x48, 0x6b, 0x9a, 0x39, 0x37, 0xa2, 0x79, 0x8c, 0x27, 0xdb,
+	0x58, 0x4d, 0x5a, 0xac, 0xc7, 0x2d, 0x66, 0x20, 0x86, 0x5d, 0x8d, 0x26, 0x52, 0x06, 0x3d, 0x30,
+	0x1f, 0xe1, 0xcc, 0x4c, 0x41, 0xb2, 0xf4, 0xfc, 0x14, 0x69, 0x92, 0x6b, 0x49, 0x4c, 0x23, 0x8d,
+	0xc9, 0x8c, 0xb0, 0x16, 0x61, 0x79, 0x76, 0xdf, 0x14, 0xa0, 0x46, 0xd8, 0xc9, 0xdf, 0x63, 0x25,
+	0xf9, 0x8f, 0xe7, 0x67, 0xe6, 0xad, 0xf1, 0x41, 0x12, 0xae, 0xa7, 0xe1, 0x05, 0x9b, 0xbc, 0x1b,
+	0xe5, 0xf9, 0x19, 0xa4, 0x60, 0x31, 0xc2, 0x4e, 0x76, 0x9d, 0x55, 0x51, 0xc6, 0x46, 0x41, 0x19,
+	0x99, 0x6d, 0xea, 0x49, 0xfe, 0x72, 0x9a, 0x9f, 0x41, 0x19, 0xf6, 0x42, 0x94, 0x31, 0x30, 0xc8,
+	0x41, 0x7d, 0x32, 0x22, 0xec, 0x3a, 0xa8, 0x4f, 0x43, 0xc2, 0x99, 0x5a, 0x13, 0xa1, 0x6b, 0x05,
+	0xa1, 0x47, 0xf4, 0x04, 0x13, 0xd6, 0xde, 0x48, 0xe2, 0xee, 0x4d, 0xcf, 0x7a, 0x02, 0x32, 0x6c,
+	0x98, 0x4e, 0x1c, 0xbb, 0x7b, 0xf1, 0x21, 0x7c, 0x01, 0xee, 0x44, 0xd8, 0x41, 0x21, 0xa7, 0x4e,
+	0x97, 0xf6, 0x07, 0x34, 0x24, 0x2e, 0x53, 0xe7, 0x9b, 0x33, 0xad, 0xd9, 0xf6, 0xea, 0xcd, 0x50,
+	0x57, 0x53, 0x5e, 0x56, 0x22, 0xea, 0xdf, 0x0b, 0x39, 0x7d, 0x3a, 0x3e, 0x81, 0x5f, 0xe3, 0x05,
+	0x66, 0x74, 0x0e, 0x26, 0x3c, 0xf0, 0x31, 0x53, 0x17, 0x44, 0x13, 0xad, 0x82, 0x26, 0x64, 0xd0,
+	0x73, 0xc2, 0x83, 0xb3, 0x9c, 0xf5, 0xe5, 0x72, 0x0d, 0xbb, 0x11, 0xdd, 0x72, 0xfb, 0x98, 0xed,
+	0x96, 0xbf, 0x9c, 0xeb, 0xa5, 0xdf, 0xe7, 0x7a, 0xa9, 0xfd, 0xea, 0xe2, 0x4a, 0x53, 0x2e, 0xaf,
+	0x34, 0xe5, 0xd7, 0x95, 0xa6, 0x7c, 0xbf, 0xd6, 0x4a, 0x97, 0xd7, 0x5a, 0xe9, 0xc7, 0xb5, 0x56,
+	0x7a, 0xb7, 0x2d, 0x3d, 0xa5, 0xc7, 0x75, 0x6d, 0x7d, 0xa4, 0x04, 0xa7, 0x57, 0xd6, 0x69, 0xfa,
+	0x52, 0x11, 0x0f, 0xed, 0xce, 0x9c, 0x78, 0xa1, 0x3c, 0xfe, 0x3b, 0x00, 0x13, 0x2a, 0x7e, 0xe3,
+	0x0f, 0x07, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeAutoCompoundEntries) > 0 {
+		for iNdEx := len(m.VeAutoCompoundEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeAutoCompoundEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.VeAutoCompounds) > 0 {
+		dAtA2 := make([]byte, len(m.VeAutoCompounds)*10)
+		var j1 int
+		for _, num := range m.VeAutoCompounds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.VeDelegatedAmounts) > 0 {
+		for iNdEx := len(m.VeDelegatedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegatedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.VeRedelegations) > 0 {
+		for iNdEx := len(m.VeRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VeUnbondingDelegations) > 0 {
+		for iNdEx := len(m.VeUnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeUnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VeDelegations) > 0 {
+		for iNdEx := len(m.VeDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VeValidators) > 0 {
+		for iNdEx := len(m.VeValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Exported {
+		i--
+		if m.Exported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LastValidatorPowers) > 0 {
+		for iNdEx := len(m.LastValidatorPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastValidatorPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.LastTotalPower.Size()
+		i -= size
+		if _, err := m.LastTotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LastTotalPower.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LastValidatorPowers) > 0 {
+		for _, e := range m.LastValidatorPowers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Exported {
+		n += 2
+	}
+	if len(m.VeValidators) > 0 {
+		for _, e := range m.VeValidators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeDelegations) > 0 {
+		for _, e := range m.VeDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeUnbondingDelegations) > 0 {
+		for _, e := range m.VeUnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeRedelegations) > 0 {
+		for _, e := range m.VeRedelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeDelegatedAmounts) > 0 {
+		for _, e := range m.VeDelegatedAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeAutoCompounds) > 0 {
+		l = 0
+		for _, e := range m.VeAutoCompounds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.VeAutoCompoundEntries) > 0 {
+		for _, e := range m.VeAutoCompoundEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTotalPower", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastValidatorPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastValidatorPowers = append(m.LastValidatorPowers, types.LastValidatorPower{})
+			if err := m.LastValidatorPowers[len(m.LastValidatorPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, types.Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, types.Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingDelegations = append(m.UnbondingDelegations, types.UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, types.Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeValidators = append(m.VeValidators, VeValidator{})
+			if err := m.VeValidators[len(m.VeValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegations = append(m.VeDelegations, VeDelegation{})
+			if err := m.VeDelegations[len(m.VeDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeUnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeUnbondingDelegations = append(m.VeUnbondingDelegations, VeUnbondingDelegation{})
+			if err := m.VeUnbondingDelegations[len(m.VeUnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeRedelegations = append(m.VeRedelegations, VeRedelegation{})
+			if err := m.VeRedelegations[len(m.VeRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegatedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegatedAmounts = append(m.VeDelegatedAmounts, VeTokens{})
+			if err := m.VeDelegatedAmounts[len(m.VeDelegatedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VeAutoCompounds = append(m.VeAutoCompounds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VeAutoCompounds) == 0 {
+					m.VeAutoCompounds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VeAutoCompounds = append(m.VeAutoCompounds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeAutoCompounds", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeAutoCompoundEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeAutoCompoundEntries = append(m.VeAutoCompoundEntries, VeAutoCompoundEntry{})
+			if err := m.VeAutoCompoundEntries[len(m.VeAutoCompoundEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
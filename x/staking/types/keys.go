@@ -11,8 +11,15 @@ var (
 	VeUnbondingDelegationKey = []byte{0xA3}
 	VeRedelegationKey        = []byte{0xA4}
 	VeTokensKey              = []byte{0xA5}
+	VeAutoCompoundKey        = []byte{0xA6}
+	VeAutoCompoundQueueKey   = []byte{0xA7}
+	VeAutoCompoundSeqKey     = []byte{0xA8}
 )
 
+// VeAutoCompoundBatchSize is the max number of pending auto-compound entries
+// processed in an EndBlocker.
+const VeAutoCompoundBatchSize = 100
+
 func GetVeValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(VeValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
@@ -53,3 +60,11 @@ func GetVeREDsKey(delAddr sdk.AccAddress) []byte {
 func GetVeTokensKey(veID uint64) []byte {
 	return append(VeTokensKey, sdk.Uint64ToBigEndian(veID)...)
 }
+
+func GetVeAutoCompoundKey(veID uint64) []byte {
+	return append(VeAutoCompoundKey, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func GetVeAutoCompoundQueueKey(seq uint64) []byte {
+	return append(VeAutoCompoundQueueKey, sdk.Uint64ToBigEndian(seq)...)
+}
@@ -8,7 +8,10 @@ import (
 )
 
 const (
-	TypeMsgVeDelegate = "ve_delegate"
+	TypeMsgVeDelegate        = "ve_delegate"
+	TypeMsgSetVeAutoCompound = "set_ve_auto_compound"
+	TypeMsgVeUndelegate      = "ve_undelegate"
+	TypeMsgVeRedelegate      = "ve_redelegate"
 )
 
 // Route implements the sdk.Msg interface.
@@ -54,3 +57,130 @@ func (m MsgVeDelegate) ValidateBasic() error {
 
 	return nil
 }
+
+// Route implements the sdk.Msg interface.
+func (m MsgSetVeAutoCompound) Route() string { return stakingtypes.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSetVeAutoCompound) Type() string { return TypeMsgSetVeAutoCompound }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSetVeAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgSetVeAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSetVeAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if vetypes.Uint64FromVeID(m.VeId) == vetypes.EmptyVeID {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ve id")
+	}
+
+	return nil
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgVeUndelegate) Route() string { return stakingtypes.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgVeUndelegate) Type() string { return TypeMsgVeUndelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgVeUndelegate) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgVeUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgVeUndelegate) ValidateBasic() error {
+	if m.DelegatorAddress == "" {
+		return stakingtypes.ErrEmptyDelegatorAddr
+	}
+
+	if m.ValidatorAddress == "" {
+		return stakingtypes.ErrEmptyValidatorAddr
+	}
+
+	return validateVeAmounts(m.Amounts)
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgVeRedelegate) Route() string { return stakingtypes.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgVeRedelegate) Type() string { return TypeMsgVeRedelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgVeRedelegate) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgVeRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgVeRedelegate) ValidateBasic() error {
+	if m.DelegatorAddress == "" {
+		return stakingtypes.ErrEmptyDelegatorAddr
+	}
+
+	if m.ValidatorSrcAddress == "" {
+		return stakingtypes.ErrEmptyValidatorAddr
+	}
+
+	if m.ValidatorDstAddress == "" {
+		return stakingtypes.ErrEmptyValidatorAddr
+	}
+
+	return validateVeAmounts(m.Amounts)
+}
+
+func validateVeAmounts(amounts []VeAmount) error {
+	if len(amounts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty ve amounts")
+	}
+
+	veIDs := make(map[uint64]bool, len(amounts))
+	for _, amount := range amounts {
+		veID := vetypes.Uint64FromVeID(amount.VeId)
+		if veID == vetypes.EmptyVeID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ve id")
+		}
+		if veIDs[veID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate ve id %s", amount.VeId)
+		}
+		veIDs[veID] = true
+
+		if !amount.Amount.IsValid() || !amount.Amount.Amount.IsPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+		}
+	}
+
+	return nil
+}
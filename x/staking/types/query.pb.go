@@ -4,9 +4,21 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -20,17 +32,6310 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VeDelegationResponse is a ve delegation with its tokens settled against the
+// validator, along with the total delegated amounts of its ves.
+type VeDelegationResponse struct {
+	VeDelegation       VeDelegation `protobuf:"bytes,1,opt,name=ve_delegation,json=veDelegation,proto3" json:"ve_delegation"`
+	VeDelegatedAmounts []VeTokens   `protobuf:"bytes,2,rep,name=ve_delegated_amounts,json=veDelegatedAmounts,proto3" json:"ve_delegated_amounts"`
+}
+
+func (m *VeDelegationResponse) Reset()         { *m = VeDelegationResponse{} }
+func (m *VeDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*VeDelegationResponse) ProtoMessage()    {}
+func (*VeDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{0}
+}
+func (m *VeDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeDelegationResponse.Merge(m, src)
+}
+func (m *VeDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeDelegationResponse proto.InternalMessageInfo
+
+func (m *VeDelegationResponse) GetVeDelegation() VeDelegation {
+	if m != nil {
+		return m.VeDelegation
+	}
+	return VeDelegation{}
+}
+
+func (m *VeDelegationResponse) GetVeDelegatedAmounts() []VeTokens {
+	if m != nil {
+		return m.VeDelegatedAmounts
+	}
+	return nil
+}
+
+// VeUnbondingDelegationResponse is a ve unbonding delegation along with the
+// unbonding delegation of which the entries are one-to-one with it.
+type VeUnbondingDelegationResponse struct {
+	VeUnbondingDelegation VeUnbondingDelegation     `protobuf:"bytes,1,opt,name=ve_unbonding_delegation,json=veUnbondingDelegation,proto3" json:"ve_unbonding_delegation"`
+	UnbondingDelegation   types.UnbondingDelegation `protobuf:"bytes,2,opt,name=unbonding_delegation,json=unbondingDelegation,proto3" json:"unbonding_delegation"`
+}
+
+func (m *VeUnbondingDelegationResponse) Reset()         { *m = VeUnbondingDelegationResponse{} }
+func (m *VeUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*VeUnbondingDelegationResponse) ProtoMessage()    {}
+func (*VeUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{1}
+}
+func (m *VeUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeUnbondingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeUnbondingDelegationResponse.Merge(m, src)
+}
+func (m *VeUnbondingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeUnbondingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeUnbondingDelegationResponse proto.InternalMessageInfo
+
+func (m *VeUnbondingDelegationResponse) GetVeUnbondingDelegation() VeUnbondingDelegation {
+	if m != nil {
+		return m.VeUnbondingDelegation
+	}
+	return VeUnbondingDelegation{}
+}
+
+func (m *VeUnbondingDelegationResponse) GetUnbondingDelegation() types.UnbondingDelegation {
+	if m != nil {
+		return m.UnbondingDelegation
+	}
+	return types.UnbondingDelegation{}
+}
+
+// VeRedelegationResponse is a ve redelegation along with the redelegation of
+// which the entries are one-to-one with it.
+type VeRedelegationResponse struct {
+	VeRedelegation VeRedelegation     `protobuf:"bytes,1,opt,name=ve_redelegation,json=veRedelegation,proto3" json:"ve_redelegation"`
+	Redelegation   types.Redelegation `protobuf:"bytes,2,opt,name=redelegation,proto3" json:"redelegation"`
+}
+
+func (m *VeRedelegationResponse) Reset()         { *m = VeRedelegationResponse{} }
+func (m *VeRedelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*VeRedelegationResponse) ProtoMessage()    {}
+func (*VeRedelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{2}
+}
+func (m *VeRedelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeRedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeRedelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeRedelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeRedelegationResponse.Merge(m, src)
+}
+func (m *VeRedelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeRedelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeRedelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeRedelegationResponse proto.InternalMessageInfo
+
+func (m *VeRedelegationResponse) GetVeRedelegation() VeRedelegation {
+	if m != nil {
+		return m.VeRedelegation
+	}
+	return VeRedelegation{}
+}
+
+func (m *VeRedelegationResponse) GetRedelegation() types.Redelegation {
+	if m != nil {
+		return m.Redelegation
+	}
+	return types.Redelegation{}
+}
+
+type QueryVeValidatorRequest struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryVeValidatorRequest) Reset()         { *m = QueryVeValidatorRequest{} }
+func (m *QueryVeValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeValidatorRequest) ProtoMessage()    {}
+func (*QueryVeValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{3}
+}
+func (m *QueryVeValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeValidatorRequest.Merge(m, src)
+}
+func (m *QueryVeValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryVeValidatorRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+type QueryVeValidatorResponse struct {
+	VeValidator VeValidator `protobuf:"bytes,1,opt,name=ve_validator,json=veValidator,proto3" json:"ve_validator"`
+}
+
+func (m *QueryVeValidatorResponse) Reset()         { *m = QueryVeValidatorResponse{} }
+func (m *QueryVeValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeValidatorResponse) ProtoMessage()    {}
+func (*QueryVeValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{4}
+}
+func (m *QueryVeValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeValidatorResponse.Merge(m, src)
+}
+func (m *QueryVeValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryVeValidatorResponse) GetVeValidator() VeValidator {
+	if m != nil {
+		return m.VeValidator
+	}
+	return VeValidator{}
+}
+
+type QueryVeDelegationRequest struct {
+	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryVeDelegationRequest) Reset()         { *m = QueryVeDelegationRequest{} }
+func (m *QueryVeDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeDelegationRequest) ProtoMessage()    {}
+func (*QueryVeDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{5}
+}
+func (m *QueryVeDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeDelegationRequest.Merge(m, src)
+}
+func (m *QueryVeDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeDelegationRequest proto.InternalMessageInfo
+
+type QueryVeDelegationResponse struct {
+	VeDelegationResponse VeDelegationResponse `protobuf:"bytes,1,opt,name=ve_delegation_response,json=veDelegationResponse,proto3" json:"ve_delegation_response"`
+}
+
+func (m *QueryVeDelegationResponse) Reset()         { *m = QueryVeDelegationResponse{} }
+func (m *QueryVeDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeDelegationResponse) ProtoMessage()    {}
+func (*QueryVeDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{6}
+}
+func (m *QueryVeDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeDelegationResponse.Merge(m, src)
+}
+func (m *QueryVeDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryVeDelegationResponse) GetVeDelegationResponse() VeDelegationResponse {
+	if m != nil {
+		return m.VeDelegationResponse
+	}
+	return VeDelegationResponse{}
+}
+
+type QueryDelegatorVeDelegationsRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorVeDelegationsRequest) Reset()         { *m = QueryDelegatorVeDelegationsRequest{} }
+func (m *QueryDelegatorVeDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorVeDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegatorVeDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{7}
+}
+func (m *QueryDelegatorVeDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorVeDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorVeDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorVeDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorVeDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorVeDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorVeDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorVeDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorVeDelegationsRequest proto.InternalMessageInfo
+
+type QueryDelegatorVeDelegationsResponse struct {
+	VeDelegationResponses []VeDelegationResponse `protobuf:"bytes,1,rep,name=ve_delegation_responses,json=veDelegationResponses,proto3" json:"ve_delegation_responses"`
+	Pagination            *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorVeDelegationsResponse) Reset()         { *m = QueryDelegatorVeDelegationsResponse{} }
+func (m *QueryDelegatorVeDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorVeDelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegatorVeDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{8}
+}
+func (m *QueryDelegatorVeDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorVeDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorVeDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorVeDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorVeDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorVeDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorVeDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorVeDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorVeDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorVeDelegationsResponse) GetVeDelegationResponses() []VeDelegationResponse {
+	if m != nil {
+		return m.VeDelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryDelegatorVeDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryValidatorVeDelegationsRequest struct {
+	ValidatorAddr string             `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorVeDelegationsRequest) Reset()         { *m = QueryValidatorVeDelegationsRequest{} }
+func (m *QueryValidatorVeDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorVeDelegationsRequest) ProtoMessage()    {}
+func (*QueryValidatorVeDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{9}
+}
+func (m *QueryValidatorVeDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorVeDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorVeDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorVeDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorVeDelegationsRequest.Merge(m, src)
+}
+func (m *QueryValidatorVeDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorVeDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorVeDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorVeDelegationsRequest proto.InternalMessageInfo
+
+type QueryValidatorVeDelegationsResponse struct {
+	VeDelegationResponses []VeDelegationResponse `protobuf:"bytes,1,rep,name=ve_delegation_responses,json=veDelegationResponses,proto3" json:"ve_delegation_responses"`
+	Pagination            *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorVeDelegationsResponse) Reset()         { *m = QueryValidatorVeDelegationsResponse{} }
+func (m *QueryValidatorVeDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorVeDelegationsResponse) ProtoMessage()    {}
+func (*QueryValidatorVeDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{10}
+}
+func (m *QueryValidatorVeDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorVeDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorVeDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorVeDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorVeDelegationsResponse.Merge(m, src)
+}
+func (m *QueryValidatorVeDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorVeDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorVeDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorVeDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorVeDelegationsResponse) GetVeDelegationResponses() []VeDelegationResponse {
+	if m != nil {
+		return m.VeDelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryValidatorVeDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeDelegationsByVeRequest struct {
+	VeId       string             `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeDelegationsByVeRequest) Reset()         { *m = QueryVeDelegationsByVeRequest{} }
+func (m *QueryVeDelegationsByVeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeDelegationsByVeRequest) ProtoMessage()    {}
+func (*QueryVeDelegationsByVeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{11}
+}
+func (m *QueryVeDelegationsByVeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeDelegationsByVeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeDelegationsByVeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeDelegationsByVeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeDelegationsByVeRequest.Merge(m, src)
+}
+func (m *QueryVeDelegationsByVeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeDelegationsByVeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeDelegationsByVeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeDelegationsByVeRequest proto.InternalMessageInfo
+
+func (m *QueryVeDelegationsByVeRequest) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *QueryVeDelegationsByVeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeDelegationsByVeResponse struct {
+	// total amount of the ve delegated, including the amount still unbonding
+	DelegatedAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
+	VeDelegationResponses []VeDelegationResponse                 `protobuf:"bytes,2,rep,name=ve_delegation_responses,json=veDelegationResponses,proto3" json:"ve_delegation_responses"`
+	Pagination            *query.PageResponse                    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeDelegationsByVeResponse) Reset()         { *m = QueryVeDelegationsByVeResponse{} }
+func (m *QueryVeDelegationsByVeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeDelegationsByVeResponse) ProtoMessage()    {}
+func (*QueryVeDelegationsByVeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{12}
+}
+func (m *QueryVeDelegationsByVeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeDelegationsByVeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeDelegationsByVeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeDelegationsByVeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeDelegationsByVeResponse.Merge(m, src)
+}
+func (m *QueryVeDelegationsByVeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeDelegationsByVeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeDelegationsByVeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeDelegationsByVeResponse proto.InternalMessageInfo
+
+func (m *QueryVeDelegationsByVeResponse) GetVeDelegationResponses() []VeDelegationResponse {
+	if m != nil {
+		return m.VeDelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryVeDelegationsByVeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeUnbondingDelegationRequest struct {
+	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryVeUnbondingDelegationRequest) Reset()         { *m = QueryVeUnbondingDelegationRequest{} }
+func (m *QueryVeUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeUnbondingDelegationRequest) ProtoMessage()    {}
+func (*QueryVeUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{13}
+}
+func (m *QueryVeUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeUnbondingDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeUnbondingDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeUnbondingDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeUnbondingDelegationRequest.Merge(m, src)
+}
+func (m *QueryVeUnbondingDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeUnbondingDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeUnbondingDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeUnbondingDelegationRequest proto.InternalMessageInfo
+
+type QueryVeUnbondingDelegationResponse struct {
+	Unbond VeUnbondingDelegationResponse `protobuf:"bytes,1,opt,name=unbond,proto3" json:"unbond"`
+}
+
+func (m *QueryVeUnbondingDelegationResponse) Reset()         { *m = QueryVeUnbondingDelegationResponse{} }
+func (m *QueryVeUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeUnbondingDelegationResponse) ProtoMessage()    {}
+func (*QueryVeUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{14}
+}
+func (m *QueryVeUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeUnbondingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeUnbondingDelegationResponse.Merge(m, src)
+}
+func (m *QueryVeUnbondingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeUnbondingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeUnbondingDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryVeUnbondingDelegationResponse) GetUnbond() VeUnbondingDelegationResponse {
+	if m != nil {
+		return m.Unbond
+	}
+	return VeUnbondingDelegationResponse{}
+}
+
+type QueryDelegatorVeUnbondingDelegationsRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) Reset() {
+	*m = QueryDelegatorVeUnbondingDelegationsRequest{}
+}
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorVeUnbondingDelegationsRequest) ProtoMessage() {}
+func (*QueryDelegatorVeUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{15}
+}
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorVeUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorVeUnbondingDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorVeUnbondingDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorVeUnbondingDelegationsRequest proto.InternalMessageInfo
+
+type QueryDelegatorVeUnbondingDelegationsResponse struct {
+	UnbondingResponses []VeUnbondingDelegationResponse `protobuf:"bytes,1,rep,name=unbonding_responses,json=unbondingResponses,proto3" json:"unbonding_responses"`
+	Pagination         *query.PageResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) Reset() {
+	*m = QueryDelegatorVeUnbondingDelegationsResponse{}
+}
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorVeUnbondingDelegationsResponse) ProtoMessage() {}
+func (*QueryDelegatorVeUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{16}
+}
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorVeUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorVeUnbondingDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorVeUnbondingDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorVeUnbondingDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) GetUnbondingResponses() []VeUnbondingDelegationResponse {
+	if m != nil {
+		return m.UnbondingResponses
+	}
+	return nil
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryValidatorVeUnbondingDelegationsRequest struct {
+	ValidatorAddr string             `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsRequest) Reset() {
+	*m = QueryValidatorVeUnbondingDelegationsRequest{}
+}
+func (m *QueryValidatorVeUnbondingDelegationsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorVeUnbondingDelegationsRequest) ProtoMessage() {}
+func (*QueryValidatorVeUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{17}
+}
+func (m *QueryValidatorVeUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorVeUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorVeUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorVeUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorVeUnbondingDelegationsRequest.Merge(m, src)
+}
+func (m *QueryValidatorVeUnbondingDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorVeUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorVeUnbondingDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorVeUnbondingDelegationsRequest proto.InternalMessageInfo
+
+type QueryValidatorVeUnbondingDelegationsResponse struct {
+	UnbondingResponses []VeUnbondingDelegationResponse `protobuf:"bytes,1,rep,name=unbonding_responses,json=unbondingResponses,proto3" json:"unbonding_responses"`
+	Pagination         *query.PageResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsResponse) Reset() {
+	*m = QueryValidatorVeUnbondingDelegationsResponse{}
+}
+func (m *QueryValidatorVeUnbondingDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorVeUnbondingDelegationsResponse) ProtoMessage() {}
+func (*QueryValidatorVeUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{18}
+}
+func (m *QueryValidatorVeUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorVeUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorVeUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorVeUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorVeUnbondingDelegationsResponse.Merge(m, src)
+}
+func (m *QueryValidatorVeUnbondingDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorVeUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorVeUnbondingDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorVeUnbondingDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorVeUnbondingDelegationsResponse) GetUnbondingResponses() []VeUnbondingDelegationResponse {
+	if m != nil {
+		return m.UnbondingResponses
+	}
+	return nil
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeUnbondingDelegationsByVeRequest struct {
+	VeId       string             `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeUnbondingDelegationsByVeRequest) Reset() {
+	*m = QueryVeUnbondingDelegationsByVeRequest{}
+}
+func (m *QueryVeUnbondingDelegationsByVeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeUnbondingDelegationsByVeRequest) ProtoMessage()    {}
+func (*QueryVeUnbondingDelegationsByVeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{19}
+}
+func (m *QueryVeUnbondingDelegationsByVeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeUnbondingDelegationsByVeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeUnbondingDelegationsByVeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeUnbondingDelegationsByVeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeUnbondingDelegationsByVeRequest.Merge(m, src)
+}
+func (m *QueryVeUnbondingDelegationsByVeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeUnbondingDelegationsByVeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeUnbondingDelegationsByVeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeUnbondingDelegationsByVeRequest proto.InternalMessageInfo
+
+func (m *QueryVeUnbondingDelegationsByVeRequest) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *QueryVeUnbondingDelegationsByVeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeUnbondingDelegationsByVeResponse struct {
+	UnbondingResponses []VeUnbondingDelegationResponse `protobuf:"bytes,1,rep,name=unbonding_responses,json=unbondingResponses,proto3" json:"unbonding_responses"`
+	Pagination         *query.PageResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeUnbondingDelegationsByVeResponse) Reset() {
+	*m = QueryVeUnbondingDelegationsByVeResponse{}
+}
+func (m *QueryVeUnbondingDelegationsByVeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeUnbondingDelegationsByVeResponse) ProtoMessage()    {}
+func (*QueryVeUnbondingDelegationsByVeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{20}
+}
+func (m *QueryVeUnbondingDelegationsByVeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeUnbondingDelegationsByVeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeUnbondingDelegationsByVeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeUnbondingDelegationsByVeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeUnbondingDelegationsByVeResponse.Merge(m, src)
+}
+func (m *QueryVeUnbondingDelegationsByVeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeUnbondingDelegationsByVeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeUnbondingDelegationsByVeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeUnbondingDelegationsByVeResponse proto.InternalMessageInfo
+
+func (m *QueryVeUnbondingDelegationsByVeResponse) GetUnbondingResponses() []VeUnbondingDelegationResponse {
+	if m != nil {
+		return m.UnbondingResponses
+	}
+	return nil
+}
+
+func (m *QueryVeUnbondingDelegationsByVeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeRedelegationsRequest struct {
+	DelegatorAddr    string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	SrcValidatorAddr string             `protobuf:"bytes,2,opt,name=src_validator_addr,json=srcValidatorAddr,proto3" json:"src_validator_addr,omitempty"`
+	DstValidatorAddr string             `protobuf:"bytes,3,opt,name=dst_validator_addr,json=dstValidatorAddr,proto3" json:"dst_validator_addr,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeRedelegationsRequest) Reset()         { *m = QueryVeRedelegationsRequest{} }
+func (m *QueryVeRedelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeRedelegationsRequest) ProtoMessage()    {}
+func (*QueryVeRedelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{21}
+}
+func (m *QueryVeRedelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeRedelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeRedelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeRedelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeRedelegationsRequest.Merge(m, src)
+}
+func (m *QueryVeRedelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeRedelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeRedelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeRedelegationsRequest proto.InternalMessageInfo
+
+type QueryVeRedelegationsResponse struct {
+	RedelegationResponses []VeRedelegationResponse `protobuf:"bytes,1,rep,name=redelegation_responses,json=redelegationResponses,proto3" json:"redelegation_responses"`
+	Pagination            *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeRedelegationsResponse) Reset()         { *m = QueryVeRedelegationsResponse{} }
+func (m *QueryVeRedelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeRedelegationsResponse) ProtoMessage()    {}
+func (*QueryVeRedelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{22}
+}
+func (m *QueryVeRedelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeRedelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeRedelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeRedelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeRedelegationsResponse.Merge(m, src)
+}
+func (m *QueryVeRedelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeRedelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeRedelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeRedelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryVeRedelegationsResponse) GetRedelegationResponses() []VeRedelegationResponse {
+	if m != nil {
+		return m.RedelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryVeRedelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeRedelegationsByVeRequest struct {
+	VeId       string             `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeRedelegationsByVeRequest) Reset()         { *m = QueryVeRedelegationsByVeRequest{} }
+func (m *QueryVeRedelegationsByVeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeRedelegationsByVeRequest) ProtoMessage()    {}
+func (*QueryVeRedelegationsByVeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{23}
+}
+func (m *QueryVeRedelegationsByVeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeRedelegationsByVeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeRedelegationsByVeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeRedelegationsByVeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeRedelegationsByVeRequest.Merge(m, src)
+}
+func (m *QueryVeRedelegationsByVeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeRedelegationsByVeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeRedelegationsByVeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeRedelegationsByVeRequest proto.InternalMessageInfo
+
+func (m *QueryVeRedelegationsByVeRequest) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *QueryVeRedelegationsByVeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeRedelegationsByVeResponse struct {
+	RedelegationResponses []VeRedelegationResponse `protobuf:"bytes,1,rep,name=redelegation_responses,json=redelegationResponses,proto3" json:"redelegation_responses"`
+	Pagination            *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeRedelegationsByVeResponse) Reset()         { *m = QueryVeRedelegationsByVeResponse{} }
+func (m *QueryVeRedelegationsByVeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeRedelegationsByVeResponse) ProtoMessage()    {}
+func (*QueryVeRedelegationsByVeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{24}
+}
+func (m *QueryVeRedelegationsByVeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeRedelegationsByVeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeRedelegationsByVeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeRedelegationsByVeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeRedelegationsByVeResponse.Merge(m, src)
+}
+func (m *QueryVeRedelegationsByVeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeRedelegationsByVeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeRedelegationsByVeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeRedelegationsByVeResponse proto.InternalMessageInfo
+
+func (m *QueryVeRedelegationsByVeResponse) GetRedelegationResponses() []VeRedelegationResponse {
+	if m != nil {
+		return m.RedelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryVeRedelegationsByVeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*VeDelegationResponse)(nil), "gridiron.staking.v1.VeDelegationResponse")
+	proto.RegisterType((*VeUnbondingDelegationResponse)(nil), "gridiron.staking.v1.VeUnbondingDelegationResponse")
+	proto.RegisterType((*VeRedelegationResponse)(nil), "gridiron.staking.v1.VeRedelegationResponse")
+	proto.RegisterType((*QueryVeValidatorRequest)(nil), "gridiron.staking.v1.QueryVeValidatorRequest")
+	proto.RegisterType((*QueryVeValidatorResponse)(nil), "gridiron.staking.v1.QueryVeValidatorResponse")
+	proto.RegisterType((*QueryVeDelegationRequest)(nil), "gridiron.staking.v1.QueryVeDelegationRequest")
+	proto.RegisterType((*QueryVeDelegationResponse)(nil), "gridiron.staking.v1.QueryVeDelegationResponse")
+	proto.RegisterType((*QueryDelegatorVeDelegationsRequest)(nil), "gridiron.staking.v1.QueryDelegatorVeDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorVeDelegationsResponse)(nil), "gridiron.staking.v1.QueryDelegatorVeDelegationsResponse")
+	proto.RegisterType((*QueryValidatorVeDelegationsRequest)(nil), "gridiron.staking.v1.QueryValidatorVeDelegationsRequest")
+	proto.RegisterType((*QueryValidatorVeDelegationsResponse)(nil), "gridiron.staking.v1.QueryValidatorVeDelegationsResponse")
+	proto.RegisterType((*QueryVeDelegationsByVeRequest)(nil), "gridiron.staking.v1.QueryVeDelegationsByVeRequest")
+	proto.RegisterType((*QueryVeDelegationsByVeResponse)(nil), "gridiron.staking.v1.QueryVeDelegationsByVeResponse")
+	proto.RegisterType((*QueryVeUnbondingDelegationRequest)(nil), "gridiron.staking.v1.QueryVeUnbondingDelegationRequest")
+	proto.RegisterType((*QueryVeUnbondingDelegationResponse)(nil), "gridiron.staking.v1.QueryVeUnbondingDelegationResponse")
+	proto.RegisterType((*QueryDelegatorVeUnbondingDelegationsRequest)(nil), "gridiron.staking.v1.QueryDelegatorVeUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorVeUnbondingDelegationsResponse)(nil), "gridiron.staking.v1.QueryDelegatorVeUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryValidatorVeUnbondingDelegationsRequest)(nil), "gridiron.staking.v1.QueryValidatorVeUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryValidatorVeUnbondingDelegationsResponse)(nil), "gridiron.staking.v1.QueryValidatorVeUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryVeUnbondingDelegationsByVeRequest)(nil), "gridiron.staking.v1.QueryVeUnbondingDelegationsByVeRequest")
+	proto.RegisterType((*QueryVeUnbondingDelegationsByVeResponse)(nil), "gridiron.staking.v1.QueryVeUnbondingDelegationsByVeResponse")
+	proto.RegisterType((*QueryVeRedelegationsRequest)(nil), "gridiron.staking.v1.QueryVeRedelegationsRequest")
+	proto.RegisterType((*QueryVeRedelegationsResponse)(nil), "gridiron.staking.v1.QueryVeRedelegationsResponse")
+	proto.RegisterType((*QueryVeRedelegationsByVeRequest)(nil), "gridiron.staking.v1.QueryVeRedelegationsByVeRequest")
+	proto.RegisterType((*QueryVeRedelegationsByVeResponse)(nil), "gridiron.staking.v1.QueryVeRedelegationsByVeResponse")
+}
+
 func init() { proto.RegisterFile("gridiron/staking/v1/query.proto", fileDescriptor_c2ca4589a59aa8e2) }
 
-var fileDescriptor_c2ca4589a59aa8e2 = []byte{
-	// 129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4, 0x2f,
-	0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xca, 0xeb, 0x41,
-	0xe5, 0xf5, 0xca, 0x0c, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xaa, 0x51, 0xb7,
-	0x2a, 0x3f, 0x2f, 0x15, 0xc6, 0xd1, 0xaf, 0x80, 0xdb, 0x53, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
-	0x06, 0xb6, 0xc5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x69, 0x7c, 0xa1, 0x87, 0x00, 0x00,
-	0x00,
+var fileDescriptor_c2ca4589a59aa8e2 = []byte{
+	// 1315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6c, 0xdc, 0x44,
+	0x14, 0xde, 0xd9, 0xa4, 0x15, 0xbc, 0xa4, 0x3f, 0x4c, 0xd2, 0x34, 0x98, 0x76, 0x37, 0xd9, 0x96,
+	0xb4, 0x34, 0x89, 0x9d, 0xdd, 0x94, 0x34, 0x94, 0x2a, 0x4a, 0xa2, 0x28, 0x10, 0x01, 0xa5, 0xac,
+	0xc8, 0x4a, 0x70, 0x59, 0x79, 0xe3, 0x91, 0xbb, 0x24, 0xb1, 0x53, 0x8f, 0xd7, 0x22, 0x84, 0x72,
+	0xa8, 0x84, 0xd4, 0x23, 0x12, 0x67, 0xa4, 0x1e, 0x10, 0x37, 0x2e, 0x1c, 0x39, 0x20, 0x81, 0x10,
+	0xea, 0xb1, 0x15, 0x42, 0x45, 0x48, 0x54, 0x28, 0xe1, 0xc0, 0x19, 0x01, 0x67, 0xb4, 0xe3, 0x59,
+	0xaf, 0xbd, 0x3b, 0xf6, 0xda, 0xf9, 0x69, 0x95, 0x53, 0x22, 0xcf, 0x9b, 0xf7, 0xbe, 0xef, 0x7b,
+	0xf3, 0x9e, 0xdf, 0x78, 0x21, 0xab, 0x5b, 0x55, 0xad, 0x6a, 0x99, 0x86, 0x42, 0x6d, 0x75, 0xb5,
+	0x6a, 0xe8, 0x8a, 0x93, 0x57, 0x6e, 0xd5, 0x88, 0xb5, 0x29, 0x6f, 0x58, 0xa6, 0x6d, 0xe2, 0xbe,
+	0x86, 0x81, 0xcc, 0x0d, 0x64, 0x27, 0x2f, 0xf5, 0xeb, 0xa6, 0x6e, 0xb2, 0x75, 0xa5, 0xfe, 0x9f,
+	0x6b, 0x2a, 0x9d, 0xd1, 0x4d, 0x53, 0x5f, 0x23, 0x8a, 0xba, 0x51, 0x55, 0x54, 0xc3, 0x30, 0x6d,
+	0xd5, 0xae, 0x9a, 0x06, 0xe5, 0xab, 0x97, 0x56, 0x4c, 0xba, 0x6e, 0x52, 0xa5, 0xa2, 0x52, 0xe2,
+	0x46, 0x50, 0x9c, 0x7c, 0x85, 0xd8, 0x6a, 0x5e, 0xd9, 0x50, 0xf5, 0xaa, 0xc1, 0x8c, 0xb9, 0xed,
+	0x79, 0x6e, 0xdb, 0xc4, 0xe4, 0x1a, 0x36, 0x20, 0xb8, 0x56, 0xc3, 0x22, 0xec, 0x01, 0x93, 0xdc,
+	0xf7, 0x08, 0xfa, 0x4b, 0x64, 0x81, 0xac, 0x11, 0x9d, 0xf9, 0x2f, 0x12, 0xba, 0x61, 0x1a, 0x94,
+	0xe0, 0x37, 0xe1, 0x98, 0x43, 0xca, 0x9a, 0xb7, 0x30, 0x88, 0x86, 0xd0, 0xc5, 0x9e, 0xc2, 0xb0,
+	0x2c, 0xa0, 0x2b, 0xfb, 0x3d, 0xcc, 0x77, 0xdf, 0x7f, 0x9c, 0x4d, 0x15, 0x7b, 0x1d, 0xdf, 0x33,
+	0xbc, 0x0c, 0xfd, 0x4d, 0x6f, 0x44, 0x2b, 0xab, 0xeb, 0x66, 0xcd, 0xb0, 0xe9, 0x60, 0x7a, 0xa8,
+	0xeb, 0x62, 0x4f, 0xe1, 0x6c, 0x88, 0xd3, 0x77, 0xcd, 0x55, 0x62, 0x50, 0xee, 0x10, 0x7b, 0x0e,
+	0x89, 0x36, 0xe7, 0x6e, 0xbf, 0xda, 0xfd, 0xd7, 0xbd, 0x6c, 0x2a, 0xf7, 0x2f, 0x82, 0xb3, 0x25,
+	0xb2, 0x6c, 0x54, 0x4c, 0x43, 0xab, 0x1a, 0xba, 0x80, 0xcc, 0x4d, 0x38, 0xed, 0x90, 0x72, 0xad,
+	0x61, 0xd1, 0x4e, 0xeb, 0x52, 0x08, 0x02, 0x81, 0x53, 0x0e, 0xe7, 0x94, 0x23, 0x5a, 0xc4, 0x1a,
+	0xf4, 0x0b, 0xc3, 0xa4, 0x59, 0x98, 0x51, 0xd9, 0xcd, 0x9b, 0x2f, 0x08, 0xcb, 0x9b, 0x1c, 0x1e,
+	0xa7, 0xaf, 0xd6, 0xbe, 0xc4, 0x79, 0xff, 0x80, 0x60, 0xa0, 0x44, 0x8a, 0x44, 0x6b, 0x27, 0x5c,
+	0x84, 0x13, 0x0e, 0x29, 0x5b, 0xa4, 0x8d, 0xe8, 0xb9, 0x10, 0xa2, 0x7e, 0x2f, 0x3c, 0xf2, 0x71,
+	0x27, 0xf0, 0x14, 0x5f, 0x87, 0x5e, 0x8b, 0xb4, 0x51, 0x3a, 0x1f, 0x46, 0x49, 0xe0, 0x31, 0xb0,
+	0x9f, 0x93, 0x98, 0x85, 0xd3, 0xef, 0xd4, 0xcf, 0x7a, 0x89, 0x94, 0xd4, 0xb5, 0xaa, 0xa6, 0xda,
+	0xa6, 0x55, 0x24, 0xb7, 0x6a, 0x84, 0xda, 0xf8, 0x45, 0x38, 0xee, 0x34, 0x9e, 0x95, 0x55, 0x4d,
+	0xb3, 0x18, 0x87, 0x67, 0x8b, 0xc7, 0xbc, 0xa7, 0x73, 0x9a, 0x66, 0xe5, 0x08, 0x0c, 0xb6, 0x7b,
+	0xe0, 0x3a, 0x2c, 0x41, 0xaf, 0x43, 0xca, 0x9e, 0x3d, 0x17, 0x61, 0x28, 0x44, 0x04, 0x6f, 0x3f,
+	0xc7, 0xdb, 0xe3, 0x34, 0x1f, 0xe5, 0x2c, 0x2f, 0x8c, 0xff, 0x80, 0x79, 0x48, 0x39, 0xb1, 0x16,
+	0xa4, 0xde, 0xd3, 0x3a, 0x52, 0x01, 0xa1, 0xb4, 0x80, 0xd0, 0xd5, 0x67, 0xee, 0xde, 0xcb, 0xa6,
+	0x98, 0x38, 0x77, 0x10, 0x3c, 0x2f, 0x08, 0xca, 0xc9, 0x11, 0x18, 0x08, 0x94, 0x68, 0xd9, 0xe2,
+	0x2b, 0x9c, 0xe6, 0x4b, 0x1d, 0x6b, 0xb5, 0xe1, 0x8a, 0xf3, 0xed, 0x77, 0x04, 0x6b, 0xb9, 0x2f,
+	0x10, 0xe4, 0x18, 0x88, 0x85, 0x06, 0x19, 0xbf, 0x0b, 0x9a, 0x50, 0x83, 0x45, 0x80, 0x66, 0x37,
+	0xe3, 0x67, 0x68, 0xa4, 0x71, 0x86, 0xea, 0xad, 0x4f, 0x76, 0x9b, 0x6b, 0xe3, 0x18, 0xdd, 0x50,
+	0x75, 0xc2, 0x43, 0x14, 0x7d, 0x3b, 0x7d, 0x22, 0x3d, 0x42, 0x70, 0x2e, 0x12, 0x1f, 0x97, 0x4b,
+	0x67, 0x4d, 0x40, 0x20, 0x17, 0x1d, 0x44, 0x43, 0x5d, 0xbb, 0xd1, 0xeb, 0x94, 0x48, 0x2f, 0x8a,
+	0x5f, 0x13, 0x50, 0xbc, 0xd0, 0x91, 0xa2, 0xbb, 0xdb, 0xcf, 0xb1, 0xa9, 0xbc, 0x77, 0x0a, 0xc3,
+	0x94, 0x8f, 0x51, 0x27, 0x07, 0xa9, 0x7c, 0x18, 0xbe, 0x43, 0xab, 0xfc, 0xc7, 0x70, 0xb6, 0xad,
+	0xee, 0xe8, 0xfc, 0x66, 0xa9, 0x21, 0x08, 0xee, 0x83, 0x23, 0x0e, 0x29, 0x57, 0x35, 0x2e, 0x75,
+	0xb7, 0x43, 0x96, 0xb4, 0xfd, 0x52, 0x38, 0xf7, 0x75, 0x1a, 0x32, 0x61, 0xe1, 0xb9, 0xa4, 0xef,
+	0xc1, 0xc9, 0xd6, 0xb7, 0xa9, 0x0b, 0x65, 0x5e, 0xae, 0x0b, 0xf4, 0xdb, 0xe3, 0xec, 0x88, 0x5e,
+	0xb5, 0x6f, 0xd6, 0x2a, 0xf2, 0x8a, 0xb9, 0xae, 0xf0, 0x69, 0xc1, 0xfd, 0x33, 0x4e, 0xb5, 0x55,
+	0xc5, 0xde, 0xdc, 0x20, 0x54, 0x5e, 0x32, 0xec, 0xe2, 0x09, 0x2d, 0xf8, 0x56, 0x8d, 0xca, 0x56,
+	0xfa, 0x00, 0xb3, 0xd5, 0xb5, 0xfb, 0x6c, 0x6d, 0xc1, 0x30, 0x97, 0x4b, 0x38, 0x04, 0x1c, 0x6c,
+	0x8f, 0x76, 0x20, 0x17, 0x15, 0x9c, 0xe7, 0xeb, 0x06, 0x1c, 0x75, 0x5f, 0xe4, 0xbc, 0x37, 0x17,
+	0xe2, 0x0f, 0x1c, 0x2d, 0x62, 0x72, 0x3f, 0xb9, 0xaf, 0x10, 0x8c, 0xb6, 0xb6, 0x3d, 0xc1, 0xee,
+	0xa7, 0xdf, 0x9f, 0xb7, 0x11, 0x8c, 0xc5, 0x03, 0xca, 0xb5, 0xaa, 0x42, 0x73, 0xe8, 0x69, 0x6b,
+	0x15, 0xbb, 0x17, 0x0e, 0x7b, 0x4e, 0x0f, 0xa0, 0x61, 0x78, 0xd9, 0xf0, 0xb5, 0xc2, 0x0e, 0xd9,
+	0x78, 0x3a, 0x3d, 0xdb, 0xcb, 0x46, 0x47, 0xa0, 0x87, 0x38, 0x1b, 0x9f, 0x22, 0x18, 0x09, 0x2f,
+	0xca, 0x27, 0xd7, 0xc8, 0x7f, 0x47, 0x70, 0xa1, 0x23, 0x8e, 0x43, 0xac, 0xf3, 0xdf, 0x08, 0x5e,
+	0xe0, 0xfc, 0xfc, 0xe3, 0x7e, 0xd2, 0x9e, 0x33, 0x06, 0x98, 0x5a, 0x2b, 0x65, 0x61, 0xdf, 0x3d,
+	0x49, 0xad, 0x95, 0x52, 0xa0, 0x26, 0xc6, 0x00, 0x6b, 0xd4, 0x6e, 0xb5, 0xee, 0x72, 0xad, 0x35,
+	0x6a, 0x97, 0x22, 0x2a, 0xa8, 0x7b, 0x1f, 0x2a, 0xe8, 0x21, 0x82, 0x33, 0x62, 0xd2, 0xde, 0x6d,
+	0x73, 0xc0, 0x7f, 0xd1, 0x69, 0x4b, 0xe6, 0x68, 0x8c, 0x3b, 0x58, 0xeb, 0x1b, 0xd4, 0x12, 0xac,
+	0xed, 0x63, 0x22, 0x3f, 0x81, 0xac, 0x88, 0xd2, 0x13, 0x2b, 0x94, 0x5f, 0x10, 0x0c, 0x85, 0x03,
+	0x38, 0xb4, 0xba, 0x16, 0xee, 0x62, 0x38, 0xc2, 0x78, 0xe1, 0x2f, 0x11, 0xf4, 0xf8, 0x6e, 0x98,
+	0x78, 0x4c, 0x08, 0x36, 0xe4, 0x2a, 0x2c, 0x8d, 0xc7, 0xb4, 0xe6, 0x57, 0xb6, 0xe9, 0x3b, 0x3f,
+	0xff, 0xf9, 0x79, 0xba, 0x80, 0x27, 0x14, 0xd1, 0x17, 0x20, 0xaf, 0x72, 0xa8, 0xb2, 0x15, 0xac,
+	0xa2, 0xdb, 0xf8, 0x47, 0x04, 0xbd, 0xfe, 0x49, 0x0e, 0x47, 0x46, 0x6e, 0x9b, 0xb2, 0x24, 0x39,
+	0xae, 0x39, 0x47, 0xba, 0xcc, 0x90, 0xbe, 0x8d, 0xdf, 0x4a, 0x8a, 0x54, 0xf1, 0x9d, 0x12, 0x65,
+	0x2b, 0xd8, 0x65, 0x6e, 0xe3, 0x9f, 0x10, 0x0c, 0x88, 0xaf, 0x83, 0xf8, 0x4a, 0x38, 0xc2, 0xc8,
+	0x0b, 0xae, 0x34, 0x9d, 0x7c, 0x23, 0x27, 0xf9, 0x0a, 0x23, 0x39, 0x89, 0xf3, 0x42, 0x92, 0x91,
+	0x44, 0x1e, 0xd6, 0xbf, 0xf1, 0x08, 0x6f, 0x57, 0x51, 0x44, 0x22, 0xef, 0x8b, 0xd2, 0x74, 0xf2,
+	0x8d, 0x9c, 0xc8, 0x02, 0x23, 0x32, 0x83, 0xaf, 0xed, 0x25, 0x5b, 0xf8, 0x1b, 0x04, 0xcf, 0xb5,
+	0xdd, 0x6c, 0x70, 0x21, 0xde, 0xc9, 0xf1, 0xf7, 0x24, 0x69, 0x32, 0xd1, 0x1e, 0x4e, 0xe2, 0x32,
+	0x23, 0x21, 0xe3, 0x31, 0x31, 0x09, 0x52, 0x47, 0x5f, 0x6f, 0x74, 0x41, 0xd0, 0xff, 0x20, 0x38,
+	0x25, 0x7c, 0xdf, 0xe2, 0xa9, 0x28, 0x10, 0xe1, 0x17, 0x12, 0xe9, 0x4a, 0xe2, 0x7d, 0x9c, 0xc0,
+	0x07, 0x8c, 0x80, 0x86, 0x2b, 0xfb, 0x5a, 0x33, 0x8a, 0xe8, 0xbb, 0x25, 0xfe, 0x0f, 0x41, 0xb6,
+	0xc3, 0xdc, 0x8e, 0x67, 0x63, 0x15, 0x46, 0xc4, 0x34, 0x2c, 0xcd, 0xed, 0xc1, 0x03, 0x17, 0xe5,
+	0x3a, 0x13, 0xe5, 0x75, 0xbc, 0x18, 0x55, 0x63, 0x4c, 0x94, 0x38, 0xbc, 0x29, 0x23, 0xde, 0x61,
+	0x44, 0x8e, 0x22, 0x1e, 0xef, 0x1a, 0x20, 0xcd, 0xed, 0xc1, 0x43, 0x2c, 0xe2, 0x51, 0xa7, 0x41,
+	0x4c, 0xfc, 0x11, 0x02, 0x29, 0x7c, 0x5c, 0xc5, 0xaf, 0x26, 0x3c, 0xb5, 0x81, 0x7a, 0xbd, 0xb6,
+	0xbb, 0xcd, 0x9c, 0xe9, 0x0c, 0x63, 0x3a, 0x8d, 0xa7, 0x3a, 0x16, 0xae, 0x98, 0xd9, 0x77, 0x08,
+	0x4e, 0xb4, 0xcc, 0x17, 0x78, 0x22, 0x0a, 0x91, 0x68, 0xa6, 0x95, 0xf2, 0x09, 0x76, 0x70, 0xe0,
+	0x8b, 0x0c, 0xf8, 0x2c, 0x9e, 0x49, 0x7c, 0x36, 0xad, 0x00, 0xd8, 0x6f, 0x11, 0xf4, 0x09, 0x06,
+	0x24, 0x7c, 0x39, 0x36, 0x24, 0x7f, 0x32, 0x5e, 0x4e, 0xb8, 0x8b, 0x93, 0x99, 0x62, 0x64, 0x26,
+	0xb0, 0xdc, 0x31, 0x0b, 0x01, 0xf0, 0xf3, 0x6f, 0xdc, 0xdf, 0xce, 0xa0, 0x07, 0xdb, 0x19, 0xf4,
+	0xc7, 0x76, 0x06, 0x7d, 0xb6, 0x93, 0x49, 0x3d, 0xd8, 0xc9, 0xa4, 0x7e, 0xdd, 0xc9, 0xa4, 0xde,
+	0xcf, 0xfb, 0xbe, 0x54, 0x35, 0x7c, 0x8e, 0x7f, 0x64, 0x1a, 0xa4, 0x19, 0xe1, 0x43, 0x2f, 0x06,
+	0xfb, 0x70, 0x55, 0x39, 0xca, 0x7e, 0xbd, 0x9a, 0xfc, 0x7f, 0x00, 0xc7, 0x75, 0x75, 0x58, 0x9e,
+	0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// VeValidator queries the ve delegator shares of a validator.
+	VeValidator(ctx context.Context, in *QueryVeValidatorRequest, opts ...grpc.CallOption) (*QueryVeValidatorResponse, error)
+	// VeDelegation queries the ve delegation of a delegator to a validator.
+	VeDelegation(ctx context.Context, in *QueryVeDelegationRequest, opts ...grpc.CallOption) (*QueryVeDelegationResponse, error)
+	// DelegatorVeDelegations queries all ve delegations of a delegator.
+	DelegatorVeDelegations(ctx context.Context, in *QueryDelegatorVeDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorVeDelegationsResponse, error)
+	// ValidatorVeDelegations queries all ve delegations to a validator.
+	ValidatorVeDelegations(ctx context.Context, in *QueryValidatorVeDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorVeDelegationsResponse, error)
+	// VeDelegationsByVe queries all ve delegations of a ve.
+	VeDelegationsByVe(ctx context.Context, in *QueryVeDelegationsByVeRequest, opts ...grpc.CallOption) (*QueryVeDelegationsByVeResponse, error)
+	// VeUnbondingDelegation queries the ve unbonding delegation of a delegator
+	// from a validator.
+	VeUnbondingDelegation(ctx context.Context, in *QueryVeUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryVeUnbondingDelegationResponse, error)
+	// DelegatorVeUnbondingDelegations queries all ve unbonding delegations of a
+	// delegator.
+	DelegatorVeUnbondingDelegations(ctx context.Context, in *QueryDelegatorVeUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorVeUnbondingDelegationsResponse, error)
+	// ValidatorVeUnbondingDelegations queries all ve unbonding delegations from
+	// a validator.
+	ValidatorVeUnbondingDelegations(ctx context.Context, in *QueryValidatorVeUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorVeUnbondingDelegationsResponse, error)
+	// VeUnbondingDelegationsByVe queries all ve unbonding delegations of a ve.
+	VeUnbondingDelegationsByVe(ctx context.Context, in *QueryVeUnbondingDelegationsByVeRequest, opts ...grpc.CallOption) (*QueryVeUnbondingDelegationsByVeResponse, error)
+	// VeRedelegations queries the ve redelegations of a delegator, optionally
+	// filtered by the source and destination validators.
+	VeRedelegations(ctx context.Context, in *QueryVeRedelegationsRequest, opts ...grpc.CallOption) (*QueryVeRedelegationsResponse, error)
+	// VeRedelegationsByVe queries all ve redelegations of a ve.
+	VeRedelegationsByVe(ctx context.Context, in *QueryVeRedelegationsByVeRequest, opts ...grpc.CallOption) (*QueryVeRedelegationsByVeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VeValidator(ctx context.Context, in *QueryVeValidatorRequest, opts ...grpc.CallOption) (*QueryVeValidatorResponse, error) {
+	out := new(QueryVeValidatorResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/VeValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeDelegation(ctx context.Context, in *QueryVeDelegationRequest, opts ...grpc.CallOption) (*QueryVeDelegationResponse, error) {
+	out := new(QueryVeDelegationResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/VeDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorVeDelegations(ctx context.Context, in *QueryDelegatorVeDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorVeDelegationsResponse, error) {
+	out := new(QueryDelegatorVeDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/DelegatorVeDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorVeDelegations(ctx context.Context, in *QueryValidatorVeDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorVeDelegationsResponse, error) {
+	out := new(QueryValidatorVeDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/ValidatorVeDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeDelegationsByVe(ctx context.Context, in *QueryVeDelegationsByVeRequest, opts ...grpc.CallOption) (*QueryVeDelegationsByVeResponse, error) {
+	out := new(QueryVeDelegationsByVeResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/VeDelegationsByVe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeUnbondingDelegation(ctx context.Context, in *QueryVeUnbondingDelegationRequest, opts ...grpc.CallOption) (*QueryVeUnbondingDelegationResponse, error) {
+	out := new(QueryVeUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/VeUnbondingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorVeUnbondingDelegations(ctx context.Context, in *QueryDelegatorVeUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorVeUnbondingDelegationsResponse, error) {
+	out := new(QueryDelegatorVeUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/DelegatorVeUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorVeUnbondingDelegations(ctx context.Context, in *QueryValidatorVeUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorVeUnbondingDelegationsResponse, error) {
+	out := new(QueryValidatorVeUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/ValidatorVeUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeUnbondingDelegationsByVe(ctx context.Context, in *QueryVeUnbondingDelegationsByVeRequest, opts ...grpc.CallOption) (*QueryVeUnbondingDelegationsByVeResponse, error) {
+	out := new(QueryVeUnbondingDelegationsByVeResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/VeUnbondingDelegationsByVe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeRedelegations(ctx context.Context, in *QueryVeRedelegationsRequest, opts ...grpc.CallOption) (*QueryVeRedelegationsResponse, error) {
+	out := new(QueryVeRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/VeRedelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeRedelegationsByVe(ctx context.Context, in *QueryVeRedelegationsByVeRequest, opts ...grpc.CallOption) (*QueryVeRedelegationsByVeResponse, error) {
+	out := new(QueryVeRedelegationsByVeResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Query/VeRedelegationsByVe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// VeValidator queries the ve delegator shares of a validator.
+	VeValidator(context.Context, *QueryVeValidatorRequest) (*QueryVeValidatorResponse, error)
+	// VeDelegation queries the ve delegation of a delegator to a validator.
+	VeDelegation(context.Context, *QueryVeDelegationRequest) (*QueryVeDelegationResponse, error)
+	// DelegatorVeDelegations queries all ve delegations of a delegator.
+	DelegatorVeDelegations(context.Context, *QueryDelegatorVeDelegationsRequest) (*QueryDelegatorVeDelegationsResponse, error)
+	// ValidatorVeDelegations queries all ve delegations to a validator.
+	ValidatorVeDelegations(context.Context, *QueryValidatorVeDelegationsRequest) (*QueryValidatorVeDelegationsResponse, error)
+	// VeDelegationsByVe queries all ve delegations of a ve.
+	VeDelegationsByVe(context.Context, *QueryVeDelegationsByVeRequest) (*QueryVeDelegationsByVeResponse, error)
+	// VeUnbondingDelegation queries the ve unbonding delegation of a delegator
+	// from a validator.
+	VeUnbondingDelegation(context.Context, *QueryVeUnbondingDelegationRequest) (*QueryVeUnbondingDelegationResponse, error)
+	// DelegatorVeUnbondingDelegations queries all ve unbonding delegations of a
+	// delegator.
+	DelegatorVeUnbondingDelegations(context.Context, *QueryDelegatorVeUnbondingDelegationsRequest) (*QueryDelegatorVeUnbondingDelegationsResponse, error)
+	// ValidatorVeUnbondingDelegations queries all ve unbonding delegations from
+	// a validator.
+	ValidatorVeUnbondingDelegations(context.Context, *QueryValidatorVeUnbondingDelegationsRequest) (*QueryValidatorVeUnbondingDelegationsResponse, error)
+	// VeUnbondingDelegationsByVe queries all ve unbonding delegations of a ve.
+	VeUnbondingDelegationsByVe(context.Context, *QueryVeUnbondingDelegationsByVeRequest) (*QueryVeUnbondingDelegationsByVeResponse, error)
+	// VeRedelegations queries the ve redelegations of a delegator, optionally
+	// filtered by the source and destination validators.
+	VeRedelegations(context.Context, *QueryVeRedelegationsRequest) (*QueryVeRedelegationsResponse, error)
+	// VeRedelegationsByVe queries all ve redelegations of a ve.
+	VeRedelegationsByVe(context.Context, *QueryVeRedelegationsByVeRequest) (*QueryVeRedelegationsByVeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) VeValidator(ctx context.Context, req *QueryVeValidatorRequest) (*QueryVeValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeValidator not implemented")
+}
+func (*UnimplementedQueryServer) VeDelegation(ctx context.Context, req *QueryVeDelegationRequest) (*QueryVeDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeDelegation not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorVeDelegations(ctx context.Context, req *QueryDelegatorVeDelegationsRequest) (*QueryDelegatorVeDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorVeDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorVeDelegations(ctx context.Context, req *QueryValidatorVeDelegationsRequest) (*QueryValidatorVeDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorVeDelegations not implemented")
+}
+func (*UnimplementedQueryServer) VeDelegationsByVe(ctx context.Context, req *QueryVeDelegationsByVeRequest) (*QueryVeDelegationsByVeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeDelegationsByVe not implemented")
+}
+func (*UnimplementedQueryServer) VeUnbondingDelegation(ctx context.Context, req *QueryVeUnbondingDelegationRequest) (*QueryVeUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeUnbondingDelegation not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorVeUnbondingDelegations(ctx context.Context, req *QueryDelegatorVeUnbondingDelegationsRequest) (*QueryDelegatorVeUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorVeUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorVeUnbondingDelegations(ctx context.Context, req *QueryValidatorVeUnbondingDelegationsRequest) (*QueryValidatorVeUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorVeUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) VeUnbondingDelegationsByVe(ctx context.Context, req *QueryVeUnbondingDelegationsByVeRequest) (*QueryVeUnbondingDelegationsByVeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeUnbondingDelegationsByVe not implemented")
+}
+func (*UnimplementedQueryServer) VeRedelegations(ctx context.Context, req *QueryVeRedelegationsRequest) (*QueryVeRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeRedelegations not implemented")
+}
+func (*UnimplementedQueryServer) VeRedelegationsByVe(ctx context.Context, req *QueryVeRedelegationsByVeRequest) (*QueryVeRedelegationsByVeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeRedelegationsByVe not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_VeValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/VeValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeValidator(ctx, req.(*QueryVeValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/VeDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeDelegation(ctx, req.(*QueryVeDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorVeDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorVeDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorVeDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/DelegatorVeDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorVeDelegations(ctx, req.(*QueryDelegatorVeDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorVeDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorVeDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorVeDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/ValidatorVeDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorVeDelegations(ctx, req.(*QueryValidatorVeDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeDelegationsByVe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeDelegationsByVeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeDelegationsByVe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/VeDelegationsByVe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeDelegationsByVe(ctx, req.(*QueryVeDelegationsByVeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeUnbondingDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeUnbondingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/VeUnbondingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeUnbondingDelegation(ctx, req.(*QueryVeUnbondingDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorVeUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorVeUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorVeUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/DelegatorVeUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorVeUnbondingDelegations(ctx, req.(*QueryDelegatorVeUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorVeUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorVeUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorVeUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/ValidatorVeUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorVeUnbondingDelegations(ctx, req.(*QueryValidatorVeUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeUnbondingDelegationsByVe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeUnbondingDelegationsByVeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeUnbondingDelegationsByVe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/VeUnbondingDelegationsByVe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeUnbondingDelegationsByVe(ctx, req.(*QueryVeUnbondingDelegationsByVeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeRedelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeRedelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/VeRedelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeRedelegations(ctx, req.(*QueryVeRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeRedelegationsByVe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeRedelegationsByVeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeRedelegationsByVe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Query/VeRedelegationsByVe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeRedelegationsByVe(ctx, req.(*QueryVeRedelegationsByVeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gridiron.staking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VeValidator",
+			Handler:    _Query_VeValidator_Handler,
+		},
+		{
+			MethodName: "VeDelegation",
+			Handler:    _Query_VeDelegation_Handler,
+		},
+		{
+			MethodName: "DelegatorVeDelegations",
+			Handler:    _Query_DelegatorVeDelegations_Handler,
+		},
+		{
+			MethodName: "ValidatorVeDelegations",
+			Handler:    _Query_ValidatorVeDelegations_Handler,
+		},
+		{
+			MethodName: "VeDelegationsByVe",
+			Handler:    _Query_VeDelegationsByVe_Handler,
+		},
+		{
+			MethodName: "VeUnbondingDelegation",
+			Handler:    _Query_VeUnbondingDelegation_Handler,
+		},
+		{
+			MethodName: "DelegatorVeUnbondingDelegations",
+			Handler:    _Query_DelegatorVeUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "ValidatorVeUnbondingDelegations",
+			Handler:    _Query_ValidatorVeUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "VeUnbondingDelegationsByVe",
+			Handler:    _Query_VeUnbondingDelegationsByVe_Handler,
+		},
+		{
+			MethodName: "VeRedelegations",
+			Handler:    _Query_VeRedelegations_Handler,
+		},
+		{
+			MethodName: "VeRedelegationsByVe",
+			Handler:    _Query_VeRedelegationsByVe_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gridiron/staking/v1/query.proto",
+}
+
+func (m *VeDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeDelegatedAmounts) > 0 {
+		for iNdEx := len(m.VeDelegatedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegatedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.VeDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VeUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VeUnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VeRedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeRedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeRedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VeRedelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VeValidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VeDelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorVeDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorVeDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorVeDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorVeDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorVeDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorVeDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeDelegationResponses) > 0 {
+		for iNdEx := len(m.VeDelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorVeDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorVeDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorVeDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorVeDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorVeDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorVeDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeDelegationResponses) > 0 {
+		for iNdEx := len(m.VeDelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeDelegationsByVeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeDelegationsByVeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeDelegationsByVeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeDelegationsByVeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeDelegationsByVeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeDelegationsByVeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeDelegationResponses) > 0 {
+		for iNdEx := len(m.VeDelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeUnbondingDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeUnbondingDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeUnbondingDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Unbond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingResponses) > 0 {
+		for iNdEx := len(m.UnbondingResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingResponses) > 0 {
+		for iNdEx := len(m.UnbondingResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeUnbondingDelegationsByVeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeUnbondingDelegationsByVeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeUnbondingDelegationsByVeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeUnbondingDelegationsByVeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeUnbondingDelegationsByVeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeUnbondingDelegationsByVeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingResponses) > 0 {
+		for iNdEx := len(m.UnbondingResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeRedelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeRedelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeRedelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DstValidatorAddr) > 0 {
+		i -= len(m.DstValidatorAddr)
+		copy(dAtA[i:], m.DstValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DstValidatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidatorAddr) > 0 {
+		i -= len(m.SrcValidatorAddr)
+		copy(dAtA[i:], m.SrcValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SrcValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeRedelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeRedelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeRedelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedelegationResponses) > 0 {
+		for iNdEx := len(m.RedelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeRedelegationsByVeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeRedelegationsByVeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeRedelegationsByVeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeRedelegationsByVeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeRedelegationsByVeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeRedelegationsByVeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedelegationResponses) > 0 {
+		for iNdEx := len(m.RedelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VeDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VeDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.VeDelegatedAmounts) > 0 {
+		for _, e := range m.VeDelegatedAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VeUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VeUnbondingDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnbondingDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VeRedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VeRedelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Redelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVeValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VeValidator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVeDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VeDelegationResponse.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorVeDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorVeDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VeDelegationResponses) > 0 {
+		for _, e := range m.VeDelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorVeDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorVeDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VeDelegationResponses) > 0 {
+		for _, e := range m.VeDelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeDelegationsByVeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeDelegationsByVeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.VeDelegationResponses) > 0 {
+		for _, e := range m.VeDelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeUnbondingDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Unbond.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingResponses) > 0 {
+		for _, e := range m.UnbondingResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorVeUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingResponses) > 0 {
+		for _, e := range m.UnbondingResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeUnbondingDelegationsByVeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeUnbondingDelegationsByVeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingResponses) > 0 {
+		for _, e := range m.UnbondingResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
+
+func (m *QueryVeRedelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SrcValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DstValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeRedelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedelegationResponses) > 0 {
+		for _, e := range m.RedelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeRedelegationsByVeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeRedelegationsByVeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedelegationResponses) > 0 {
+		for _, e := range m.RedelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VeDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegatedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegatedAmounts = append(m.VeDelegatedAmounts, VeTokens{})
+			if err := m.VeDelegatedAmounts[len(m.VeDelegatedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VeUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeUnbondingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeUnbondingDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeUnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VeRedelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeRedelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeRedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeRedelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeRedelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegationResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeDelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorVeDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorVeDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorVeDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorVeDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorVeDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorVeDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegationResponses = append(m.VeDelegationResponses, VeDelegationResponse{})
+			if err := m.VeDelegationResponses[len(m.VeDelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorVeDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorVeDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorVeDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorVeDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorVeDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorVeDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegationResponses = append(m.VeDelegationResponses, VeDelegationResponse{})
+			if err := m.VeDelegationResponses[len(m.VeDelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeDelegationsByVeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeDelegationsByVeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeDelegationsByVeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeDelegationsByVeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeDelegationsByVeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeDelegationsByVeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegationResponses = append(m.VeDelegationResponses, VeDelegationResponse{})
+			if err := m.VeDelegationResponses[len(m.VeDelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeUnbondingDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unbond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorVeUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorVeUnbondingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorVeUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorVeUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorVeUnbondingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorVeUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingResponses = append(m.UnbondingResponses, VeUnbondingDelegationResponse{})
+			if err := m.UnbondingResponses[len(m.UnbondingResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorVeUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorVeUnbondingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorVeUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorVeUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorVeUnbondingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorVeUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingResponses = append(m.UnbondingResponses, VeUnbondingDelegationResponse{})
+			if err := m.UnbondingResponses[len(m.UnbondingResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeUnbondingDelegationsByVeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationsByVeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationsByVeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeUnbondingDelegationsByVeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationsByVeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationsByVeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingResponses = append(m.UnbondingResponses, VeUnbondingDelegationResponse{})
+			if err := m.UnbondingResponses[len(m.UnbondingResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeRedelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeRedelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeRedelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeRedelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeRedelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeRedelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationResponses = append(m.RedelegationResponses, VeRedelegationResponse{})
+			if err := m.RedelegationResponses[len(m.RedelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeRedelegationsByVeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeRedelegationsByVeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeRedelegationsByVeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeRedelegationsByVeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeRedelegationsByVeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeRedelegationsByVeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationResponses = append(m.RedelegationResponses, VeRedelegationResponse{})
+			if err := m.RedelegationResponses[len(m.RedelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
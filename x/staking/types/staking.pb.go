@@ -410,6 +410,51 @@ func (m *VeTokens) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VeTokens proto.InternalMessageInfo
 
+// VeAutoCompoundEntry is the staking rewards of a ve withdrawn from a
+// delegation, which are pending to be compounded into the ve lock and
+// delegated back to the validator.
+type VeAutoCompoundEntry struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// address to which the rewards have been withdrawn
+	WithdrawAddress string                                 `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	VeId            uint64                                 `protobuf:"varint,4,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Amount          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *VeAutoCompoundEntry) Reset()         { *m = VeAutoCompoundEntry{} }
+func (m *VeAutoCompoundEntry) String() string { return proto.CompactTextString(m) }
+func (*VeAutoCompoundEntry) ProtoMessage()    {}
+func (*VeAutoCompoundEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ca92d6e009ad29a, []int{10}
+}
+func (m *VeAutoCompoundEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeAutoCompoundEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeAutoCompoundEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeAutoCompoundEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeAutoCompoundEntry.Merge(m, src)
+}
+func (m *VeAutoCompoundEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeAutoCompoundEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeAutoCompoundEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeAutoCompoundEntry proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*VeValidator)(nil), "gridiron.staking.v1.VeValidator")
 	proto.RegisterType((*VeDelegation)(nil), "gridiron.staking.v1.VeDelegation")
@@ -421,54 +466,58 @@ func init() {
 	proto.RegisterType((*VeRedelegationEntry)(nil), "gridiron.staking.v1.VeRedelegationEntry")
 	proto.RegisterType((*VeRedelegationEntryShares)(nil), "gridiron.staking.v1.VeRedelegationEntryShares")
 	proto.RegisterType((*VeTokens)(nil), "gridiron.staking.v1.VeTokens")
+	proto.RegisterType((*VeAutoCompoundEntry)(nil), "gridiron.staking.v1.VeAutoCompoundEntry")
 }
 
 func init() { proto.RegisterFile("gridiron/staking/v1/staking.proto", fileDescriptor_0ca92d6e009ad29a) }
 
 var fileDescriptor_0ca92d6e009ad29a = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0x34, 0xb1, 0x4d, 0x5f, 0xa5, 0x7f, 0x36, 0x2d, 0xd4, 0x22, 0x9b, 0xd2, 0x83, 0x56,
-	0x4a, 0x77, 0x49, 0x05, 0x0f, 0x5e, 0xc4, 0x12, 0xab, 0x3d, 0x54, 0x24, 0xb5, 0x2b, 0x08, 0x1a,
-	0x37, 0xbb, 0x8f, 0x74, 0x69, 0x32, 0x53, 0x77, 0xa6, 0x83, 0xf1, 0x2e, 0x78, 0xf4, 0x23, 0x14,
-	0xbf, 0x82, 0x5f, 0xa2, 0xe0, 0xa5, 0xe0, 0x45, 0x3c, 0x14, 0x49, 0x41, 0xfc, 0x08, 0x1e, 0x65,
-	0xff, 0xcc, 0x66, 0xdb, 0x6e, 0x89, 0xee, 0xa1, 0xa7, 0x4c, 0xe6, 0xbd, 0xdf, 0xef, 0xfd, 0x7e,
-	0xf3, 0x1e, 0x6f, 0x61, 0xb1, 0x8b, 0x7e, 0xc7, 0x63, 0xd4, 0xe4, 0xc2, 0xde, 0xf3, 0x68, 0xdb,
-	0x94, 0x35, 0x75, 0x34, 0xf6, 0x7d, 0x26, 0x98, 0xa6, 0xc5, 0x19, 0x86, 0xba, 0x96, 0xb5, 0x85,
-	0xd9, 0x36, 0x6b, 0xb3, 0x30, 0x6c, 0x06, 0xa7, 0x28, 0x73, 0x41, 0x77, 0x18, 0xef, 0x32, 0x6e,
-	0xb6, 0x6c, 0x8e, 0xa6, 0xac, 0xb5, 0x50, 0xd8, 0x35, 0xd3, 0x61, 0x1e, 0x8d, 0xe2, 0x4b, 0x9f,
-	0x09, 0x4c, 0x58, 0x68, 0xd9, 0x1d, 0xcf, 0xb5, 0x05, 0xf3, 0xb5, 0x3b, 0x30, 0xcd, 0xf6, 0xd1,
-	0x0f, 0xce, 0x4d, 0xdb, 0x75, 0x7d, 0xe4, 0x7c, 0x9e, 0x2c, 0x92, 0xe5, 0xf1, 0xc6, 0x94, 0xba,
-	0x7f, 0x18, 0x5d, 0x6b, 0xaf, 0xa1, 0x22, 0xb1, 0xe9, 0x62, 0x07, 0xdb, 0x61, 0x3a, 0xdf, 0xb5,
-	0x7d, 0xe4, 0xf3, 0x23, 0x41, 0xf6, 0xba, 0x71, 0x74, 0x52, 0x2d, 0xfc, 0x38, 0xa9, 0xde, 0x6a,
-	0x7b, 0x62, 0xf7, 0xa0, 0x65, 0x38, 0xac, 0x6b, 0xc6, 0x52, 0xa2, 0x9f, 0x55, 0xee, 0xee, 0x99,
-	0xa2, 0xb7, 0x8f, 0xdc, 0xa8, 0xa3, 0xd3, 0x98, 0x91, 0x58, 0x57, 0x4c, 0xdb, 0x21, 0xd1, 0xfd,
-	0xf2, 0xc7, 0xc3, 0x6a, 0xe1, 0xf7, 0x61, 0xb5, 0xb0, 0xf4, 0x85, 0xc0, 0x75, 0x4b, 0xc5, 0x3d,
-	0x46, 0xb5, 0x15, 0x98, 0x19, 0xd4, 0x3d, 0x2b, 0x73, 0x3a, 0x09, 0x28, 0x9d, 0x2b, 0x30, 0x23,
-	0x95, 0xbf, 0x24, 0x79, 0x24, 0x4a, 0x4e, 0x02, 0x2a, 0xf9, 0x01, 0x8c, 0x4b, 0x54, 0x56, 0x8a,
-	0x8b, 0xc5, 0xe5, 0x89, 0xb5, 0x9b, 0xc6, 0xc5, 0xd7, 0x36, 0x2c, 0x8c, 0x54, 0xae, 0x97, 0x02,
-	0xa3, 0x8d, 0xb2, 0xc4, 0x0b, 0xaa, 0xbf, 0x11, 0x28, 0xab, 0x34, 0xad, 0x02, 0xd7, 0x24, 0x36,
-	0x3d, 0x37, 0x54, 0x59, 0x6a, 0x94, 0x24, 0x6e, 0xba, 0xda, 0x1b, 0x98, 0x15, 0x6c, 0x0f, 0x29,
-	0x6f, 0x76, 0xed, 0x5e, 0xf3, 0x80, 0x72, 0x14, 0xa2, 0x83, 0x6e, 0x8e, 0x27, 0xdc, 0xa4, 0xa2,
-	0xa1, 0x45, 0x5c, 0x5b, 0x76, 0x6f, 0x47, 0x31, 0x69, 0x1b, 0x30, 0x9a, 0x78, 0xc9, 0xd3, 0x96,
-	0x18, 0x9d, 0x72, 0xf5, 0x95, 0xc0, 0x9c, 0x85, 0x3b, 0xb4, 0xc5, 0xa8, 0xeb, 0xd1, 0xf6, 0x95,
-	0x34, 0xe5, 0x29, 0x8c, 0x21, 0x15, 0xbe, 0x97, 0xb4, 0xc4, 0xc8, 0x6e, 0x49, 0x86, 0xaa, 0x47,
-	0x54, 0xf8, 0xbd, 0xb8, 0x49, 0x8a, 0x24, 0xe5, 0xe6, 0x03, 0x81, 0x85, 0xcb, 0x71, 0xda, 0x2b,
-	0x98, 0x90, 0xd8, 0x6c, 0xd9, 0x1d, 0x9b, 0x3a, 0x18, 0x98, 0x09, 0x8a, 0xdf, 0xfb, 0xcf, 0xe2,
-	0x31, 0x3a, 0x16, 0x01, 0x12, 0xd5, 0x4d, 0x4a, 0xc7, 0x2f, 0x02, 0x4b, 0xc3, 0x29, 0xb2, 0xa7,
-	0xe8, 0x05, 0x4c, 0x79, 0xd4, 0x13, 0x9e, 0xdd, 0x51, 0x4a, 0x73, 0x0e, 0xd0, 0x64, 0x4c, 0x13,
-	0x97, 0xd3, 0x9e, 0xc0, 0x98, 0x22, 0x2c, 0xe6, 0x22, 0x54, 0xf0, 0x94, 0xd1, 0x3f, 0x04, 0x26,
-	0x2d, 0x6c, 0xa0, 0x9b, 0x73, 0x6e, 0xd6, 0x60, 0x6e, 0x30, 0x37, 0xdc, 0x77, 0xce, 0xcd, 0x4e,
-	0x25, 0x09, 0x6e, 0xfb, 0x4e, 0x26, 0xc6, 0xe5, 0x22, 0xc1, 0x14, 0xcf, 0x61, 0xea, 0x5c, 0x28,
-	0xcc, 0xe3, 0xc1, 0xc8, 0x95, 0xc2, 0xae, 0xdf, 0xce, 0xee, 0x7a, 0xda, 0xc9, 0x90, 0x59, 0x7b,
-	0x0b, 0x95, 0x8c, 0x7c, 0xed, 0x59, 0x7a, 0xe3, 0x44, 0x13, 0xb6, 0xfa, 0x8f, 0xb5, 0x86, 0xae,
-	0xa0, 0x3e, 0x81, 0x1b, 0x97, 0xe2, 0xae, 0x78, 0x9a, 0xb6, 0x00, 0x22, 0x93, 0x41, 0x0b, 0x72,
-	0xae, 0xa3, 0xf1, 0x88, 0xa1, 0xce, 0xc5, 0x99, 0x77, 0x2d, 0x5b, 0xf8, 0x3c, 0xdc, 0x7d, 0xd9,
-	0x96, 0x36, 0x60, 0x34, 0x5a, 0x8d, 0x39, 0x9d, 0xc4, 0xe8, 0x41, 0xc9, 0xf5, 0xcd, 0xa3, 0xbe,
-	0x4e, 0x8e, 0xfb, 0x3a, 0xf9, 0xd9, 0xd7, 0xc9, 0xa7, 0x53, 0xbd, 0x70, 0x7c, 0xaa, 0x17, 0xbe,
-	0x9f, 0xea, 0x85, 0x97, 0x66, 0x8a, 0x33, 0x6e, 0xe2, 0xea, 0x7b, 0x46, 0x51, 0xfd, 0x31, 0xdf,
-	0x25, 0x5f, 0xf5, 0xb0, 0x40, 0x6b, 0x34, 0xfc, 0x0e, 0xdf, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x1d, 0x7b, 0xd9, 0xa0, 0xf5, 0x07, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x4f, 0x13, 0x5d,
+	0x14, 0xee, 0xd0, 0x52, 0xe0, 0xf0, 0x86, 0x8f, 0x29, 0x24, 0xbc, 0x24, 0x6f, 0xcb, 0xcb, 0xc2,
+	0x40, 0x08, 0x33, 0x29, 0x2e, 0x4c, 0x5c, 0x09, 0x56, 0x02, 0x31, 0xc4, 0x58, 0xa4, 0x26, 0x2e,
+	0xa8, 0xb7, 0xbd, 0x27, 0xe5, 0x86, 0xf6, 0xde, 0x3a, 0xf7, 0x76, 0xb0, 0xee, 0xdc, 0x18, 0x97,
+	0xfe, 0x04, 0xe2, 0x6f, 0xf0, 0x47, 0xb0, 0x70, 0x41, 0xe2, 0xc6, 0xb8, 0x20, 0xa6, 0x24, 0xc6,
+	0xbf, 0xe0, 0xce, 0xcc, 0x4c, 0xef, 0x74, 0xa8, 0x6d, 0xd0, 0x49, 0x64, 0xd5, 0xdb, 0xf3, 0xf1,
+	0x9c, 0xf3, 0x9c, 0xf3, 0xe4, 0x64, 0xe0, 0xff, 0x9a, 0xc3, 0x28, 0x73, 0x04, 0xb7, 0xa5, 0x22,
+	0xc7, 0x8c, 0xd7, 0x6c, 0x37, 0xaf, 0x9f, 0x56, 0xd3, 0x11, 0x4a, 0x98, 0x19, 0x1d, 0x62, 0x69,
+	0xbb, 0x9b, 0x5f, 0x9c, 0xab, 0x89, 0x9a, 0xf0, 0xfd, 0xb6, 0xf7, 0x0a, 0x42, 0x17, 0xb3, 0x55,
+	0x21, 0x1b, 0x42, 0xda, 0x15, 0x22, 0xd1, 0x76, 0xf3, 0x15, 0x54, 0x24, 0x6f, 0x57, 0x05, 0xe3,
+	0x81, 0x7f, 0xf9, 0xbd, 0x01, 0x93, 0x25, 0x2c, 0x91, 0x3a, 0xa3, 0x44, 0x09, 0xc7, 0x5c, 0x85,
+	0x19, 0xd1, 0x44, 0xc7, 0x7b, 0x97, 0x09, 0xa5, 0x0e, 0x4a, 0xb9, 0x60, 0x2c, 0x19, 0x2b, 0x13,
+	0xc5, 0x69, 0x6d, 0xdf, 0x0c, 0xcc, 0xe6, 0x21, 0x64, 0x5c, 0x2c, 0x53, 0xac, 0x63, 0xcd, 0x0f,
+	0x97, 0x47, 0xc4, 0x41, 0xb9, 0x30, 0xe2, 0x45, 0x6f, 0x59, 0x67, 0x17, 0xb9, 0xc4, 0x97, 0x8b,
+	0xdc, 0xad, 0x1a, 0x53, 0x47, 0xad, 0x8a, 0x55, 0x15, 0x0d, 0xbb, 0xdb, 0x4a, 0xf0, 0xb3, 0x2e,
+	0xe9, 0xb1, 0xad, 0xda, 0x4d, 0x94, 0x56, 0x01, 0xab, 0xc5, 0x59, 0x17, 0x0b, 0x1a, 0x69, 0xdf,
+	0x07, 0xba, 0x3b, 0xfe, 0xf6, 0x34, 0x97, 0xf8, 0x7e, 0x9a, 0x4b, 0x2c, 0x7f, 0x30, 0xe0, 0x9f,
+	0x92, 0xf6, 0x33, 0xc1, 0xcd, 0x35, 0x98, 0xed, 0xd5, 0xbd, 0xda, 0xe6, 0x4c, 0xe8, 0xd0, 0x7d,
+	0xae, 0xc1, 0xac, 0xab, 0xf9, 0x85, 0xc1, 0x23, 0x41, 0x70, 0xe8, 0xd0, 0xc1, 0xf7, 0x60, 0xc2,
+	0x45, 0x4d, 0x25, 0xb9, 0x94, 0x5c, 0x99, 0xdc, 0xf8, 0xcf, 0x1a, 0x30, 0x6e, 0xab, 0x84, 0x41,
+	0x9b, 0x5b, 0x29, 0x8f, 0x69, 0x71, 0xdc, 0xc5, 0x5f, 0xda, 0xfe, 0x64, 0xc0, 0xb8, 0x0e, 0x33,
+	0x33, 0x30, 0xea, 0x62, 0x99, 0x51, 0xbf, 0xcd, 0x54, 0x31, 0xe5, 0xe2, 0x2e, 0x35, 0x9f, 0xc3,
+	0x9c, 0x12, 0xc7, 0xc8, 0x65, 0xb9, 0x41, 0xda, 0xe5, 0x16, 0x97, 0xa8, 0x54, 0x1d, 0x69, 0x8c,
+	0x19, 0xee, 0x72, 0x55, 0x34, 0x03, 0xac, 0x3d, 0xd2, 0x3e, 0xd0, 0x48, 0xe6, 0x36, 0xa4, 0x43,
+	0x32, 0x71, 0xf6, 0x92, 0x96, 0xfd, 0xac, 0x3e, 0x1a, 0x30, 0x5f, 0xc2, 0x03, 0x5e, 0x11, 0x9c,
+	0x32, 0x5e, 0xbb, 0x91, 0xad, 0x3c, 0x82, 0x31, 0xe4, 0xca, 0x61, 0xe1, 0x4e, 0xec, 0x21, 0x3b,
+	0x19, 0xd0, 0xd6, 0x03, 0xae, 0x9c, 0x76, 0x77, 0x4b, 0x1a, 0x25, 0x42, 0xe7, 0x8d, 0x01, 0x8b,
+	0xc3, 0xf3, 0xcc, 0x43, 0x98, 0x74, 0xb1, 0x5c, 0x21, 0x75, 0xc2, 0xab, 0xe8, 0xb1, 0xf1, 0xaa,
+	0xdf, 0xf9, 0xd3, 0xea, 0xdd, 0xf4, 0x6e, 0x17, 0xe0, 0xa2, 0xb6, 0x44, 0x1a, 0xf9, 0x66, 0xc0,
+	0xf2, 0xf5, 0x10, 0x83, 0x75, 0xf4, 0x14, 0xa6, 0x19, 0x67, 0x8a, 0x91, 0xba, 0x6e, 0x35, 0xa6,
+	0x84, 0xa6, 0xba, 0x30, 0xdd, 0x72, 0xe6, 0x0e, 0x8c, 0x69, 0xc0, 0x64, 0x2c, 0x40, 0x9d, 0x1e,
+	0x21, 0xfa, 0xc3, 0x80, 0xa9, 0x12, 0x16, 0x91, 0xc6, 0x54, 0xce, 0x06, 0xcc, 0xf7, 0x94, 0x23,
+	0x9d, 0x6a, 0x9f, 0x7a, 0x32, 0xa1, 0x73, 0xdf, 0xa9, 0x0e, 0xcc, 0xa1, 0x52, 0x85, 0x39, 0xc9,
+	0xbe, 0x9c, 0x82, 0x54, 0x3a, 0x67, 0xa7, 0x27, 0xba, 0x94, 0xbf, 0xf6, 0x95, 0x21, 0x6b, 0x8f,
+	0x52, 0xb9, 0x46, 0x6d, 0x0e, 0x64, 0x06, 0xc4, 0x9b, 0x8f, 0xa3, 0x57, 0x27, 0xd0, 0x98, 0xf5,
+	0xbb, 0xc5, 0xae, 0x3d, 0x43, 0x1d, 0x03, 0xfe, 0x1d, 0x9a, 0x77, 0xc3, 0x7a, 0xda, 0x03, 0x08,
+	0x58, 0x7a, 0x4b, 0x88, 0x79, 0x92, 0x26, 0x02, 0x84, 0x82, 0x54, 0x11, 0x92, 0x2f, 0xbc, 0x53,
+	0xfb, 0xc4, 0xbf, 0x7f, 0x83, 0x29, 0x6d, 0x43, 0x3a, 0x38, 0x8f, 0x31, 0x99, 0x74, 0xb3, 0x23,
+	0x25, 0x5f, 0x8f, 0x78, 0xcb, 0xdc, 0x6c, 0x29, 0x71, 0x5f, 0x34, 0x9a, 0xa2, 0xc5, 0x69, 0xb0,
+	0xcc, 0xbf, 0x77, 0x06, 0x57, 0x61, 0xe6, 0x84, 0xa9, 0x23, 0xea, 0x90, 0x93, 0x3e, 0x01, 0x4f,
+	0x6b, 0xbb, 0x0e, 0x0d, 0x67, 0x90, 0xba, 0x3a, 0x03, 0xd2, 0x10, 0x2d, 0xae, 0x16, 0x46, 0xe3,
+	0xcd, 0x20, 0xc8, 0xee, 0xcd, 0x60, 0xeb, 0xe1, 0x59, 0x27, 0x6b, 0x9c, 0x77, 0xb2, 0xc6, 0xd7,
+	0x4e, 0xd6, 0x78, 0x77, 0x99, 0x4d, 0x9c, 0x5f, 0x66, 0x13, 0x9f, 0x2f, 0xb3, 0x89, 0x67, 0xf9,
+	0x08, 0xa6, 0x56, 0xf2, 0xfa, 0x2b, 0xc1, 0x31, 0xfc, 0x67, 0xbf, 0x0c, 0xbf, 0x70, 0xfc, 0x12,
+	0x95, 0xb4, 0xff, 0x49, 0x72, 0xfb, 0xe7, 0x00, 0x2d, 0x13, 0xf5, 0xa5, 0x02, 0x09, 0x00, 0x00,
 }
 
 func (m *VeValidator) Marshal() (dAtA []byte, err error) {
@@ -927,6 +976,65 @@ func (m *VeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VeAutoCompoundEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeAutoCompoundEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeAutoCompoundEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.VeId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -1118,6 +1226,32 @@ func (m *VeTokens) Size() (n int) {
 	return n
 }
 
+func (m *VeAutoCompoundEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.VeId != 0 {
+		n += 1 + sovStaking(uint64(m.VeId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2398,6 +2532,205 @@ func (m *VeTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VeAutoCompoundEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeAutoCompoundEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeAutoCompoundEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
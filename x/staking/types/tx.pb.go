@@ -10,19 +10,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -106,41 +110,349 @@ func (m *MsgVeDelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVeDelegateResponse proto.InternalMessageInfo
 
+type MsgSetVeAutoCompound struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId    string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetVeAutoCompound) Reset()         { *m = MsgSetVeAutoCompound{} }
+func (m *MsgSetVeAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVeAutoCompound) ProtoMessage()    {}
+func (*MsgSetVeAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{2}
+}
+func (m *MsgSetVeAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVeAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVeAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVeAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVeAutoCompound.Merge(m, src)
+}
+func (m *MsgSetVeAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVeAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVeAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVeAutoCompound proto.InternalMessageInfo
+
+type MsgSetVeAutoCompoundResponse struct {
+}
+
+func (m *MsgSetVeAutoCompoundResponse) Reset()         { *m = MsgSetVeAutoCompoundResponse{} }
+func (m *MsgSetVeAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVeAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetVeAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{3}
+}
+func (m *MsgSetVeAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVeAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVeAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVeAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVeAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetVeAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVeAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVeAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVeAutoCompoundResponse proto.InternalMessageInfo
+
+// VeAmount defines an amount of coins delegated from a ve.
+type VeAmount struct {
+	VeId   string     `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *VeAmount) Reset()         { *m = VeAmount{} }
+func (m *VeAmount) String() string { return proto.CompactTextString(m) }
+func (*VeAmount) ProtoMessage()    {}
+func (*VeAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{4}
+}
+func (m *VeAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeAmount.Merge(m, src)
+}
+func (m *VeAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeAmount proto.InternalMessageInfo
+
+type MsgVeUndelegate struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address"`
+	Amounts          []VeAmount `protobuf:"bytes,3,rep,name=amounts,proto3" json:"amounts"`
+}
+
+func (m *MsgVeUndelegate) Reset()         { *m = MsgVeUndelegate{} }
+func (m *MsgVeUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgVeUndelegate) ProtoMessage()    {}
+func (*MsgVeUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{5}
+}
+func (m *MsgVeUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVeUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVeUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVeUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVeUndelegate.Merge(m, src)
+}
+func (m *MsgVeUndelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVeUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVeUndelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVeUndelegate proto.InternalMessageInfo
+
+type MsgVeUndelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgVeUndelegateResponse) Reset()         { *m = MsgVeUndelegateResponse{} }
+func (m *MsgVeUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVeUndelegateResponse) ProtoMessage()    {}
+func (*MsgVeUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{6}
+}
+func (m *MsgVeUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVeUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVeUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVeUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVeUndelegateResponse.Merge(m, src)
+}
+func (m *MsgVeUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVeUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVeUndelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVeUndelegateResponse proto.InternalMessageInfo
+
+func (m *MsgVeUndelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+type MsgVeRedelegate struct {
+	DelegatorAddress    string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address"`
+	ValidatorSrcAddress string     `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address"`
+	ValidatorDstAddress string     `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address"`
+	Amounts             []VeAmount `protobuf:"bytes,4,rep,name=amounts,proto3" json:"amounts"`
+}
+
+func (m *MsgVeRedelegate) Reset()         { *m = MsgVeRedelegate{} }
+func (m *MsgVeRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgVeRedelegate) ProtoMessage()    {}
+func (*MsgVeRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{7}
+}
+func (m *MsgVeRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVeRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVeRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVeRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVeRedelegate.Merge(m, src)
+}
+func (m *MsgVeRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVeRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVeRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVeRedelegate proto.InternalMessageInfo
+
+type MsgVeRedelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgVeRedelegateResponse) Reset()         { *m = MsgVeRedelegateResponse{} }
+func (m *MsgVeRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVeRedelegateResponse) ProtoMessage()    {}
+func (*MsgVeRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{8}
+}
+func (m *MsgVeRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVeRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVeRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVeRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVeRedelegateResponse.Merge(m, src)
+}
+func (m *MsgVeRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVeRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVeRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVeRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgVeRedelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*MsgVeDelegate)(nil), "gridiron.staking.v1.MsgVeDelegate")
 	proto.RegisterType((*MsgVeDelegateResponse)(nil), "gridiron.staking.v1.MsgVeDelegateResponse")
+	proto.RegisterType((*MsgSetVeAutoCompound)(nil), "gridiron.staking.v1.MsgSetVeAutoCompound")
+	proto.RegisterType((*MsgSetVeAutoCompoundResponse)(nil), "gridiron.staking.v1.MsgSetVeAutoCompoundResponse")
+	proto.RegisterType((*VeAmount)(nil), "gridiron.staking.v1.VeAmount")
+	proto.RegisterType((*MsgVeUndelegate)(nil), "gridiron.staking.v1.MsgVeUndelegate")
+	proto.RegisterType((*MsgVeUndelegateResponse)(nil), "gridiron.staking.v1.MsgVeUndelegateResponse")
+	proto.RegisterType((*MsgVeRedelegate)(nil), "gridiron.staking.v1.MsgVeRedelegate")
+	proto.RegisterType((*MsgVeRedelegateResponse)(nil), "gridiron.staking.v1.MsgVeRedelegateResponse")
 }
 
 func init() { proto.RegisterFile("gridiron/staking/v1/tx.proto", fileDescriptor_4c4f94f8ae90b572) }
 
 var fileDescriptor_4c4f94f8ae90b572 = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x8b, 0xd4, 0x40,
-	0x14, 0xc7, 0x33, 0x77, 0xeb, 0xe1, 0x8d, 0x08, 0x67, 0xf0, 0x70, 0x5d, 0x65, 0x72, 0x06, 0x8b,
-	0x53, 0x70, 0x86, 0x9c, 0x85, 0x60, 0x67, 0xb4, 0xb9, 0xe2, 0x9a, 0x14, 0x16, 0x36, 0xcb, 0x64,
-	0xf3, 0x18, 0x07, 0x93, 0x79, 0x21, 0x33, 0x17, 0x4e, 0x4b, 0x0b, 0xb1, 0x12, 0xc1, 0x2f, 0x70,
-	0x1f, 0x67, 0xcb, 0x05, 0x1b, 0xab, 0x45, 0x76, 0x2d, 0x64, 0x3f, 0x82, 0x95, 0xec, 0x26, 0x1b,
-	0x5d, 0x56, 0xb0, 0x7b, 0xfc, 0xff, 0xef, 0xfd, 0x86, 0xff, 0x7b, 0x43, 0xef, 0x14, 0x50, 0xe5,
-	0x1a, 0x8d, 0xb0, 0x4e, 0xbe, 0xd1, 0x46, 0x89, 0x3a, 0x12, 0xee, 0x82, 0x97, 0x15, 0x3a, 0xf4,
-	0xfd, 0xd6, 0xe4, 0xad, 0xc9, 0xeb, 0x68, 0x70, 0x57, 0x21, 0xaa, 0x1c, 0x84, 0x2c, 0xb5, 0x90,
-	0xc6, 0xa0, 0x93, 0x4e, 0xa3, 0xb1, 0xcd, 0xc4, 0xe0, 0xa6, 0x42, 0x85, 0xab, 0x52, 0x2c, 0xab,
-	0x56, 0x65, 0x23, 0xb4, 0x05, 0x5a, 0x91, 0x4a, 0x0b, 0xa2, 0x8e, 0x52, 0x70, 0x32, 0x12, 0x23,
-	0xd4, 0xa6, 0xf1, 0xc3, 0x5f, 0x84, 0x5e, 0x3f, 0xb3, 0xea, 0x25, 0xbc, 0x80, 0x1c, 0x94, 0x74,
-	0xe0, 0xc7, 0xf4, 0x46, 0xd6, 0xd4, 0x58, 0x0d, 0x65, 0x96, 0x55, 0x60, 0x6d, 0x9f, 0x1c, 0x91,
-	0xe3, 0xfd, 0xf8, 0x70, 0x31, 0x0d, 0xb6, 0xcd, 0xe4, 0xa0, 0x93, 0x9e, 0x35, 0xca, 0x92, 0x51,
-	0xcb, 0x5c, 0x67, 0x1b, 0x8c, 0x9d, 0x3f, 0x8c, 0x2d, 0x33, 0x39, 0xe8, 0xa4, 0x35, 0x83, 0xd1,
-	0x2b, 0x35, 0x0c, 0x75, 0xd6, 0xdf, 0x5d, 0xcd, 0xed, 0x2f, 0xa6, 0x41, 0x23, 0x24, 0xbd, 0x1a,
-	0x4e, 0x33, 0xff, 0x09, 0xdd, 0x93, 0x05, 0x9e, 0x1b, 0xd7, 0xef, 0x1d, 0x91, 0xe3, 0x6b, 0x27,
-	0xb7, 0x79, 0x13, 0x95, 0x2f, 0xa3, 0xf2, 0x36, 0x2a, 0x7f, 0x8e, 0xda, 0xc4, 0xbd, 0xf1, 0x34,
-	0xf0, 0x92, 0xb6, 0xfd, 0xe9, 0xd5, 0x8f, 0x97, 0x81, 0xf7, 0xf3, 0x32, 0xf0, 0xc2, 0x5b, 0xf4,
-	0x70, 0x23, 0x7b, 0x02, 0xb6, 0x44, 0x63, 0xe1, 0xe4, 0x13, 0xa1, 0xbb, 0x67, 0x56, 0xf9, 0x1f,
-	0x08, 0xa5, 0x7f, 0xad, 0xe6, 0x1e, 0xdf, 0xbe, 0x0a, 0xdf, 0x20, 0x0c, 0x1e, 0xfc, 0xb7, 0x65,
-	0xfd, 0x48, 0xf8, 0xf0, 0xfd, 0xd7, 0x1f, 0x5f, 0x76, 0xee, 0xfb, 0xa1, 0xf8, 0xe7, 0x47, 0x10,
-	0x35, 0x0c, 0xdb, 0xc5, 0x42, 0x7c, 0x3a, 0x9e, 0x31, 0x32, 0x99, 0x31, 0xf2, 0x7d, 0xc6, 0xc8,
-	0xe7, 0x39, 0xf3, 0x26, 0x73, 0xe6, 0x7d, 0x9b, 0x33, 0xef, 0x95, 0x50, 0xda, 0xbd, 0x3e, 0x4f,
-	0xf9, 0x08, 0x8b, 0x35, 0xe7, 0xd1, 0x3b, 0x34, 0xd0, 0x41, 0x2f, 0x3a, 0xac, 0x7b, 0x5b, 0x82,
-	0x4d, 0xf7, 0x56, 0x87, 0x7f, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x97, 0x1e, 0x77, 0x7f,
-	0x02, 0x00, 0x00,
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x4f, 0x13, 0x4d,
+	0x1c, 0xee, 0xb6, 0x7d, 0xa1, 0x0c, 0xef, 0xfb, 0x02, 0x0b, 0x48, 0x69, 0x70, 0x97, 0x54, 0x89,
+	0xa8, 0x30, 0x9b, 0x56, 0x13, 0x13, 0x13, 0x0f, 0x14, 0x2e, 0xc6, 0xf4, 0x32, 0x28, 0x07, 0x2f,
+	0xcd, 0xb6, 0xfb, 0x73, 0xd9, 0xd8, 0x9d, 0x69, 0x76, 0xa6, 0x1b, 0xf4, 0xe8, 0x49, 0x6f, 0x18,
+	0xbf, 0x00, 0x37, 0xbf, 0x84, 0x1f, 0x80, 0x23, 0x89, 0x17, 0x4f, 0xd5, 0xb4, 0x1e, 0x0c, 0x1f,
+	0xc1, 0x93, 0xe9, 0xfe, 0x6d, 0xe9, 0x22, 0x0d, 0xc1, 0xc4, 0xdb, 0xce, 0xef, 0xcf, 0x33, 0xcf,
+	0xf3, 0xcc, 0xfc, 0x66, 0xd1, 0x8a, 0xe9, 0x58, 0x86, 0xe5, 0x30, 0xaa, 0x71, 0xa1, 0xbf, 0xb4,
+	0xa8, 0xa9, 0xb9, 0x25, 0x4d, 0x1c, 0xe0, 0x96, 0xc3, 0x04, 0x93, 0xe7, 0xc3, 0x2c, 0x0e, 0xb2,
+	0xd8, 0x2d, 0x15, 0x56, 0x4c, 0xc6, 0xcc, 0x26, 0x68, 0x7a, 0xcb, 0xd2, 0x74, 0x4a, 0x99, 0xd0,
+	0x85, 0xc5, 0x28, 0xf7, 0x5b, 0x0a, 0x0b, 0x26, 0x33, 0x99, 0xf7, 0xa9, 0xf5, 0xbf, 0x82, 0xa8,
+	0x1a, 0xf4, 0x78, 0xab, 0x7a, 0xfb, 0x85, 0x26, 0x2c, 0x1b, 0xb8, 0xd0, 0xed, 0x56, 0x50, 0xa0,
+	0x34, 0x18, 0xb7, 0x19, 0xd7, 0xea, 0x3a, 0x07, 0xcd, 0x2d, 0xd5, 0x41, 0xe8, 0x25, 0xad, 0xc1,
+	0x2c, 0xea, 0xe7, 0x8b, 0x3f, 0x25, 0xf4, 0x5f, 0x95, 0x9b, 0x7b, 0xb0, 0x03, 0x4d, 0x30, 0x75,
+	0x01, 0x72, 0x05, 0xcd, 0x19, 0xfe, 0x37, 0x73, 0x6a, 0xba, 0x61, 0x38, 0xc0, 0x79, 0x5e, 0x5a,
+	0x95, 0xd6, 0xa7, 0x2a, 0x8b, 0xa7, 0x1d, 0x75, 0x34, 0x49, 0x66, 0xa3, 0xd0, 0x96, 0x1f, 0xe9,
+	0x63, 0xb8, 0x7a, 0xd3, 0x32, 0x86, 0x30, 0xd2, 0x31, 0xc6, 0x48, 0x92, 0xcc, 0x46, 0xa1, 0x10,
+	0x43, 0x41, 0xff, 0xb8, 0x50, 0xb3, 0x8c, 0x7c, 0xc6, 0xeb, 0x9b, 0x3a, 0xed, 0xa8, 0x7e, 0x80,
+	0x64, 0x5d, 0x78, 0x6c, 0xc8, 0x0f, 0xd0, 0x84, 0x6e, 0xb3, 0x36, 0x15, 0xf9, 0xec, 0xaa, 0xb4,
+	0x3e, 0x5d, 0x5e, 0xc6, 0xbe, 0x54, 0xdc, 0x97, 0x8a, 0x03, 0xa9, 0x78, 0x9b, 0x59, 0xb4, 0x92,
+	0x3d, 0xee, 0xa8, 0x29, 0x12, 0x94, 0x3f, 0xcc, 0xbd, 0x3d, 0x52, 0x53, 0x3f, 0x8e, 0xd4, 0x54,
+	0x71, 0x09, 0x2d, 0x0e, 0x69, 0x27, 0xc0, 0x5b, 0x8c, 0x72, 0x28, 0x9a, 0x68, 0xa1, 0xca, 0xcd,
+	0x5d, 0x10, 0x7b, 0xb0, 0xd5, 0x16, 0x6c, 0x9b, 0xd9, 0x2d, 0xd6, 0xa6, 0x86, 0x7c, 0x0d, 0x4d,
+	0x70, 0xa0, 0x06, 0x38, 0xbe, 0x21, 0x24, 0x58, 0xc9, 0xf3, 0x21, 0x57, 0x4f, 0x63, 0x40, 0x30,
+	0x8f, 0x26, 0x81, 0xea, 0xf5, 0x26, 0xf8, 0x12, 0x72, 0x24, 0x5c, 0x0e, 0x30, 0x50, 0xd0, 0x4a,
+	0xd2, 0x46, 0x11, 0x11, 0x1b, 0xe5, 0xf6, 0x60, 0xcb, 0xe3, 0x1d, 0x1b, 0x22, 0x5d, 0x64, 0x48,
+	0xfa, 0xb2, 0x86, 0x74, 0x25, 0x34, 0xe3, 0x39, 0xf2, 0x8c, 0x1a, 0x7f, 0xdb, 0x7d, 0x78, 0x84,
+	0x26, 0x7d, 0xbe, 0x3c, 0x9f, 0x59, 0xcd, 0xac, 0x4f, 0x97, 0xaf, 0xe3, 0x84, 0x29, 0xc2, 0xa1,
+	0x5d, 0x81, 0xc6, 0xb0, 0x67, 0x40, 0xe4, 0x3e, 0x5a, 0x3a, 0xa3, 0x31, 0xb4, 0x5b, 0xae, 0xa2,
+	0x99, 0x06, 0xb3, 0x5b, 0x4d, 0xe8, 0x4f, 0x5e, 0xad, 0x3f, 0x4b, 0x9e, 0xd2, 0xe9, 0x72, 0x01,
+	0xfb, 0x83, 0x86, 0xc3, 0x41, 0xc3, 0x4f, 0xc3, 0x41, 0xab, 0xe4, 0xfa, 0x1b, 0x1d, 0x7e, 0x55,
+	0x25, 0xf2, 0x7f, 0xdc, 0xdc, 0x4f, 0x17, 0x3f, 0xa5, 0x03, 0x3b, 0x09, 0x5c, 0xa9, 0x9d, 0x55,
+	0xb4, 0x18, 0x3b, 0xc6, 0x9d, 0xc6, 0x19, 0x4b, 0x97, 0x4f, 0x3b, 0x6a, 0x72, 0x01, 0x99, 0x8f,
+	0xc2, 0xbb, 0x4e, 0x23, 0x11, 0xce, 0xe0, 0x22, 0x82, 0xcb, 0x24, 0xc1, 0x0d, 0x14, 0x0c, 0xc0,
+	0xed, 0x70, 0x91, 0x70, 0x50, 0xd9, 0x2b, 0x39, 0x28, 0x02, 0x7f, 0xf8, 0xa0, 0xca, 0xbd, 0x2c,
+	0xca, 0x54, 0xb9, 0x29, 0xbf, 0x93, 0x10, 0x1a, 0x78, 0x0a, 0x8b, 0x89, 0xc4, 0x87, 0x9e, 0x8c,
+	0xc2, 0x9d, 0x8b, 0x6b, 0xa2, 0x69, 0xbe, 0xfb, 0xe6, 0xf3, 0xf7, 0x0f, 0xe9, 0x35, 0xf9, 0x86,
+	0x96, 0xfc, 0x77, 0xd0, 0x5c, 0xa8, 0x45, 0x17, 0xe5, 0xa3, 0x84, 0xe6, 0x46, 0x5f, 0xa0, 0xdb,
+	0xe7, 0x6d, 0x37, 0x52, 0x5a, 0x28, 0x8d, 0x5d, 0x1a, 0x11, 0xbc, 0xef, 0x11, 0xc4, 0xf2, 0xc6,
+	0x79, 0x04, 0x39, 0x88, 0x9a, 0x0b, 0x35, 0xbd, 0x2d, 0x58, 0xad, 0x11, 0x72, 0x7a, 0x2f, 0xa1,
+	0x7f, 0x87, 0x9e, 0x8c, 0x9b, 0xe7, 0x7b, 0x12, 0x57, 0x15, 0x36, 0xc6, 0xa9, 0x8a, 0xa8, 0x6d,
+	0x7a, 0xd4, 0x6e, 0xc9, 0x6b, 0xbf, 0xf1, 0xae, 0x1d, 0x53, 0xf0, 0x39, 0x11, 0x18, 0x87, 0x13,
+	0x81, 0x71, 0x38, 0x11, 0xb8, 0x14, 0x27, 0x27, 0x6a, 0xab, 0x3c, 0x39, 0xee, 0x2a, 0xd2, 0x49,
+	0x57, 0x91, 0xbe, 0x75, 0x15, 0xe9, 0xb0, 0xa7, 0xa4, 0x4e, 0x7a, 0x4a, 0xea, 0x4b, 0x4f, 0x49,
+	0x3d, 0x2f, 0x99, 0x96, 0xd8, 0x6f, 0xd7, 0x71, 0x83, 0xd9, 0x11, 0xd4, 0xe6, 0x6b, 0x46, 0x21,
+	0x06, 0x3e, 0x88, 0xa0, 0xc5, 0xab, 0x16, 0xf0, 0xfa, 0x84, 0x77, 0xc1, 0xef, 0xfd, 0x1a, 0x00,
+	0x47, 0x43, 0xeb, 0x04, 0x69, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +470,16 @@ type MsgClient interface {
 	// VeDelegate defines a method for performing a delegation of ve-locked coins
 	// from a delegator to a validator.
 	VeDelegate(ctx context.Context, in *MsgVeDelegate, opts ...grpc.CallOption) (*MsgVeDelegateResponse, error)
+	// SetVeAutoCompound defines a method for enabling or disabling the
+	// auto-compounding of the staking rewards of a ve into its lock.
+	SetVeAutoCompound(ctx context.Context, in *MsgSetVeAutoCompound, opts ...grpc.CallOption) (*MsgSetVeAutoCompoundResponse, error)
+	// VeUndelegate defines a method for performing an undelegation of the
+	// specified amounts of ve-locked coins from a delegator and a validator.
+	VeUndelegate(ctx context.Context, in *MsgVeUndelegate, opts ...grpc.CallOption) (*MsgVeUndelegateResponse, error)
+	// VeRedelegate defines a method for performing a redelegation of the
+	// specified amounts of ve-locked coins from a delegator and source validator
+	// to a destination validator.
+	VeRedelegate(ctx context.Context, in *MsgVeRedelegate, opts ...grpc.CallOption) (*MsgVeRedelegateResponse, error)
 }
 
 type msgClient struct {
@@ -177,11 +499,48 @@ func (c *msgClient) VeDelegate(ctx context.Context, in *MsgVeDelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetVeAutoCompound(ctx context.Context, in *MsgSetVeAutoCompound, opts ...grpc.CallOption) (*MsgSetVeAutoCompoundResponse, error) {
+	out := new(MsgSetVeAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Msg/SetVeAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VeUndelegate(ctx context.Context, in *MsgVeUndelegate, opts ...grpc.CallOption) (*MsgVeUndelegateResponse, error) {
+	out := new(MsgVeUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Msg/VeUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VeRedelegate(ctx context.Context, in *MsgVeRedelegate, opts ...grpc.CallOption) (*MsgVeRedelegateResponse, error) {
+	out := new(MsgVeRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.staking.v1.Msg/VeRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// VeDelegate defines a method for performing a delegation of ve-locked coins
 	// from a delegator to a validator.
 	VeDelegate(context.Context, *MsgVeDelegate) (*MsgVeDelegateResponse, error)
+	// SetVeAutoCompound defines a method for enabling or disabling the
+	// auto-compounding of the staking rewards of a ve into its lock.
+	SetVeAutoCompound(context.Context, *MsgSetVeAutoCompound) (*MsgSetVeAutoCompoundResponse, error)
+	// VeUndelegate defines a method for performing an undelegation of the
+	// specified amounts of ve-locked coins from a delegator and a validator.
+	VeUndelegate(context.Context, *MsgVeUndelegate) (*MsgVeUndelegateResponse, error)
+	// VeRedelegate defines a method for performing a redelegation of the
+	// specified amounts of ve-locked coins from a delegator and source validator
+	// to a destination validator.
+	VeRedelegate(context.Context, *MsgVeRedelegate) (*MsgVeRedelegateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -191,6 +550,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) VeDelegate(ctx context.Context, req *MsgVeDelegate) (*MsgVeDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VeDelegate not implemented")
 }
+func (*UnimplementedMsgServer) SetVeAutoCompound(ctx context.Context, req *MsgSetVeAutoCompound) (*MsgSetVeAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVeAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) VeUndelegate(ctx context.Context, req *MsgVeUndelegate) (*MsgVeUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeUndelegate not implemented")
+}
+func (*UnimplementedMsgServer) VeRedelegate(ctx context.Context, req *MsgVeRedelegate) (*MsgVeRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeRedelegate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -214,6 +582,60 @@ func _Msg_VeDelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetVeAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVeAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVeAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Msg/SetVeAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVeAutoCompound(ctx, req.(*MsgSetVeAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VeUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVeUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VeUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Msg/VeUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VeUndelegate(ctx, req.(*MsgVeUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VeRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVeRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VeRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.staking.v1.Msg/VeRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VeRedelegate(ctx, req.(*MsgVeRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.staking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -222,6 +644,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VeDelegate",
 			Handler:    _Msg_VeDelegate_Handler,
 		},
+		{
+			MethodName: "SetVeAutoCompound",
+			Handler:    _Msg_SetVeAutoCompound_Handler,
+		},
+		{
+			MethodName: "VeUndelegate",
+			Handler:    _Msg_VeUndelegate_Handler,
+		},
+		{
+			MethodName: "VeRedelegate",
+			Handler:    _Msg_VeRedelegate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/staking/v1/tx.proto",
@@ -252,108 +686,1264 @@ func (m *MsgVeDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgVeDelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVeAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVeAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVeAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVeAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVeAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVeAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VeAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgVeDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVeDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVeAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetVeAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VeAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVeUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVeUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVeRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVeRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVeDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVeDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVeDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVeDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVeDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVeAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVeAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVeAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVeAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVeAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVeAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VeAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVeUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVeUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVeUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, VeAmount{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
 
-func (m *MsgVeDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgVeDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgVeDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgVeUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVeUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVeUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgVeDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgVeRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -376,10 +1966,10 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVeDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVeRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVeDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVeRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -416,7 +2006,7 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -444,11 +2034,11 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -476,11 +2066,11 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -507,7 +2097,8 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amounts = append(m.Amounts, VeAmount{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -532,7 +2123,7 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVeDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVeRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -555,12 +2146,45 @@ func (m *MsgVeDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVeDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVeRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVeDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVeRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
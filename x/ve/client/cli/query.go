@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -10,6 +11,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagVeID = "ve-id"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group ve queries under a subcommand
@@ -22,6 +27,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryVeNftContract())
+	cmd.AddCommand(CmdQueryVotingDelegations())
+	cmd.AddCommand(CmdQueryVotingPowerHistory())
+	cmd.AddCommand(CmdQuerySlopeChanges())
+	cmd.AddCommand(CmdQueryLockedDistribution())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -50,3 +60,185 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryVeNftContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "venft-contract",
+		Short: "shows the address of the ERC-721 mirror contract of veNFTs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeNftContract(context.Background(), &types.QueryVeNftContractRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVotingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-delegations [delegate]",
+		Short: "lists the unexpired voting delegations of veNFTs to a delegate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingDelegations(context.Background(), &types.QueryVotingDelegationsRequest{
+				Delegate:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "voting-delegations")
+
+	return cmd
+}
+
+func CmdQueryVotingPowerHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power-history [start-time] [end-time] [points]",
+		Short: "samples the total voting power, or that of a veNFT, at evenly spaced unix times in the past or the future",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endTime, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			points, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			veID, err := cmd.Flags().GetString(FlagVeID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingPowerHistory(context.Background(), &types.QueryVotingPowerHistoryRequest{
+				VeId:      veID,
+				StartTime: startTime,
+				EndTime:   endTime,
+				Points:    uint32(points),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagVeID, "", "veNFT to sample, e.g., ve-100; the total voting power if empty")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySlopeChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slope-changes [start-time] [end-time]",
+		Short: "lists the scheduled slope changes of the total voting power, from now on by default",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySlopeChangesRequest{}
+			var err error
+			if len(args) > 0 {
+				req.StartTime, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+			if len(args) > 1 {
+				req.EndTime, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.SlopeChanges(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryLockedDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locked-distribution [start-time] [end-time]",
+		Short: "shows the unexpired locked amounts grouped by the unlocking time, from now on by default",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLockedDistributionRequest{}
+			var err error
+			if len(args) > 0 {
+				req.StartTime, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+			if len(args) > 1 {
+				req.EndTime, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.LockedDistribution(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
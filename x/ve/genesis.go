@@ -2,6 +2,8 @@ package ve
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/gridiron-zone/gridiron/x/ve/keeper"
 	"github.com/gridiron-zone/gridiron/x/ve/types"
 )
@@ -11,29 +13,124 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
-	// we only allow upgrade by in-place store migrations
-	// so check only once at block 1
+	// the class may have been imported by the nft module along with the ve NFTs
 	if !k.HasNftClass(ctx) {
 		if err := k.SaveNftClass(ctx); err != nil {
 			panic(err)
 		}
+	}
 
-		k.SetTotalLockedAmount(ctx, sdk.ZeroInt())
-		k.SetNextVeID(ctx, types.FirstVeID)
-		k.SetEpoch(ctx, types.EmptyEpoch)
-		k.SetCheckpoint(ctx, types.EmptyEpoch, types.Checkpoint{
-			Bias:      sdk.ZeroInt(),
-			Slope:     sdk.ZeroInt(),
-			Timestamp: 0,
-			Block:     0,
-		})
+	k.SetNextVeID(ctx, genState.NextVeId)
+	k.SetTotalLockedAmount(ctx, genState.TotalLockedAmount)
+	for _, locked := range genState.LockedAmounts {
+		k.SetLockedAmountByUser(ctx, locked.VeId, locked.Locked)
+	}
+	k.InitCheckpointHistory(ctx, types.LockedCheckpointKeys, genState.LockedCheckpoints)
+	for _, locked := range genState.DelegatedLockedAmounts {
+		k.SetDelegatedLockedByUser(ctx, locked.VeId, locked.Locked)
+	}
+	k.InitCheckpointHistory(ctx, types.DelegatedCheckpointKeys, genState.DelegatedCheckpoints)
+
+	for _, record := range genState.OwnerHistory {
+		var owner sdk.AccAddress
+		if record.Owner != "" {
+			var err error
+			if owner, err = sdk.AccAddressFromBech32(record.Owner); err != nil {
+				panic(err)
+			}
+		}
+		k.SetVeOwnerAt(ctx, record.VeId, record.Block, owner)
+	}
+	for _, delegation := range genState.VotingDelegations {
+		delegate, err := sdk.AccAddressFromBech32(delegation.Delegate)
+		if err != nil {
+			panic(err)
+		}
+		k.SetVotingDelegate(ctx, types.Uint64FromVeID(delegation.VeId), delegate)
+	}
+
+	if genState.NftContract != "" {
+		k.SetNftContract(ctx, common.HexToAddress(genState.NftContract))
+	}
+
+	// zero values are left unset, as they are before any emission
+	if !genState.TotalEmission.IsZero() {
+		k.SetTotalEmission(ctx, genState.TotalEmission)
+	}
+	if !genState.EmissionAtLastPeriod.IsZero() {
+		k.SetEmissionAtLastPeriod(ctx, genState.EmissionAtLastPeriod)
+	}
+	if genState.EmissionLastTimestamp != 0 {
+		k.SetEmissionLastTimestamp(ctx, genState.EmissionLastTimestamp)
+	}
+	if genState.DistributionAccruedLastTimestamp != 0 {
+		k.SetDistributionAccruedLastTimestamp(ctx, genState.DistributionAccruedLastTimestamp)
+	}
+	if !genState.DistributionTotalAmount.IsZero() {
+		k.SetDistributionTotalAmount(ctx, genState.DistributionTotalAmount)
+	}
+	for _, distribution := range genState.DistributionPerPeriod {
+		k.SetDistributionPerPeriod(ctx, distribution.Timestamp, distribution.Amount)
+	}
+	for _, claim := range genState.DistributionClaimLastTimestamps {
+		k.SetDistributionClaimLastTimestampByUser(ctx, claim.VeId, claim.Timestamp)
 	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
-	genesis.Params = k.GetParams(ctx)
+	genesis := &types.GenesisState{
+		Params:               k.GetParams(ctx),
+		NextVeId:             k.GetNextVeID(ctx),
+		TotalLockedAmount:    k.GetTotalLockedAmount(ctx),
+		LockedCheckpoints:    k.ExportCheckpointHistory(ctx, types.LockedCheckpointKeys),
+		DelegatedCheckpoints: k.ExportCheckpointHistory(ctx, types.DelegatedCheckpointKeys),
+
+		TotalEmission:                    k.GetTotalEmission(ctx),
+		EmissionAtLastPeriod:             k.GetEmissionAtLastPeriod(ctx),
+		EmissionLastTimestamp:            k.GetEmissionLastTimestamp(ctx),
+		DistributionAccruedLastTimestamp: k.GetDistributionAccruedLastTimestamp(ctx),
+		DistributionTotalAmount:          k.GetDistributionTotalAmount(ctx),
+	}
+
+	k.IterateLockedAmountByUser(ctx, func(veID uint64, locked types.LockedBalance) (stop bool) {
+		genesis.LockedAmounts = append(genesis.LockedAmounts, types.VeLockedBalance{VeId: veID, Locked: locked})
+		return false
+	})
+	k.IterateDelegatedLockedByUser(ctx, func(veID uint64, locked types.LockedBalance) (stop bool) {
+		genesis.DelegatedLockedAmounts = append(genesis.DelegatedLockedAmounts, types.VeLockedBalance{VeId: veID, Locked: locked})
+		return false
+	})
+
+	k.IterateVeOwnerHistory(ctx, func(veID uint64, block int64, owner sdk.AccAddress) (stop bool) {
+		record := types.VeOwnerRecord{VeId: veID, Block: block}
+		if !owner.Empty() {
+			record.Owner = owner.String()
+		}
+		genesis.OwnerHistory = append(genesis.OwnerHistory, record)
+		return false
+	})
+	k.IterateVotingDelegates(ctx, func(veID uint64, delegate sdk.AccAddress) (stop bool) {
+		genesis.VotingDelegations = append(genesis.VotingDelegations, types.VotingDelegation{
+			VeId:     types.VeIDFromUint64(veID),
+			Delegate: delegate.String(),
+			Expiry:   k.GetLockedAmountByUser(ctx, veID).End,
+		})
+		return false
+	})
+
+	k.IterateDistributionPerPeriod(ctx, func(timestamp uint64, amount sdk.Int) (stop bool) {
+		genesis.DistributionPerPeriod = append(genesis.DistributionPerPeriod, types.PeriodDistribution{Timestamp: timestamp, Amount: amount})
+		return false
+	})
+	k.IterateDistributionClaimLastTimestampByUser(ctx, func(veID uint64, timestamp uint64) (stop bool) {
+		genesis.DistributionClaimLastTimestamps = append(genesis.DistributionClaimLastTimestamps, types.VeClaimTimestamp{VeId: veID, Timestamp: timestamp})
+		return false
+	})
+
+	if contract, found := k.GetNftContract(ctx); found {
+		genesis.NftContract = contract.Hex()
+	}
 
 	return genesis
 }
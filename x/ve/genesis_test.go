@@ -2,10 +2,12 @@ package ve_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/tests"
 
 	"github.com/gridiron-zone/gridiron/app"
 	gridiron "github.com/gridiron-zone/gridiron/types"
@@ -44,14 +46,14 @@ func (suite *GenesisTestSuite) TestVeInitGenesis() {
 	suite.Require().Equal(types.Checkpoint{
 		Bias:      sdk.ZeroInt(),
 		Slope:     sdk.ZeroInt(),
+		Permanent: sdk.ZeroInt(),
 		Timestamp: 0,
 		Block:     0,
 	}, veKeeper.GetCheckpoint(suite.ctx, types.EmptyEpoch))
 }
 
 func (suite *GenesisTestSuite) TestVeExportGenesis() {
-	app := suite.app
-	veKeeper := app.VeKeeper
+	veKeeper := suite.app.VeKeeper
 
 	suite.Require().NotPanics(func() {
 		ve.InitGenesis(suite.ctx, veKeeper, *types.DefaultGenesis())
@@ -59,4 +61,97 @@ func (suite *GenesisTestSuite) TestVeExportGenesis() {
 
 	genesisExported := ve.ExportGenesis(suite.ctx, veKeeper)
 	suite.Require().Equal(genesisExported.Params.GetLockDenom(), gridiron.BaseDenom)
+	suite.Require().NoError(genesisExported.Validate())
+
+	// Locks and checkpoints are exported
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	veID := veKeeper.GetNextVeID(suite.ctx)
+	locked := types.LockedBalance{Amount: sdk.NewInt(1000), End: types.RegulatedUnixTimeFromNow(suite.ctx, types.MaxLockTime)}
+	veKeeper.SetNextVeID(suite.ctx, veID+1)
+	veKeeper.SetTotalLockedAmount(suite.ctx, locked.Amount)
+	veKeeper.SetLockedAmountByUser(suite.ctx, veID, locked)
+	veKeeper.RegulateUserCheckpoint(suite.ctx, veID, types.NewLockedBalance(), locked)
+	veKeeper.SetVeOwnerAt(suite.ctx, veID, suite.ctx.BlockHeight(), owner)
+
+	genesisExported = ve.ExportGenesis(suite.ctx, veKeeper)
+	suite.Require().NoError(genesisExported.Validate())
+	suite.Require().Equal(veID+1, genesisExported.NextVeId)
+	suite.Require().Equal(sdk.NewInt(1000), genesisExported.TotalLockedAmount)
+	suite.Require().Equal([]types.VeLockedBalance{{VeId: veID, Locked: locked}}, genesisExported.LockedAmounts)
+	suite.Require().Len(genesisExported.LockedCheckpoints.UserPoints, 1)
+	suite.Require().Equal(veID, genesisExported.LockedCheckpoints.UserPoints[0].VeId)
+	suite.Require().NotEmpty(genesisExported.LockedCheckpoints.SlopeChanges)
+	suite.Require().Equal([]types.VeOwnerRecord{{VeId: veID, Block: suite.ctx.BlockHeight(), Owner: owner.String()}}, genesisExported.OwnerHistory)
+
+	// and imported as they are
+	ctx, _ := suite.ctx.CacheContext()
+	ve.InitGenesis(ctx, veKeeper, *genesisExported)
+	suite.Require().Equal(genesisExported, ve.ExportGenesis(ctx, veKeeper))
+}
+
+func (suite *GenesisTestSuite) TestGenesisValidate() {
+	suite.Require().NoError(types.DefaultGenesis().Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(genState *types.GenesisState)
+	}{
+		{
+			"invalid next ve id",
+			func(genState *types.GenesisState) {
+				genState.NextVeId = types.EmptyVeID
+			},
+		},
+		{
+			"missing total locked amount",
+			func(genState *types.GenesisState) {
+				genState.TotalLockedAmount = sdk.Int{}
+			},
+		},
+		{
+			"lock of nonexistent ve",
+			func(genState *types.GenesisState) {
+				genState.LockedAmounts = []types.VeLockedBalance{{VeId: genState.NextVeId, Locked: types.NewLockedBalance()}}
+			},
+		},
+		{
+			"duplicate lock",
+			func(genState *types.GenesisState) {
+				genState.NextVeId = types.FirstVeID + 1
+				genState.LockedAmounts = []types.VeLockedBalance{
+					{VeId: types.FirstVeID, Locked: types.NewLockedBalance()},
+					{VeId: types.FirstVeID, Locked: types.NewLockedBalance()},
+				}
+			},
+		},
+		{
+			"invalid owner",
+			func(genState *types.GenesisState) {
+				genState.NextVeId = types.FirstVeID + 1
+				genState.OwnerHistory = []types.VeOwnerRecord{{VeId: types.FirstVeID, Owner: "invalid"}}
+			},
+		},
+		{
+			"invalid voting delegate",
+			func(genState *types.GenesisState) {
+				genState.NextVeId = types.FirstVeID + 1
+				genState.VotingDelegations = []types.VotingDelegation{{VeId: types.VeIDFromUint64(types.FirstVeID), Delegate: "invalid"}}
+			},
+		},
+		{
+			"invalid nft contract",
+			func(genState *types.GenesisState) {
+				genState.NftContract = "invalid"
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			genState := types.DefaultGenesis()
+			tc.malleate(genState)
+			suite.Require().Error(genState.Validate())
+		})
+	}
 }
@@ -10,10 +10,24 @@ import (
 // lockedOld:
 //             Amount: can be zero
 //             End: can be expired or zero
+//             Permanent: End must be zero if permanent
 // lockedNew:
 //             Amount: can be zero
 //             End: must be in the future or be zero
+//             Permanent: End must be zero if permanent
 func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld types.LockedBalance, lockedNew types.LockedBalance) {
+	k.regulateUserCheckpoint(ctx, types.LockedCheckpointKeys, veID, lockedOld, lockedNew)
+
+	// the delegated part of the lock follows the unlocking time of the lock
+	k.RegulateDelegatedCheckpoint(ctx, veID)
+
+	// refresh the ve NFT data with new locked amount and voting power
+	k.refreshVeNftData(ctx, veID)
+}
+
+// regulateUserCheckpoint regulates user checkpoint in the checkpoint history
+// of the keys, as well as the system checkpoint.
+func (k Keeper) regulateUserCheckpoint(ctx sdk.Context, keys types.CheckpointKeys, veID uint64, lockedOld types.LockedBalance, lockedNew types.LockedBalance) {
 	// check whether timestamp is regulated
 	types.CheckRegulatedUnixTime(lockedOld.End)
 	types.CheckRegulatedUnixTime(lockedNew.End)
@@ -23,12 +37,23 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 
 	// user point initialized with zero values
 	userPointOld := types.Checkpoint{
-		Bias:  sdk.ZeroInt(),
-		Slope: sdk.ZeroInt(),
+		Bias:      sdk.ZeroInt(),
+		Slope:     sdk.ZeroInt(),
+		Permanent: sdk.ZeroInt(),
 	}
 	userPointNew := types.Checkpoint{
-		Bias:  sdk.ZeroInt(),
-		Slope: sdk.ZeroInt(),
+		Bias:      sdk.ZeroInt(),
+		Slope:     sdk.ZeroInt(),
+		Permanent: sdk.ZeroInt(),
+	}
+
+	// permanent lock has no slope and no unlocking time,
+	// and its voting power is pinned at the maximum
+	if lockedOld.Permanent && lockedOld.Amount.IsPositive() {
+		userPointOld.Permanent = permanentVotingPower(lockedOld.Amount)
+	}
+	if lockedNew.Permanent && lockedNew.Amount.IsPositive() {
+		userPointNew.Permanent = permanentVotingPower(lockedNew.Amount)
 	}
 
 	// calculate slope and bias from now on,
@@ -50,15 +75,17 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 	// regulate system checkpoint history
 	userSlopeChange := userPointNew.Slope.Sub(userPointOld.Slope)
 	userBiasChange := userPointNew.Bias.Sub(userPointOld.Bias)
-	k.regulateCheckpoint(ctx, userSlopeChange, userBiasChange)
+	userPermanentChange := userPointNew.Permanent.Sub(userPointOld.Permanent)
+	k.regulateCheckpoint(ctx, keys, userSlopeChange, userBiasChange, userPermanentChange)
 
-	slopeChangeOld := k.GetSlopeChange(ctx, lockedOld.End)
-	slopeChangeNew := k.GetSlopeChange(ctx, lockedNew.End)
+	slopeChangeOld := k.getSlopeChange(ctx, keys, lockedOld.End)
+	slopeChangeNew := k.getSlopeChange(ctx, keys, lockedNew.End)
 
 	// Schedule future slope changes at any unlocking time of any user's ve,
 	// since the slope should be reset to zero after unlocking time is up.
 	// Actually equivalent to, for the second segment of the piecewise linear function,
 	// the slope should be zero.
+	// Permanent lock is excluded, since its end is zero.
 	if lockedOld.End > now {
 		// old slope waw subtracted, so here add it back to cancel it
 		slopeChangeOld = slopeChangeOld.Add(userPointOld.Slope)
@@ -66,50 +93,53 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 			// subtract new slope
 			slopeChangeOld = slopeChangeOld.Sub(userPointNew.Slope)
 		}
-		k.SetSlopeChange(ctx, lockedOld.End, slopeChangeOld)
+		k.setSlopeChange(ctx, keys, lockedOld.End, slopeChangeOld)
 	}
 	if lockedNew.End > now {
 		if lockedNew.End > lockedOld.End {
 			// subtract new slope, i.e., the slope is reset to zero
 			slopeChangeNew = slopeChangeNew.Sub(userPointNew.Slope)
-			k.SetSlopeChange(ctx, lockedNew.End, slopeChangeNew)
+			k.setSlopeChange(ctx, keys, lockedNew.End, slopeChangeNew)
 		} else {
 			// has been handled in slopeChangeOld
 		}
 	}
 
 	// increase user epoch
-	userEpoch := k.GetUserEpoch(ctx, veID) + 1
-	k.SetUserEpoch(ctx, veID, userEpoch)
+	userEpoch := k.getUserEpoch(ctx, keys, veID) + 1
+	k.setUserEpoch(ctx, keys, veID, userEpoch)
 
 	// set new user checkpoint
 	userPointNew.Timestamp = now
 	userPointNew.Block = ctx.BlockHeight()
-	k.SetUserCheckpoint(ctx, veID, userEpoch, userPointNew)
+	k.setUserCheckpoint(ctx, keys, veID, userEpoch, userPointNew)
 }
 
 func (k Keeper) RegulateCheckpoint(ctx sdk.Context) {
 	now := uint64(ctx.BlockTime().Unix())
-	epoch := k.GetEpoch(ctx)
-	pointLast := k.GetCheckpoint(ctx, epoch)
-	if now-pointLast.Timestamp >= types.RegulatedPeriod {
-		k.regulateCheckpoint(ctx, sdk.ZeroInt(), sdk.ZeroInt())
+	for _, keys := range []types.CheckpointKeys{types.LockedCheckpointKeys, types.DelegatedCheckpointKeys} {
+		epoch := k.getEpoch(ctx, keys)
+		pointLast := k.getCheckpoint(ctx, keys, epoch)
+		if now-pointLast.Timestamp >= types.RegulatedPeriod {
+			k.regulateCheckpoint(ctx, keys, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
+		}
 	}
 }
 
-func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasChange sdk.Int) {
+func (k Keeper) regulateCheckpoint(ctx sdk.Context, keys types.CheckpointKeys, userSlopeChange, userBiasChange, userPermanentChange sdk.Int) {
 	now := uint64(ctx.BlockTime().Unix())
 
-	epoch := k.GetEpoch(ctx)
+	epoch := k.getEpoch(ctx, keys)
 
 	pointLast := types.Checkpoint{
 		Bias:      sdk.ZeroInt(),
 		Slope:     sdk.ZeroInt(),
+		Permanent: sdk.ZeroInt(),
 		Timestamp: now,
 		Block:     ctx.BlockHeight(),
 	}
 	if epoch > 0 {
-		pointLast = k.GetCheckpoint(ctx, epoch)
+		pointLast = k.getCheckpoint(ctx, keys, epoch)
 	}
 
 	timeLast := pointLast.Timestamp
@@ -143,7 +173,7 @@ func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasCha
 			ti = now
 		} else {
 			// ti is at regulated time
-			slopeChange = k.GetSlopeChange(ctx, ti)
+			slopeChange = k.getSlopeChange(ctx, keys, ti)
 		}
 
 		// calculate new bias and slope
@@ -171,112 +201,164 @@ func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasCha
 			break // break loop
 		} else {
 			// set new checkpoint
-			k.SetCheckpoint(ctx, epoch, pointLast)
+			k.setCheckpoint(ctx, keys, epoch, pointLast)
 		}
 	}
 
 	// TODO: delete slope changes in the past, since they will be no longer used
 
 	// set new last epoch
-	k.SetEpoch(ctx, epoch)
+	k.setEpoch(ctx, keys, epoch)
 
-	// add new change at now to the new last point
-	if userBiasChange.IsPositive() {
-		pointLast.Bias = pointLast.Bias.Add(userBiasChange)
-		if pointLast.Bias.IsNegative() {
-			pointLast.Bias = sdk.ZeroInt()
-		}
+	// add new change at now to the new last point,
+	// which can be negative when the locked amount of user decreases
+	pointLast.Bias = pointLast.Bias.Add(userBiasChange)
+	if pointLast.Bias.IsNegative() {
+		pointLast.Bias = sdk.ZeroInt()
 	}
-	if userSlopeChange.IsPositive() {
-		pointLast.Slope = pointLast.Slope.Add(userSlopeChange)
-		if pointLast.Slope.IsNegative() {
-			pointLast.Slope = sdk.ZeroInt()
-		}
+	pointLast.Slope = pointLast.Slope.Add(userSlopeChange)
+	if pointLast.Slope.IsNegative() {
+		pointLast.Slope = sdk.ZeroInt()
+	}
+	// permanent voting power never decays, so it is only changed by users
+	pointLast.Permanent = pointLast.Permanent.Add(userPermanentChange)
+	if pointLast.Permanent.IsNegative() {
+		pointLast.Permanent = sdk.ZeroInt()
 	}
 
 	// set new checkpoint
-	k.SetCheckpoint(ctx, epoch, pointLast)
+	k.setCheckpoint(ctx, keys, epoch, pointLast)
 }
 
 func (k Keeper) SetEpoch(ctx sdk.Context, epoch uint64) {
+	k.setEpoch(ctx, types.LockedCheckpointKeys, epoch)
+}
+
+func (k Keeper) GetEpoch(ctx sdk.Context) uint64 {
+	return k.getEpoch(ctx, types.LockedCheckpointKeys)
+}
+
+func (k Keeper) SetCheckpoint(ctx sdk.Context, epoch uint64, point types.Checkpoint) {
+	k.setCheckpoint(ctx, types.LockedCheckpointKeys, epoch, point)
+}
+
+func (k Keeper) GetCheckpoint(ctx sdk.Context, epoch uint64) types.Checkpoint {
+	return k.getCheckpoint(ctx, types.LockedCheckpointKeys, epoch)
+}
+
+func (k Keeper) SetUserEpoch(ctx sdk.Context, veID uint64, epoch uint64) {
+	k.setUserEpoch(ctx, types.LockedCheckpointKeys, veID, epoch)
+}
+
+func (k Keeper) GetUserEpoch(ctx sdk.Context, veID uint64) uint64 {
+	return k.getUserEpoch(ctx, types.LockedCheckpointKeys, veID)
+}
+
+func (k Keeper) SetUserCheckpoint(ctx sdk.Context, veID uint64, epoch uint64, point types.Checkpoint) {
+	k.setUserCheckpoint(ctx, types.LockedCheckpointKeys, veID, epoch, point)
+}
+
+func (k Keeper) GetUserCheckpoint(ctx sdk.Context, veID uint64, epoch uint64) types.Checkpoint {
+	return k.getUserCheckpoint(ctx, types.LockedCheckpointKeys, veID, epoch)
+}
+
+func (k Keeper) SetSlopeChange(ctx sdk.Context, timestamp uint64, slopeChange sdk.Int) {
+	k.setSlopeChange(ctx, types.LockedCheckpointKeys, timestamp, slopeChange)
+}
+
+func (k Keeper) GetSlopeChange(ctx sdk.Context, timestamp uint64) sdk.Int {
+	return k.getSlopeChange(ctx, types.LockedCheckpointKeys, timestamp)
+}
+
+func (k Keeper) setEpoch(ctx sdk.Context, keys types.CheckpointKeys, epoch uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := sdk.Uint64ToBigEndian(epoch)
-	store.Set(types.EpochKey(), bz)
+	store.Set(keys.EpochKey(), bz)
 }
 
-func (k Keeper) GetEpoch(ctx sdk.Context) uint64 {
+func (k Keeper) getEpoch(ctx sdk.Context, keys types.CheckpointKeys) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.EpochKey())
+	bz := store.Get(keys.EpochKey())
 	if bz == nil {
 		return 0
 	}
 	return sdk.BigEndianToUint64(bz)
 }
 
-func (k Keeper) SetCheckpoint(ctx sdk.Context, epoch uint64, point types.Checkpoint) {
+func (k Keeper) setCheckpoint(ctx sdk.Context, keys types.CheckpointKeys, epoch uint64, point types.Checkpoint) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&point)
-	store.Set(types.PointKey(epoch), bz)
+	store.Set(keys.PointKey(epoch), bz)
 }
 
-func (k Keeper) GetCheckpoint(ctx sdk.Context, epoch uint64) types.Checkpoint {
+func (k Keeper) getCheckpoint(ctx sdk.Context, keys types.CheckpointKeys, epoch uint64) types.Checkpoint {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.PointKey(epoch))
+	bz := store.Get(keys.PointKey(epoch))
 	if bz == nil {
 		return types.Checkpoint{
-			Bias:  sdk.ZeroInt(),
-			Slope: sdk.ZeroInt(),
+			Bias:      sdk.ZeroInt(),
+			Slope:     sdk.ZeroInt(),
+			Permanent: sdk.ZeroInt(),
 		}
 	}
 	var point types.Checkpoint
 	k.cdc.MustUnmarshal(bz, &point)
+	if point.Permanent.IsNil() {
+		// checkpoint stored before permanent locks
+		point.Permanent = sdk.ZeroInt()
+	}
 	return point
 }
 
-func (k Keeper) SetUserEpoch(ctx sdk.Context, veID uint64, epoch uint64) {
+func (k Keeper) setUserEpoch(ctx sdk.Context, keys types.CheckpointKeys, veID uint64, epoch uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := sdk.Uint64ToBigEndian(epoch)
-	store.Set(types.UserEpochKey(veID), bz)
+	store.Set(keys.UserEpochKey(veID), bz)
 }
 
-func (k Keeper) GetUserEpoch(ctx sdk.Context, veID uint64) uint64 {
+func (k Keeper) getUserEpoch(ctx sdk.Context, keys types.CheckpointKeys, veID uint64) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.UserEpochKey(veID))
+	bz := store.Get(keys.UserEpochKey(veID))
 	if bz == nil {
 		return 0
 	}
 	return sdk.BigEndianToUint64(bz)
 }
 
-func (k Keeper) SetUserCheckpoint(ctx sdk.Context, veID uint64, epoch uint64, point types.Checkpoint) {
+func (k Keeper) setUserCheckpoint(ctx sdk.Context, keys types.CheckpointKeys, veID uint64, epoch uint64, point types.Checkpoint) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&point)
-	store.Set(types.UserPointKey(veID, epoch), bz)
+	store.Set(keys.UserPointKey(veID, epoch), bz)
 }
 
-func (k Keeper) GetUserCheckpoint(ctx sdk.Context, veID uint64, epoch uint64) types.Checkpoint {
+func (k Keeper) getUserCheckpoint(ctx sdk.Context, keys types.CheckpointKeys, veID uint64, epoch uint64) types.Checkpoint {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.UserPointKey(veID, epoch))
+	bz := store.Get(keys.UserPointKey(veID, epoch))
 	if bz == nil {
 		return types.Checkpoint{
-			Bias:  sdk.ZeroInt(),
-			Slope: sdk.ZeroInt(),
+			Bias:      sdk.ZeroInt(),
+			Slope:     sdk.ZeroInt(),
+			Permanent: sdk.ZeroInt(),
 		}
 	}
 	var point types.Checkpoint
 	k.cdc.MustUnmarshal(bz, &point)
+	if point.Permanent.IsNil() {
+		// checkpoint stored before permanent locks
+		point.Permanent = sdk.ZeroInt()
+	}
 	return point
 }
 
-func (k Keeper) SetSlopeChange(ctx sdk.Context, timestamp uint64, slopeChange sdk.Int) {
+func (k Keeper) setSlopeChange(ctx sdk.Context, keys types.CheckpointKeys, timestamp uint64, slopeChange sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: slopeChange})
-	store.Set(types.SlopeChangeKey(timestamp), bz)
+	store.Set(keys.SlopeChangeKey(timestamp), bz)
 }
 
-func (k Keeper) GetSlopeChange(ctx sdk.Context, timestamp uint64) sdk.Int {
+func (k Keeper) getSlopeChange(ctx sdk.Context, keys types.CheckpointKeys, timestamp uint64) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.SlopeChangeKey(timestamp))
+	bz := store.Get(keys.SlopeChangeKey(timestamp))
 	if bz == nil {
 		return sdk.ZeroInt()
 	}
@@ -284,3 +366,25 @@ func (k Keeper) GetSlopeChange(ctx sdk.Context, timestamp uint64) sdk.Int {
 	k.cdc.MustUnmarshal(bz, &slopeChange)
 	return slopeChange.Int
 }
+
+// IterateSlopeChanges iterates slope changes scheduled between the inclusive start and end time
+func (k Keeper) IterateSlopeChanges(ctx sdk.Context, start, end uint64, cb func(timestamp uint64, slopeChange sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SlopeChangeKey(start), sdk.PrefixEndBytes(types.SlopeChangeKey(end)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		timestamp := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixSlopeChange):])
+		var slopeChange sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &slopeChange)
+		if cb(timestamp, slopeChange.Int) {
+			break
+		}
+	}
+}
+
+// permanentVotingPower returns the voting power of permanent lock,
+// i.e., the voting power as if locked for the maximum lock time from now
+func permanentVotingPower(amount sdk.Int) sdk.Int {
+	return amount.QuoRaw(types.MaxLockTime).MulRaw(types.MaxLockTime)
+}
@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/x/ve/keeper"
 	"github.com/gridiron-zone/gridiron/x/ve/types"
 )
 
@@ -103,3 +105,49 @@ func (suite *KeeperTestSuite) TestKeeper_RegulateUserCheckpoint() {
 	suite.Require().Equal(sdk.ZeroInt(), userPoint.Bias)
 	suite.Require().Equal(sdk.ZeroInt(), userPoint.Slope)
 }
+
+// The system checkpoint follows the decreases of the user checkpoints as well,
+// so the total voting power is always the sum of the voting power of all ves.
+func (suite *KeeperTestSuite) TestKeeper_RegulateCheckpoint_DecreasedLocks() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	lockAmt := sdk.NewCoin("airon", sdk.NewInt(1000*types.MaxLockTime))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(sdk.NewCoin(lockAmt.Denom, lockAmt.Amount.MulRaw(3))))
+	require.NoError(err)
+	now := uint64(suite.ctx.BlockTime().Unix())
+
+	var veIDs []string
+	for _, duration := range []uint64{types.MaxLockTime, types.MaxLockTime / 2, types.MaxLockTime / 4} {
+		res, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       lockAmt,
+			LockDuration: duration,
+		})
+		require.NoError(err)
+		veIDs = append(veIDs, res.VeId)
+	}
+	requireTotal := func(liveVeIDs ...string) {
+		sum := sdk.ZeroInt()
+		for _, veID := range liveVeIDs {
+			sum = sum.Add(k.GetVotingPower(suite.ctx, types.Uint64FromVeID(veID), now, 0))
+		}
+		require.True(sum.IsPositive())
+		require.Equal(sum, k.GetTotalVotingPower(suite.ctx, now, 0))
+	}
+	requireTotal(veIDs...)
+
+	_, err = impl.Merge(ctx, &types.MsgMerge{Sender: sender.String(), FromVeId: veIDs[1], ToVeId: veIDs[0]})
+	require.NoError(err)
+	requireTotal(veIDs[0], veIDs[2])
+
+	k.SlashLockedAmountByUser(suite.ctx, types.Uint64FromVeID(veIDs[2]), lockAmt.Amount.QuoRaw(2))
+	requireTotal(veIDs[0], veIDs[2])
+
+	_, err = impl.EarlyWithdraw(ctx, &types.MsgEarlyWithdraw{Sender: sender.String(), VeId: veIDs[2]})
+	require.NoError(err)
+	requireTotal(veIDs[0])
+}
@@ -146,3 +146,35 @@ func (k Keeper) GetDistributionClaimLastTimestampByUser(ctx sdk.Context, veID ui
 	}
 	return sdk.BigEndianToUint64(bz)
 }
+
+// IterateDistributionPerPeriod iterates the amounts distributed per period,
+// in ascending order of the period start time
+func (k Keeper) IterateDistributionPerPeriod(ctx sdk.Context, cb func(timestamp uint64, amount sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixDistributionPerPeriod)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		timestamp := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixDistributionPerPeriod):])
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		if cb(timestamp, amount.Int) {
+			break
+		}
+	}
+}
+
+// IterateDistributionClaimLastTimestampByUser iterates the last claim times
+// of all ves, in ascending order of ve id
+func (k Keeper) IterateDistributionClaimLastTimestampByUser(ctx sdk.Context, cb func(veID uint64, timestamp uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixDistributionClaimLastTimestampByUser)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixDistributionClaimLastTimestampByUser):])
+		if cb(veID, sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gridiron-zone/gridiron/contracts"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+// GetNftContract gets the address of the ERC-721 mirror contract of veNFTs.
+func (k Keeper) GetNftContract(ctx sdk.Context) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NftContractKey())
+	if bz == nil {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetNftContract sets the address of the ERC-721 mirror contract of veNFTs.
+func (k Keeper) SetNftContract(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NftContractKey(), contract.Bytes())
+}
+
+// DeployNftContract deploys the ERC-721 mirror contract of veNFTs, which is
+// owned by the ve module account, and mints the mirror tokens of all the
+// existing veNFTs.
+func (k Keeper) DeployNftContract(ctx sdk.Context) (common.Address, error) {
+	if contract, found := k.GetNftContract(ctx); found {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrNftContract, "existing veNFT contract %s", contract)
+	}
+
+	// ensure the module account exists, since its sequence is the deployment nonce
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	contract, err := k.erc20Keeper().DeployContract(
+		ctx,
+		types.ModuleAddress,
+		contracts.VeNftContract,
+		types.VeNftClass.Name,
+		types.VeNftClass.Symbol,
+	)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrap(err, "failed to deploy veNFT contract")
+	}
+	k.SetNftContract(ctx, contract)
+
+	for _, token := range k.nftKeeper.GetNFTsOfClass(ctx, types.VeNftClass.Id) {
+		owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, token.Id)
+		err = k.callNftContract(ctx, contract, "mint", common.BytesToAddress(owner), nftTokenID(types.Uint64FromVeID(token.Id)))
+		if err != nil {
+			return common.Address{}, err
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDeployNftContract{
+		Contract: contract.Hex(),
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return contract, nil
+}
+
+// nftContract gets the ERC-721 mirror contract of veNFTs, which will be
+// deployed first if not exists. The returned deployed is true if it is just
+// deployed, when the mirror tokens are already in sync with the veNFTs.
+func (k Keeper) nftContract(ctx sdk.Context) (contract common.Address, deployed bool, err error) {
+	contract, found := k.GetNftContract(ctx)
+	if found {
+		return contract, false, nil
+	}
+	contract, err = k.DeployNftContract(ctx)
+	if err != nil {
+		return common.Address{}, false, err
+	}
+	return contract, true, nil
+}
+
+// mirrorNftMint mints the mirror token of the newly minted veNFT.
+func (k Keeper) mirrorNftMint(ctx sdk.Context, veID uint64, owner sdk.AccAddress) error {
+	contract, deployed, err := k.nftContract(ctx)
+	if err != nil || deployed {
+		return err
+	}
+	return k.callNftContract(ctx, contract, "mint", common.BytesToAddress(owner), nftTokenID(veID))
+}
+
+// mirrorNftBurn burns the mirror token of the burned veNFT.
+func (k Keeper) mirrorNftBurn(ctx sdk.Context, veID uint64) error {
+	contract, deployed, err := k.nftContract(ctx)
+	if err != nil || deployed {
+		return err
+	}
+	return k.callNftContract(ctx, contract, "burn", nftTokenID(veID))
+}
+
+// mirrorNftTransfer transfers the mirror token of the transferred veNFT.
+// The module account, as the contract owner, is allowed to move any token.
+func (k Keeper) mirrorNftTransfer(ctx sdk.Context, veID uint64, sender, receiver sdk.AccAddress) error {
+	contract, deployed, err := k.nftContract(ctx)
+	if err != nil || deployed {
+		return err
+	}
+	return k.callNftContract(ctx, contract, "transferFrom", common.BytesToAddress(sender), common.BytesToAddress(receiver), nftTokenID(veID))
+}
+
+func (k Keeper) callNftContract(ctx sdk.Context, contract common.Address, method string, args ...interface{}) error {
+	_, err := k.erc20Keeper().CallEVM(ctx, contracts.VeNftContract.ABI, types.ModuleAddress, contract, method, args...)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrNftContract, "failed to %s veNFT mirror token: %s", method, err)
+	}
+	return nil
+}
+
+// nftTokenID returns the ERC-721 token ID of the ve id
+func nftTokenID(veID uint64) *big.Int {
+	return new(big.Int).SetUint64(veID)
+}
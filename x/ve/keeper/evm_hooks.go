@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/gridiron-zone/gridiron/contracts"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+// EvmHooks wrapper struct for nft keeper
+type EvmHooks struct {
+	k NftKeeper
+}
+
+var _ evmtypes.EvmHooks = EvmHooks{}
+
+// EvmHooks returns the wrapper struct
+func (k NftKeeper) EvmHooks() EvmHooks {
+	return EvmHooks{k}
+}
+
+// PostTxProcessing implements evmtypes.EvmHooks.PostTxProcessing.
+// It syncs the transfers of the ERC-721 mirror tokens to the ve NFTs, and
+// fails to revert the whole EVM transaction if any ve NFT is untransferable.
+func (h EvmHooks) PostTxProcessing(
+	ctx sdk.Context,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	contract, found := h.k.veKeeper().GetNftContract(ctx)
+	if !found {
+		return nil
+	}
+	transferEventID := contracts.VeNftContract.ABI.Events["Transfer"].ID
+
+	// We only care about event Transfer(from, to, tokenId) of the contract
+	for _, log := range receipt.Logs {
+		if log.Address != contract || len(log.Topics) != 4 || log.Topics[0] != transferEventID {
+			continue
+		}
+
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		// minting and burning are only done by the ve module along with the ve NFTs
+		if from == (common.Address{}) || to == (common.Address{}) {
+			continue
+		}
+
+		tokenID := log.Topics[3].Big()
+		if !tokenID.IsUint64() {
+			return sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid veNFT token id %s", tokenID)
+		}
+		veID := tokenID.Uint64()
+		nftID := types.VeIDFromUint64(veID)
+
+		err := h.k.veKeeper().CheckVeAttached(ctx, veID)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "veNFT %s", nftID)
+		}
+
+		sender := sdk.AccAddress(from.Bytes())
+		owner := h.k.GetOwner(ctx, types.VeNftClass.Id, nftID)
+		if !owner.Equals(sender) {
+			return sdkerrors.Wrapf(types.ErrNftContract, "veNFT %s is not owned by %s", nftID, sender)
+		}
+
+		// the mirror token has been transferred, so only the ve NFT is transferred here
+		receiver := sdk.AccAddress(to.Bytes())
+		err = h.k.transferVe(ctx, veID, receiver)
+		if err != nil {
+			return err
+		}
+
+		err = ctx.EventManager().EmitTypedEvent(&nfttypes.EventSend{
+			ClassId:  types.VeNftClass.Id,
+			Id:       nftID,
+			Sender:   sender.String(),
+			Receiver: receiver.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
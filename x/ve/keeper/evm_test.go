@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/gridiron-zone/gridiron/contracts"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) nftMirrorOwner(contract common.Address, veID uint64) (common.Address, error) {
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.VeNftContract.ABI, types.ModuleAddress, contract, "ownerOf", new(big.Int).SetUint64(veID))
+	if err != nil {
+		return common.Address{}, err
+	}
+	out, err := contracts.VeNftContract.ABI.Unpack("ownerOf", res.Ret)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return out[0].(common.Address), nil
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeployNftContract() {
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	owner := sdk.AccAddress(suite.address.Bytes())
+
+	// veNFTs existing before the deployment are mirrored by the deployment
+	err := suite.app.NftKeeper.Keeper.Mint(suite.ctx, nfttypes.NFT{ClassId: types.VeNftClass.Id, Id: types.VeIDFromUint64(5)}, owner)
+	require.NoError(err)
+	_, found := k.GetNftContract(suite.ctx)
+	require.False(found)
+
+	contract, err := k.DeployNftContract(suite.ctx)
+	require.NoError(err)
+	stored, found := k.GetNftContract(suite.ctx)
+	require.True(found)
+	require.Equal(contract, stored)
+
+	mirrorOwner, err := suite.nftMirrorOwner(contract, 5)
+	require.NoError(err)
+	require.Equal(suite.address, mirrorOwner)
+
+	_, err = k.DeployNftContract(suite.ctx)
+	require.ErrorIs(err, types.ErrNftContract)
+
+	res, err := k.VeNftContract(sdk.WrapSDKContext(suite.ctx), &types.QueryVeNftContractRequest{})
+	require.NoError(err)
+	require.Equal(contract.Hex(), res.Contract)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_NftMirror() {
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	owner := sdk.AccAddress(suite.address.Bytes())
+	receiver := sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	amount := sdk.NewInt64Coin(gridiron.BaseDenom, 100)
+
+	// the contract is deployed along with the first veNFT
+	veID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+	require.NoError(err)
+	contract, found := k.GetNftContract(suite.ctx)
+	require.True(found)
+	mirrorOwner, err := suite.nftMirrorOwner(contract, veID)
+	require.NoError(err)
+	require.Equal(suite.address, mirrorOwner)
+
+	// sending the veNFT moves the mirror token
+	nftID := types.VeIDFromUint64(veID)
+	_, err = suite.app.NftKeeper.Send(sdk.WrapSDKContext(suite.ctx), &nfttypes.MsgSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       nftID,
+		Sender:   owner.String(),
+		Receiver: receiver.String(),
+	})
+	require.NoError(err)
+	mirrorOwner, err = suite.nftMirrorOwner(contract, veID)
+	require.NoError(err)
+	require.Equal(common.BytesToAddress(receiver), mirrorOwner)
+
+	// attached veNFT cannot be sent
+	k.SetVeAttached(suite.ctx, veID, 1)
+	_, err = suite.app.NftKeeper.Send(sdk.WrapSDKContext(suite.ctx), &nfttypes.MsgSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       nftID,
+		Sender:   receiver.String(),
+		Receiver: owner.String(),
+	})
+	require.ErrorIs(err, types.ErrVeAttached)
+	k.SetVeAttached(suite.ctx, veID, 0)
+
+	// burning the veNFT burns the mirror token
+	err = suite.app.NftKeeper.Burn(suite.ctx, types.VeNftClass.Id, nftID)
+	require.NoError(err)
+	_, err = suite.nftMirrorOwner(contract, veID)
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_NftMirrorEvmHooks() {
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	owner := sdk.AccAddress(suite.address.Bytes())
+	receiver := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	amount := sdk.NewInt64Coin(gridiron.BaseDenom, 100)
+
+	veID, _, err := k.CreateLock(suite.ctx, owner, owner, amount, types.MaxLockTime)
+	require.NoError(err)
+	contract, _ := k.GetNftContract(suite.ctx)
+	nftID := types.VeIDFromUint64(veID)
+
+	transfer := func(from, to common.Address) (*ethtypes.Receipt, error) {
+		res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.VeNftContract.ABI, from, contract, "transferFrom", from, to, new(big.Int).SetUint64(veID))
+		if err != nil {
+			return nil, err
+		}
+		return &ethtypes.Receipt{Logs: evmtypes.LogsToEthereum(res.Logs)}, nil
+	}
+
+	// the transfer in the EVM is synced to the veNFT by the hooks
+	receipt, err := transfer(suite.address, receiver)
+	require.NoError(err)
+	require.Equal(owner, suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, nftID))
+	err = suite.app.NftKeeper.EvmHooks().PostTxProcessing(suite.ctx, nil, receipt)
+	require.NoError(err)
+	require.Equal(sdk.AccAddress(receiver.Bytes()), suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, nftID))
+	require.Equal(sdk.AccAddress(receiver.Bytes()), k.GetVeOwnerAt(suite.ctx, veID, suite.ctx.BlockHeight()))
+
+	// the hooks fail for voted veNFT, which reverts the EVM transaction
+	k.SetVeVoted(suite.ctx, veID, true)
+	err = suite.app.NftKeeper.EvmHooks().PostTxProcessing(suite.ctx, nil, &ethtypes.Receipt{Logs: []*ethtypes.Log{{
+		Address: contract,
+		Topics: []common.Hash{
+			contracts.VeNftContract.ABI.Events["Transfer"].ID,
+			common.BytesToHash(receiver.Bytes()),
+			common.BytesToHash(suite.address.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(veID)),
+		},
+	}}})
+	require.ErrorIs(err, types.ErrVeAttached)
+	require.Equal(sdk.AccAddress(receiver.Bytes()), suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, nftID))
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+// ExportCheckpointHistory exports the checkpoint history of the specified keys
+func (k Keeper) ExportCheckpointHistory(ctx sdk.Context, keys types.CheckpointKeys) types.CheckpointHistory {
+	history := types.CheckpointHistory{
+		Epoch: k.getEpoch(ctx, keys),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, keys.PointHistoryByEpoch)
+	for ; iterator.Valid(); iterator.Next() {
+		epoch := sdk.BigEndianToUint64(iterator.Key()[len(keys.PointHistoryByEpoch):])
+		history.Points = append(history.Points, types.EpochCheckpoint{
+			Epoch: epoch,
+			Point: k.getCheckpoint(ctx, keys, epoch),
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, keys.UserEpoch)
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(keys.UserEpoch):])
+		userHistory := types.UserCheckpointHistory{
+			VeId:  veID,
+			Epoch: sdk.BigEndianToUint64(iterator.Value()),
+		}
+		prefix := keys.UserPointsPrefix(veID)
+		pointIterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; pointIterator.Valid(); pointIterator.Next() {
+			epoch := sdk.BigEndianToUint64(pointIterator.Key()[len(prefix):])
+			userHistory.Points = append(userHistory.Points, types.EpochCheckpoint{
+				Epoch: epoch,
+				Point: k.getUserCheckpoint(ctx, keys, veID, epoch),
+			})
+		}
+		pointIterator.Close()
+		history.UserPoints = append(history.UserPoints, userHistory)
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, keys.SlopeChange)
+	for ; iterator.Valid(); iterator.Next() {
+		timestamp := sdk.BigEndianToUint64(iterator.Key()[len(keys.SlopeChange):])
+		history.SlopeChanges = append(history.SlopeChanges, types.SlopeChange{
+			Timestamp:   timestamp,
+			SlopeChange: k.getSlopeChange(ctx, keys, timestamp),
+		})
+	}
+	iterator.Close()
+
+	return history
+}
+
+// InitCheckpointHistory imports the checkpoint history of the specified keys
+func (k Keeper) InitCheckpointHistory(ctx sdk.Context, keys types.CheckpointKeys, history types.CheckpointHistory) {
+	k.setEpoch(ctx, keys, history.Epoch)
+	for _, point := range history.Points {
+		k.setCheckpoint(ctx, keys, point.Epoch, point.Point)
+	}
+	for _, userHistory := range history.UserPoints {
+		k.setUserEpoch(ctx, keys, userHistory.VeId, userHistory.Epoch)
+		for _, point := range userHistory.Points {
+			k.setUserCheckpoint(ctx, keys, userHistory.VeId, point.Epoch, point.Point)
+		}
+	}
+	for _, slopeChange := range history.SlopeChanges {
+		k.setSlopeChange(ctx, keys, slopeChange.Timestamp, slopeChange.SlopeChange)
+	}
+}
@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	gridiron "github.com/gridiron-zone/gridiron/types"
 	"github.com/gridiron-zone/gridiron/x/ve/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -39,19 +42,31 @@ func (k Keeper) TotalVotingPower(c context.Context, msg *types.QueryTotalVotingP
 }
 
 func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int64) sdk.Int {
-	epoch := k.GetEpoch(ctx)
+	return k.totalVotingPower(ctx, types.LockedCheckpointKeys, atTime, atBlock)
+}
+
+// GetTotalDelegatedVotingPower returns the total voting power of the amounts
+// delegated to validators, at the specified time or block
+func (k Keeper) GetTotalDelegatedVotingPower(ctx sdk.Context, atTime uint64, atBlock int64) sdk.Int {
+	return k.totalVotingPower(ctx, types.DelegatedCheckpointKeys, atTime, atBlock)
+}
+
+func (k Keeper) totalVotingPower(ctx sdk.Context, keys types.CheckpointKeys, atTime uint64, atBlock int64) sdk.Int {
+	epoch := k.getEpoch(ctx, keys)
 	var power sdk.Int
+	// non-decaying voting power of permanent locks
+	permanent := sdk.ZeroInt()
 
 	if atTime > 0 {
-		pointLast := k.GetCheckpoint(ctx, epoch)
+		pointLast := k.getCheckpoint(ctx, keys, epoch)
 		if atTime <= pointLast.Timestamp {
 			// in the past
-			targetEpoch := k.findTimeEpoch(ctx, types.EmptyVeID, atTime, epoch)
-			point := k.GetCheckpoint(ctx, targetEpoch)
+			targetEpoch := k.findTimeEpoch(ctx, keys, types.EmptyVeID, atTime, epoch)
+			point := k.getCheckpoint(ctx, keys, targetEpoch)
 
 			var dt int64
 			if targetEpoch < epoch {
-				pointNext := k.GetCheckpoint(ctx, targetEpoch+1)
+				pointNext := k.getCheckpoint(ctx, keys, targetEpoch+1)
 				if point.Block != pointNext.Block {
 					dt = int64(atTime - point.Timestamp)
 				}
@@ -60,6 +75,7 @@ func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int6
 			}
 
 			power = point.Bias.Sub(point.Slope.MulRaw(dt))
+			permanent = point.Permanent
 
 		} else {
 			// in the future
@@ -71,7 +87,7 @@ func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int6
 					ti = atTime
 					slopeChange = sdk.ZeroInt()
 				} else {
-					slopeChange = k.GetSlopeChange(ctx, ti)
+					slopeChange = k.getSlopeChange(ctx, keys, ti)
 				}
 				pointLast.Bias = pointLast.Bias.Sub(pointLast.Slope.MulRaw(int64(ti - pointLast.Timestamp)))
 				if ti == atTime {
@@ -82,15 +98,16 @@ func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int6
 			}
 
 			power = pointLast.Bias
+			permanent = pointLast.Permanent
 		}
 
 	} else if atBlock > 0 {
-		targetEpoch := k.findBlockEpoch(ctx, types.EmptyVeID, atBlock, epoch)
-		point := k.GetCheckpoint(ctx, targetEpoch)
+		targetEpoch := k.findBlockEpoch(ctx, keys, types.EmptyVeID, atBlock, epoch)
+		point := k.getCheckpoint(ctx, keys, targetEpoch)
 
 		var dt int64
 		if targetEpoch < epoch {
-			pointNext := k.GetCheckpoint(ctx, targetEpoch+1)
+			pointNext := k.getCheckpoint(ctx, keys, targetEpoch+1)
 			if point.Block != pointNext.Block {
 				dt = (atBlock - point.Block) * int64(pointNext.Timestamp-point.Timestamp) / (pointNext.Block - point.Block)
 			}
@@ -99,6 +116,7 @@ func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int6
 		}
 
 		power = point.Bias.Sub(point.Slope.MulRaw(dt))
+		permanent = point.Permanent
 
 	}
 
@@ -106,7 +124,7 @@ func (k Keeper) GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int6
 		power = sdk.ZeroInt()
 	}
 
-	return power
+	return power.Add(permanent)
 }
 
 func (k Keeper) VotingPower(c context.Context, msg *types.QueryVotingPowerRequest) (*types.QueryVotingPowerResponse, error) {
@@ -142,26 +160,40 @@ func (k Keeper) VotingPower(c context.Context, msg *types.QueryVotingPowerReques
 }
 
 func (k Keeper) GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int {
+	return k.votingPower(ctx, types.LockedCheckpointKeys, veID, atTime, atBlock)
+}
+
+// GetDelegatedVotingPower returns the voting power of the amount of veID
+// delegated to validators, at the specified time or block
+func (k Keeper) GetDelegatedVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int {
+	return k.votingPower(ctx, types.DelegatedCheckpointKeys, veID, atTime, atBlock)
+}
+
+func (k Keeper) votingPower(ctx sdk.Context, keys types.CheckpointKeys, veID uint64, atTime uint64, atBlock int64) sdk.Int {
 	epoch := k.GetEpoch(ctx)
-	userEpoch := k.GetUserEpoch(ctx, veID)
+	userEpoch := k.getUserEpoch(ctx, keys, veID)
 	var power sdk.Int
+	// non-decaying voting power of permanent lock
+	permanent := sdk.ZeroInt()
 
 	if atTime > 0 {
-		userPoint := k.GetUserCheckpoint(ctx, veID, userEpoch)
+		userPoint := k.getUserCheckpoint(ctx, keys, veID, userEpoch)
 		if atTime <= userPoint.Timestamp {
 			// in the past
-			targetUserEpoch := k.findTimeEpoch(ctx, veID, atTime, userEpoch)
-			userPoint = k.GetUserCheckpoint(ctx, veID, targetUserEpoch)
+			targetUserEpoch := k.findTimeEpoch(ctx, keys, veID, atTime, userEpoch)
+			userPoint = k.getUserCheckpoint(ctx, keys, veID, targetUserEpoch)
 		} else {
 			// in the future
 		}
 		power = userPoint.Bias.Sub(userPoint.Slope.MulRaw(int64(atTime - userPoint.Timestamp)))
+		permanent = userPoint.Permanent
 
 	} else if atBlock > 0 {
-		// find timestamp through system checkpoint history
+		// find timestamp through system checkpoint history of the locked amounts,
+		// which is regulated since genesis
 		var blockTimestamp uint64
 		{
-			targetEpoch := k.findBlockEpoch(ctx, types.EmptyVeID, atBlock, epoch)
+			targetEpoch := k.findBlockEpoch(ctx, types.LockedCheckpointKeys, types.EmptyVeID, atBlock, epoch)
 			point := k.GetCheckpoint(ctx, targetEpoch)
 
 			var dt int64
@@ -177,10 +209,11 @@ func (k Keeper) GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBl
 			blockTimestamp = point.Timestamp + uint64(dt)
 		}
 
-		targetUserEpoch := k.findBlockEpoch(ctx, veID, atBlock, userEpoch)
-		userPoint := k.GetUserCheckpoint(ctx, veID, targetUserEpoch)
+		targetUserEpoch := k.findBlockEpoch(ctx, keys, veID, atBlock, userEpoch)
+		userPoint := k.getUserCheckpoint(ctx, keys, veID, targetUserEpoch)
 
 		power = userPoint.Bias.Sub(userPoint.Slope.MulRaw(int64(blockTimestamp - userPoint.Timestamp)))
+		permanent = userPoint.Permanent
 
 	}
 
@@ -188,7 +221,113 @@ func (k Keeper) GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBl
 		power = sdk.ZeroInt()
 	}
 
-	return power
+	return power.Add(permanent)
+}
+
+func (k Keeper) VotingPowerHistory(c context.Context, msg *types.QueryVotingPowerHistoryRequest) (*types.QueryVotingPowerHistoryResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := uint64(types.EmptyVeID)
+	if len(msg.VeId) > 0 {
+		if !k.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, msg.VeId) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.VeId)
+		}
+		veID = types.Uint64FromVeID(msg.VeId)
+	}
+
+	if msg.StartTime == 0 || msg.EndTime < msg.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+	if msg.Points == 0 || msg.Points > types.MaxVotingPowerPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "points must be between 1 and %d", types.MaxVotingPowerPoints)
+	}
+	if msg.Points > 1 && msg.EndTime == msg.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "multiple points at the same time")
+	}
+
+	// all non-permanent locks will have expired after the maximum lock time,
+	// so the voting power will not change any more
+	maxTime := uint64(ctx.BlockTime().Unix()) + types.MaxLockTime
+
+	points := make([]types.VotingPowerPoint, msg.Points)
+	for i := range points {
+		timestamp := msg.StartTime
+		if msg.Points > 1 {
+			timestamp += (msg.EndTime - msg.StartTime) * uint64(i) / uint64(msg.Points-1)
+		}
+		atTime := gridiron.Min(timestamp, maxTime)
+
+		var power sdk.Int
+		if veID != types.EmptyVeID {
+			power = k.GetVotingPower(ctx, veID, atTime, 0)
+		} else {
+			power = k.GetTotalVotingPower(ctx, atTime, 0)
+		}
+		points[i] = types.VotingPowerPoint{Timestamp: timestamp, Power: power}
+	}
+
+	return &types.QueryVotingPowerHistoryResponse{Points: points}, nil
+}
+
+func (k Keeper) SlopeChanges(c context.Context, msg *types.QuerySlopeChangesRequest) (*types.QuerySlopeChangesResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	start := msg.StartTime
+	if start == 0 {
+		start = uint64(ctx.BlockTime().Unix())
+	}
+	end := msg.EndTime
+	if end == 0 {
+		end = start + types.MaxLockTime
+	}
+	if end < start {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+
+	var slopeChanges []types.SlopeChange
+	k.IterateSlopeChanges(ctx, start, end, func(timestamp uint64, slopeChange sdk.Int) (stop bool) {
+		// skip the canceled ones
+		if !slopeChange.IsZero() {
+			slopeChanges = append(slopeChanges, types.SlopeChange{Timestamp: timestamp, SlopeChange: slopeChange})
+		}
+		return false
+	})
+
+	return &types.QuerySlopeChangesResponse{SlopeChanges: slopeChanges}, nil
+}
+
+func (k Keeper) LockedDistribution(c context.Context, msg *types.QueryLockedDistributionRequest) (*types.QueryLockedDistributionResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// skip the expired locks, which are unlocked at or before now
+	now := uint64(ctx.BlockTime().Unix())
+	start := msg.StartTime
+	if start <= now {
+		start = now + 1
+	}
+	end := msg.EndTime
+	if end == 0 {
+		end = start + types.MaxLockTime
+	}
+	if end < start {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+
+	res := &types.QueryLockedDistributionResponse{Permanent: k.GetLockedAmountByUnlockTime(ctx, 0)}
+	k.IterateLockedAmountByUnlockTime(ctx, start, end, func(unlockTime uint64, amount sdk.Int) (stop bool) {
+		res.Amounts = append(res.Amounts, types.LockedAmountByTime{UnlockTime: unlockTime, Amount: amount})
+		return false
+	})
+	return res, nil
 }
 
 func (k Keeper) VeNfts(c context.Context, msg *types.QueryVeNftsRequest) (*types.QueryVeNftsResponse, error) {
@@ -207,10 +346,10 @@ func (k Keeper) VeNfts(c context.Context, msg *types.QueryVeNftsRequest) (*types
 	}
 
 	for _, nft := range nftsResponse.Nfts {
-		veID := types.Uint64FromVeID(nft.Id)
-		power := k.GetVotingPower(ctx, veID, 0, ctx.BlockHeight())
-		locked := k.GetLockedAmountByUser(ctx, veID)
-		nft.Uri = types.VeNftUri(nft.Id, power, locked.End, locked.Amount)
+		err = k.renderVeNft(ctx, nft)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.QueryVeNftsResponse{
@@ -234,14 +373,67 @@ func (k Keeper) VeNft(c context.Context, msg *types.QueryVeNftRequest) (*types.Q
 	}
 
 	nft := nftResponse.Nft
-	veID := types.Uint64FromVeID(nft.Id)
-	power := k.GetVotingPower(ctx, veID, 0, ctx.BlockHeight())
-	locked := k.GetLockedAmountByUser(ctx, veID)
-	nft.Uri = types.VeNftUri(nft.Id, power, locked.End, locked.Amount)
+	err = k.renderVeNft(ctx, nft)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryVeNftResponse{Nft: nft}, nil
 }
 
+func (k Keeper) VeNftContract(c context.Context, msg *types.QueryVeNftContractRequest) (*types.QueryVeNftContractResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryVeNftContractResponse{}
+	if contract, found := k.GetNftContract(ctx); found {
+		res.Contract = contract.Hex()
+	}
+	return res, nil
+}
+
+func (k Keeper) VotingDelegations(c context.Context, msg *types.QueryVotingDelegationsRequest) (*types.QueryVotingDelegationsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+	var delegations []types.VotingDelegation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingDelegationsByDelegatePrefix(delegate))
+	pageRes, err := query.FilteredPaginate(store, msg.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		veID := sdk.BigEndianToUint64(key)
+		locked := k.GetLockedAmountByUser(ctx, veID)
+		// skip the expired delegations
+		if locked.IsExpired(now) {
+			return false, nil
+		}
+		if accumulate {
+			delegations = append(delegations, types.VotingDelegation{
+				VeId:     types.VeIDFromUint64(veID),
+				Delegate: msg.Delegate,
+				Expiry:   locked.End,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotingDelegationsResponse{
+		Delegations: delegations,
+		Pagination:  pageRes,
+	}, nil
+}
+
 func (k Keeper) Params(c context.Context, msg *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -252,7 +444,7 @@ func (k Keeper) Params(c context.Context, msg *types.QueryParamsRequest) (*types
 }
 
 // findTimeEpoch finds approximate epoch for specified timestamp
-func (k Keeper) findTimeEpoch(ctx sdk.Context, veID uint64, timestamp uint64, maxEpoch uint64) uint64 {
+func (k Keeper) findTimeEpoch(ctx sdk.Context, keys types.CheckpointKeys, veID uint64, timestamp uint64, maxEpoch uint64) uint64 {
 	min := uint64(0)
 	max := maxEpoch
 	// binary search
@@ -264,9 +456,9 @@ func (k Keeper) findTimeEpoch(ctx sdk.Context, veID uint64, timestamp uint64, ma
 
 		var midTimestamp uint64
 		if veID != types.EmptyVeID {
-			midTimestamp = k.GetUserCheckpoint(ctx, veID, mid).Timestamp
+			midTimestamp = k.getUserCheckpoint(ctx, keys, veID, mid).Timestamp
 		} else {
-			midTimestamp = k.GetCheckpoint(ctx, mid).Timestamp
+			midTimestamp = k.getCheckpoint(ctx, keys, mid).Timestamp
 		}
 
 		if midTimestamp <= timestamp {
@@ -279,7 +471,7 @@ func (k Keeper) findTimeEpoch(ctx sdk.Context, veID uint64, timestamp uint64, ma
 }
 
 // findBlockEpoch finds approximate epoch for specified block
-func (k Keeper) findBlockEpoch(ctx sdk.Context, veID uint64, block int64, maxEpoch uint64) uint64 {
+func (k Keeper) findBlockEpoch(ctx sdk.Context, keys types.CheckpointKeys, veID uint64, block int64, maxEpoch uint64) uint64 {
 	min := uint64(0)
 	max := maxEpoch
 	// binary search
@@ -291,9 +483,9 @@ func (k Keeper) findBlockEpoch(ctx sdk.Context, veID uint64, block int64, maxEpo
 
 		var midBlock int64
 		if veID != types.EmptyVeID {
-			midBlock = k.GetUserCheckpoint(ctx, veID, mid).Block
+			midBlock = k.getUserCheckpoint(ctx, keys, veID, mid).Block
 		} else {
-			midBlock = k.GetCheckpoint(ctx, mid).Block
+			midBlock = k.getCheckpoint(ctx, keys, mid).Block
 		}
 
 		if midBlock <= block {
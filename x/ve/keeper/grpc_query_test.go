@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/x/ve/keeper"
 	"github.com/gridiron-zone/gridiron/x/ve/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -167,6 +171,168 @@ func (suite *KeeperTestSuite) TestKeeper_VeNft() {
 	suite.Require().Equal(res.Nft.ClassId, types.VeNftClass.Id)
 }
 
+// createLocks creates ve-1 and ve-2 locked for different durations,
+// and the permanently locked ve-3
+func (suite *KeeperTestSuite) createLocks() (end1, end2 uint64) {
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amounts := []int64{1000, 500, 200}
+	durations := []uint64{types.MaxLockTime, types.RegulatedPeriod * 2, types.MaxLockTime}
+	ends := make([]uint64, len(amounts))
+	for i, amount := range amounts {
+		coin := sdk.NewCoin(k.LockDenom(suite.ctx), sdk.NewInt(amount*types.MaxLockTime))
+		err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(coin))
+		require.NoError(err)
+		_, ends[i], err = k.CreateLock(suite.ctx, sender, sender, coin, durations[i])
+		require.NoError(err)
+	}
+	_, err := keeper.NewMsgServerImpl(k).LockPermanent(sdk.WrapSDKContext(suite.ctx), &types.MsgLockPermanent{Sender: sender.String(), VeId: "ve-3"})
+	require.NoError(err)
+	return ends[0], ends[1]
+}
+
+func (suite *KeeperTestSuite) TestKeeper_VotingPowerHistory() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	end1, end2 := suite.createLocks()
+	now := uint64(suite.ctx.BlockTime().Unix())
+
+	for _, msg := range []*types.QueryVotingPowerHistoryRequest{
+		nil,
+		{VeId: "ve-100", StartTime: now, EndTime: end1, Points: 10},
+		{StartTime: 0, EndTime: end1, Points: 10},
+		{StartTime: end1, EndTime: now, Points: 10},
+		{StartTime: now, EndTime: now, Points: 2},
+		{StartTime: now, EndTime: end1, Points: 0},
+		{StartTime: now, EndTime: end1, Points: types.MaxVotingPowerPoints + 1},
+	} {
+		_, err := k.VotingPowerHistory(ctx, msg)
+		require.Error(err)
+	}
+
+	// The total voting power decays to that of the permanent lock,
+	// which is kept even long after the maximum lock time
+	res, err := k.VotingPowerHistory(ctx, &types.QueryVotingPowerHistoryRequest{
+		StartTime: now,
+		EndTime:   now + types.MaxLockTime*2,
+		Points:    5,
+	})
+	require.NoError(err)
+	require.Len(res.Points, 5)
+	require.Equal(types.VotingPowerPoint{Timestamp: now, Power: k.GetTotalVotingPower(suite.ctx, now, 0)}, res.Points[0])
+	require.Equal(now+types.MaxLockTime/2, res.Points[1].Timestamp)
+	require.Equal(k.GetTotalVotingPower(suite.ctx, now+types.MaxLockTime/2, 0), res.Points[1].Power)
+	for i := 1; i < len(res.Points); i++ {
+		require.True(res.Points[i].Power.LTE(res.Points[i-1].Power))
+	}
+	require.Equal(types.VotingPowerPoint{Timestamp: now + types.MaxLockTime*2, Power: sdk.NewInt(200 * types.MaxLockTime)}, res.Points[4])
+
+	// The voting power of a ve decays to zero at its unlocking time
+	res, err = k.VotingPowerHistory(ctx, &types.QueryVotingPowerHistoryRequest{
+		VeId:      "ve-2",
+		StartTime: now,
+		EndTime:   end2,
+		Points:    3,
+	})
+	require.NoError(err)
+	require.Equal([]types.VotingPowerPoint{
+		{Timestamp: now, Power: sdk.NewInt(500 * int64(end2-now))},
+		{Timestamp: (now + end2) / 2, Power: sdk.NewInt(500 * int64(end2-(now+end2)/2))},
+		{Timestamp: end2, Power: sdk.ZeroInt()},
+	}, res.Points)
+
+	res, err = k.VotingPowerHistory(ctx, &types.QueryVotingPowerHistoryRequest{
+		VeId:      "ve-1",
+		StartTime: now,
+		EndTime:   now,
+		Points:    1,
+	})
+	require.NoError(err)
+	require.Equal([]types.VotingPowerPoint{{Timestamp: now, Power: k.GetVotingPower(suite.ctx, 1, now, 0)}}, res.Points)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SlopeChanges() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	end1, end2 := suite.createLocks()
+
+	_, err := k.SlopeChanges(ctx, nil)
+	require.Error(err)
+	_, err = k.SlopeChanges(ctx, &types.QuerySlopeChangesRequest{StartTime: end1, EndTime: end2})
+	require.Error(err)
+
+	// The permanent lock has no slope change
+	res, err := k.SlopeChanges(ctx, &types.QuerySlopeChangesRequest{})
+	require.NoError(err)
+	require.Equal([]types.SlopeChange{
+		{Timestamp: end2, SlopeChange: sdk.NewInt(-500)},
+		{Timestamp: end1, SlopeChange: sdk.NewInt(-1000)},
+	}, res.SlopeChanges)
+
+	res, err = k.SlopeChanges(ctx, &types.QuerySlopeChangesRequest{StartTime: end2 + 1, EndTime: end1})
+	require.NoError(err)
+	require.Equal([]types.SlopeChange{{Timestamp: end1, SlopeChange: sdk.NewInt(-1000)}}, res.SlopeChanges)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_LockedDistribution() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := k.LockedDistribution(ctx, nil)
+	require.Error(err)
+	res, err := k.LockedDistribution(ctx, &types.QueryLockedDistributionRequest{})
+	require.NoError(err)
+	require.Empty(res.Amounts)
+	require.Equal(sdk.ZeroInt(), res.Permanent)
+
+	end1, end2 := suite.createLocks()
+	res, err = k.LockedDistribution(ctx, &types.QueryLockedDistributionRequest{})
+	require.NoError(err)
+	require.Equal([]types.LockedAmountByTime{
+		{UnlockTime: end2, Amount: sdk.NewInt(500 * types.MaxLockTime)},
+		{UnlockTime: end1, Amount: sdk.NewInt(1000 * types.MaxLockTime)},
+	}, res.Amounts)
+	require.Equal(sdk.NewInt(200*types.MaxLockTime), res.Permanent)
+
+	// Within a time range
+	res, err = k.LockedDistribution(ctx, &types.QueryLockedDistributionRequest{StartTime: end2 + 1, EndTime: end1})
+	require.NoError(err)
+	require.Equal([]types.LockedAmountByTime{
+		{UnlockTime: end1, Amount: sdk.NewInt(1000 * types.MaxLockTime)},
+	}, res.Amounts)
+	res, err = k.LockedDistribution(ctx, &types.QueryLockedDistributionRequest{StartTime: end2, EndTime: end1 - 1})
+	require.NoError(err)
+	require.Equal([]types.LockedAmountByTime{
+		{UnlockTime: end2, Amount: sdk.NewInt(500 * types.MaxLockTime)},
+	}, res.Amounts)
+	_, err = k.LockedDistribution(ctx, &types.QueryLockedDistributionRequest{StartTime: end1, EndTime: end2})
+	require.Error(err)
+
+	// The expired locks are skipped even if not withdrawn
+	expiredCtx := sdk.WrapSDKContext(suite.ctx.WithBlockTime(time.Unix(int64(end2), 0)))
+	res, err = k.LockedDistribution(expiredCtx, &types.QueryLockedDistributionRequest{})
+	require.NoError(err)
+	require.Equal([]types.LockedAmountByTime{
+		{UnlockTime: end1, Amount: sdk.NewInt(1000 * types.MaxLockTime)},
+	}, res.Amounts)
+	res, err = k.LockedDistribution(expiredCtx, &types.QueryLockedDistributionRequest{StartTime: end2 - 1})
+	require.NoError(err)
+	require.Len(res.Amounts, 1)
+	require.Equal(sdk.NewInt(200*types.MaxLockTime), res.Permanent)
+
+	// Withdrawn locks are removed from the distribution
+	_, err = keeper.NewMsgServerImpl(k).Withdraw(expiredCtx, &types.MsgWithdraw{Sender: sdk.AccAddress(suite.address.Bytes()).String(), VeId: "ve-2"})
+	require.NoError(err)
+	require.True(k.GetLockedAmountByUnlockTime(suite.ctx, end2).IsZero())
+}
+
 func (suite *KeeperTestSuite) TestKeeper_Params() {
 	suite.SetupTest()
 	k := suite.app.VeKeeper
@@ -21,8 +21,11 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	nftKeeper     types.NftKeeper
+	erc20Keeper   func() types.Erc20Keeper
 
-	getDelegatedAmount func(ctx sdk.Context, veID uint64) sdk.Int
+	// getDelegatedAmount is set by the staking keeper after the keeper is
+	// constructed and copied around, so it is shared by pointer
+	getDelegatedAmount *func(ctx sdk.Context, veID uint64) sdk.Int
 }
 
 // NewKeeper creates a new ve Keeper instance
@@ -34,6 +37,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	nftKeeper types.NftKeeper,
+	erc20Keeper func() types.Erc20Keeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -53,6 +57,9 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		nftKeeper:     nftKeeper,
+		erc20Keeper:   erc20Keeper,
+
+		getDelegatedAmount: new(func(ctx sdk.Context, veID uint64) sdk.Int),
 	}
 }
 
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+// CreateLock locks the amount taken from sender for the lock duration,
+// and mints a new veNFT representing the lock to receiver.
+func (k Keeper) CreateLock(ctx sdk.Context, sender, receiver sdk.AccAddress, amount sdk.Coin, lockDuration uint64) (veID uint64, unlockTime uint64, err error) {
+	err = k.checkLockDenom(ctx, amount)
+	if err != nil {
+		return
+	}
+
+	unlockTime = types.RegulatedUnixTimeFromNow(ctx, lockDuration)
+	if unlockTime <= uint64(ctx.BlockTime().Unix()) {
+		return 0, 0, sdkerrors.Wrapf(types.ErrPastLockTime, "past time: %s", time.Unix(int64(unlockTime), 0))
+	}
+	if unlockTime > uint64(ctx.BlockTime().Unix())+types.MaxLockTime {
+		return 0, 0, sdkerrors.Wrapf(types.ErrTooLongLockTime, "future time: %s", time.Unix(int64(unlockTime), 0))
+	}
+
+	// mint nft for new ve id
+	veID, err = k.mintVeNft(ctx, receiver)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// deposit for ve id
+	err = k.DepositFor(ctx, sender, veID, amount.Amount, unlockTime, types.NewLockedBalance(), true)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreate{
+		Sender:     sender.String(),
+		Receiver:   receiver.String(),
+		VeId:       types.VeIDFromUint64(veID),
+		Amount:     amount,
+		UnlockTime: unlockTime,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return veID, unlockTime, nil
+}
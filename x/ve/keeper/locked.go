@@ -26,6 +26,10 @@ func (k Keeper) GetTotalLockedAmount(ctx sdk.Context) sdk.Int {
 
 // SetLockedAmountByUser sets locked amount of the specified ve
 func (k Keeper) SetLockedAmountByUser(ctx sdk.Context, veID uint64, amount types.LockedBalance) {
+	old := k.GetLockedAmountByUser(ctx, veID)
+	k.addLockedAmountByUnlockTime(ctx, old.End, old.Amount.Neg())
+	k.addLockedAmountByUnlockTime(ctx, amount.End, amount.Amount)
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&amount)
 	store.Set(types.LockedAmountByUserKey(veID), bz)
@@ -45,6 +49,69 @@ func (k Keeper) GetLockedAmountByUser(ctx sdk.Context, veID uint64) types.Locked
 
 // DeleteLockedAmountByUser deletes locked amount of the specified ve
 func (k Keeper) DeleteLockedAmountByUser(ctx sdk.Context, veID uint64) {
+	old := k.GetLockedAmountByUser(ctx, veID)
+	k.addLockedAmountByUnlockTime(ctx, old.End, old.Amount.Neg())
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.LockedAmountByUserKey(veID))
 }
+
+// IterateLockedAmountByUser iterates locked amounts of all ves in ascending order of ve id
+func (k Keeper) IterateLockedAmountByUser(ctx sdk.Context, cb func(veID uint64, locked types.LockedBalance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixLockedAmountByUser)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixLockedAmountByUser):])
+		var locked types.LockedBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &locked)
+		if cb(veID, locked) {
+			break
+		}
+	}
+}
+
+// GetLockedAmountByUnlockTime gets the total locked amount of the ves unlocking
+// at the specified time, where zero is for the permanent locks
+func (k Keeper) GetLockedAmountByUnlockTime(ctx sdk.Context, unlockTime uint64) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LockedAmountByUnlockTimeKey(unlockTime))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount.Int
+}
+
+// IterateLockedAmountByUnlockTime iterates the total locked amounts by the
+// unlocking time between the inclusive start and end time
+func (k Keeper) IterateLockedAmountByUnlockTime(ctx sdk.Context, start, end uint64, cb func(unlockTime uint64, amount sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.LockedAmountByUnlockTimeKey(start), sdk.PrefixEndBytes(types.LockedAmountByUnlockTimeKey(end)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		unlockTime := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixLockedAmountByUnlockTime):])
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		if cb(unlockTime, amount.Int) {
+			break
+		}
+	}
+}
+
+func (k Keeper) addLockedAmountByUnlockTime(ctx sdk.Context, unlockTime uint64, amount sdk.Int) {
+	if amount.IsZero() {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	total := k.GetLockedAmountByUnlockTime(ctx, unlockTime).Add(amount)
+	if total.IsZero() {
+		store.Delete(types.LockedAmountByUnlockTimeKey(unlockTime))
+		return
+	}
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: total})
+	store.Set(types.LockedAmountByUnlockTimeKey(unlockTime), bz)
+}
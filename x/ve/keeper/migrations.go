@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.backfillVeOwners(ctx)
+	m.keeper.backfillDelegatedCheckpoints(ctx)
+	m.keeper.backfillLockedAmountByUnlockTime(ctx)
+	return nil
+}
+
+// backfillVeOwners records the current owners of the existing ve NFTs,
+// which were not recorded before, as their owners since the first block.
+func (k Keeper) backfillVeOwners(ctx sdk.Context) {
+	for _, token := range k.nftKeeper.GetNFTsOfClass(ctx, types.VeNftClass.Id) {
+		veID := types.Uint64FromVeID(token.Id)
+		owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, token.Id)
+		k.SetVeOwnerAt(ctx, veID, 0, owner)
+	}
+}
+
+// backfillDelegatedCheckpoints starts the checkpoint history of the amounts
+// delegated to validators with the current delegations of the existing ves.
+func (k Keeper) backfillDelegatedCheckpoints(ctx sdk.Context) {
+	k.IterateLockedAmountByUser(ctx, func(veID uint64, _ types.LockedBalance) (stop bool) {
+		k.RegulateDelegatedCheckpoint(ctx, veID)
+		return false
+	})
+}
+
+// backfillLockedAmountByUnlockTime indexes the locked amounts of the existing
+// ves by their unlocking time.
+func (k Keeper) backfillLockedAmountByUnlockTime(ctx sdk.Context) {
+	k.IterateLockedAmountByUser(ctx, func(_ uint64, locked types.LockedBalance) (stop bool) {
+		k.addLockedAmountByUnlockTime(ctx, locked.End, locked.Amount)
+		return false
+	})
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/x/ve/keeper"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+	lockAmt := sdk.NewCoin("airon", sdk.NewInt(1000*types.MaxLockTime))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt))
+	require.NoError(err)
+	veID, _, err := k.CreateLock(suite.ctx, sender, sender, lockAmt, types.MaxLockTime)
+	require.NoError(err)
+	suite.app.StakingKeeper.SetVeDelegatedAmount(suite.ctx, veID, lockAmt.Amount.QuoRaw(2))
+	now := uint64(suite.ctx.BlockTime().Unix())
+	delegatedPower := k.GetDelegatedVotingPower(suite.ctx, veID, now, 0)
+	locked := k.GetLockedAmountByUser(suite.ctx, veID)
+
+	// The owners and the delegated checkpoints were not recorded before
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	for _, prefix := range [][]byte{
+		types.KeyPrefixOwnerHistory,
+		types.KeyPrefixOwnedVe,
+		types.KeyPrefixDelegatedEpoch,
+		types.KeyPrefixDelegatedPointHistoryByEpoch,
+		types.KeyPrefixDelegatedUserEpoch,
+		types.KeyPrefixDelegatedUserPointHistoryByUserEpoch,
+		types.KeyPrefixDelegatedSlopeChange,
+		types.KeyPrefixDelegatedLockedByUser,
+		types.KeyPrefixLockedAmountByUnlockTime,
+	} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	require.Nil(k.GetVeOwnerAt(suite.ctx, veID, suite.ctx.BlockHeight()))
+	require.True(k.GetTotalDelegatedVotingPower(suite.ctx, now, 0).IsZero())
+	require.True(k.GetLockedAmountByUnlockTime(suite.ctx, locked.End).IsZero())
+
+	err = keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	require.NoError(err)
+	require.Equal(sender, k.GetVeOwnerAt(suite.ctx, veID, 0))
+	require.Equal(delegatedPower, k.GetDelegatedVotingPower(suite.ctx, veID, now, 0))
+	require.Equal(delegatedPower, k.GetTotalDelegatedVotingPower(suite.ctx, now, 0))
+	require.Equal(locked.Amount, k.GetLockedAmountByUnlockTime(suite.ctx, locked.End))
+}
@@ -25,56 +25,16 @@ var _ types.MsgServer = msgServer{}
 func (m msgServer) Create(c context.Context, msg *types.MsgCreate) (*types.MsgCreateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	err := m.Keeper.checkLockDenom(ctx, msg.Amount)
-	if err != nil {
-		return nil, err
-	}
-
 	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
 	if err != nil {
 		return nil, err
 	}
 
-	unlockTime := types.RegulatedUnixTimeFromNow(ctx, msg.LockDuration)
-	if unlockTime <= uint64(ctx.BlockTime().Unix()) {
-		return nil, sdkerrors.Wrapf(types.ErrPastLockTime, "past time: %s", time.Unix(int64(unlockTime), 0))
-	}
-	if unlockTime > uint64(ctx.BlockTime().Unix())+types.MaxLockTime {
-		return nil, sdkerrors.Wrapf(types.ErrTooLongLockTime, "future time: %s", time.Unix(int64(unlockTime), 0))
-	}
-
-	// get new ve id
-	veID := m.Keeper.GetNextVeID(ctx)
-	if veID > types.MaxVeID || veID == types.EmptyVeID {
-		return nil, sdkerrors.Wrap(types.ErrInvalidVeID, "no available ve id")
-	}
-	m.Keeper.SetNextVeID(ctx, veID+1)
-
-	// mint nft for ve id
-	err = m.Keeper.nftKeeper.Mint(ctx, nfttypes.NFT{
-		ClassId: types.VeNftClass.Id,
-		Id:      types.VeIDFromUint64(veID),
-	}, receiver)
+	veID, unlockTime, err := m.Keeper.CreateLock(ctx, sender, receiver, msg.Amount, msg.LockDuration)
 	if err != nil {
 		return nil, err
 	}
 
-	// deposit for ve id
-	err = m.Keeper.DepositFor(ctx, sender, veID, msg.Amount.Amount, unlockTime, types.NewLockedBalance(), true)
-	if err != nil {
-		return nil, err
-	}
-
-	err = ctx.EventManager().EmitTypedEvent(&types.EventCreate{
-		Sender:     sender.String(),
-		Receiver:   receiver.String(),
-		VeId:       types.VeIDFromUint64(veID),
-		Amount:     msg.Amount,
-		UnlockTime: unlockTime,
-	})
-	if err != nil {
-		return nil, err
-	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -112,7 +72,7 @@ func (m msgServer) Deposit(c context.Context, msg *types.MsgDeposit) (*types.Msg
 		// should not happen
 		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
 	}
-	if locked.End <= uint64(ctx.BlockTime().Unix()) {
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
 		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
 
@@ -158,7 +118,10 @@ func (m msgServer) ExtendTime(c context.Context, msg *types.MsgExtendTime) (*typ
 		// should not happen
 		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
 	}
-	if locked.End <= uint64(ctx.BlockTime().Unix()) {
+	if locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrPermanentLock, "cannot extend locking duration of ve %s", msg.VeId)
+	}
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
 		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
 
@@ -226,11 +189,13 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 	lockedFrom := m.Keeper.GetLockedAmountByUser(ctx, fromVeID)
 	lockedTo := m.Keeper.GetLockedAmountByUser(ctx, toVeID)
 
-	if m.Keeper.getDelegatedAmount != nil {
-		delegatedAmt := m.Keeper.getDelegatedAmount(ctx, fromVeID)
-		if delegatedAmt.IsPositive() {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount of from ve is delegated for staking")
-		}
+	delegatedAmt := m.Keeper.GetDelegatedAmountByUser(ctx, fromVeID)
+	if delegatedAmt.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount of from ve is delegated or unbonding for staking")
+	}
+
+	if lockedFrom.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrPermanentLock, "from ve %s permanently locked", msg.FromVeId)
 	}
 
 	// NOTE: here do not check whether locks are expired
@@ -240,6 +205,10 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 	if lockedTo.End > end {
 		end = lockedTo.End
 	}
+	if lockedTo.Permanent {
+		// merged into permanent lock, which has no end time
+		end = 0
+	}
 
 	// delete user locked of fromVeID
 	m.Keeper.DeleteLockedAmountByUser(ctx, fromVeID)
@@ -277,7 +246,7 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 	return &types.MsgMergeResponse{}, nil
 }
 
-func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
+func (m msgServer) Split(c context.Context, msg *types.MsgSplit) (*types.MsgSplitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -287,7 +256,7 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 
 	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
 	if !sender.Equals(owner) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, owner)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
 	}
 
 	veID := types.Uint64FromVeID(msg.VeId)
@@ -298,34 +267,286 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 	}
 
 	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
-	if locked.End > uint64(ctx.BlockTime().Unix()) {
-		return nil, sdkerrors.Wrapf(types.ErrLockNotExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
 
-	if m.Keeper.getDelegatedAmount != nil {
-		delegatedAmt := m.Keeper.getDelegatedAmount(ctx, veID)
-		if delegatedAmt.IsPositive() {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount is delegated for staking")
+	splitAmt := sdk.ZeroInt()
+	for _, amount := range msg.Amounts {
+		splitAmt = splitAmt.Add(amount)
+	}
+	// the ve must keep some locked amount
+	remainingAmt := locked.Amount.Sub(splitAmt)
+	if !remainingAmt.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientLocked, "locked amount %s but split %s", locked.Amount, splitAmt)
+	}
+
+	delegatedAmt := m.Keeper.GetDelegatedAmountByUser(ctx, veID)
+	if delegatedAmt.GT(remainingAmt) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount %s is delegated or unbonding for staking, but only %s remains", delegatedAmt, remainingAmt)
+	}
+
+	// NOTE: the total locked amount is unchanged
+
+	// update user locked of veID
+	lockedRemaining := types.LockedBalance{Amount: remainingAmt, End: locked.End, Permanent: locked.Permanent}
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedRemaining)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedRemaining)
+
+	newVeIDs := make([]string, len(msg.Amounts))
+	for i, amount := range msg.Amounts {
+		// mint nft for new ve id, with the same end time or also permanently locked
+		newVeID, err := m.Keeper.mintVeNft(ctx, sender)
+		if err != nil {
+			return nil, err
 		}
+		newVeIDs[i] = types.VeIDFromUint64(newVeID)
+
+		lockedNew := types.LockedBalance{Amount: amount, End: locked.End, Permanent: locked.Permanent}
+		m.Keeper.SetLockedAmountByUser(ctx, newVeID, lockedNew)
+
+		// regulate checkpoint of new ve id
+		m.Keeper.RegulateUserCheckpoint(ctx, newVeID, types.NewLockedBalance(), lockedNew)
 	}
 
-	// delete user locked
-	m.Keeper.DeleteLockedAmountByUser(ctx, veID)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSplit{
+		Sender:   sender.String(),
+		VeId:     msg.VeId,
+		NewVeIds: newVeIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
 
-	// update total locked
-	totalLocked := m.Keeper.GetTotalLockedAmount(ctx)
-	totalLocked = totalLocked.Sub(locked.Amount)
-	if totalLocked.IsNegative() {
-		// should never happen
-		panic("total locked negative")
+	return &types.MsgSplitResponse{VeIds: newVeIDs}, nil
+}
+
+func (m msgServer) LockPermanent(c context.Context, msg *types.MsgLockPermanent) (*types.MsgLockPermanentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if !locked.Amount.IsPositive() {
+		// should not happen
+		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
+	}
+	if locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrPermanentLock, "ve %s already permanently locked", msg.VeId)
 	}
-	m.Keeper.SetTotalLockedAmount(ctx, totalLocked)
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	// permanent lock has no end time
+	lockedNew := types.LockedBalance{Amount: locked.Amount, End: 0, Permanent: true}
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedNew)
 
 	// regulate checkpoint of veID
-	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, types.NewLockedBalance())
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedNew)
 
-	// burn nft of veID
-	err = m.Keeper.nftKeeper.Burn(ctx, types.VeNftClass.Id, msg.VeId)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockPermanent{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgLockPermanentResponse{}, nil
+}
+
+func (m msgServer) UnlockPermanent(c context.Context, msg *types.MsgUnlockPermanent) (*types.MsgUnlockPermanentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if !locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrNotPermanentLock, "ve %s", msg.VeId)
+	}
+
+	// voting power starts to decay from the maximum lock time on
+	unlockTime := types.RegulatedUnixTimeFromNow(ctx, types.MaxLockTime)
+	lockedNew := types.LockedBalance{Amount: locked.Amount, End: unlockTime}
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedNew)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedNew)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUnlockPermanent{
+		Sender:     sender.String(),
+		VeId:       msg.VeId,
+		UnlockTime: unlockTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgUnlockPermanentResponse{}, nil
+}
+
+func (m msgServer) DelegateVoting(c context.Context, msg *types.MsgDelegateVeVoting) (*types.MsgDelegateVeVotingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+	if delegate.Equals(owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot delegate voting to the owner")
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	m.Keeper.SetVotingDelegate(ctx, veID, delegate)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDelegateVoting{
+		Sender:   sender.String(),
+		VeId:     msg.VeId,
+		Delegate: delegate.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgDelegateVeVotingResponse{}, nil
+}
+
+func (m msgServer) RevokeVoting(c context.Context, msg *types.MsgRevokeVeVoting) (*types.MsgRevokeVeVotingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	delegate, found := m.Keeper.GetVotingDelegate(ctx, veID)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no voting delegation of ve %s", msg.VeId)
+	}
+
+	m.Keeper.DeleteVotingDelegate(ctx, veID)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRevokeVoting{
+		Sender:   sender.String(),
+		VeId:     msg.VeId,
+		Delegate: delegate.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgRevokeVeVotingResponse{}, nil
+}
+
+func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, owner)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	err = m.Keeper.CheckVeAttached(ctx, veID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ve id attached")
+	}
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrPermanentLock, "cannot withdraw ve %s before unlocking permanent lock", msg.VeId)
+	}
+	if locked.End > uint64(ctx.BlockTime().Unix()) {
+		return nil, sdkerrors.Wrapf(types.ErrLockNotExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	delegatedAmt := m.Keeper.GetDelegatedAmountByUser(ctx, veID)
+	if delegatedAmt.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount is delegated or unbonding for staking")
+	}
+
+	err = m.Keeper.removeLock(ctx, veID, locked)
 	if err != nil {
 		return nil, err
 	}
@@ -354,10 +575,130 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 	return &types.MsgWithdrawResponse{}, nil
 }
 
+func (m msgServer) EarlyWithdraw(c context.Context, msg *types.MsgEarlyWithdraw) (*types.MsgEarlyWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	err = m.Keeper.CheckVeAttached(ctx, veID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ve id attached")
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrPermanentLock, "cannot withdraw ve %s before unlocking permanent lock", msg.VeId)
+	}
+	if locked.IsExpired(now) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s, so withdraw without penalty", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	delegatedAmt := m.Keeper.GetDelegatedAmountByUser(ctx, veID)
+	if delegatedAmt.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount is delegated or unbonding for staking")
+	}
+
+	err = m.Keeper.removeLock(ctx, veID, locked)
+	if err != nil {
+		return nil, err
+	}
+
+	// penalty goes to the distribution pool, so that it will be distributed
+	// to the remaining ve holders along with the compensation of emission
+	lockDenom := m.Keeper.LockDenom(ctx)
+	penalty := sdk.NewCoin(lockDenom, locked.EarlyWithdrawPenalty(now))
+	if penalty.IsPositive() {
+		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.DistributionPoolName, sdk.NewCoins(penalty))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// send remaining amount to sender
+	coin := sdk.NewCoin(lockDenom, locked.Amount.Sub(penalty.Amount))
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin))
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEarlyWithdraw{
+		Sender:  sender.String(),
+		VeId:    msg.VeId,
+		Amount:  coin,
+		Penalty: penalty,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgEarlyWithdrawResponse{
+		Amount:  coin,
+		Penalty: penalty,
+	}, nil
+}
+
+// removeLock deletes the lock of the ve and burns its nft,
+// while the locked amount is still kept by the module.
+func (k Keeper) removeLock(ctx sdk.Context, veID uint64, locked types.LockedBalance) error {
+	// delete user locked
+	k.DeleteLockedAmountByUser(ctx, veID)
+
+	// update total locked
+	totalLocked := k.GetTotalLockedAmount(ctx)
+	totalLocked = totalLocked.Sub(locked.Amount)
+	if totalLocked.IsNegative() {
+		// should never happen
+		panic("total locked negative")
+	}
+	k.SetTotalLockedAmount(ctx, totalLocked)
+
+	// regulate checkpoint of veID
+	k.RegulateUserCheckpoint(ctx, veID, locked, types.NewLockedBalance())
+
+	// burn nft of veID
+	return k.nftKeeper.Burn(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+}
+
+// mintVeNft mints a veNFT of new ve id to receiver.
+func (k Keeper) mintVeNft(ctx sdk.Context, receiver sdk.AccAddress) (uint64, error) {
+	// get new ve id
+	veID := k.GetNextVeID(ctx)
+	if veID > types.MaxVeID || veID == types.EmptyVeID {
+		return 0, sdkerrors.Wrap(types.ErrInvalidVeID, "no available ve id")
+	}
+	k.SetNextVeID(ctx, veID+1)
+
+	err := k.nftKeeper.Mint(ctx, nfttypes.NFT{
+		ClassId: types.VeNftClass.Id,
+		Id:      types.VeIDFromUint64(veID),
+	}, receiver)
+	if err != nil {
+		return 0, err
+	}
+	return veID, nil
+}
+
 // DepositFor deposits some more amount and/or update locking end time for a veNFT.
 // 	 veID: must be valid ve id
 //   amount: locked amount to add; can be zero if no more amount to deposit
-//   unlockTime: when unlocking; can be zero if no need to update
+//   unlockTime: when unlocking; can be zero if no need to update, and must be zero for permanent lock
 //   locked: existing locked; may be zero if no existing locked
 //   sendCoins: false when extend time or merge
 func (k Keeper) DepositFor(ctx sdk.Context, sender sdk.AccAddress, veID uint64, amount sdk.Int, unlockTime uint64, locked types.LockedBalance, sendCoins bool) error {
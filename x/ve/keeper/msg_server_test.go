@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gridiron-zone/gridiron/app"
 	"github.com/gridiron-zone/gridiron/x/ve/keeper"
 	"github.com/gridiron-zone/gridiron/x/ve/types"
 	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
@@ -236,6 +238,197 @@ func (suite *KeeperTestSuite) TestVeMerge() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVeSplit() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "airon"
+	lockAmt := sdk.NewCoin(denom, sdk.NewInt(1000*types.MaxLockTime))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt.Add(lockAmt).Add(lockAmt)))
+	require.NoError(err)
+	// Create Valid VeID
+	for i := 1; i <= 2; i++ {
+		res, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       lockAmt,
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+		require.Equal(fmt.Sprintf("ve-%d", i), res.VeId)
+	}
+	k.SetVeVoted(suite.ctx, 2, true)
+
+	// Another NFT
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	receiver := sdk.AccAddress(priv.PubKey().Address())
+	_, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           receiver.String(),
+		Amount:       lockAmt,
+		LockDuration: types.MaxLockTime,
+	})
+	require.NoError(err)
+
+	// Part of ve-1 is delegated for staking
+	delegated := sdk.NewInt(400 * types.MaxLockTime)
+	k.SetGetDelegatedAmountByUser(func(ctx sdk.Context, veID uint64) sdk.Int {
+		if veID == 1 {
+			return delegated
+		}
+		return sdk.ZeroInt()
+	})
+
+	testCases := []struct {
+		name    string
+		pass    bool
+		sender  sdk.AccAddress
+		veId    string
+		amounts []int64
+	}{
+		{"invalid sender", false, []byte("xxx"), "ve-1", []int64{100}},
+		{"user doesn't own veId", false, sender, "ve-3", []int64{100}},
+		{"ve voted", false, sender, "ve-2", []int64{100}},
+		{"nothing remains", false, sender, "ve-1", []int64{600, 400}},
+		{"delegated amount above remaining", false, sender, "ve-1", []int64{300, 301}},
+		{"ok", true, sender, "ve-1", []int64{100, 500}},
+	}
+
+	locked := k.GetLockedAmountByUser(suite.ctx, 1)
+	totalLocked := k.GetTotalLockedAmount(suite.ctx)
+	totalPower := k.GetTotalVotingPower(suite.ctx, 0, suite.ctx.BlockHeight())
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			amounts := make([]sdk.Int, len(tc.amounts))
+			for i, amount := range tc.amounts {
+				amounts[i] = sdk.NewInt(amount * types.MaxLockTime)
+			}
+			res, err := impl.Split(ctx, &types.MsgSplit{
+				Sender:  tc.sender.String(),
+				VeId:    tc.veId,
+				Amounts: amounts,
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+				require.Equal([]string{"ve-4", "ve-5"}, res.VeIds)
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+
+	// The split ves are locked until the same end time
+	require.Equal(types.LockedBalance{Amount: delegated, End: locked.End}, k.GetLockedAmountByUser(suite.ctx, 1))
+	require.Equal(types.LockedBalance{Amount: sdk.NewInt(100 * types.MaxLockTime), End: locked.End}, k.GetLockedAmountByUser(suite.ctx, 4))
+	require.Equal(types.LockedBalance{Amount: sdk.NewInt(500 * types.MaxLockTime), End: locked.End}, k.GetLockedAmountByUser(suite.ctx, 5))
+	require.Equal(sender, suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, "ve-4"))
+	require.Equal(sender, suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, "ve-5"))
+
+	// The total locked amount and voting power are unchanged
+	require.Equal(totalLocked, k.GetTotalLockedAmount(suite.ctx))
+	require.Equal(totalPower, k.GetTotalVotingPower(suite.ctx, 0, suite.ctx.BlockHeight()))
+	power := sdk.ZeroInt()
+	for _, veID := range []uint64{1, 4, 5} {
+		power = power.Add(k.GetVotingPower(suite.ctx, veID, uint64(suite.ctx.BlockTime().Unix()), 0))
+	}
+	require.Equal(k.GetVotingPower(suite.ctx, 2, uint64(suite.ctx.BlockTime().Unix()), 0), power)
+}
+
+func (suite *KeeperTestSuite) TestVePermanentLock() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "airon"
+	lockAmt := sdk.NewCoin(denom, sdk.NewInt(1000*types.MaxLockTime))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt.Add(lockAmt)))
+	require.NoError(err)
+	for _, duration := range []uint64{types.MaxLockTime, types.RegulatedPeriod * 2} {
+		_, err = impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			Amount:       lockAmt,
+			LockDuration: duration,
+		})
+		require.NoError(err)
+	}
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	other := sdk.AccAddress(priv.PubKey().Address())
+	now := uint64(suite.ctx.BlockTime().Unix())
+	future := now + types.MaxLockTime*2
+
+	// Only the owner can permanently lock the ve, only once
+	_, err = impl.LockPermanent(ctx, &types.MsgLockPermanent{Sender: other.String(), VeId: "ve-2"})
+	require.Error(err)
+	_, err = impl.LockPermanent(ctx, &types.MsgLockPermanent{Sender: sender.String(), VeId: "ve-2"})
+	require.NoError(err)
+	_, err = impl.LockPermanent(ctx, &types.MsgLockPermanent{Sender: sender.String(), VeId: "ve-2"})
+	require.ErrorIs(err, types.ErrPermanentLock)
+	require.Equal(types.LockedBalance{Amount: lockAmt.Amount, Permanent: true}, k.GetLockedAmountByUser(suite.ctx, 2))
+
+	// The voting power is pinned at the maximum, even after ve-1 expires
+	power1 := k.GetVotingPower(suite.ctx, 1, now, 0)
+	require.Equal(lockAmt.Amount, k.GetVotingPower(suite.ctx, 2, now, 0))
+	require.Equal(lockAmt.Amount, k.GetVotingPower(suite.ctx, 2, future, 0))
+	require.Equal(power1.Add(lockAmt.Amount), k.GetTotalVotingPower(suite.ctx, now, 0))
+	require.Equal(lockAmt.Amount, k.GetTotalVotingPower(suite.ctx, future, 0))
+
+	// The permanent lock can be neither extended nor withdrawn
+	_, err = impl.ExtendTime(ctx, &types.MsgExtendTime{Sender: sender.String(), VeId: "ve-2", LockDuration: types.MaxLockTime})
+	require.ErrorIs(err, types.ErrPermanentLock)
+	futureCtx := sdk.WrapSDKContext(suite.ctx.WithBlockTime(time.Unix(int64(future), 0)))
+	_, err = impl.Withdraw(futureCtx, &types.MsgWithdraw{Sender: sender.String(), VeId: "ve-2"})
+	require.ErrorIs(err, types.ErrPermanentLock)
+
+	// The split ve is also permanently locked
+	res, err := impl.Split(ctx, &types.MsgSplit{
+		Sender:  sender.String(),
+		VeId:    "ve-2",
+		Amounts: []sdk.Int{sdk.NewInt(100 * types.MaxLockTime)},
+	})
+	require.NoError(err)
+	require.Equal([]string{"ve-3"}, res.VeIds)
+	require.Equal(types.LockedBalance{Amount: sdk.NewInt(100 * types.MaxLockTime), Permanent: true}, k.GetLockedAmountByUser(suite.ctx, 3))
+	require.Equal(power1.Add(lockAmt.Amount), k.GetTotalVotingPower(suite.ctx, now, 0))
+
+	// The permanent lock cannot be merged into another ve, but the reverse
+	_, err = impl.Merge(ctx, &types.MsgMerge{Sender: sender.String(), FromVeId: "ve-2", ToVeId: "ve-1"})
+	require.ErrorIs(err, types.ErrPermanentLock)
+	_, err = impl.Merge(ctx, &types.MsgMerge{Sender: sender.String(), FromVeId: "ve-1", ToVeId: "ve-2"})
+	require.NoError(err)
+	require.Equal(types.LockedBalance{Amount: sdk.NewInt(1900 * types.MaxLockTime), Permanent: true}, k.GetLockedAmountByUser(suite.ctx, 2))
+	require.Equal(lockAmt.Amount.Add(lockAmt.Amount), k.GetTotalVotingPower(suite.ctx, now, 0))
+	require.Equal(lockAmt.Amount.Add(lockAmt.Amount), k.GetTotalVotingPower(suite.ctx, future, 0))
+
+	// The voting power does not decay a week later
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(types.RegulatedPeriod * time.Second))
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	k.RegulateCheckpoint(suite.ctx)
+	now = uint64(suite.ctx.BlockTime().Unix())
+	require.Equal(sdk.NewInt(1900*types.MaxLockTime), k.GetVotingPower(suite.ctx, 2, 0, suite.ctx.BlockHeight()))
+	require.Equal(lockAmt.Amount.Add(lockAmt.Amount), k.GetTotalVotingPower(suite.ctx, 0, suite.ctx.BlockHeight()))
+
+	// Only the permanent lock can be unlocked by the owner, which starts to
+	// decay from the maximum lock time on
+	_, err = impl.UnlockPermanent(ctx, &types.MsgUnlockPermanent{Sender: other.String(), VeId: "ve-2"})
+	require.Error(err)
+	_, err = impl.UnlockPermanent(ctx, &types.MsgUnlockPermanent{Sender: sender.String(), VeId: "ve-2"})
+	require.NoError(err)
+	_, err = impl.UnlockPermanent(ctx, &types.MsgUnlockPermanent{Sender: sender.String(), VeId: "ve-2"})
+	require.ErrorIs(err, types.ErrNotPermanentLock)
+	end := types.RegulatedUnixTimeFromNow(suite.ctx, types.MaxLockTime)
+	require.Equal(types.LockedBalance{Amount: sdk.NewInt(1900 * types.MaxLockTime), End: end}, k.GetLockedAmountByUser(suite.ctx, 2))
+	power2 := sdk.NewInt(1900 * int64(end-now))
+	require.Equal(power2, k.GetVotingPower(suite.ctx, 2, now, 0))
+	require.Equal(power2.Add(sdk.NewInt(100*types.MaxLockTime)), k.GetTotalVotingPower(suite.ctx, now, 0))
+	require.Equal(sdk.NewInt(100*types.MaxLockTime), k.GetTotalVotingPower(suite.ctx, end, 0))
+}
+
 func (suite *KeeperTestSuite) TestVeWithdraw() {
 	require := suite.Require()
 	ctx := sdk.WrapSDKContext(suite.ctx)
@@ -301,6 +494,95 @@ func (suite *KeeperTestSuite) TestVeWithdraw() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVeEarlyWithdraw() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "airon"
+	lockAmt := sdk.NewCoin(denom, sdk.NewInt(1000*types.MaxLockTime))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt.Add(lockAmt).Add(lockAmt).Add(lockAmt)))
+	require.NoError(err)
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	receiver := sdk.AccAddress(priv.PubKey().Address())
+	for _, to := range []sdk.AccAddress{sender, receiver, sender, sender} {
+		_, err = impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           to.String(),
+			Amount:       lockAmt,
+			LockDuration: types.MaxLockTime / 2,
+		})
+		require.NoError(err)
+	}
+	k.SetVeVoted(suite.ctx, 3, true)
+	_, err = impl.LockPermanent(ctx, &types.MsgLockPermanent{Sender: sender.String(), VeId: "ve-4"})
+	require.NoError(err)
+
+	testCases := []struct {
+		name   string
+		sender sdk.AccAddress
+		veId   string
+	}{
+		{"invalid sender", []byte("xxx"), "ve-1"},
+		{"user doesn't own veId", sender, "ve-2"},
+		{"ve voted", sender, "ve-3"},
+		{"ve permanently locked", sender, "ve-4"},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, err := impl.EarlyWithdraw(ctx, &types.MsgEarlyWithdraw{
+				Sender: tc.sender.String(),
+				VeId:   tc.veId,
+			})
+			require.Error(err, tc.name)
+		})
+	}
+
+	// The expired ve can only be withdrawn without penalty
+	locked := k.GetLockedAmountByUser(suite.ctx, 1)
+	expiredCtx := sdk.WrapSDKContext(suite.ctx.WithBlockTime(time.Unix(int64(locked.End), 0)))
+	_, err = impl.EarlyWithdraw(expiredCtx, &types.MsgEarlyWithdraw{Sender: sender.String(), VeId: "ve-1"})
+	require.ErrorIs(err, types.ErrLockExpired)
+
+	// The ve delegated for staking cannot be withdrawn
+	k.SetGetDelegatedAmountByUser(func(ctx sdk.Context, veID uint64) sdk.Int {
+		return sdk.NewInt(1)
+	})
+	_, err = impl.EarlyWithdraw(ctx, &types.MsgEarlyWithdraw{Sender: sender.String(), VeId: "ve-1"})
+	require.Error(err)
+	k.SetGetDelegatedAmountByUser(func(ctx sdk.Context, veID uint64) sdk.Int {
+		return sdk.ZeroInt()
+	})
+
+	// The penalty is proportional to the remaining locking time
+	now := uint64(suite.ctx.BlockTime().Unix())
+	penalty := sdk.NewCoin(denom, sdk.NewInt(1000*int64(locked.End-now)))
+	require.True(penalty.Amount.LT(lockAmt.Amount))
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom)
+	totalLocked := k.GetTotalLockedAmount(suite.ctx)
+	power2 := k.GetVotingPower(suite.ctx, 2, now, 0)
+	power4 := k.GetVotingPower(suite.ctx, 4, now, 0)
+	power3 := k.GetVotingPower(suite.ctx, 3, now, 0)
+	res, err := impl.EarlyWithdraw(ctx, &types.MsgEarlyWithdraw{Sender: sender.String(), VeId: "ve-1"})
+	require.NoError(err)
+	require.Equal(penalty, res.Penalty)
+	require.Equal(lockAmt.Sub(penalty), res.Amount)
+	require.Equal(balance.Add(res.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom))
+	require.False(suite.app.NftKeeper.HasNFT(suite.ctx, types.VeNftClass.Id, "ve-1"))
+	require.Equal(types.NewLockedBalance(), k.GetLockedAmountByUser(suite.ctx, 1))
+	require.Equal(totalLocked.Sub(lockAmt.Amount), k.GetTotalLockedAmount(suite.ctx))
+	require.Equal(power2.Add(power3).Add(power4), k.GetTotalVotingPower(suite.ctx, now, 0))
+
+	// The penalty is distributed to the remaining ve holders
+	poolAddr := suite.app.AccountKeeper.GetModuleAddress(types.DistributionPoolName)
+	require.Equal(penalty, suite.app.BankKeeper.GetBalance(suite.ctx, poolAddr, denom))
+	k.SetDistributionAccruedLastTimestamp(suite.ctx, now)
+	keeper.NewDistributor(k).DistributePerPeriod(suite.ctx)
+	require.Equal(penalty.Amount, k.GetDistributionPerPeriod(suite.ctx, types.RegulatedUnixTime(now)))
+}
+
 func (suite *KeeperTestSuite) TestKeeper_DepositFor() {
 	require := suite.Require()
 	ctx := sdk.WrapSDKContext(suite.ctx)
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
 	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
@@ -35,19 +37,249 @@ func NewNftKeeper(keeper nftkeeper.Keeper, veKeeper func() Keeper) NftKeeper {
 }
 
 // Send implement Send method of the types.MsgServer of the nft module.
-// Here we customize it with checking whether the ve NFT has been attached.
+// Here we customize it by transferring through Transfer below, which checks
+// whether the ve NFT has been attached.
 func (k NftKeeper) Send(c context.Context, msg *nfttypes.MsgSend) (*nfttypes.MsgSendResponse, error) {
-	// only check for ve NFT class
-	if msg.ClassId == types.VeNftClass.Id {
-		ctx := sdk.UnwrapSDKContext(c)
-		veID := types.Uint64FromVeID(msg.Id)
-		err := k.veKeeper().CheckVeAttached(ctx, veID)
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Transfer(ctx, msg.ClassId, msg.Id, receiver); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&nfttypes.EventSend{
+		ClassId:  msg.ClassId,
+		Id:       msg.Id,
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nfttypes.MsgSendResponse{}, nil
+}
+
+// Mint mints the NFT. For ve NFT, the ERC-721 mirror token is minted too.
+func (k NftKeeper) Mint(ctx sdk.Context, token nfttypes.NFT, receiver sdk.AccAddress) error {
+	if err := k.Keeper.Mint(ctx, token, receiver); err != nil {
+		return err
+	}
+	if token.ClassId != types.VeNftClass.Id {
+		return nil
+	}
+	veID := types.Uint64FromVeID(token.Id)
+	k.veKeeper().setVeOwner(ctx, veID, receiver)
+	return k.veKeeper().mirrorNftMint(ctx, veID, receiver)
+}
+
+// Burn burns the NFT. For ve NFT, the ERC-721 mirror token is burned too.
+func (k NftKeeper) Burn(ctx sdk.Context, classID string, nftID string) error {
+	if err := k.Keeper.Burn(ctx, classID, nftID); err != nil {
+		return err
+	}
+	if classID != types.VeNftClass.Id {
+		return nil
+	}
+	veID := types.Uint64FromVeID(nftID)
+	k.veKeeper().setVeOwner(ctx, veID, nil)
+	k.veKeeper().DeleteVotingDelegate(ctx, veID)
+	return k.veKeeper().mirrorNftBurn(ctx, veID)
+}
+
+// NFT implements NFT method of the types.QueryServer of the nft module.
+// Here we customize it by rendering the ve NFT with its current data.
+func (k NftKeeper) NFT(c context.Context, r *nfttypes.QueryNFTRequest) (*nfttypes.QueryNFTResponse, error) {
+	res, err := k.Keeper.NFT(c, r)
+	if err != nil {
+		return nil, err
+	}
+	if res.Nft != nil && res.Nft.ClassId == types.VeNftClass.Id {
+		err = k.veKeeper().renderVeNft(sdk.UnwrapSDKContext(c), res.Nft)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// NFTs implements NFTs method of the types.QueryServer of the nft module.
+// Here we customize it by rendering the ve NFTs with their current data.
+func (k NftKeeper) NFTs(c context.Context, r *nfttypes.QueryNFTsRequest) (*nfttypes.QueryNFTsResponse, error) {
+	res, err := k.Keeper.NFTs(c, r)
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range res.Nfts {
+		if token.ClassId != types.VeNftClass.Id {
+			continue
+		}
+		err = k.veKeeper().renderVeNft(sdk.UnwrapSDKContext(c), token)
 		if err != nil {
 			return nil, err
 		}
 	}
+	return res, nil
+}
 
-	return k.Keeper.Send(c, msg)
+// Transfer transfers the NFT. For ve NFT, it checks whether the ve NFT has
+// been attached, and the ERC-721 mirror token is transferred too. The voting
+// delegation of the ve NFT, if any, is not kept for the new owner.
+func (k NftKeeper) Transfer(ctx sdk.Context, classID string, nftID string, receiver sdk.AccAddress) error {
+	if classID != types.VeNftClass.Id {
+		return k.Keeper.Transfer(ctx, classID, nftID, receiver)
+	}
+
+	veID := types.Uint64FromVeID(nftID)
+	err := k.veKeeper().CheckVeAttached(ctx, veID)
+	if err != nil {
+		return err
+	}
+
+	sender := k.GetOwner(ctx, classID, nftID)
+	if err := k.transferVe(ctx, veID, receiver); err != nil {
+		return err
+	}
+	return k.veKeeper().mirrorNftTransfer(ctx, veID, sender, receiver)
+}
+
+// transferVe transfers the ve NFT without its ERC-721 mirror token, and
+// records the new owner. The voting delegation of the ve NFT, if any, is not
+// kept for the new owner.
+func (k NftKeeper) transferVe(ctx sdk.Context, veID uint64, receiver sdk.AccAddress) error {
+	if err := k.Keeper.Transfer(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID), receiver); err != nil {
+		return err
+	}
+	k.veKeeper().setVeOwner(ctx, veID, receiver)
+	k.veKeeper().DeleteVotingDelegate(ctx, veID)
+	return nil
+}
+
+// setVeOwner records the owner of the ve from the current block on,
+// nil if the ve is burned
+func (k Keeper) setVeOwner(ctx sdk.Context, veID uint64, owner sdk.AccAddress) {
+	k.SetVeOwnerAt(ctx, veID, ctx.BlockHeight(), owner)
+}
+
+// SetVeOwnerAt records the owner of the ve from the specified block on,
+// nil if the ve is burned
+func (k Keeper) SetVeOwnerAt(ctx sdk.Context, veID uint64, block int64, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OwnerHistoryKey(veID, block), append([]byte{}, owner...))
+	if !owner.Empty() {
+		store.Set(types.OwnedVeKey(owner, veID), []byte{})
+	}
+}
+
+// IterateVeOwnerHistory iterates the owner history of all ves, in ascending
+// order of ve id and block
+func (k Keeper) IterateVeOwnerHistory(ctx sdk.Context, cb func(veID uint64, block int64, owner sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixOwnerHistory)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixOwnerHistory):]
+		veID := sdk.BigEndianToUint64(key[:8])
+		block := int64(sdk.BigEndianToUint64(key[8:]))
+		if cb(veID, block, iterator.Value()) {
+			break
+		}
+	}
+}
+
+// GetVeOwnerAt returns the owner of the ve at the end of the specified block,
+// or nil if the ve did not exist then
+func (k Keeper) GetVeOwnerAt(ctx sdk.Context, veID uint64, block int64) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.OwnerHistoryPrefix(veID), types.OwnerHistoryKey(veID, block+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() || len(iterator.Value()) == 0 {
+		return nil
+	}
+	return iterator.Value()
+}
+
+// IterateVeIDsOwnedAt iterates the ves owned by the owner at the end of
+// the specified block, in ascending order of ve id
+func (k Keeper) IterateVeIDsOwnedAt(ctx sdk.Context, owner sdk.AccAddress, block int64, cb func(veID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.OwnedVesPrefix(owner)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		if !owner.Equals(k.GetVeOwnerAt(ctx, veID, block)) {
+			continue
+		}
+		if cb(veID) {
+			break
+		}
+	}
+}
+
+// GetVeNftMetadata gets the current data of the ve NFT
+func (k Keeper) GetVeNftMetadata(ctx sdk.Context, veID uint64) types.VeNftMetadata {
+	locked := k.GetLockedAmountByUser(ctx, veID)
+	return types.VeNftMetadata{
+		Amount:      locked.Amount,
+		UnlockTime:  locked.End,
+		Permanent:   locked.Permanent,
+		VotingPower: k.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0),
+		Attached:    k.GetVeAttached(ctx, veID) != 0,
+		Voted:       k.GetVeVoted(ctx, veID),
+	}
+}
+
+// refreshVeNftData refreshes the lock data of the ve NFT in the nft module
+// if the ve NFT exists. The voting power, which decays over time, and the
+// attached/voted flags are not stored but rendered on queries.
+func (k Keeper) refreshVeNftData(ctx sdk.Context, veID uint64) {
+	token, found := k.nftKeeper.GetNFT(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+	if !found {
+		return
+	}
+	locked := k.GetLockedAmountByUser(ctx, veID)
+	metadata := types.VeNftMetadata{
+		Amount:      locked.Amount,
+		UnlockTime:  locked.End,
+		Permanent:   locked.Permanent,
+		VotingPower: sdk.ZeroInt(),
+	}
+	data, err := codectypes.NewAnyWithValue(&metadata)
+	if err != nil {
+		panic(err)
+	}
+	token.Data = data
+	if err := k.nftKeeper.Update(ctx, token); err != nil {
+		panic(err)
+	}
+}
+
+// renderVeNft renders the ve NFT with its current data and the data URI
+func (k Keeper) renderVeNft(ctx sdk.Context, token *nfttypes.NFT) error {
+	metadata := k.GetVeNftMetadata(ctx, types.Uint64FromVeID(token.Id))
+	data, err := codectypes.NewAnyWithValue(&metadata)
+	if err != nil {
+		return err
+	}
+	token.Data = data
+	token.Uri = types.VeNftUri(token.Id, metadata)
+	return nil
 }
 
 // CheckVeAttached checks whether the ve has attached/voted
@@ -1,5 +1,14 @@
 package keeper_test
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/x/ve/keeper"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
 func (suite *KeeperTestSuite) TestKeeper_CheckVeAttached() {
 	suite.SetupTest()
 	k := suite.app.VeKeeper
@@ -65,3 +74,97 @@ func (suite *KeeperTestSuite) TestKeeper_SetVeVoted_GetVeVoted() {
 	voted = k.GetVeVoted(suite.ctx, uint64(1))
 	suite.Require().Equal(true, voted)
 }
+
+func (suite *KeeperTestSuite) veNftMetadata(veID string) types.VeNftMetadata {
+	token, found := suite.app.NftKeeper.GetNFT(suite.ctx, types.VeNftClass.Id, veID)
+	suite.Require().True(found)
+	suite.Require().NotNil(token.Data)
+	var metadata types.VeNftMetadata
+	suite.Require().NoError(metadata.Unmarshal(token.Data.Value))
+	return metadata
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RefreshVeNftData() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	lockAmt := sdk.NewCoin("airon", sdk.NewInt(1000*types.MaxLockTime))
+	now := uint64(suite.ctx.BlockTime().Unix())
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt.Add(lockAmt)))
+	require.NoError(err)
+
+	// only the lock data is stored
+	res, err := impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       lockAmt,
+		LockDuration: types.MaxLockTime / 2,
+	})
+	require.NoError(err)
+	metadata := suite.veNftMetadata(res.VeId)
+	require.Equal(lockAmt.Amount, metadata.Amount)
+	require.Equal(res.UnlockTime, metadata.UnlockTime)
+	require.False(metadata.Permanent)
+	require.True(metadata.VotingPower.IsZero())
+	require.False(metadata.Attached)
+	require.False(metadata.Voted)
+
+	_, err = impl.Deposit(ctx, &types.MsgDeposit{Sender: sender.String(), VeId: res.VeId, Amount: lockAmt})
+	require.NoError(err)
+	require.Equal(lockAmt.Amount.MulRaw(2), suite.veNftMetadata(res.VeId).Amount)
+
+	_, err = impl.ExtendTime(ctx, &types.MsgExtendTime{Sender: sender.String(), VeId: res.VeId, LockDuration: types.MaxLockTime})
+	require.NoError(err)
+	metadata = suite.veNftMetadata(res.VeId)
+	require.Equal(types.RegulatedUnixTimeFromNow(suite.ctx, types.MaxLockTime), metadata.UnlockTime)
+
+	// voting does not rewrite the stored data
+	k.SetVeVoted(suite.ctx, 1, true)
+	require.Equal(metadata, suite.veNftMetadata(res.VeId))
+
+	// nft query renders the ve NFT with the current voting power and flags
+	nftRes, err := suite.app.NftKeeper.NFT(ctx, &nft.QueryNFTRequest{ClassId: types.VeNftClass.Id, Id: res.VeId})
+	require.NoError(err)
+	var rendered types.VeNftMetadata
+	require.NoError(rendered.Unmarshal(nftRes.Nft.Data.Value))
+	require.Equal(k.GetVotingPower(suite.ctx, 1, now, 0), rendered.VotingPower)
+	require.True(rendered.VotingPower.IsPositive())
+	require.True(rendered.Voted)
+	require.Equal(k.GetVeNftMetadata(suite.ctx, 1), rendered)
+	require.Equal(types.VeNftUri(res.VeId, rendered), nftRes.Nft.Uri)
+	veNftRes, err := k.VeNft(ctx, &types.QueryVeNftRequest{Id: res.VeId})
+	require.NoError(err)
+	require.Equal(nftRes.Nft.Uri, veNftRes.Nft.Uri)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetVeOwnerAt() {
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	block := suite.ctx.BlockHeight()
+	veID, _, err := k.CreateLock(suite.ctx, sender, sender, sdk.NewCoin("airon", sdk.NewInt(100)), types.MaxLockTime)
+	require.NoError(err)
+	require.Nil(k.GetVeOwnerAt(suite.ctx, veID, block-1))
+	require.Equal(sender, k.GetVeOwnerAt(suite.ctx, veID, block))
+
+	suite.ctx = suite.ctx.WithBlockHeight(block + 1)
+	err = suite.app.NftKeeper.Transfer(suite.ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID), receiver)
+	require.NoError(err)
+	require.Equal(sender, k.GetVeOwnerAt(suite.ctx, veID, block))
+	require.Equal(receiver, k.GetVeOwnerAt(suite.ctx, veID, block+1))
+
+	ownedAt := func(owner sdk.AccAddress, block int64) (veIDs []uint64) {
+		k.IterateVeIDsOwnedAt(suite.ctx, owner, block, func(veID uint64) (stop bool) {
+			veIDs = append(veIDs, veID)
+			return false
+		})
+		return
+	}
+	require.Equal([]uint64{veID}, ownedAt(sender, block))
+	require.Empty(ownedAt(sender, block+1))
+	require.Empty(ownedAt(receiver, block))
+	require.Equal([]uint64{veID}, ownedAt(receiver, block+1))
+}
@@ -32,5 +32,78 @@ func (k Keeper) SlashLockedAmountByUser(ctx sdk.Context, veID uint64, amount sdk
 }
 
 func (k Keeper) SetGetDelegatedAmountByUser(getDelegatedAmount func(ctx sdk.Context, veID uint64) sdk.Int) {
-	k.getDelegatedAmount = getDelegatedAmount
+	*k.getDelegatedAmount = getDelegatedAmount
+}
+
+// GetDelegatedAmountByUser returns the amount of veID delegated for staking,
+// including the amount still unbonding, which is unavailable until the
+// unbonding completes.
+func (k Keeper) GetDelegatedAmountByUser(ctx sdk.Context, veID uint64) sdk.Int {
+	if k.getDelegatedAmount == nil || *k.getDelegatedAmount == nil {
+		return sdk.ZeroInt()
+	}
+	return (*k.getDelegatedAmount)(ctx, veID)
+}
+
+// RegulateDelegatedCheckpoint regulates the checkpoint of the part of veID
+// delegated to validators, which unlocks along with the lock of veID.
+// It must be called whenever the locked or delegated amount of veID changes.
+func (k Keeper) RegulateDelegatedCheckpoint(ctx sdk.Context, veID uint64) {
+	locked := k.GetLockedAmountByUser(ctx, veID)
+	delegated := k.GetDelegatedAmountByUser(ctx, veID)
+
+	lockedNew := types.LockedBalance{
+		Amount:    sdk.MinInt(delegated, locked.Amount),
+		End:       locked.End,
+		Permanent: locked.Permanent,
+	}
+	lockedOld := k.GetDelegatedLockedByUser(ctx, veID)
+	if lockedNew.Amount.IsZero() && lockedOld.Amount.IsZero() {
+		// no voting power delegated before or after
+		return
+	}
+	if lockedNew.Amount.Equal(lockedOld.Amount) && lockedNew.End == lockedOld.End && lockedNew.Permanent == lockedOld.Permanent {
+		return
+	}
+
+	k.regulateUserCheckpoint(ctx, types.DelegatedCheckpointKeys, veID, lockedOld, lockedNew)
+	k.SetDelegatedLockedByUser(ctx, veID, lockedNew)
+}
+
+// SetDelegatedLockedByUser sets the delegated part of the lock of the
+// specified ve, as last checkpointed
+func (k Keeper) SetDelegatedLockedByUser(ctx sdk.Context, veID uint64, amount types.LockedBalance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&amount)
+	store.Set(types.DelegatedLockedByUserKey(veID), bz)
+}
+
+// GetDelegatedLockedByUser gets the delegated part of the lock of the
+// specified ve, as last checkpointed
+func (k Keeper) GetDelegatedLockedByUser(ctx sdk.Context, veID uint64) types.LockedBalance {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DelegatedLockedByUserKey(veID))
+	if bz == nil {
+		return types.NewLockedBalance()
+	}
+	var amount types.LockedBalance
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount
+}
+
+// IterateDelegatedLockedByUser iterates the delegated parts of the locks of
+// all ves, as last checkpointed, in ascending order of ve id
+func (k Keeper) IterateDelegatedLockedByUser(ctx sdk.Context, cb func(veID uint64, locked types.LockedBalance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixDelegatedLockedByUser)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixDelegatedLockedByUser):])
+		var locked types.LockedBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &locked)
+		if cb(veID, locked) {
+			break
+		}
+	}
 }
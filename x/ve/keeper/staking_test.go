@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gridiron-zone/gridiron/app"
 	"github.com/gridiron-zone/gridiron/x/ve/keeper"
 	"github.com/gridiron-zone/gridiron/x/ve/types"
 )
@@ -36,3 +37,34 @@ func (suite *KeeperTestSuite) TestKeeper_SlashLockedAmountByUser() {
 	require.Equal(lockAmt.Amount.Sub(slashed), locked.Amount)
 	require.Equal(lockAmt.Amount.Sub(slashed), totalLocked)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_RegulateDelegatedCheckpoint() {
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+	lockAmt := sdk.NewCoin("airon", sdk.NewInt(1000*types.MaxLockTime))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt))
+	require.NoError(err)
+	veID, _, err := k.CreateLock(suite.ctx, sender, sender, lockAmt, types.MaxLockTime)
+	require.NoError(err)
+	now := uint64(suite.ctx.BlockTime().Unix())
+	require.True(k.GetDelegatedVotingPower(suite.ctx, veID, now, 0).IsZero())
+
+	// delegating half of the lock checkpoints half of its voting power
+	suite.app.StakingKeeper.SetVeDelegatedAmount(suite.ctx, veID, lockAmt.Amount.QuoRaw(2))
+	power := k.GetVotingPower(suite.ctx, veID, now, 0)
+	delegatedPower := k.GetDelegatedVotingPower(suite.ctx, veID, now, 0)
+	require.Equal(power.QuoRaw(2), delegatedPower)
+	require.Equal(delegatedPower, k.GetTotalDelegatedVotingPower(suite.ctx, now, 0))
+
+	// the delegated voting power decays along with the lock
+	later := now + types.MaxLockTime/2
+	require.Equal(k.GetVotingPower(suite.ctx, veID, later, 0).QuoRaw(2), k.GetDelegatedVotingPower(suite.ctx, veID, later, 0))
+	require.Equal(k.GetDelegatedVotingPower(suite.ctx, veID, later, 0), k.GetTotalDelegatedVotingPower(suite.ctx, later, 0))
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	suite.app.StakingKeeper.RemoveVeDelegatedAmount(suite.ctx, veID)
+	require.True(k.GetDelegatedVotingPower(suite.ctx, veID, now, 0).IsZero())
+	require.True(k.GetTotalDelegatedVotingPower(suite.ctx, now, 0).IsZero())
+	require.Equal(delegatedPower, k.GetDelegatedVotingPower(suite.ctx, veID, 0, suite.ctx.BlockHeight()-1))
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+// SetVotingDelegate sets the delegate authorized to vote for the ve,
+// replacing the existing one if any
+func (k Keeper) SetVotingDelegate(ctx sdk.Context, veID uint64, delegate sdk.AccAddress) {
+	k.DeleteVotingDelegate(ctx, veID)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VotingDelegateKey(veID), delegate)
+	store.Set(types.VotingDelegationByDelegateKey(delegate, veID), []byte{})
+}
+
+// GetVotingDelegate gets the delegate authorized to vote for the ve,
+// no matter whether the ve has expired
+func (k Keeper) GetVotingDelegate(ctx sdk.Context, veID uint64) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VotingDelegateKey(veID))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// DeleteVotingDelegate deletes the voting delegation of the ve if any
+func (k Keeper) DeleteVotingDelegate(ctx sdk.Context, veID uint64) {
+	delegate, found := k.GetVotingDelegate(ctx, veID)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VotingDelegateKey(veID))
+	store.Delete(types.VotingDelegationByDelegateKey(delegate, veID))
+}
+
+// IterateVotingDelegationsByDelegate iterates the ves delegated to the delegate
+// for voting, no matter whether they have expired
+func (k Keeper) IterateVotingDelegationsByDelegate(ctx sdk.Context, delegate sdk.AccAddress, cb func(veID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.VotingDelegationsByDelegatePrefix(delegate)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		if cb(veID) {
+			break
+		}
+	}
+}
+
+// IterateVotingDelegates iterates the voting delegates of all ves, in
+// ascending order of ve id, no matter whether they have expired
+func (k Keeper) IterateVotingDelegates(ctx sdk.Context, cb func(veID uint64, delegate sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixVotingDelegate)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixVotingDelegate):])
+		if cb(veID, iterator.Value()) {
+			break
+		}
+	}
+}
+
+// CheckVotingAuthorized checks whether the address is the owner of the ve, or
+// its voting delegate before the unlocking time of the ve
+func (k Keeper) CheckVotingAuthorized(ctx sdk.Context, veID uint64, addr sdk.AccAddress) error {
+	owner := k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+	if owner.Empty() {
+		return sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", types.VeIDFromUint64(veID))
+	}
+	if addr.Equals(owner) {
+		return nil
+	}
+
+	delegate, found := k.GetVotingDelegate(ctx, veID)
+	if !found || !addr.Equals(delegate) {
+		return sdkerrors.Wrapf(types.ErrNotVotingAuthorized, "user %s, ve %s", addr, types.VeIDFromUint64(veID))
+	}
+	locked := k.GetLockedAmountByUser(ctx, veID)
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
+		return sdkerrors.Wrapf(types.ErrNotVotingAuthorized, "voting delegation expired at %s", time.Unix(int64(locked.End), 0))
+	}
+	return nil
+}
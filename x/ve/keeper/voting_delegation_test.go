@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gridiron-zone/gridiron/app"
+	"github.com/gridiron-zone/gridiron/x/ve/keeper"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+	voterkeeper "github.com/gridiron-zone/gridiron/x/voter/keeper"
+	votertypes "github.com/gridiron-zone/gridiron/x/voter/types"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+)
+
+func (suite *KeeperTestSuite) TestVotingDelegation() {
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	voterImpl := voterkeeper.NewMsgServerImpl(suite.app.VoterKeeper)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "airon"
+	poolDenom := "pool"
+
+	lockAmt := sdk.NewCoin(denom, sdk.NewInt(1000*types.MaxLockTime))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt))
+	require.NoError(err)
+	res, err := impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       lockAmt,
+		LockDuration: types.RegulatedPeriod * 2,
+	})
+	require.NoError(err)
+	veID := types.Uint64FromVeID(res.VeId)
+	suite.app.VoterKeeper.CreateGauge(suite.ctx, poolDenom)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	delegate := sdk.AccAddress(priv.PubKey().Address())
+	priv, err = ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	other := sdk.AccAddress(priv.PubKey().Address())
+
+	vote := func(sender sdk.AccAddress) error {
+		_, err := voterImpl.Vote(ctx, &votertypes.MsgVote{
+			Sender:      sender.String(),
+			VeId:        res.VeId,
+			PoolWeights: []votertypes.PoolWeight{{PoolDenom: poolDenom, Weight: sdk.OneDec()}},
+		})
+		return err
+	}
+
+	// The delegate cannot vote before the delegation
+	require.Error(vote(delegate))
+
+	_, err = impl.DelegateVoting(ctx, &types.MsgDelegateVeVoting{
+		Sender:   other.String(),
+		VeId:     res.VeId,
+		Delegate: delegate.String(),
+	})
+	require.Error(err, "only the owner can delegate")
+	_, err = impl.DelegateVoting(ctx, &types.MsgDelegateVeVoting{
+		Sender:   sender.String(),
+		VeId:     res.VeId,
+		Delegate: delegate.String(),
+	})
+	require.NoError(err)
+
+	delegations, err := k.VotingDelegations(ctx, &types.QueryVotingDelegationsRequest{Delegate: delegate.String()})
+	require.NoError(err)
+	locked := k.GetLockedAmountByUser(suite.ctx, veID)
+	require.Equal([]types.VotingDelegation{{VeId: res.VeId, Delegate: delegate.String(), Expiry: locked.End}}, delegations.Delegations)
+
+	// The delegate votes, pokes and abstains for the ve, but others cannot
+	require.NoError(k.CheckVotingAuthorized(suite.ctx, veID, sender))
+	require.NoError(k.CheckVotingAuthorized(suite.ctx, veID, delegate))
+	require.ErrorIs(k.CheckVotingAuthorized(suite.ctx, veID, other), types.ErrNotVotingAuthorized)
+	require.Error(vote(other))
+	require.NoError(vote(delegate))
+	require.True(k.GetVeVoted(suite.ctx, veID))
+	require.True(suite.app.VoterKeeper.GetPoolWeightedVotesByUser(suite.ctx, veID, poolDenom).IsPositive())
+	_, err = voterImpl.Poke(ctx, &votertypes.MsgPoke{Sender: delegate.String(), VeId: res.VeId})
+	require.NoError(err)
+	_, err = voterImpl.ClaimBribes(ctx, &votertypes.MsgClaimBribes{Sender: delegate.String(), VeId: res.VeId})
+	require.NoError(err)
+	_, err = voterImpl.Abstain(ctx, &votertypes.MsgAbstain{Sender: other.String(), VeId: res.VeId})
+	require.Error(err)
+	_, err = voterImpl.Abstain(ctx, &votertypes.MsgAbstain{Sender: delegate.String(), VeId: res.VeId})
+	require.NoError(err)
+	require.False(k.GetVeVoted(suite.ctx, veID))
+
+	// The delegation expires at the unlocking time
+	expiredCtx := suite.ctx.WithBlockTime(time.Unix(int64(locked.End), 0))
+	require.ErrorIs(k.CheckVotingAuthorized(expiredCtx, veID, delegate), types.ErrNotVotingAuthorized)
+	delegations, err = k.VotingDelegations(sdk.WrapSDKContext(expiredCtx), &types.QueryVotingDelegationsRequest{Delegate: delegate.String()})
+	require.NoError(err)
+	require.Empty(delegations.Delegations)
+
+	// The delegation is revoked by the owner
+	_, err = impl.RevokeVoting(ctx, &types.MsgRevokeVeVoting{Sender: sender.String(), VeId: res.VeId})
+	require.NoError(err)
+	require.Error(vote(delegate))
+	_, err = impl.RevokeVoting(ctx, &types.MsgRevokeVeVoting{Sender: sender.String(), VeId: res.VeId})
+	require.Error(err)
+
+	// The delegation is not kept for the new owner
+	_, err = impl.DelegateVoting(ctx, &types.MsgDelegateVeVoting{
+		Sender:   sender.String(),
+		VeId:     res.VeId,
+		Delegate: delegate.String(),
+	})
+	require.NoError(err)
+	err = suite.app.NftKeeper.Transfer(suite.ctx, types.VeNftClass.Id, res.VeId, other)
+	require.NoError(err)
+	_, found := k.GetVotingDelegate(suite.ctx, veID)
+	require.False(found)
+	delegations, err = k.VotingDelegations(ctx, &types.QueryVotingDelegationsRequest{Delegate: delegate.String()})
+	require.NoError(err)
+	require.Empty(delegations.Delegations)
+}
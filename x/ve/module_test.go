@@ -0,0 +1,221 @@
+package ve_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/gridiron-zone/gridiron/app"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+const chainID = "gridiron_5000-101"
+
+// MsgServiceTestSuite delivers the ve messages in transactions through the
+// app, from the ante handler and the msg service router to the committed
+// state.
+type MsgServiceTestSuite struct {
+	suite.Suite
+	app *app.Gridiron
+
+	priv      *ethsecp256k1.PrivKey
+	sender    sdk.AccAddress
+	proposer  sdk.ConsAddress
+	blockTime time.Time
+}
+
+func TestMsgServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServiceTestSuite))
+}
+
+func (suite *MsgServiceTestSuite) SetupTest() {
+	require := suite.Require()
+
+	var err error
+	suite.priv, err = ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	suite.sender = sdk.AccAddress(suite.priv.PubKey().Address())
+
+	// the proposer is required by the EVM, where the veNFTs are mirrored
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	suite.proposer = sdk.ConsAddress(privCons.PubKey().Address())
+	suite.blockTime = time.Now().UTC()
+
+	suite.app = app.Setup(false)
+	ctx := suite.app.BaseApp.NewContext(false, suite.header(1))
+
+	valAddr := sdk.ValAddress(suite.sender)
+	validator, err := stakingtypes.NewValidator(valAddr, privCons.PubKey(), stakingtypes.Description{})
+	require.NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper.Keeper, ctx, validator, true)
+	suite.app.StakingKeeper.AfterValidatorCreated(ctx, validator.GetOperator())
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	require.NoError(err)
+
+	err = app.FundAccount(suite.app.BankKeeper, ctx, suite.sender, sdk.NewCoins(lockCoin(10000)))
+	require.NoError(err)
+	suite.app.Commit()
+}
+
+func (suite *MsgServiceTestSuite) header(height int64) tmproto.Header {
+	return tmproto.Header{
+		ChainID:         chainID,
+		Height:          height,
+		Time:            suite.blockTime,
+		ProposerAddress: suite.proposer.Bytes(),
+	}
+}
+
+// ctx returns the context of the committed state
+func (suite *MsgServiceTestSuite) ctx() sdk.Context {
+	return suite.app.BaseApp.NewUncachedContext(false, suite.header(suite.app.LastBlockHeight()))
+}
+
+// deliver delivers the message signed by the sender in a new block, and
+// unmarshals the response of the message into res
+func (suite *MsgServiceTestSuite) deliver(msg sdk.Msg, res proto.Message) {
+	require := suite.Require()
+
+	header := suite.header(suite.app.LastBlockHeight() + 1)
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.app.BaseApp.NewContext(false, header), suite.sender)
+	txConfig := suite.app.GetTxConfig()
+	tx, err := helpers.GenTx(txConfig, []sdk.Msg{msg}, sdk.Coins{}, helpers.DefaultGenTxGas*10, chainID,
+		[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, suite.priv)
+	require.NoError(err)
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(err)
+
+	resTx := suite.app.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+	require.True(resTx.IsOK(), resTx.Log)
+
+	suite.app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	suite.app.Commit()
+
+	var txMsgData sdk.TxMsgData
+	require.NoError(proto.Unmarshal(resTx.Data, &txMsgData))
+	require.Len(txMsgData.Data, 1)
+	require.Equal(sdk.MsgTypeURL(msg), txMsgData.Data[0].MsgType)
+	require.NoError(proto.Unmarshal(txMsgData.Data[0].Data, res))
+}
+
+// lockCoin returns the amount of whole tokens, since the voting power of
+// tiny locks is truncated to zero
+func lockCoin(amount int64) sdk.Coin {
+	return sdk.NewCoin(gridiron.BaseDenom, sdk.NewIntWithDecimal(amount, 18))
+}
+
+func (suite *MsgServiceTestSuite) create(amount int64, lockDuration uint64) string {
+	var res types.MsgCreateResponse
+	suite.deliver(&types.MsgCreate{
+		Sender:       suite.sender.String(),
+		To:           suite.sender.String(),
+		Amount:       lockCoin(amount),
+		LockDuration: lockDuration,
+	}, &res)
+	return res.VeId
+}
+
+func (suite *MsgServiceTestSuite) locked(veID string) types.LockedBalance {
+	return suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx(), types.Uint64FromVeID(veID))
+}
+
+func (suite *MsgServiceTestSuite) TestCreateDepositExtendTime() {
+	require := suite.Require()
+
+	veID := suite.create(1000, types.MaxLockTime/2)
+	require.Equal("ve-1", veID)
+	require.Equal(suite.sender, suite.app.NftKeeper.GetOwner(suite.ctx(), types.VeNftClass.Id, veID))
+	require.Equal(lockCoin(1000).Amount, suite.locked(veID).Amount)
+	require.True(suite.app.VeKeeper.GetVotingPower(suite.ctx(), 1, uint64(suite.blockTime.Unix()), 0).IsPositive())
+
+	suite.deliver(&types.MsgDeposit{Sender: suite.sender.String(), VeId: veID, Amount: lockCoin(500)}, &types.MsgDepositResponse{})
+	require.Equal(lockCoin(1500).Amount, suite.locked(veID).Amount)
+
+	end := suite.locked(veID).End
+	suite.deliver(&types.MsgExtendTime{Sender: suite.sender.String(), VeId: veID, LockDuration: types.MaxLockTime}, &types.MsgExtendTimeResponse{})
+	require.Greater(suite.locked(veID).End, end)
+}
+
+func (suite *MsgServiceTestSuite) TestMergeSplit() {
+	require := suite.Require()
+
+	veID1 := suite.create(1000, types.MaxLockTime)
+	veID2 := suite.create(2000, types.MaxLockTime)
+
+	suite.deliver(&types.MsgMerge{Sender: suite.sender.String(), FromVeId: veID2, ToVeId: veID1}, &types.MsgMergeResponse{})
+	require.False(suite.app.NftKeeper.HasNFT(suite.ctx(), types.VeNftClass.Id, veID2))
+	require.Equal(lockCoin(3000).Amount, suite.locked(veID1).Amount)
+
+	var res types.MsgSplitResponse
+	suite.deliver(&types.MsgSplit{Sender: suite.sender.String(), VeId: veID1, Amounts: []sdk.Int{lockCoin(1000).Amount}}, &res)
+	require.Len(res.VeIds, 1)
+	require.Equal(suite.sender, suite.app.NftKeeper.GetOwner(suite.ctx(), types.VeNftClass.Id, res.VeIds[0]))
+	require.Equal(lockCoin(2000).Amount, suite.locked(veID1).Amount)
+	require.Equal(lockCoin(1000).Amount, suite.locked(res.VeIds[0]).Amount)
+	require.Equal(suite.locked(veID1).End, suite.locked(res.VeIds[0]).End)
+}
+
+func (suite *MsgServiceTestSuite) TestLockUnlockPermanent() {
+	require := suite.Require()
+
+	veID := suite.create(1000, types.MaxLockTime/2)
+
+	suite.deliver(&types.MsgLockPermanent{Sender: suite.sender.String(), VeId: veID}, &types.MsgLockPermanentResponse{})
+	require.True(suite.locked(veID).Permanent)
+
+	suite.deliver(&types.MsgUnlockPermanent{Sender: suite.sender.String(), VeId: veID}, &types.MsgUnlockPermanentResponse{})
+	require.False(suite.locked(veID).Permanent)
+	require.NotZero(suite.locked(veID).End)
+}
+
+func (suite *MsgServiceTestSuite) TestDelegateRevokeVoting() {
+	require := suite.Require()
+
+	veID := suite.create(1000, types.MaxLockTime)
+	delegate := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	suite.deliver(&types.MsgDelegateVeVoting{Sender: suite.sender.String(), VeId: veID, Delegate: delegate.String()}, &types.MsgDelegateVeVotingResponse{})
+	got, found := suite.app.VeKeeper.GetVotingDelegate(suite.ctx(), types.Uint64FromVeID(veID))
+	require.True(found)
+	require.Equal(delegate, got)
+
+	suite.deliver(&types.MsgRevokeVeVoting{Sender: suite.sender.String(), VeId: veID}, &types.MsgRevokeVeVotingResponse{})
+	_, found = suite.app.VeKeeper.GetVotingDelegate(suite.ctx(), types.Uint64FromVeID(veID))
+	require.False(found)
+}
+
+func (suite *MsgServiceTestSuite) TestWithdrawEarlyWithdraw() {
+	require := suite.Require()
+
+	veID1 := suite.create(1000, types.MaxLockTime)
+	veID2 := suite.create(1000, types.RegulatedPeriod)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx(), suite.sender, gridiron.BaseDenom)
+
+	var res types.MsgEarlyWithdrawResponse
+	suite.deliver(&types.MsgEarlyWithdraw{Sender: suite.sender.String(), VeId: veID1}, &res)
+	require.False(suite.app.NftKeeper.HasNFT(suite.ctx(), types.VeNftClass.Id, veID1))
+	require.True(res.Penalty.IsPositive())
+	require.Equal(lockCoin(1000), res.Amount.Add(res.Penalty))
+	balance = balance.Add(res.Amount)
+	require.Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx(), suite.sender, gridiron.BaseDenom))
+
+	// the lock expires within a regulated period
+	suite.blockTime = suite.blockTime.Add(time.Duration(types.RegulatedPeriod) * time.Second)
+	suite.deliver(&types.MsgWithdraw{Sender: suite.sender.String(), VeId: veID2}, &types.MsgWithdrawResponse{})
+	require.False(suite.app.NftKeeper.HasNFT(suite.ctx(), types.VeNftClass.Id, veID2))
+	require.Equal(balance.Add(lockCoin(1000)), suite.app.BankKeeper.GetBalance(suite.ctx(), suite.sender, gridiron.BaseDenom))
+}
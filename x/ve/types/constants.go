@@ -23,6 +23,9 @@ const (
 
 	EmptyEpoch = 0
 	FirstEpoch = 1
+
+	// Maximum number of samples of voting power history query
+	MaxVotingPowerPoints = 500
 )
 
 var (
@@ -16,4 +16,9 @@ var (
 	ErrAmountNotPositive    = sdkerrors.Register(ModuleName, 9, "amount must be positive")
 	ErrSameVeID             = sdkerrors.Register(ModuleName, 10, "from ve id and to ve id must be different")
 	ErrVeAttached           = sdkerrors.Register(ModuleName, 11, "ve owner deposited into gauge or ve voted")
+	ErrNftContract          = sdkerrors.Register(ModuleName, 12, "veNFT contract error")
+	ErrInsufficientLocked   = sdkerrors.Register(ModuleName, 13, "insufficient locked amount")
+	ErrNotVotingAuthorized  = sdkerrors.Register(ModuleName, 14, "neither owner nor voting delegate of ve")
+	ErrPermanentLock        = sdkerrors.Register(ModuleName, 15, "ve permanently locked")
+	ErrNotPermanentLock     = sdkerrors.Register(ModuleName, 16, "ve not permanently locked")
 )
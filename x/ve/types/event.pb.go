@@ -280,23 +280,24 @@ func (m *EventMerge) GetToVeId() string {
 	return ""
 }
 
-type EventWithdraw struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+type EventSplit struct {
+	Sender   string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId     string   `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	NewVeIds []string `protobuf:"bytes,3,rep,name=new_ve_ids,json=newVeIds,proto3" json:"new_ve_ids,omitempty"`
 }
 
-func (m *EventWithdraw) Reset()         { *m = EventWithdraw{} }
-func (m *EventWithdraw) String() string { return proto.CompactTextString(m) }
-func (*EventWithdraw) ProtoMessage()    {}
-func (*EventWithdraw) Descriptor() ([]byte, []int) {
+func (m *EventSplit) Reset()         { *m = EventSplit{} }
+func (m *EventSplit) String() string { return proto.CompactTextString(m) }
+func (*EventSplit) ProtoMessage()    {}
+func (*EventSplit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4a4788112a5f5655, []int{4}
 }
-func (m *EventWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *EventSplit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSplit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -306,250 +307,536 @@ func (m *EventWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EventWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventWithdraw.Merge(m, src)
+func (m *EventSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSplit.Merge(m, src)
 }
-func (m *EventWithdraw) XXX_Size() int {
+func (m *EventSplit) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventWithdraw.DiscardUnknown(m)
+func (m *EventSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSplit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventWithdraw proto.InternalMessageInfo
+var xxx_messageInfo_EventSplit proto.InternalMessageInfo
 
-func (m *EventWithdraw) GetSender() string {
+func (m *EventSplit) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *EventWithdraw) GetVeId() string {
+func (m *EventSplit) GetVeId() string {
 	if m != nil {
 		return m.VeId
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventCreate)(nil), "gridiron.ve.v1.EventCreate")
-	proto.RegisterType((*EventDeposit)(nil), "gridiron.ve.v1.EventDeposit")
-	proto.RegisterType((*EventExtendTime)(nil), "gridiron.ve.v1.EventExtendTime")
-	proto.RegisterType((*EventMerge)(nil), "gridiron.ve.v1.EventMerge")
-	proto.RegisterType((*EventWithdraw)(nil), "gridiron.ve.v1.EventWithdraw")
+func (m *EventSplit) GetNewVeIds() []string {
+	if m != nil {
+		return m.NewVeIds
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("gridiron/ve/v1/event.proto", fileDescriptor_4a4788112a5f5655) }
-
-var fileDescriptor_4a4788112a5f5655 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0x13, 0x41,
-	0x10, 0xc6, 0xd3, 0x9b, 0xd9, 0x21, 0x56, 0x5c, 0x84, 0x51, 0x64, 0x36, 0xc8, 0x6c, 0x98, 0x53,
-	0x10, 0xec, 0x26, 0x7a, 0xf0, 0xe2, 0x69, 0xff, 0x1c, 0x3c, 0x78, 0x09, 0xa2, 0x20, 0xc2, 0x30,
-	0x7f, 0xca, 0xd9, 0xc6, 0x9d, 0xae, 0xd0, 0xd3, 0x69, 0x57, 0x9f, 0xc2, 0x47, 0xf1, 0x31, 0xf6,
-	0xb8, 0x47, 0x4f, 0x22, 0xc9, 0x8b, 0x48, 0xf7, 0x8c, 0xcb, 0xa2, 0x9b, 0x43, 0x6e, 0x5d, 0xf5,
-	0x15, 0xdf, 0xf7, 0x2b, 0xba, 0xe0, 0xb0, 0x41, 0x7d, 0x21, 0x49, 0x09, 0x8b, 0xc2, 0xce, 0x05,
-	0x5a, 0x54, 0x86, 0x2f, 0x35, 0x19, 0x8a, 0x0e, 0x7a, 0x89, 0x5b, 0xe4, 0x76, 0x3e, 0x79, 0x54,
-	0x53, 0x4d, 0x5e, 0x11, 0xee, 0xd5, 0x0d, 0x4d, 0x92, 0x92, 0xda, 0x86, 0x5a, 0x51, 0xe4, 0xad,
-	0x33, 0x28, 0xd0, 0xe4, 0x73, 0x51, 0x92, 0x54, 0x9d, 0x9e, 0xfe, 0x60, 0x30, 0x3e, 0x73, 0xa6,
-	0x27, 0x1a, 0x73, 0x83, 0xd1, 0x63, 0x08, 0x5b, 0x54, 0x15, 0xea, 0x98, 0x4d, 0xd9, 0xec, 0xde,
-	0xa2, 0xaf, 0xa2, 0x09, 0x8c, 0x34, 0x96, 0x28, 0x2d, 0xea, 0x78, 0xcf, 0x2b, 0x37, 0x75, 0xf4,
-	0x10, 0xf6, 0x2d, 0x66, 0xb2, 0x8a, 0x87, 0x5e, 0x08, 0x2c, 0xbe, 0xae, 0xa2, 0x97, 0x10, 0xe6,
-	0x0d, 0xad, 0x94, 0x89, 0x83, 0x29, 0x9b, 0x8d, 0x9f, 0x1f, 0xf2, 0x8e, 0x84, 0x3b, 0x12, 0xde,
-	0x93, 0xf0, 0x13, 0x92, 0xea, 0x38, 0xb8, 0xfa, 0x75, 0x34, 0x58, 0xf4, 0xe3, 0xd1, 0x11, 0x8c,
-	0x57, 0xea, 0x82, 0xca, 0xcf, 0x99, 0x91, 0x0d, 0xc6, 0xfb, 0x53, 0x36, 0x0b, 0x16, 0xd0, 0xb5,
-	0xde, 0xca, 0x06, 0x53, 0x03, 0xf7, 0x3d, 0xf1, 0x29, 0x2e, 0xa9, 0x95, 0x66, 0x2b, 0xf2, 0x0d,
-	0xd6, 0xde, 0x9d, 0x58, 0xc3, 0x9d, 0xb0, 0xd2, 0x0c, 0x1e, 0xf8, 0xd4, 0xb3, 0x4b, 0x83, 0xaa,
-	0x72, 0x20, 0xbb, 0x05, 0xff, 0xb3, 0xd6, 0xf0, 0xbf, 0xb5, 0x3e, 0x02, 0xf8, 0x80, 0x37, 0xa8,
-	0xeb, 0xed, 0xde, 0x4f, 0x00, 0x3e, 0x69, 0x6a, 0xb2, 0xdb, 0x01, 0x23, 0xd7, 0x79, 0xe7, 0x42,
-	0x62, 0x18, 0x19, 0xca, 0x6e, 0x7f, 0x46, 0x68, 0xc8, 0x29, 0xe9, 0x2b, 0x38, 0xf0, 0xee, 0xef,
-	0xa5, 0x39, 0xaf, 0x74, 0xfe, 0x65, 0x27, 0xf8, 0xe3, 0xd3, 0xab, 0x75, 0xc2, 0xae, 0xd7, 0x09,
-	0xfb, 0xbd, 0x4e, 0xd8, 0xf7, 0x4d, 0x32, 0xb8, 0xde, 0x24, 0x83, 0x9f, 0x9b, 0x64, 0xf0, 0xe1,
-	0x69, 0x2d, 0xcd, 0xf9, 0xaa, 0xe0, 0x25, 0x35, 0xa2, 0xbf, 0xc7, 0x67, 0xdf, 0x48, 0xe1, 0xdf,
-	0x42, 0x5c, 0xba, 0xcb, 0x35, 0x5f, 0x97, 0xd8, 0x16, 0xa1, 0x3f, 0xb9, 0x17, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x82, 0x01, 0x1d, 0xac, 0xd4, 0x02, 0x00, 0x00,
+type EventLockPermanent struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
 }
 
-func (m *EventCreate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventLockPermanent) Reset()         { *m = EventLockPermanent{} }
+func (m *EventLockPermanent) String() string { return proto.CompactTextString(m) }
+func (*EventLockPermanent) ProtoMessage()    {}
+func (*EventLockPermanent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a4788112a5f5655, []int{5}
 }
-
-func (m *EventCreate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventLockPermanent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockTime != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventLockPermanent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLockPermanent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventLockPermanent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLockPermanent.Merge(m, src)
+}
+func (m *EventLockPermanent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLockPermanent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLockPermanent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLockPermanent proto.InternalMessageInfo
+
+func (m *EventLockPermanent) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventLockPermanent) GetVeId() string {
+	if m != nil {
+		return m.VeId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventUnlockPermanent struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId       string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	UnlockTime uint64 `protobuf:"varint,3,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
 }
 
-func (m *EventDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventUnlockPermanent) Reset()         { *m = EventUnlockPermanent{} }
+func (m *EventUnlockPermanent) String() string { return proto.CompactTextString(m) }
+func (*EventUnlockPermanent) ProtoMessage()    {}
+func (*EventUnlockPermanent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a4788112a5f5655, []int{6}
+}
+func (m *EventUnlockPermanent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnlockPermanent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnlockPermanent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventUnlockPermanent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnlockPermanent.Merge(m, src)
+}
+func (m *EventUnlockPermanent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnlockPermanent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnlockPermanent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnlockPermanent proto.InternalMessageInfo
+
+func (m *EventUnlockPermanent) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventUnlockPermanent) GetVeId() string {
+	if m != nil {
+		return m.VeId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventExtendTime) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUnlockPermanent) GetUnlockTime() uint64 {
+	if m != nil {
+		return m.UnlockTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventExtendTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventDelegateVoting struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId     string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
 }
 
-func (m *EventExtendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockTime != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
-		i--
-		dAtA[i] = 0x18
+func (m *EventDelegateVoting) Reset()         { *m = EventDelegateVoting{} }
+func (m *EventDelegateVoting) String() string { return proto.CompactTextString(m) }
+func (*EventDelegateVoting) ProtoMessage()    {}
+func (*EventDelegateVoting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a4788112a5f5655, []int{7}
+}
+func (m *EventDelegateVoting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegateVoting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegateVoting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventDelegateVoting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegateVoting.Merge(m, src)
+}
+func (m *EventDelegateVoting) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegateVoting) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegateVoting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegateVoting proto.InternalMessageInfo
+
+func (m *EventDelegateVoting) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDelegateVoting) GetVeId() string {
+	if m != nil {
+		return m.VeId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMerge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDelegateVoting) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMerge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventRevokeVoting struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId     string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
 }
 
-func (m *EventMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToVeId) > 0 {
-		i -= len(m.ToVeId)
-		copy(dAtA[i:], m.ToVeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ToVeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FromVeId) > 0 {
-		i -= len(m.FromVeId)
-		copy(dAtA[i:], m.FromVeId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromVeId)))
+func (m *EventRevokeVoting) Reset()         { *m = EventRevokeVoting{} }
+func (m *EventRevokeVoting) String() string { return proto.CompactTextString(m) }
+func (*EventRevokeVoting) ProtoMessage()    {}
+func (*EventRevokeVoting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a4788112a5f5655, []int{8}
+}
+func (m *EventRevokeVoting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRevokeVoting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRevokeVoting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRevokeVoting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRevokeVoting.Merge(m, src)
+}
+func (m *EventRevokeVoting) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRevokeVoting) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRevokeVoting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRevokeVoting proto.InternalMessageInfo
+
+func (m *EventRevokeVoting) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventRevokeVoting) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *EventRevokeVoting) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+type EventWithdraw struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+}
+
+func (m *EventWithdraw) Reset()         { *m = EventWithdraw{} }
+func (m *EventWithdraw) String() string { return proto.CompactTextString(m) }
+func (*EventWithdraw) ProtoMessage()    {}
+func (*EventWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a4788112a5f5655, []int{9}
+}
+func (m *EventWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdraw.Merge(m, src)
+}
+func (m *EventWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdraw proto.InternalMessageInfo
+
+func (m *EventWithdraw) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventWithdraw) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+type EventEarlyWithdraw struct {
+	Sender  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	VeId    string     `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Penalty types.Coin `protobuf:"bytes,4,opt,name=penalty,proto3" json:"penalty"`
+}
+
+func (m *EventEarlyWithdraw) Reset()         { *m = EventEarlyWithdraw{} }
+func (m *EventEarlyWithdraw) String() string { return proto.CompactTextString(m) }
+func (*EventEarlyWithdraw) ProtoMessage()    {}
+func (*EventEarlyWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a4788112a5f5655, []int{10}
+}
+func (m *EventEarlyWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEarlyWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEarlyWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEarlyWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEarlyWithdraw.Merge(m, src)
+}
+func (m *EventEarlyWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEarlyWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEarlyWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEarlyWithdraw proto.InternalMessageInfo
+
+func (m *EventEarlyWithdraw) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventEarlyWithdraw) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *EventEarlyWithdraw) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventEarlyWithdraw) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
+	}
+	return types.Coin{}
+}
+
+type EventDeployNftContract struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *EventDeployNftContract) Reset()         { *m = EventDeployNftContract{} }
+func (m *EventDeployNftContract) String() string { return proto.CompactTextString(m) }
+func (*EventDeployNftContract) ProtoMessage()    {}
+func (*EventDeployNftContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a4788112a5f5655, []int{11}
+}
+func (m *EventDeployNftContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeployNftContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeployNftContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeployNftContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeployNftContract.Merge(m, src)
+}
+func (m *EventDeployNftContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeployNftContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeployNftContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeployNftContract proto.InternalMessageInfo
+
+func (m *EventDeployNftContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventCreate)(nil), "gridiron.ve.v1.EventCreate")
+	proto.RegisterType((*EventDeposit)(nil), "gridiron.ve.v1.EventDeposit")
+	proto.RegisterType((*EventExtendTime)(nil), "gridiron.ve.v1.EventExtendTime")
+	proto.RegisterType((*EventMerge)(nil), "gridiron.ve.v1.EventMerge")
+	proto.RegisterType((*EventSplit)(nil), "gridiron.ve.v1.EventSplit")
+	proto.RegisterType((*EventLockPermanent)(nil), "gridiron.ve.v1.EventLockPermanent")
+	proto.RegisterType((*EventUnlockPermanent)(nil), "gridiron.ve.v1.EventUnlockPermanent")
+	proto.RegisterType((*EventDelegateVoting)(nil), "gridiron.ve.v1.EventDelegateVoting")
+	proto.RegisterType((*EventRevokeVoting)(nil), "gridiron.ve.v1.EventRevokeVoting")
+	proto.RegisterType((*EventWithdraw)(nil), "gridiron.ve.v1.EventWithdraw")
+	proto.RegisterType((*EventEarlyWithdraw)(nil), "gridiron.ve.v1.EventEarlyWithdraw")
+	proto.RegisterType((*EventDeployNftContract)(nil), "gridiron.ve.v1.EventDeployNftContract")
+}
+
+func init() { proto.RegisterFile("gridiron/ve/v1/event.proto", fileDescriptor_4a4788112a5f5655) }
+
+var fileDescriptor_4a4788112a5f5655 = []byte{
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x26, 0x0d, 0x61, 0xc2, 0x1f, 0xe1, 0x56, 0x95, 0x89, 0x2a, 0x37, 0xf2, 0x29,
+	0x07, 0xb0, 0x15, 0x40, 0x42, 0x48, 0x5c, 0x68, 0x08, 0x12, 0x12, 0x20, 0x14, 0xa0, 0x95, 0x50,
+	0x45, 0xe4, 0xd8, 0x53, 0x77, 0x15, 0x7b, 0x27, 0x5a, 0x6f, 0x9c, 0x86, 0xa7, 0xe0, 0x51, 0xb8,
+	0xf0, 0x0e, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0x45, 0xd0, 0x6e, 0x9c, 0xa8, 0x02, 0x2a, 0x70,
+	0xd4, 0xdb, 0xce, 0xce, 0xf8, 0xf7, 0x7d, 0x3b, 0xeb, 0x1d, 0x68, 0x44, 0x82, 0x85, 0x4c, 0x10,
+	0xf7, 0x32, 0xf4, 0xb2, 0xb6, 0x87, 0x19, 0x72, 0xe9, 0x8e, 0x04, 0x49, 0x32, 0x6f, 0x2d, 0x73,
+	0x6e, 0x86, 0x6e, 0xd6, 0x6e, 0x6c, 0x47, 0x14, 0x91, 0x4e, 0x79, 0x6a, 0xb5, 0xa8, 0x6a, 0xd8,
+	0x01, 0xa5, 0x09, 0xa5, 0xde, 0xc0, 0x4f, 0x15, 0x61, 0x80, 0xd2, 0x6f, 0x7b, 0x01, 0x31, 0xbe,
+	0xc8, 0x3b, 0x5f, 0x0d, 0xa8, 0x77, 0x15, 0xb5, 0x23, 0xd0, 0x97, 0x68, 0xee, 0x40, 0x35, 0x45,
+	0x1e, 0xa2, 0xb0, 0x8c, 0xa6, 0xd1, 0xba, 0xde, 0xcb, 0x23, 0xb3, 0x01, 0x35, 0x81, 0x01, 0xb2,
+	0x0c, 0x85, 0xb5, 0xa1, 0x33, 0xab, 0xd8, 0xdc, 0x82, 0xcd, 0x0c, 0xfb, 0x2c, 0xb4, 0xca, 0x3a,
+	0x51, 0xc9, 0xf0, 0x65, 0x68, 0x3e, 0x86, 0xaa, 0x9f, 0xd0, 0x98, 0x4b, 0xab, 0xd2, 0x34, 0x5a,
+	0xf5, 0x07, 0x77, 0xdd, 0x85, 0x13, 0x57, 0x39, 0x71, 0x73, 0x27, 0x6e, 0x87, 0x18, 0xdf, 0xaf,
+	0x9c, 0xfd, 0xd8, 0x2b, 0xf5, 0xf2, 0x72, 0x73, 0x0f, 0xea, 0x63, 0x1e, 0x53, 0x30, 0xec, 0x4b,
+	0x96, 0xa0, 0xb5, 0xd9, 0x34, 0x5a, 0x95, 0x1e, 0x2c, 0xb6, 0xde, 0xb3, 0x04, 0x1d, 0x09, 0x37,
+	0xb4, 0xe3, 0xe7, 0x38, 0xa2, 0x94, 0xc9, 0x4b, 0x2d, 0xaf, 0x6c, 0x6d, 0xfc, 0xd5, 0x56, 0xb9,
+	0x90, 0x2d, 0xa7, 0x0f, 0xb7, 0xb5, 0x6a, 0xf7, 0x54, 0x22, 0x0f, 0x95, 0x91, 0x62, 0xc2, 0xbf,
+	0x1d, 0xab, 0xfc, 0xc7, 0xb1, 0x8e, 0x00, 0xb4, 0xc0, 0x6b, 0x14, 0xd1, 0xe5, 0xec, 0x5d, 0x80,
+	0x63, 0x41, 0x49, 0xff, 0xa2, 0x40, 0x4d, 0xed, 0x1c, 0x28, 0x11, 0x0b, 0x6a, 0x92, 0xfa, 0x17,
+	0x2f, 0xa3, 0x2a, 0x49, 0x65, 0x9c, 0xc3, 0x9c, 0xfe, 0x6e, 0x14, 0x17, 0x6d, 0xd9, 0x2e, 0x00,
+	0xc7, 0xc9, 0x82, 0x9a, 0x5a, 0xe5, 0x66, 0x59, 0x49, 0x72, 0x9c, 0x28, 0x6e, 0xea, 0x3c, 0x03,
+	0x53, 0x83, 0x5f, 0x51, 0x30, 0x7c, 0x8b, 0x22, 0xf1, 0x39, 0xf2, 0x62, 0x02, 0x4e, 0x08, 0xdb,
+	0x1a, 0xf1, 0x81, 0xc7, 0x6b, 0x43, 0xfe, 0xdd, 0xdf, 0x4f, 0xb0, 0x95, 0xff, 0x36, 0x31, 0x46,
+	0xbe, 0xc4, 0x03, 0x92, 0x8c, 0x47, 0xc5, 0x44, 0x1a, 0x50, 0x0b, 0xf3, 0xcf, 0xf3, 0xfe, 0xae,
+	0x62, 0xe7, 0x08, 0xee, 0x68, 0x7e, 0x0f, 0x33, 0x1a, 0x5e, 0x39, 0xfd, 0x29, 0xdc, 0xd4, 0xf4,
+	0x43, 0x26, 0x4f, 0x42, 0xe1, 0x4f, 0x8a, 0x75, 0xf8, 0x9b, 0x91, 0xdf, 0x52, 0xd7, 0x17, 0xf1,
+	0x74, 0x2d, 0xc6, 0xda, 0x2f, 0xc7, 0x7c, 0x02, 0xd7, 0x46, 0xc8, 0xfd, 0x58, 0x4e, 0xff, 0x77,
+	0x14, 0x2c, 0xeb, 0x9d, 0x47, 0xb0, 0xb3, 0x7c, 0xea, 0x31, 0x4d, 0xdf, 0x1c, 0xcb, 0x0e, 0x71,
+	0x29, 0xfc, 0x40, 0xaa, 0x5e, 0x05, 0xf9, 0x3a, 0x37, 0xbf, 0x8a, 0xf7, 0x5f, 0x9c, 0xcd, 0x6c,
+	0xe3, 0x7c, 0x66, 0x1b, 0x3f, 0x67, 0xb6, 0xf1, 0x65, 0x6e, 0x97, 0xce, 0xe7, 0x76, 0xe9, 0xfb,
+	0xdc, 0x2e, 0x7d, 0xbc, 0x17, 0x31, 0x79, 0x32, 0x1e, 0xb8, 0x01, 0x25, 0xde, 0x72, 0x7c, 0xde,
+	0xff, 0x4c, 0x1c, 0x57, 0x91, 0x77, 0xaa, 0x46, 0xad, 0x9c, 0x8e, 0x30, 0x1d, 0x54, 0xf5, 0x88,
+	0x7c, 0xf8, 0x6b, 0x00, 0x16, 0x85, 0xd3, 0x47, 0x86, 0x05, 0x00, 0x00,
+}
+
+func (m *EventCreate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -563,7 +850,7 @@ func (m *EventMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
+func (m *EventDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -573,16 +860,26 @@ func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventWithdraw) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.VeId) > 0 {
 		i -= len(m.VeId)
 		copy(dAtA[i:], m.VeId)
@@ -600,127 +897,1570 @@ func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventExtendTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+
+func (m *EventExtendTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExtendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.UnlockTime != 0 {
-		n += 1 + sovEvent(uint64(m.UnlockTime))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMerge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMerge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ToVeId) > 0 {
+		i -= len(m.ToVeId)
+		copy(dAtA[i:], m.ToVeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ToVeId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.FromVeId) > 0 {
+		i -= len(m.FromVeId)
+		copy(dAtA[i:], m.FromVeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromVeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventExtendTime) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NewVeIds) > 0 {
+		for iNdEx := len(m.NewVeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewVeIds[iNdEx])
+			copy(dAtA[i:], m.NewVeIds[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.NewVeIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UnlockTime != 0 {
-		n += 1 + sovEvent(uint64(m.UnlockTime))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMerge) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventLockPermanent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLockPermanent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLockPermanent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.FromVeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ToVeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnlockPermanent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventUnlockPermanent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnlockPermanent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegateVoting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegateVoting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegateVoting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventRevokeVoting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRevokeVoting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRevokeVoting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEarlyWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEarlyWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEarlyWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeployNftContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeployNftContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeployNftContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.UnlockTime != 0 {
+		n += 1 + sovEvent(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *EventDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventExtendTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovEvent(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *EventMerge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FromVeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ToVeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.NewVeIds) > 0 {
+		for _, s := range m.NewVeIds {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventLockPermanent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUnlockPermanent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovEvent(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *EventDelegateVoting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRevokeVoting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEarlyWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventDeployNftContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExtendTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMerge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMerge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewVeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewVeIds = append(m.NewVeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLockPermanent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLockPermanent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLockPermanent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventCreate) Unmarshal(dAtA []byte) error {
+func (m *EventUnlockPermanent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -743,10 +2483,10 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnlockPermanent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnlockPermanent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -783,7 +2523,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -811,11 +2551,80 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDelegateVoting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegateVoting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegateVoting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -843,13 +2652,13 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -859,30 +2668,29 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			m.UnlockTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -892,11 +2700,24 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnlockTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -918,7 +2739,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventRevokeVoting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -941,10 +2762,10 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRevokeVoting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRevokeVoting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1013,9 +2834,9 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1025,24 +2846,23 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1065,7 +2885,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1088,10 +2908,10 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExtendTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1158,25 +2978,6 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 			}
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
-			}
-			m.UnlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1198,7 +2999,7 @@ func (m *EventExtendTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMerge) Unmarshal(dAtA []byte) error {
+func (m *EventEarlyWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1221,10 +3022,10 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMerge: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEarlyWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEarlyWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1261,7 +3062,7 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1289,13 +3090,13 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1305,23 +3106,57 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1344,7 +3179,7 @@ func (m *EventMerge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventDeployNftContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1367,47 +3202,15 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDeployNftContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDeployNftContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1435,7 +3238,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6,12 +6,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	// Methods imported from account should be defined here
 }
 
@@ -35,10 +39,19 @@ type NftKeeper interface {
 	HasClass(ctx sdk.Context, classID string) bool
 	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
 	Burn(ctx sdk.Context, classID string, nftID string) error
+	Update(ctx sdk.Context, token nft.NFT) error
+	GetNFT(ctx sdk.Context, classID, nftID string) (nft.NFT, bool)
 	GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []nft.NFT)
+	GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []nft.NFT)
 	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
 	HasNFT(ctx sdk.Context, classID, id string) bool
 	NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.QueryNFTsResponse, error)
 	NFT(goCtx context.Context, r *nft.QueryNFTRequest) (*nft.QueryNFTResponse, error)
 	// Methods imported from nft should be defined here
 }
+
+// Erc20Keeper defines the expected interface needed to deploy and call EVM contracts.
+type Erc20Keeper interface {
+	DeployContract(ctx sdk.Context, from common.Address, contract evmtypes.CompiledContract, args ...interface{}) (common.Address, error)
+	CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+}
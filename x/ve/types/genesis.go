@@ -1,7 +1,10 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // DefaultIndex is the default capability global index
@@ -10,15 +13,109 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:                  DefaultParams(),
+		NextVeId:                FirstVeID,
+		TotalLockedAmount:       sdk.ZeroInt(),
+		TotalEmission:           sdk.ZeroInt(),
+		EmissionAtLastPeriod:    sdk.ZeroInt(),
+		DistributionTotalAmount: sdk.ZeroInt(),
+		LockedCheckpoints: CheckpointHistory{
+			Epoch: EmptyEpoch,
+			Points: []EpochCheckpoint{{
+				Epoch: EmptyEpoch,
+				Point: Checkpoint{
+					Bias:      sdk.ZeroInt(),
+					Slope:     sdk.ZeroInt(),
+					Permanent: sdk.ZeroInt(),
+				},
+			}},
+		},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextVeId < FirstVeID {
+		return fmt.Errorf("invalid next ve id: %d", gs.NextVeId)
+	}
+	if gs.TotalLockedAmount.IsNil() || gs.TotalLockedAmount.IsNegative() {
+		return fmt.Errorf("invalid total locked amount: %s", gs.TotalLockedAmount)
+	}
+	if err := gs.validateLockedAmounts(gs.LockedAmounts); err != nil {
+		return fmt.Errorf("invalid locked amounts: %w", err)
+	}
+	if err := gs.validateLockedAmounts(gs.DelegatedLockedAmounts); err != nil {
+		return fmt.Errorf("invalid delegated locked amounts: %w", err)
+	}
+
+	for _, record := range gs.OwnerHistory {
+		if record.VeId < FirstVeID || record.VeId >= gs.NextVeId {
+			return fmt.Errorf("invalid ve id of owner record: %d", record.VeId)
+		}
+		if record.Owner == "" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("invalid owner of ve %d: %w", record.VeId, err)
+		}
+	}
+
+	seenDelegations := make(map[uint64]bool)
+	for _, delegation := range gs.VotingDelegations {
+		veID := Uint64FromVeID(delegation.VeId)
+		if veID == EmptyVeID || veID >= gs.NextVeId {
+			return fmt.Errorf("invalid ve id of voting delegation: %s", delegation.VeId)
+		}
+		if seenDelegations[veID] {
+			return fmt.Errorf("duplicate voting delegation of ve %s", delegation.VeId)
+		}
+		seenDelegations[veID] = true
+		if _, err := sdk.AccAddressFromBech32(delegation.Delegate); err != nil {
+			return fmt.Errorf("invalid voting delegate of ve %s: %w", delegation.VeId, err)
+		}
+	}
+
+	for _, amount := range []sdk.Int{gs.TotalEmission, gs.EmissionAtLastPeriod, gs.DistributionTotalAmount} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("invalid emission or distribution amount: %s", amount)
+		}
+	}
+	for _, distribution := range gs.DistributionPerPeriod {
+		if distribution.Amount.IsNil() || distribution.Amount.IsNegative() {
+			return fmt.Errorf("invalid distribution amount of period %d: %s", distribution.Timestamp, distribution.Amount)
+		}
+	}
+	for _, claim := range gs.DistributionClaimLastTimestamps {
+		if claim.VeId < FirstVeID || claim.VeId >= gs.NextVeId {
+			return fmt.Errorf("invalid ve id of distribution claim: %d", claim.VeId)
+		}
+	}
 
-	return gs.Params.Validate()
+	if gs.NftContract != "" && !common.IsHexAddress(gs.NftContract) {
+		return fmt.Errorf("invalid veNFT contract address: %s", gs.NftContract)
+	}
+
+	return nil
+}
+
+func (gs GenesisState) validateLockedAmounts(lockedAmounts []VeLockedBalance) error {
+	seen := make(map[uint64]bool)
+	for _, locked := range lockedAmounts {
+		if locked.VeId < FirstVeID || locked.VeId >= gs.NextVeId {
+			return fmt.Errorf("invalid ve id: %d", locked.VeId)
+		}
+		if seen[locked.VeId] {
+			return fmt.Errorf("duplicate ve id: %d", locked.VeId)
+		}
+		seen[locked.VeId] = true
+		if locked.Locked.Amount.IsNil() || locked.Locked.Amount.IsNegative() {
+			return fmt.Errorf("invalid locked amount of ve %d: %s", locked.VeId, locked.Locked.Amount)
+		}
+	}
+	return nil
 }
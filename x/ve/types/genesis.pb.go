@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,7 +26,29 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ve module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params            Params                                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	NextVeId          uint64                                 `protobuf:"varint,2,opt,name=next_ve_id,json=nextVeId,proto3" json:"next_ve_id,omitempty" yaml:"next_ve_id"`
+	TotalLockedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_locked_amount,json=totalLockedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_locked_amount" yaml:"total_locked_amount"`
+	// locks of the existing ves
+	LockedAmounts []VeLockedBalance `protobuf:"bytes,4,rep,name=locked_amounts,json=lockedAmounts,proto3" json:"locked_amounts" yaml:"locked_amounts"`
+	// checkpoint history of the voting power of the locked amounts
+	LockedCheckpoints CheckpointHistory `protobuf:"bytes,5,opt,name=locked_checkpoints,json=lockedCheckpoints,proto3" json:"locked_checkpoints" yaml:"locked_checkpoints"`
+	// delegated parts of the locks, as last checkpointed
+	DelegatedLockedAmounts []VeLockedBalance `protobuf:"bytes,6,rep,name=delegated_locked_amounts,json=delegatedLockedAmounts,proto3" json:"delegated_locked_amounts" yaml:"delegated_locked_amounts"`
+	// checkpoint history of the voting power of the amounts delegated to
+	// validators
+	DelegatedCheckpoints CheckpointHistory  `protobuf:"bytes,7,opt,name=delegated_checkpoints,json=delegatedCheckpoints,proto3" json:"delegated_checkpoints" yaml:"delegated_checkpoints"`
+	OwnerHistory         []VeOwnerRecord    `protobuf:"bytes,8,rep,name=owner_history,json=ownerHistory,proto3" json:"owner_history" yaml:"owner_history"`
+	VotingDelegations    []VotingDelegation `protobuf:"bytes,9,rep,name=voting_delegations,json=votingDelegations,proto3" json:"voting_delegations" yaml:"voting_delegations"`
+	// address of the ERC-721 mirror contract of veNFTs, empty if not deployed
+	NftContract                      string                                 `protobuf:"bytes,10,opt,name=nft_contract,json=nftContract,proto3" json:"nft_contract,omitempty" yaml:"nft_contract"`
+	TotalEmission                    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_emission,json=totalEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_emission" yaml:"total_emission"`
+	EmissionAtLastPeriod             github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=emission_at_last_period,json=emissionAtLastPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"emission_at_last_period" yaml:"emission_at_last_period"`
+	EmissionLastTimestamp            uint64                                 `protobuf:"varint,13,opt,name=emission_last_timestamp,json=emissionLastTimestamp,proto3" json:"emission_last_timestamp,omitempty" yaml:"emission_last_timestamp"`
+	DistributionAccruedLastTimestamp uint64                                 `protobuf:"varint,14,opt,name=distribution_accrued_last_timestamp,json=distributionAccruedLastTimestamp,proto3" json:"distribution_accrued_last_timestamp,omitempty" yaml:"distribution_accrued_last_timestamp"`
+	DistributionTotalAmount          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=distribution_total_amount,json=distributionTotalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"distribution_total_amount" yaml:"distribution_total_amount"`
+	DistributionPerPeriod            []PeriodDistribution                   `protobuf:"bytes,16,rep,name=distribution_per_period,json=distributionPerPeriod,proto3" json:"distribution_per_period" yaml:"distribution_per_period"`
+	DistributionClaimLastTimestamps  []VeClaimTimestamp                     `protobuf:"bytes,17,rep,name=distribution_claim_last_timestamps,json=distributionClaimLastTimestamps,proto3" json:"distribution_claim_last_timestamps" yaml:"distribution_claim_last_timestamps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,22 +91,108 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-// Params defines the parameters for the module.
-type Params struct {
-	LockDenom string `protobuf:"bytes,1,opt,name=lock_denom,json=lockDenom,proto3" json:"lock_denom,omitempty"`
+func (m *GenesisState) GetNextVeId() uint64 {
+	if m != nil {
+		return m.NextVeId
+	}
+	return 0
 }
 
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
+func (m *GenesisState) GetLockedAmounts() []VeLockedBalance {
+	if m != nil {
+		return m.LockedAmounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockedCheckpoints() CheckpointHistory {
+	if m != nil {
+		return m.LockedCheckpoints
+	}
+	return CheckpointHistory{}
+}
+
+func (m *GenesisState) GetDelegatedLockedAmounts() []VeLockedBalance {
+	if m != nil {
+		return m.DelegatedLockedAmounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatedCheckpoints() CheckpointHistory {
+	if m != nil {
+		return m.DelegatedCheckpoints
+	}
+	return CheckpointHistory{}
+}
+
+func (m *GenesisState) GetOwnerHistory() []VeOwnerRecord {
+	if m != nil {
+		return m.OwnerHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotingDelegations() []VotingDelegation {
+	if m != nil {
+		return m.VotingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNftContract() string {
+	if m != nil {
+		return m.NftContract
+	}
+	return ""
+}
+
+func (m *GenesisState) GetEmissionLastTimestamp() uint64 {
+	if m != nil {
+		return m.EmissionLastTimestamp
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDistributionAccruedLastTimestamp() uint64 {
+	if m != nil {
+		return m.DistributionAccruedLastTimestamp
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDistributionPerPeriod() []PeriodDistribution {
+	if m != nil {
+		return m.DistributionPerPeriod
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionClaimLastTimestamps() []VeClaimTimestamp {
+	if m != nil {
+		return m.DistributionClaimLastTimestamps
+	}
+	return nil
+}
+
+// VeLockedBalance is the lock of a ve.
+type VeLockedBalance struct {
+	VeId   uint64        `protobuf:"varint,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	Locked LockedBalance `protobuf:"bytes,2,opt,name=locked,proto3" json:"locked"`
+}
+
+func (m *VeLockedBalance) Reset()         { *m = VeLockedBalance{} }
+func (m *VeLockedBalance) String() string { return proto.CompactTextString(m) }
+func (*VeLockedBalance) ProtoMessage()    {}
+func (*VeLockedBalance) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c0b8a7f3753a833a, []int{1}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *VeLockedBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VeLockedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VeLockedBalance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -93,155 +202,2475 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *VeLockedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeLockedBalance.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *VeLockedBalance) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *VeLockedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeLockedBalance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_VeLockedBalance proto.InternalMessageInfo
 
-func (m *Params) GetLockDenom() string {
+func (m *VeLockedBalance) GetVeId() uint64 {
 	if m != nil {
-		return m.LockDenom
+		return m.VeId
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "gridiron.ve.v1.GenesisState")
-	proto.RegisterType((*Params)(nil), "gridiron.ve.v1.Params")
+func (m *VeLockedBalance) GetLocked() LockedBalance {
+	if m != nil {
+		return m.Locked
+	}
+	return LockedBalance{}
 }
 
-func init() { proto.RegisterFile("gridiron/ve/v1/genesis.proto", fileDescriptor_c0b8a7f3753a833a) }
+// CheckpointHistory is a checkpoint history of voting power.
+type CheckpointHistory struct {
+	// latest epoch of the global checkpoints
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// global checkpoints by epoch
+	Points       []EpochCheckpoint       `protobuf:"bytes,2,rep,name=points,proto3" json:"points"`
+	UserPoints   []UserCheckpointHistory `protobuf:"bytes,3,rep,name=user_points,json=userPoints,proto3" json:"user_points" yaml:"user_points"`
+	SlopeChanges []SlopeChange           `protobuf:"bytes,4,rep,name=slope_changes,json=slopeChanges,proto3" json:"slope_changes" yaml:"slope_changes"`
+}
 
-var fileDescriptor_c0b8a7f3753a833a = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x4b, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x4a, 0xea, 0x95, 0xa5, 0xea, 0x95,
-	0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf4, 0x41, 0x2c, 0x88, 0x22, 0x25, 0x67,
-	0x2e, 0x1e, 0x77, 0x88, 0xae, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x63, 0x2e, 0xb6, 0x82, 0xc4,
-	0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x51, 0x3d, 0x14, 0x53, 0xf4,
-	0x02, 0xc0, 0x92, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x95, 0x2a, 0xe9, 0x72, 0xb1,
-	0x41, 0xc4, 0x85, 0x64, 0xb9, 0xb8, 0x72, 0xf2, 0x93, 0xb3, 0xe3, 0x53, 0x52, 0xf3, 0xf2, 0x73,
-	0xc1, 0x46, 0x70, 0x06, 0x71, 0x82, 0x44, 0x5c, 0x40, 0x02, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33,
-	0x38, 0xb9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x56, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x5e, 0xdd, 0xaa, 0xfc, 0xbc, 0x54,
-	0x18, 0x47, 0xbf, 0x02, 0xe4, 0xd3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x07, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x88, 0x5c, 0x2d, 0x65, 0x04, 0x01, 0x00, 0x00,
+func (m *CheckpointHistory) Reset()         { *m = CheckpointHistory{} }
+func (m *CheckpointHistory) String() string { return proto.CompactTextString(m) }
+func (*CheckpointHistory) ProtoMessage()    {}
+func (*CheckpointHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{2}
+}
+func (m *CheckpointHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointHistory.Merge(m, src)
+}
+func (m *CheckpointHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointHistory.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckpointHistory proto.InternalMessageInfo
+
+func (m *CheckpointHistory) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointHistory) GetPoints() []EpochCheckpoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *CheckpointHistory) GetUserPoints() []UserCheckpointHistory {
+	if m != nil {
+		return m.UserPoints
+	}
+	return nil
+}
+
+func (m *CheckpointHistory) GetSlopeChanges() []SlopeChange {
+	if m != nil {
+		return m.SlopeChanges
+	}
+	return nil
+}
+
+// EpochCheckpoint is a checkpoint at an epoch.
+type EpochCheckpoint struct {
+	Epoch uint64     `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Point Checkpoint `protobuf:"bytes,2,opt,name=point,proto3" json:"point"`
+}
+
+func (m *EpochCheckpoint) Reset()         { *m = EpochCheckpoint{} }
+func (m *EpochCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*EpochCheckpoint) ProtoMessage()    {}
+func (*EpochCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{3}
+}
+func (m *EpochCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EpochCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCheckpoint.Merge(m, src)
+}
+func (m *EpochCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCheckpoint.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EpochCheckpoint proto.InternalMessageInfo
+
+func (m *EpochCheckpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EpochCheckpoint) GetPoint() Checkpoint {
+	if m != nil {
+		return m.Point
+	}
+	return Checkpoint{}
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LockDenom) > 0 {
-		i -= len(m.LockDenom)
-		copy(dAtA[i:], m.LockDenom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockDenom)))
-		i--
-		dAtA[i] = 0xa
+// UserCheckpointHistory is the checkpoint history of a ve.
+type UserCheckpointHistory struct {
+	VeId uint64 `protobuf:"varint,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	// latest epoch of the checkpoints of the ve
+	Epoch  uint64            `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Points []EpochCheckpoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points"`
+}
+
+func (m *UserCheckpointHistory) Reset()         { *m = UserCheckpointHistory{} }
+func (m *UserCheckpointHistory) String() string { return proto.CompactTextString(m) }
+func (*UserCheckpointHistory) ProtoMessage()    {}
+func (*UserCheckpointHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{4}
+}
+func (m *UserCheckpointHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserCheckpointHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserCheckpointHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserCheckpointHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserCheckpointHistory.Merge(m, src)
+}
+func (m *UserCheckpointHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserCheckpointHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserCheckpointHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserCheckpointHistory proto.InternalMessageInfo
+
+func (m *UserCheckpointHistory) GetVeId() uint64 {
+	if m != nil {
+		return m.VeId
+	}
+	return 0
+}
+
+func (m *UserCheckpointHistory) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *UserCheckpointHistory) GetPoints() []EpochCheckpoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// VeOwnerRecord records the owner of a ve from a block on.
+type VeOwnerRecord struct {
+	VeId  uint64 `protobuf:"varint,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	Block int64  `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	// owner address, empty if the ve is burned
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *VeOwnerRecord) Reset()         { *m = VeOwnerRecord{} }
+func (m *VeOwnerRecord) String() string { return proto.CompactTextString(m) }
+func (*VeOwnerRecord) ProtoMessage()    {}
+func (*VeOwnerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{5}
+}
+func (m *VeOwnerRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeOwnerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeOwnerRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeOwnerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeOwnerRecord.Merge(m, src)
+}
+func (m *VeOwnerRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeOwnerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeOwnerRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeOwnerRecord proto.InternalMessageInfo
+
+func (m *VeOwnerRecord) GetVeId() uint64 {
+	if m != nil {
+		return m.VeId
+	}
+	return 0
+}
+
+func (m *VeOwnerRecord) GetBlock() int64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *VeOwnerRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// PeriodDistribution is the amount distributed to ves in the period starting
+// at the timestamp.
+type PeriodDistribution struct {
+	Timestamp uint64                                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *PeriodDistribution) Reset()         { *m = PeriodDistribution{} }
+func (m *PeriodDistribution) String() string { return proto.CompactTextString(m) }
+func (*PeriodDistribution) ProtoMessage()    {}
+func (*PeriodDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{6}
+}
+func (m *PeriodDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodDistribution.Merge(m, src)
+}
+func (m *PeriodDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodDistribution proto.InternalMessageInfo
+
+func (m *PeriodDistribution) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// VeClaimTimestamp is the time until which a ve has claimed its distribution.
+type VeClaimTimestamp struct {
+	VeId      uint64 `protobuf:"varint,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *VeClaimTimestamp) Reset()         { *m = VeClaimTimestamp{} }
+func (m *VeClaimTimestamp) String() string { return proto.CompactTextString(m) }
+func (*VeClaimTimestamp) ProtoMessage()    {}
+func (*VeClaimTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{7}
+}
+func (m *VeClaimTimestamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeClaimTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeClaimTimestamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeClaimTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeClaimTimestamp.Merge(m, src)
+}
+func (m *VeClaimTimestamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeClaimTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeClaimTimestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeClaimTimestamp proto.InternalMessageInfo
+
+func (m *VeClaimTimestamp) GetVeId() uint64 {
+	if m != nil {
+		return m.VeId
+	}
+	return 0
+}
+
+func (m *VeClaimTimestamp) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	LockDenom string `protobuf:"bytes,1,opt,name=lock_denom,json=lockDenom,proto3" json:"lock_denom,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{8}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetLockDenom() string {
+	if m != nil {
+		return m.LockDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gridiron.ve.v1.GenesisState")
+	proto.RegisterType((*VeLockedBalance)(nil), "gridiron.ve.v1.VeLockedBalance")
+	proto.RegisterType((*CheckpointHistory)(nil), "gridiron.ve.v1.CheckpointHistory")
+	proto.RegisterType((*EpochCheckpoint)(nil), "gridiron.ve.v1.EpochCheckpoint")
+	proto.RegisterType((*UserCheckpointHistory)(nil), "gridiron.ve.v1.UserCheckpointHistory")
+	proto.RegisterType((*VeOwnerRecord)(nil), "gridiron.ve.v1.VeOwnerRecord")
+	proto.RegisterType((*PeriodDistribution)(nil), "gridiron.ve.v1.PeriodDistribution")
+	proto.RegisterType((*VeClaimTimestamp)(nil), "gridiron.ve.v1.VeClaimTimestamp")
+	proto.RegisterType((*Params)(nil), "gridiron.ve.v1.Params")
+}
+
+func init() { proto.RegisterFile("gridiron/ve/v1/genesis.proto", fileDescriptor_c0b8a7f3753a833a) }
+
+var fileDescriptor_c0b8a7f3753a833a = []byte{
+	// 1124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x3a, 0x89, 0x69, 0x26, 0x76, 0x12, 0x4f, 0xe3, 0x66, 0x1b, 0x12, 0xaf, 0x3b, 0xd0,
+	0x12, 0x10, 0xb1, 0x95, 0x16, 0x71, 0x08, 0xe2, 0x90, 0x4d, 0xda, 0x52, 0x29, 0x12, 0xd1, 0xb4,
+	0x14, 0xa9, 0x07, 0x96, 0xf5, 0xee, 0xd4, 0x59, 0xc5, 0xbb, 0x63, 0xed, 0x8c, 0x4d, 0x53, 0x95,
+	0x4b, 0x2f, 0xdc, 0x10, 0x12, 0x17, 0x8e, 0xfd, 0x01, 0xfc, 0x90, 0x1e, 0x7b, 0x44, 0x1c, 0x2c,
+	0x94, 0xfc, 0x03, 0x1f, 0x38, 0xa3, 0xf9, 0xb0, 0xbd, 0xbb, 0xde, 0x88, 0xa4, 0x27, 0x7b, 0xde,
+	0x8f, 0xe7, 0x79, 0x3f, 0x76, 0xde, 0x77, 0xc0, 0x46, 0x3b, 0x0e, 0xfc, 0x20, 0xa6, 0x51, 0xb3,
+	0x4f, 0x9a, 0xfd, 0x9d, 0x66, 0x9b, 0x44, 0x84, 0x05, 0xac, 0xd1, 0x8d, 0x29, 0xa7, 0x70, 0x69,
+	0xa4, 0x6d, 0xf4, 0x49, 0xa3, 0xbf, 0xb3, 0xbe, 0xda, 0xa6, 0x6d, 0x2a, 0x55, 0x4d, 0xf1, 0x4f,
+	0x59, 0xad, 0xaf, 0x65, 0x30, 0xfa, 0x44, 0x29, 0xd0, 0xbf, 0x4b, 0xa0, 0xf4, 0x50, 0x01, 0x3e,
+	0xe6, 0x2e, 0x27, 0xf0, 0x0b, 0x50, 0xec, 0xba, 0xb1, 0x1b, 0x32, 0xd3, 0xa8, 0x1b, 0x5b, 0x8b,
+	0x77, 0x6f, 0x34, 0xd2, 0x04, 0x8d, 0x23, 0xa9, 0xb5, 0xe7, 0xde, 0x0e, 0xac, 0x19, 0xac, 0x6d,
+	0xe1, 0x3d, 0x00, 0x22, 0xf2, 0x82, 0x3b, 0x7d, 0xe2, 0x04, 0xbe, 0x59, 0xa8, 0x1b, 0x5b, 0x73,
+	0x76, 0x75, 0x38, 0xb0, 0x2a, 0xa7, 0x6e, 0xd8, 0xd9, 0x45, 0x13, 0x1d, 0xc2, 0xd7, 0xc4, 0xe1,
+	0x29, 0x79, 0xe4, 0xc3, 0x57, 0xe0, 0x3a, 0xa7, 0xdc, 0xed, 0x38, 0x1d, 0xea, 0x9d, 0x10, 0xdf,
+	0x71, 0x43, 0xda, 0x8b, 0xb8, 0x39, 0x5b, 0x37, 0xb6, 0x16, 0xec, 0x43, 0x81, 0xff, 0xf7, 0xc0,
+	0xba, 0xd3, 0x0e, 0xf8, 0x71, 0xaf, 0xd5, 0xf0, 0x68, 0xd8, 0xf4, 0x28, 0x0b, 0x29, 0xd3, 0x3f,
+	0xdb, 0xcc, 0x3f, 0x69, 0xf2, 0xd3, 0x2e, 0x61, 0x8d, 0x47, 0x11, 0x1f, 0x0e, 0xac, 0x75, 0xc5,
+	0x95, 0x03, 0x89, 0x70, 0x45, 0x4a, 0x0f, 0xa5, 0x70, 0x4f, 0xca, 0x20, 0x01, 0x4b, 0x29, 0x23,
+	0x66, 0xce, 0xd5, 0x67, 0xb7, 0x16, 0xef, 0x5a, 0xd9, 0x84, 0x9f, 0x12, 0xe5, 0x67, 0xbb, 0x1d,
+	0x37, 0xf2, 0x88, 0xbd, 0x29, 0x22, 0x1b, 0x0e, 0xac, 0xaa, 0xe2, 0x4b, 0x83, 0x20, 0x5c, 0xee,
+	0x24, 0x58, 0x18, 0x64, 0x00, 0x6a, 0x0b, 0xef, 0x98, 0x78, 0x27, 0x5d, 0x1a, 0x08, 0xaa, 0x79,
+	0x59, 0xdb, 0x5b, 0x59, 0xaa, 0xfd, 0xb1, 0xc9, 0x37, 0x01, 0xe3, 0x34, 0x3e, 0xb5, 0x6f, 0x69,
+	0xb2, 0x9b, 0x29, 0xb2, 0x04, 0x14, 0xc2, 0x15, 0x25, 0x9c, 0xf8, 0x32, 0xf8, 0xda, 0x00, 0xa6,
+	0x4f, 0x3a, 0xa4, 0xed, 0x72, 0xe2, 0x3b, 0x99, 0x34, 0x8b, 0x97, 0x4b, 0xf3, 0x13, 0xcd, 0x6c,
+	0x29, 0xe6, 0x8b, 0xe0, 0x10, 0xbe, 0x31, 0x56, 0x1d, 0xa6, 0x32, 0x7f, 0x05, 0xaa, 0x13, 0xa7,
+	0x64, 0xf2, 0x1f, 0x5c, 0x36, 0xf9, 0x8f, 0x75, 0x08, 0x1b, 0xd9, 0x10, 0x52, 0xf9, 0xaf, 0x8e,
+	0xe5, 0xc9, 0x12, 0xfc, 0x08, 0xca, 0xf4, 0xa7, 0x88, 0xc4, 0xce, 0xb1, 0x02, 0x33, 0xaf, 0xc9,
+	0xb4, 0x37, 0xa7, 0xd3, 0xfe, 0x56, 0x98, 0x61, 0xe2, 0xd1, 0xd8, 0xb7, 0x37, 0x34, 0xe3, 0xaa,
+	0x62, 0x4c, 0x21, 0x20, 0x5c, 0x92, 0x67, 0x1d, 0x1d, 0x8c, 0x01, 0xec, 0x53, 0x1e, 0x44, 0x6d,
+	0x47, 0x07, 0x10, 0xd0, 0x88, 0x99, 0x0b, 0x92, 0xa6, 0x3e, 0x45, 0x23, 0x2d, 0x0f, 0xc6, 0x86,
+	0xd9, 0xc6, 0x4e, 0x23, 0x21, 0x5c, 0xe9, 0x67, 0x9c, 0x18, 0xdc, 0x05, 0xa5, 0xe8, 0x39, 0x77,
+	0x3c, 0x1a, 0xf1, 0xd8, 0xf5, 0xb8, 0x09, 0xe4, 0x5d, 0x59, 0x1b, 0x0e, 0xac, 0xeb, 0xfa, 0xa6,
+	0x25, 0xb4, 0x08, 0x2f, 0x46, 0xcf, 0xf9, 0xbe, 0x3e, 0xc1, 0x08, 0x2c, 0xa9, 0xbb, 0x41, 0xc2,
+	0x80, 0xb1, 0x80, 0x46, 0xe6, 0xa2, 0xf4, 0x7e, 0x78, 0xe5, 0x9b, 0x56, 0x4d, 0xde, 0xb4, 0x11,
+	0x1a, 0xc2, 0x65, 0x29, 0xb8, 0xaf, 0xcf, 0xf0, 0x17, 0x03, 0xac, 0x8d, 0x94, 0x8e, 0xcb, 0x9d,
+	0x8e, 0xcb, 0xb8, 0xd3, 0x25, 0x71, 0x40, 0x7d, 0xb3, 0x24, 0x99, 0x8f, 0xae, 0xcc, 0x5c, 0x53,
+	0xcc, 0x17, 0xc0, 0x22, 0xbc, 0x3a, 0xd2, 0xec, 0xf1, 0x43, 0x97, 0xf1, 0x23, 0x29, 0x86, 0xcf,
+	0x12, 0x81, 0x48, 0x73, 0x1e, 0x84, 0x84, 0x71, 0x37, 0xec, 0x9a, 0x65, 0x39, 0xaa, 0x50, 0x0e,
+	0x74, 0xda, 0x10, 0xe1, 0xea, 0x48, 0x23, 0x80, 0x9f, 0x8c, 0xe4, 0xf0, 0x67, 0xf0, 0x91, 0x1f,
+	0x30, 0x1e, 0x07, 0xad, 0x1e, 0x97, 0x11, 0x79, 0x5e, 0xdc, 0x23, 0x7e, 0xc6, 0xdd, 0x5c, 0x92,
+	0x3c, 0x8d, 0xe1, 0xc0, 0xfa, 0x4c, 0x7f, 0xcc, 0xff, 0xef, 0x84, 0x70, 0x3d, 0x69, 0xb5, 0xa7,
+	0x8c, 0xd2, 0xf4, 0xbf, 0x1a, 0xe0, 0x66, 0x0a, 0x4a, 0x35, 0x45, 0x8f, 0xd2, 0x65, 0x59, 0x66,
+	0x7c, 0xe5, 0x32, 0xd7, 0x73, 0x62, 0x4c, 0x02, 0x23, 0xbc, 0x96, 0xd4, 0x3d, 0x11, 0x2a, 0x3d,
+	0x56, 0x5f, 0x1b, 0x20, 0xa5, 0x13, 0xad, 0x19, 0x75, 0x7d, 0x45, 0xde, 0x0d, 0x34, 0xb5, 0x51,
+	0xa4, 0xf6, 0x20, 0xe1, 0x64, 0xdf, 0xd1, 0xb7, 0xa3, 0x96, 0x13, 0xc8, 0x04, 0x10, 0xe1, 0x6a,
+	0x52, 0x73, 0x44, 0x62, 0xdd, 0xf0, 0x37, 0x06, 0x48, 0xfb, 0x78, 0x1d, 0x37, 0x08, 0x33, 0xe5,
+	0x65, 0x66, 0xe5, 0x82, 0xbb, 0x4a, 0xf6, 0x85, 0xf9, 0xb8, 0xc8, 0xf6, 0x8e, 0x8e, 0xe6, 0xd3,
+	0x9c, 0x68, 0x72, 0x91, 0x11, 0xb6, 0x92, 0x46, 0x12, 0x2e, 0xd5, 0x37, 0x86, 0x7a, 0x60, 0x39,
+	0x33, 0x71, 0xe1, 0x6d, 0x30, 0xaf, 0xf6, 0xa7, 0x21, 0x3f, 0x96, 0x95, 0xe1, 0xc0, 0x2a, 0xe9,
+	0xe9, 0xa0, 0x56, 0xe7, 0x5c, 0x5f, 0xac, 0xcd, 0xaf, 0x40, 0x51, 0x8d, 0x60, 0xb9, 0x67, 0x73,
+	0x46, 0x5a, 0x7a, 0x8e, 0xeb, 0x45, 0xad, 0x5c, 0xd0, 0x9f, 0x05, 0x50, 0x99, 0x1a, 0xb4, 0x70,
+	0x15, 0xcc, 0x93, 0x2e, 0xf5, 0x8e, 0x15, 0x33, 0x56, 0x07, 0xf8, 0x35, 0x28, 0xea, 0x89, 0x5d,
+	0xc8, 0x5f, 0x19, 0xf7, 0x85, 0xd9, 0x04, 0x6d, 0xfc, 0x26, 0x50, 0x13, 0xb8, 0x05, 0x16, 0x7b,
+	0x4c, 0xf4, 0x4a, 0x61, 0xcc, 0x4a, 0x8c, 0xdb, 0x59, 0x8c, 0xef, 0x18, 0x89, 0xa7, 0x27, 0xff,
+	0xba, 0xae, 0x38, 0x54, 0xf9, 0x27, 0x70, 0x10, 0x06, 0xe2, 0x74, 0xa4, 0x38, 0x7e, 0x00, 0x65,
+	0xd6, 0xa1, 0x5d, 0xe2, 0x78, 0xc7, 0x6e, 0xd4, 0x26, 0xa3, 0x1d, 0xfe, 0x61, 0x96, 0xe5, 0xb1,
+	0x30, 0xda, 0x97, 0x36, 0xd9, 0x19, 0x9f, 0xf2, 0x47, 0xb8, 0xc4, 0x26, 0xa6, 0x0c, 0x39, 0x60,
+	0x39, 0x93, 0xe4, 0x05, 0xb5, 0xfa, 0x12, 0xcc, 0x4b, 0xb5, 0xee, 0xc9, 0xfa, 0xc5, 0xcb, 0x4d,
+	0x57, 0x49, 0x99, 0xa3, 0xdf, 0x0d, 0x50, 0xcd, 0x2d, 0xc1, 0x65, 0xbf, 0x86, 0x71, 0x38, 0x85,
+	0xfc, 0xd6, 0xcd, 0xbe, 0x47, 0xeb, 0x50, 0x0b, 0x94, 0x53, 0x7b, 0xf1, 0x0a, 0xc1, 0xb4, 0xc4,
+	0x87, 0x26, 0x83, 0x99, 0xc5, 0xea, 0x20, 0xa4, 0x72, 0x71, 0xaa, 0x97, 0x1d, 0x56, 0x07, 0xf4,
+	0x12, 0xc0, 0xe9, 0x8b, 0x0f, 0x37, 0xc0, 0xc2, 0x64, 0x68, 0xaa, 0x0a, 0x4f, 0x04, 0xf0, 0x01,
+	0x28, 0xea, 0xc9, 0x56, 0x90, 0x93, 0xad, 0x71, 0xb5, 0xc9, 0x86, 0xb5, 0x37, 0xfa, 0x1e, 0xac,
+	0x64, 0x2f, 0xf9, 0x65, 0x53, 0x4c, 0x05, 0x58, 0xc8, 0x04, 0x88, 0xb6, 0x41, 0x51, 0xbd, 0x8f,
+	0xe1, 0x26, 0x00, 0x22, 0x79, 0xc7, 0x27, 0x11, 0x0d, 0x25, 0xe6, 0x02, 0x5e, 0x10, 0x92, 0x03,
+	0x21, 0xd8, 0x9d, 0xfb, 0xe3, 0x8d, 0x35, 0x63, 0x3f, 0x78, 0x7b, 0x56, 0x33, 0xde, 0x9d, 0xd5,
+	0x8c, 0x7f, 0xce, 0x6a, 0xc6, 0x6f, 0xe7, 0xb5, 0x99, 0x77, 0xe7, 0xb5, 0x99, 0xbf, 0xce, 0x6b,
+	0x33, 0xcf, 0x3e, 0x4f, 0x64, 0x34, 0x6a, 0xdd, 0xf6, 0x4b, 0x1a, 0x91, 0xf1, 0xa9, 0xf9, 0x42,
+	0xbc, 0xe5, 0x65, 0x6e, 0xad, 0xa2, 0x7c, 0xcc, 0xdf, 0xfb, 0x6f, 0x00, 0x00, 0x36, 0x5f, 0xf7,
+	0x2b, 0x0c, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributionClaimLastTimestamps) > 0 {
+		for iNdEx := len(m.DistributionClaimLastTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionClaimLastTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.DistributionPerPeriod) > 0 {
+		for iNdEx := len(m.DistributionPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size := m.DistributionTotalAmount.Size()
+		i -= size
+		if _, err := m.DistributionTotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.DistributionAccruedLastTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DistributionAccruedLastTimestamp))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.EmissionLastTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EmissionLastTimestamp))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.EmissionAtLastPeriod.Size()
+		i -= size
+		if _, err := m.EmissionAtLastPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.TotalEmission.Size()
+		i -= size
+		if _, err := m.TotalEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.NftContract) > 0 {
+		i -= len(m.NftContract)
+		copy(dAtA[i:], m.NftContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NftContract)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.VotingDelegations) > 0 {
+		for iNdEx := len(m.VotingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.OwnerHistory) > 0 {
+		for iNdEx := len(m.OwnerHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.DelegatedCheckpoints.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DelegatedLockedAmounts) > 0 {
+		for iNdEx := len(m.DelegatedLockedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedLockedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.LockedCheckpoints.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.LockedAmounts) > 0 {
+		for iNdEx := len(m.LockedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TotalLockedAmount.Size()
+		i -= size
+		if _, err := m.TotalLockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NextVeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextVeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VeLockedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeLockedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeLockedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.VeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlopeChanges) > 0 {
+		for iNdEx := len(m.SlopeChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlopeChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserPoints) > 0 {
+		for iNdEx := len(m.UserPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Point.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserCheckpointHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserCheckpointHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserCheckpointHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VeOwnerRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeOwnerRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeOwnerRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Block != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VeClaimTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeClaimTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeClaimTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockDenom) > 0 {
+		i -= len(m.LockDenom)
+		copy(dAtA[i:], m.LockDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.NextVeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextVeId))
+	}
+	l = m.TotalLockedAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LockedAmounts) > 0 {
+		for _, e := range m.LockedAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LockedCheckpoints.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegatedLockedAmounts) > 0 {
+		for _, e := range m.DelegatedLockedAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.DelegatedCheckpoints.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OwnerHistory) > 0 {
+		for _, e := range m.OwnerHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VotingDelegations) > 0 {
+		for _, e := range m.VotingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.NftContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TotalEmission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.EmissionAtLastPeriod.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EmissionLastTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.EmissionLastTimestamp))
+	}
+	if m.DistributionAccruedLastTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.DistributionAccruedLastTimestamp))
+	}
+	l = m.DistributionTotalAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DistributionPerPeriod) > 0 {
+		for _, e := range m.DistributionPerPeriod {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionClaimLastTimestamps) > 0 {
+		for _, e := range m.DistributionClaimLastTimestamps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VeLockedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VeId != 0 {
+		n += 1 + sovGenesis(uint64(m.VeId))
+	}
+	l = m.Locked.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CheckpointHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UserPoints) > 0 {
+		for _, e := range m.UserPoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlopeChanges) > 0 {
+		for _, e := range m.SlopeChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.Point.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *UserCheckpointHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VeId != 0 {
+		n += 1 + sovGenesis(uint64(m.VeId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VeOwnerRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VeId != 0 {
+		n += 1 + sovGenesis(uint64(m.VeId))
+	}
+	if m.Block != 0 {
+		n += 1 + sovGenesis(uint64(m.Block))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *VeClaimTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VeId != 0 {
+		n += 1 + sovGenesis(uint64(m.VeId))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVeId", wireType)
+			}
+			m.NextVeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedAmounts = append(m.LockedAmounts, VeLockedBalance{})
+			if err := m.LockedAmounts[len(m.LockedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedCheckpoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedLockedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedLockedAmounts = append(m.DelegatedLockedAmounts, VeLockedBalance{})
+			if err := m.DelegatedLockedAmounts[len(m.DelegatedLockedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedCheckpoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerHistory = append(m.OwnerHistory, VeOwnerRecord{})
+			if err := m.OwnerHistory[len(m.OwnerHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingDelegations = append(m.VotingDelegations, VotingDelegation{})
+			if err := m.VotingDelegations[len(m.VotingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionAtLastPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionAtLastPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionLastTimestamp", wireType)
+			}
+			m.EmissionLastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionLastTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionAccruedLastTimestamp", wireType)
+			}
+			m.DistributionAccruedLastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionAccruedLastTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionTotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionPerPeriod = append(m.DistributionPerPeriod, PeriodDistribution{})
+			if err := m.DistributionPerPeriod[len(m.DistributionPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaimLastTimestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionClaimLastTimestamps = append(m.DistributionClaimLastTimestamps, VeClaimTimestamp{})
+			if err := m.DistributionClaimLastTimestamps[len(m.DistributionClaimLastTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VeLockedBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeLockedBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeLockedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, EpochCheckpoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserPoints = append(m.UserPoints, UserCheckpointHistory{})
+			if err := m.UserPoints[len(m.UserPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlopeChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlopeChanges = append(m.SlopeChanges, SlopeChange{})
+			if err := m.SlopeChanges[len(m.SlopeChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Point", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Point.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserCheckpointHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserCheckpointHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserCheckpointHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, EpochCheckpoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VeOwnerRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeOwnerRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeOwnerRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LockDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *PeriodDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -264,17 +2693,36 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeriodDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeriodDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -284,22 +2732,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -324,6 +2773,94 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VeClaimTimestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeClaimTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeClaimTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
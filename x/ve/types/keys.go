@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
 
 const (
 	// ModuleName defines the module name
@@ -22,6 +27,13 @@ const (
 	DistributionPoolName = "ve_distribution_pool"
 )
 
+// ModuleAddress is the native module address for EVM
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
 const (
 	prefixTotalLockedAmount = iota + 1
 	prefixLockedAmountByUser
@@ -43,6 +55,23 @@ const (
 	prefixDistributionTotalAmount
 	prefixDistributionPerPeriod
 	prefixDistributionClaimLastTimestampByUser
+
+	prefixNftContract
+
+	prefixVotingDelegate
+	prefixVotingDelegationByDelegate
+
+	prefixDelegatedEpoch
+	prefixDelegatedPointHistoryByEpoch
+	prefixDelegatedUserEpoch
+	prefixDelegatedUserPointHistoryByUserEpoch
+	prefixDelegatedSlopeChange
+	prefixDelegatedLockedByUser
+
+	prefixOwnerHistory
+	prefixOwnedVe
+
+	prefixLockedAmountByUnlockTime
 )
 
 var (
@@ -66,8 +95,77 @@ var (
 	KeyPrefixDistributionTotalAmount              = []byte{prefixDistributionTotalAmount}
 	KeyPrefixDistributionPerPeriod                = []byte{prefixDistributionPerPeriod}
 	KeyPrefixDistributionClaimLastTimestampByUser = []byte{prefixDistributionClaimLastTimestampByUser}
+
+	KeyPrefixNftContract = []byte{prefixNftContract}
+
+	KeyPrefixVotingDelegate             = []byte{prefixVotingDelegate}
+	KeyPrefixVotingDelegationByDelegate = []byte{prefixVotingDelegationByDelegate}
+
+	KeyPrefixDelegatedEpoch                       = []byte{prefixDelegatedEpoch}
+	KeyPrefixDelegatedPointHistoryByEpoch         = []byte{prefixDelegatedPointHistoryByEpoch}
+	KeyPrefixDelegatedUserEpoch                   = []byte{prefixDelegatedUserEpoch}
+	KeyPrefixDelegatedUserPointHistoryByUserEpoch = []byte{prefixDelegatedUserPointHistoryByUserEpoch}
+	KeyPrefixDelegatedSlopeChange                 = []byte{prefixDelegatedSlopeChange}
+	KeyPrefixDelegatedLockedByUser                = []byte{prefixDelegatedLockedByUser}
+
+	KeyPrefixOwnerHistory = []byte{prefixOwnerHistory}
+	KeyPrefixOwnedVe      = []byte{prefixOwnedVe}
+
+	KeyPrefixLockedAmountByUnlockTime = []byte{prefixLockedAmountByUnlockTime}
 )
 
+// CheckpointKeys are the key prefixes of a checkpoint history of voting power.
+// The voting power of the locked amounts and that of the amounts delegated to
+// validators are kept in separate histories.
+type CheckpointKeys struct {
+	Epoch                       []byte
+	PointHistoryByEpoch         []byte
+	UserEpoch                   []byte
+	UserPointHistoryByUserEpoch []byte
+	SlopeChange                 []byte
+}
+
+var (
+	LockedCheckpointKeys = CheckpointKeys{
+		Epoch:                       KeyPrefixEpoch,
+		PointHistoryByEpoch:         KeyPrefixPointHistoryByEpoch,
+		UserEpoch:                   KeyPrefixUserEpoch,
+		UserPointHistoryByUserEpoch: KeyPrefixUserPointHistoryByUserEpoch,
+		SlopeChange:                 KeyPrefixSlopeChange,
+	}
+	DelegatedCheckpointKeys = CheckpointKeys{
+		Epoch:                       KeyPrefixDelegatedEpoch,
+		PointHistoryByEpoch:         KeyPrefixDelegatedPointHistoryByEpoch,
+		UserEpoch:                   KeyPrefixDelegatedUserEpoch,
+		UserPointHistoryByUserEpoch: KeyPrefixDelegatedUserPointHistoryByUserEpoch,
+		SlopeChange:                 KeyPrefixDelegatedSlopeChange,
+	}
+)
+
+func (ck CheckpointKeys) EpochKey() []byte {
+	return ck.Epoch
+}
+
+func (ck CheckpointKeys) PointKey(epoch uint64) []byte {
+	return append(append([]byte{}, ck.PointHistoryByEpoch...), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func (ck CheckpointKeys) UserEpochKey(veID uint64) []byte {
+	return append(append([]byte{}, ck.UserEpoch...), sdk.Uint64ToBigEndian(veID)...)
+}
+
+func (ck CheckpointKeys) UserPointsPrefix(veID uint64) []byte {
+	return append(append([]byte{}, ck.UserPointHistoryByUserEpoch...), sdk.Uint64ToBigEndian(veID)...)
+}
+
+func (ck CheckpointKeys) UserPointKey(veID uint64, userEpoch uint64) []byte {
+	return append(ck.UserPointsPrefix(veID), sdk.Uint64ToBigEndian(userEpoch)...)
+}
+
+func (ck CheckpointKeys) SlopeChangeKey(timestamp uint64) []byte {
+	return append(append([]byte{}, ck.SlopeChange...), sdk.Uint64ToBigEndian(timestamp)...)
+}
+
 func TotalLockedAmountKey() []byte {
 	return KeyPrefixTotalLockedAmount
 }
@@ -76,28 +174,32 @@ func LockedAmountByUserKey(veID uint64) []byte {
 	return append(KeyPrefixLockedAmountByUser, sdk.Uint64ToBigEndian(veID)...)
 }
 
+func LockedAmountByUnlockTimeKey(unlockTime uint64) []byte {
+	return append(KeyPrefixLockedAmountByUnlockTime, sdk.Uint64ToBigEndian(unlockTime)...)
+}
+
 func NextVeIDKey() []byte {
 	return KeyPrefixNextVeID
 }
 
 func EpochKey() []byte {
-	return KeyPrefixEpoch
+	return LockedCheckpointKeys.EpochKey()
 }
 
 func PointKey(epoch uint64) []byte {
-	return append(KeyPrefixPointHistoryByEpoch, sdk.Uint64ToBigEndian(epoch)...)
+	return LockedCheckpointKeys.PointKey(epoch)
 }
 
 func UserEpochKey(veID uint64) []byte {
-	return append(KeyPrefixUserEpoch, sdk.Uint64ToBigEndian(veID)...)
+	return LockedCheckpointKeys.UserEpochKey(veID)
 }
 
 func UserPointKey(veID uint64, userEpoch uint64) []byte {
-	return append(append(KeyPrefixUserPointHistoryByUserEpoch, sdk.Uint64ToBigEndian(veID)...), sdk.Uint64ToBigEndian(userEpoch)...)
+	return LockedCheckpointKeys.UserPointKey(veID, userEpoch)
 }
 
 func SlopeChangeKey(timestamp uint64) []byte {
-	return append(KeyPrefixSlopeChange, sdk.Uint64ToBigEndian(timestamp)...)
+	return LockedCheckpointKeys.SlopeChangeKey(timestamp)
 }
 
 func AttachedKey(veID uint64) []byte {
@@ -135,3 +237,39 @@ func DistributionPerPeriodKey(timestamp uint64) []byte {
 func DistributionClaimLastTimestampByUserKey(veID uint64) []byte {
 	return append(KeyPrefixDistributionClaimLastTimestampByUser, sdk.Uint64ToBigEndian(veID)...)
 }
+
+func NftContractKey() []byte {
+	return KeyPrefixNftContract
+}
+
+func VotingDelegateKey(veID uint64) []byte {
+	return append(KeyPrefixVotingDelegate, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func VotingDelegationsByDelegatePrefix(delegate sdk.AccAddress) []byte {
+	return append(KeyPrefixVotingDelegationByDelegate, address.MustLengthPrefix(delegate)...)
+}
+
+func VotingDelegationByDelegateKey(delegate sdk.AccAddress, veID uint64) []byte {
+	return append(VotingDelegationsByDelegatePrefix(delegate), sdk.Uint64ToBigEndian(veID)...)
+}
+
+func DelegatedLockedByUserKey(veID uint64) []byte {
+	return append(KeyPrefixDelegatedLockedByUser, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func OwnerHistoryPrefix(veID uint64) []byte {
+	return append(KeyPrefixOwnerHistory, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func OwnerHistoryKey(veID uint64, block int64) []byte {
+	return append(OwnerHistoryPrefix(veID), sdk.Uint64ToBigEndian(uint64(block))...)
+}
+
+func OwnedVesPrefix(owner sdk.AccAddress) []byte {
+	return append(KeyPrefixOwnedVe, address.MustLengthPrefix(owner)...)
+}
+
+func OwnedVeKey(owner sdk.AccAddress, veID uint64) []byte {
+	return append(OwnedVesPrefix(owner), sdk.Uint64ToBigEndian(veID)...)
+}
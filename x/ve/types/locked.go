@@ -8,3 +8,23 @@ func NewLockedBalance() LockedBalance {
 		End:    0,
 	}
 }
+
+// IsExpired returns whether the lock has reached its unlocking time,
+// which never happens to permanent lock
+func (l LockedBalance) IsExpired(now uint64) bool {
+	return !l.Permanent && l.End <= now
+}
+
+// EarlyWithdrawPenalty returns the penalty of withdrawing the unexpired lock
+// at now, which is proportional to the remaining locking time, up to the whole
+// locked amount for the maximum lock time
+func (l LockedBalance) EarlyWithdrawPenalty(now uint64) sdk.Int {
+	if l.IsExpired(now) {
+		return sdk.ZeroInt()
+	}
+	remaining := uint64(MaxLockTime)
+	if !l.Permanent && l.End-now < remaining {
+		remaining = l.End - now
+	}
+	return l.Amount.MulRaw(int64(remaining)).QuoRaw(MaxLockTime)
+}
@@ -12,3 +12,23 @@ func TestNewLockedBalance(t *testing.T) {
 	require.Equal(t, sdk.ZeroInt(), bal.Amount)
 	require.Equal(t, uint64(0), bal.End)
 }
+
+func TestLockedBalance_IsExpired(t *testing.T) {
+	bal := LockedBalance{Amount: sdk.NewInt(100), End: 1000}
+	require.False(t, bal.IsExpired(999))
+	require.True(t, bal.IsExpired(1000))
+	require.True(t, bal.IsExpired(1001))
+
+	bal = LockedBalance{Amount: sdk.NewInt(100), Permanent: true}
+	require.False(t, bal.IsExpired(1000))
+}
+
+func TestLockedBalance_EarlyWithdrawPenalty(t *testing.T) {
+	bal := LockedBalance{Amount: sdk.NewInt(1000), End: MaxLockTime + 100}
+	require.Equal(t, sdk.NewInt(1000), bal.EarlyWithdrawPenalty(100))
+	require.Equal(t, sdk.NewInt(500), bal.EarlyWithdrawPenalty(MaxLockTime/2+100))
+	require.Equal(t, sdk.ZeroInt(), bal.EarlyWithdrawPenalty(MaxLockTime+100))
+
+	bal = LockedBalance{Amount: sdk.NewInt(1000), Permanent: true}
+	require.Equal(t, sdk.NewInt(1000), bal.EarlyWithdrawPenalty(100))
+}
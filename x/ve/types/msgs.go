@@ -10,7 +10,14 @@ const (
 	TypeMsgDeposit    = "deposit"
 	TypeMsgExtendTime = "extend_time"
 	TypeMsgMerge      = "merge"
+	TypeMsgSplit      = "split"
 	TypeMsgWithdraw   = "withdraw"
+
+	TypeMsgLockPermanent    = "lock_permanent"
+	TypeMsgUnlockPermanent  = "unlock_permanent"
+	TypeMsgDelegateVeVoting = "delegate_ve_voting"
+	TypeMsgRevokeVeVoting   = "revoke_ve_voting"
+	TypeMsgEarlyWithdraw    = "early_withdraw"
 )
 
 var (
@@ -18,7 +25,13 @@ var (
 	_ sdk.Msg = &MsgDeposit{}
 	_ sdk.Msg = &MsgExtendTime{}
 	_ sdk.Msg = &MsgMerge{}
+	_ sdk.Msg = &MsgSplit{}
+	_ sdk.Msg = &MsgLockPermanent{}
+	_ sdk.Msg = &MsgUnlockPermanent{}
+	_ sdk.Msg = &MsgDelegateVeVoting{}
+	_ sdk.Msg = &MsgRevokeVeVoting{}
 	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgEarlyWithdraw{}
 )
 
 // Route implements sdk.Msg
@@ -176,6 +189,181 @@ func (m *MsgMerge) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// Route implements sdk.Msg
+func (m *MsgSplit) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgSplit) Type() string { return TypeMsgSplit }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSplit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSplit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	if len(m.Amounts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no amounts to split")
+	}
+	for _, amount := range m.Amounts {
+		if amount.IsNil() || !amount.IsPositive() {
+			return ErrAmountNotPositive
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSplit) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgLockPermanent) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgLockPermanent) Type() string { return TypeMsgLockPermanent }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgLockPermanent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgLockPermanent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgLockPermanent) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgUnlockPermanent) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgUnlockPermanent) Type() string { return TypeMsgUnlockPermanent }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgUnlockPermanent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUnlockPermanent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUnlockPermanent) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgDelegateVeVoting) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgDelegateVeVoting) Type() string { return TypeMsgDelegateVeVoting }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgDelegateVeVoting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDelegateVeVoting) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	_, err = sdk.AccAddressFromBech32(m.Delegate)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegate address (%s)", err)
+	}
+	if m.Delegate == m.Sender {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot delegate voting to the sender")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDelegateVeVoting) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgRevokeVeVoting) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgRevokeVeVoting) Type() string { return TypeMsgRevokeVeVoting }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgRevokeVeVoting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRevokeVeVoting) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRevokeVeVoting) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 // Route implements sdk.Msg
 func (m *MsgWithdraw) Route() string { return RouterKey }
 
@@ -207,3 +395,35 @@ func (m *MsgWithdraw) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgEarlyWithdraw) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgEarlyWithdraw) Type() string { return TypeMsgEarlyWithdraw }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgEarlyWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgEarlyWithdraw) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgEarlyWithdraw) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -284,6 +284,153 @@ func TestMsgMerge_GetSigners(t *testing.T) {
 	require.Equal(t, sender, signers[0])
 }
 
+func TestMsgSplit_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc    string
+		sender  string
+		veId    string
+		amounts []sdk.Int
+		valid   bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:   "xxx",
+		},
+		{
+			desc:   "no amounts",
+			sender: "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:   "ve-100",
+		},
+		{
+			desc:    "zero amount",
+			sender:  "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:    "ve-100",
+			amounts: []sdk.Int{sdk.NewInt(100), sdk.ZeroInt()},
+		},
+		{
+			desc:    "valid",
+			sender:  "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:    "ve-100",
+			amounts: []sdk.Int{sdk.NewInt(100), sdk.NewInt(200)},
+			valid:   true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgSplit{
+				Sender:  tc.sender,
+				VeId:    tc.veId,
+				Amounts: tc.amounts,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgLockPermanent_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			for _, msg := range []sdk.Msg{
+				&types.MsgLockPermanent{Sender: tc.sender, VeId: tc.veId},
+				&types.MsgUnlockPermanent{Sender: tc.sender, VeId: tc.veId},
+			} {
+				err := msg.ValidateBasic()
+				if tc.valid {
+					require.NoError(t, err)
+				} else {
+					require.Error(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestMsgDelegateVeVoting_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc     string
+		sender   string
+		veId     string
+		delegate string
+		valid    bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:   "xxx",
+		},
+		{
+			desc:     "invalid delegate address",
+			sender:   "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:     "ve-100",
+			delegate: "xxx",
+		},
+		{
+			desc:     "delegate is sender",
+			sender:   "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:     "ve-100",
+			delegate: "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+		},
+		{
+			desc:     "valid",
+			sender:   "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:     "ve-100",
+			delegate: sdk.AccAddress([]byte("delegate")).String(),
+			valid:    true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgDelegateVeVoting{
+				Sender:   tc.sender,
+				VeId:     tc.veId,
+				Delegate: tc.delegate,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgWithdraw_ValidateBasic(t *testing.T) {
 	app.Setup(false)
 	for _, tc := range []struct {
@@ -334,3 +481,42 @@ func TestMsgWithdraw_GetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, sender, signers[0])
 }
+
+func TestMsgEarlyWithdraw_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgEarlyWithdraw{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"strconv"
 	"strings"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -37,17 +36,36 @@ func Uint64FromVeID(veID string) uint64 {
 	return id
 }
 
-func VeNftUri(nftID string, balance sdk.Int, lockedEnd uint64, value sdk.Int) string {
-	output := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" preserveAspectRatio="xMinYMin meet" viewBox="0 0 350 350"><style>.base { fill: white; font-family: serif; font-size: 14px; }</style><rect width="100%%" height="100%%" fill="black" /><text x="10" y="20" class="base">token %s</text><text x="10" y="40" class="base">balanceOf %s</text><text x="10" y="60" class="base">locked_end %d</text><text x="10" y="80" class="base">value %s</text></svg>`, nftID, balance, lockedEnd, value)
+// VeNftUri renders the data URI of the JSON metadata of ve NFT, along with
+// the SVG image, which is deterministic for the same ve NFT data
+func VeNftUri(nftID string, metadata VeNftMetadata) string {
+	lockedEnd := strconv.FormatUint(metadata.UnlockTime, 10)
+	if metadata.Permanent {
+		lockedEnd = "permanent"
+	}
+	output := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" preserveAspectRatio="xMinYMin meet" viewBox="0 0 350 350"><style>.base { fill: white; font-family: serif; font-size: 14px; }</style><rect width="100%%" height="100%%" fill="black" /><text x="10" y="20" class="base">token %s</text><text x="10" y="40" class="base">balanceOf %s</text><text x="10" y="60" class="base">locked_end %s</text><text x="10" y="80" class="base">value %s</text><text x="10" y="100" class="base">attached %t</text><text x="10" y="120" class="base">voted %t</text></svg>`,
+		nftID, metadata.VotingPower, lockedEnd, metadata.Amount, metadata.Attached, metadata.Voted)
 
+	type attribute struct {
+		TraitType string `json:"trait_type"`
+		Value     string `json:"value"`
+	}
 	var uri struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
-		Image       string `json:"image"`
+		Name        string      `json:"name"`
+		Description string      `json:"description"`
+		Image       string      `json:"image"`
+		Attributes  []attribute `json:"attributes"`
 	}
 	uri.Name = fmt.Sprintf("lock #%s", nftID)
 	uri.Description = VeNftClass.Description
 	uri.Image = fmt.Sprintf("data:image/svg+xml;base64,%s", base64.URLEncoding.EncodeToString([]byte(output)))
+	uri.Attributes = []attribute{
+		{"amount", metadata.Amount.String()},
+		{"locked_end", lockedEnd},
+		{"voting_power", metadata.VotingPower.String()},
+		{"attached", strconv.FormatBool(metadata.Attached)},
+		{"voted", strconv.FormatBool(metadata.Voted)},
+	}
 
 	uriStr, err := json.Marshal(&uri)
 	if err != nil {
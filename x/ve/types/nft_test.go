@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,12 +31,51 @@ func TestUint64FromVeID(t *testing.T) {
 }
 
 func TestVeNftUri(t *testing.T) {
-	var (
-		nftID     = "10000"
-		balance   = sdk.NewInt(10000)
-		lockedEnd = uint64(10000)
-		value     = sdk.NewInt(10000)
-		uri       = VeNftUri(nftID, balance, lockedEnd, value)
-	)
-	require.Equal(t, "data:application/json;base64,eyJuYW1lIjoibG9jayAjMTAwMDAiLCJkZXNjcmlwdGlvbiI6Ik1lcmxpb24gbG9ja3MsIGNhbiBiZSB1c2VkIHRvIGJvb3N0IGdhdWdlIHlpZWxkcywgdm90ZSBvbiB0b2tlbiBlbWlzc2lvbiwgYW5kIHJlY2VpdmUgYnJpYmVzIiwiaW1hZ2UiOiJkYXRhOmltYWdlL3N2Zyt4bWw7YmFzZTY0LFBITjJaeUI0Yld4dWN6MGlhSFIwY0RvdkwzZDNkeTUzTXk1dmNtY3ZNakF3TUM5emRtY2lJSEJ5WlhObGNuWmxRWE53WldOMFVtRjBhVzg5SW5oTmFXNVpUV2x1SUcxbFpYUWlJSFpwWlhkQ2IzZzlJakFnTUNBek5UQWdNelV3SWo0OGMzUjViR1UtTG1KaGMyVWdleUJtYVd4c09pQjNhR2wwWlRzZ1ptOXVkQzFtWVcxcGJIazZJSE5sY21sbU95Qm1iMjUwTFhOcGVtVTZJREUwY0hnN0lIMDhMM04wZVd4bFBqeHlaV04wSUhkcFpIUm9QU0l4TURBbElpQm9aV2xuYUhROUlqRXdNQ1VpSUdacGJHdzlJbUpzWVdOcklpQXZQangwWlhoMElIZzlJakV3SWlCNVBTSXlNQ0lnWTJ4aGMzTTlJbUpoYzJVaVBuUnZhMlZ1SURFd01EQXdQQzkwWlhoMFBqeDBaWGgwSUhnOUlqRXdJaUI1UFNJME1DSWdZMnhoYzNNOUltSmhjMlVpUG1KaGJHRnVZMlZQWmlBeE1EQXdNRHd2ZEdWNGRENDhkR1Y0ZENCNFBTSXhNQ0lnZVQwaU5qQWlJR05zWVhOelBTSmlZWE5sSWo1c2IyTnJaV1JmWlc1a0lERXdNREF3UEM5MFpYaDBQangwWlhoMElIZzlJakV3SWlCNVBTSTRNQ0lnWTJ4aGMzTTlJbUpoYzJVaVBuWmhiSFZsSURFd01EQXdQQzkwWlhoMFBqd3ZjM1puUGc9PSJ9", uri)
+	metadata := VeNftMetadata{
+		Amount:      sdk.NewInt(10000),
+		UnlockTime:  10000,
+		VotingPower: sdk.NewInt(5000),
+		Attached:    true,
+	}
+	uri := VeNftUri("10000", metadata)
+	require.Equal(t, uri, VeNftUri("10000", metadata))
+
+	prefix := "data:application/json;base64,"
+	require.True(t, strings.HasPrefix(uri, prefix))
+	bz, err := base64.URLEncoding.DecodeString(strings.TrimPrefix(uri, prefix))
+	require.NoError(t, err)
+
+	var decoded struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Image       string `json:"image"`
+		Attributes  []struct {
+			TraitType string `json:"trait_type"`
+			Value     string `json:"value"`
+		} `json:"attributes"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, "lock #10000", decoded.Name)
+	require.Equal(t, VeNftClass.Description, decoded.Description)
+	require.Len(t, decoded.Attributes, 5)
+	require.Equal(t, "amount", decoded.Attributes[0].TraitType)
+	require.Equal(t, "10000", decoded.Attributes[0].Value)
+	require.Equal(t, "10000", decoded.Attributes[1].Value)
+	require.Equal(t, "5000", decoded.Attributes[2].Value)
+	require.Equal(t, "true", decoded.Attributes[3].Value)
+	require.Equal(t, "false", decoded.Attributes[4].Value)
+
+	imagePrefix := "data:image/svg+xml;base64,"
+	require.True(t, strings.HasPrefix(decoded.Image, imagePrefix))
+	svg, err := base64.URLEncoding.DecodeString(strings.TrimPrefix(decoded.Image, imagePrefix))
+	require.NoError(t, err)
+	require.Contains(t, string(svg), "balanceOf 5000")
+	require.Contains(t, string(svg), "locked_end 10000")
+
+	metadata.Permanent = true
+	uri = VeNftUri("10000", metadata)
+	bz, err = base64.URLEncoding.DecodeString(strings.TrimPrefix(uri, prefix))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, "permanent", decoded.Attributes[1].Value)
 }
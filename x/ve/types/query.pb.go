@@ -218,6 +218,430 @@ func (m *QueryVotingPowerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryVotingPowerResponse proto.InternalMessageInfo
 
+// QueryVotingPowerHistoryRequest is the request type for the
+// Query/VotingPowerHistory RPC method
+type QueryVotingPowerHistoryRequest struct {
+	// ve_id is the veNFT, empty for the total voting power
+	VeId string `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	// start_time is the unix time of the first sample
+	StartTime uint64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the unix time of the last sample
+	EndTime uint64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// points is the number of samples
+	Points uint32 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (m *QueryVotingPowerHistoryRequest) Reset()         { *m = QueryVotingPowerHistoryRequest{} }
+func (m *QueryVotingPowerHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerHistoryRequest) ProtoMessage()    {}
+func (*QueryVotingPowerHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{4}
+}
+func (m *QueryVotingPowerHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerHistoryRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryVotingPowerHistoryRequest) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *QueryVotingPowerHistoryRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryVotingPowerHistoryRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryVotingPowerHistoryRequest) GetPoints() uint32 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+// VotingPowerPoint is a sample of voting power.
+type VotingPowerPoint struct {
+	Timestamp uint64                                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Power     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=power,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"power"`
+}
+
+func (m *VotingPowerPoint) Reset()         { *m = VotingPowerPoint{} }
+func (m *VotingPowerPoint) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerPoint) ProtoMessage()    {}
+func (*VotingPowerPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{5}
+}
+func (m *VotingPowerPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerPoint.Merge(m, src)
+}
+func (m *VotingPowerPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerPoint proto.InternalMessageInfo
+
+func (m *VotingPowerPoint) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// QueryVotingPowerHistoryResponse is the response type for the
+// Query/VotingPowerHistory RPC method
+type QueryVotingPowerHistoryResponse struct {
+	Points []VotingPowerPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *QueryVotingPowerHistoryResponse) Reset()         { *m = QueryVotingPowerHistoryResponse{} }
+func (m *QueryVotingPowerHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerHistoryResponse) ProtoMessage()    {}
+func (*QueryVotingPowerHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{6}
+}
+func (m *QueryVotingPowerHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerHistoryResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowerHistoryResponse) GetPoints() []VotingPowerPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// QuerySlopeChangesRequest is the request type for the Query/SlopeChanges RPC
+// method
+type QuerySlopeChangesRequest struct {
+	// start_time is the inclusive unix time from which, now if zero
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the inclusive unix time until which, the maximum lock time
+	// later than the start time if zero
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *QuerySlopeChangesRequest) Reset()         { *m = QuerySlopeChangesRequest{} }
+func (m *QuerySlopeChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlopeChangesRequest) ProtoMessage()    {}
+func (*QuerySlopeChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{7}
+}
+func (m *QuerySlopeChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlopeChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlopeChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlopeChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlopeChangesRequest.Merge(m, src)
+}
+func (m *QuerySlopeChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlopeChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlopeChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlopeChangesRequest proto.InternalMessageInfo
+
+func (m *QuerySlopeChangesRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QuerySlopeChangesRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// QuerySlopeChangesResponse is the response type for the Query/SlopeChanges
+// RPC method
+type QuerySlopeChangesResponse struct {
+	SlopeChanges []SlopeChange `protobuf:"bytes,1,rep,name=slope_changes,json=slopeChanges,proto3" json:"slope_changes"`
+}
+
+func (m *QuerySlopeChangesResponse) Reset()         { *m = QuerySlopeChangesResponse{} }
+func (m *QuerySlopeChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlopeChangesResponse) ProtoMessage()    {}
+func (*QuerySlopeChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{8}
+}
+func (m *QuerySlopeChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlopeChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlopeChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlopeChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlopeChangesResponse.Merge(m, src)
+}
+func (m *QuerySlopeChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlopeChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlopeChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlopeChangesResponse proto.InternalMessageInfo
+
+func (m *QuerySlopeChangesResponse) GetSlopeChanges() []SlopeChange {
+	if m != nil {
+		return m.SlopeChanges
+	}
+	return nil
+}
+
+// QueryLockedDistributionRequest is the request type for the
+// Query/LockedDistribution RPC method
+type QueryLockedDistributionRequest struct {
+	// start_time is the inclusive unix time from which, now if zero, and the
+	// expired locks are never included
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the inclusive unix time until which, the maximum lock time
+	// later than the start time if zero
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *QueryLockedDistributionRequest) Reset()         { *m = QueryLockedDistributionRequest{} }
+func (m *QueryLockedDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedDistributionRequest) ProtoMessage()    {}
+func (*QueryLockedDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{9}
+}
+func (m *QueryLockedDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedDistributionRequest.Merge(m, src)
+}
+func (m *QueryLockedDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryLockedDistributionRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryLockedDistributionRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// LockedAmountByTime is the total locked amount of the ves unlocking at the
+// same time.
+type LockedAmountByTime struct {
+	UnlockTime uint64                                 `protobuf:"varint,1,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *LockedAmountByTime) Reset()         { *m = LockedAmountByTime{} }
+func (m *LockedAmountByTime) String() string { return proto.CompactTextString(m) }
+func (*LockedAmountByTime) ProtoMessage()    {}
+func (*LockedAmountByTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{10}
+}
+func (m *LockedAmountByTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedAmountByTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedAmountByTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedAmountByTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedAmountByTime.Merge(m, src)
+}
+func (m *LockedAmountByTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedAmountByTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedAmountByTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedAmountByTime proto.InternalMessageInfo
+
+func (m *LockedAmountByTime) GetUnlockTime() uint64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+// QueryLockedDistributionResponse is the response type for the
+// Query/LockedDistribution RPC method
+type QueryLockedDistributionResponse struct {
+	// amounts are ordered by the unlocking time, excluding the expired but not
+	// withdrawn ones
+	Amounts []LockedAmountByTime `protobuf:"bytes,1,rep,name=amounts,proto3" json:"amounts"`
+	// permanent is the total locked amount of the permanent locks
+	Permanent github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=permanent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"permanent"`
+}
+
+func (m *QueryLockedDistributionResponse) Reset()         { *m = QueryLockedDistributionResponse{} }
+func (m *QueryLockedDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedDistributionResponse) ProtoMessage()    {}
+func (*QueryLockedDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{11}
+}
+func (m *QueryLockedDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedDistributionResponse.Merge(m, src)
+}
+func (m *QueryLockedDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryLockedDistributionResponse) GetAmounts() []LockedAmountByTime {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
 // QueryVeNftsRequest is the request type for the Query/VeNfts RPC method
 type QueryVeNftsRequest struct {
 	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -228,7 +652,7 @@ func (m *QueryVeNftsRequest) Reset()         { *m = QueryVeNftsRequest{} }
 func (m *QueryVeNftsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVeNftsRequest) ProtoMessage()    {}
 func (*QueryVeNftsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{4}
+	return fileDescriptor_256fa148a9e7f65f, []int{12}
 }
 func (m *QueryVeNftsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,7 +705,7 @@ func (m *QueryVeNftsResponse) Reset()         { *m = QueryVeNftsResponse{} }
 func (m *QueryVeNftsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVeNftsResponse) ProtoMessage()    {}
 func (*QueryVeNftsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{5}
+	return fileDescriptor_256fa148a9e7f65f, []int{13}
 }
 func (m *QueryVeNftsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +757,7 @@ func (m *QueryVeNftRequest) Reset()         { *m = QueryVeNftRequest{} }
 func (m *QueryVeNftRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVeNftRequest) ProtoMessage()    {}
 func (*QueryVeNftRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{6}
+	return fileDescriptor_256fa148a9e7f65f, []int{14}
 }
 func (m *QueryVeNftRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +802,7 @@ func (m *QueryVeNftResponse) Reset()         { *m = QueryVeNftResponse{} }
 func (m *QueryVeNftResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVeNftResponse) ProtoMessage()    {}
 func (*QueryVeNftResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{7}
+	return fileDescriptor_256fa148a9e7f65f, []int{15}
 }
 func (m *QueryVeNftResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,22 +838,23 @@ func (m *QueryVeNftResponse) GetNft() *nft.NFT {
 	return nil
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryVeNftContractRequest is the request type for the Query/VeNftContract
+// RPC method
+type QueryVeNftContractRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{8}
+func (m *QueryVeNftContractRequest) Reset()         { *m = QueryVeNftContractRequest{} }
+func (m *QueryVeNftContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftContractRequest) ProtoMessage()    {}
+func (*QueryVeNftContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{16}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryVeNftContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVeNftContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVeNftContractRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -439,8 +864,200 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryVeNftContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftContractRequest.Merge(m, src)
+}
+func (m *QueryVeNftContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeNftContractRequest proto.InternalMessageInfo
+
+// QueryVeNftContractResponse is the response type for the Query/VeNftContract
+// RPC method
+type QueryVeNftContractResponse struct {
+	// contract is the hex address of the contract, empty if not deployed yet
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryVeNftContractResponse) Reset()         { *m = QueryVeNftContractResponse{} }
+func (m *QueryVeNftContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeNftContractResponse) ProtoMessage()    {}
+func (*QueryVeNftContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{17}
+}
+func (m *QueryVeNftContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeNftContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeNftContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeNftContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeNftContractResponse.Merge(m, src)
+}
+func (m *QueryVeNftContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeNftContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeNftContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeNftContractResponse proto.InternalMessageInfo
+
+func (m *QueryVeNftContractResponse) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// QueryVotingDelegationsRequest is the request type for the
+// Query/VotingDelegations RPC method
+type QueryVotingDelegationsRequest struct {
+	Delegate   string             `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotingDelegationsRequest) Reset()         { *m = QueryVotingDelegationsRequest{} }
+func (m *QueryVotingDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingDelegationsRequest) ProtoMessage()    {}
+func (*QueryVotingDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{18}
+}
+func (m *QueryVotingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingDelegationsRequest.Merge(m, src)
+}
+func (m *QueryVotingDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryVotingDelegationsRequest) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *QueryVotingDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVotingDelegationsResponse is the response type for the
+// Query/VotingDelegations RPC method
+type QueryVotingDelegationsResponse struct {
+	Delegations []VotingDelegation  `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotingDelegationsResponse) Reset()         { *m = QueryVotingDelegationsResponse{} }
+func (m *QueryVotingDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingDelegationsResponse) ProtoMessage()    {}
+func (*QueryVotingDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{19}
+}
+func (m *QueryVotingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingDelegationsResponse.Merge(m, src)
+}
+func (m *QueryVotingDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryVotingDelegationsResponse) GetDelegations() []VotingDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryVotingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_256fa148a9e7f65f, []int{20}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
 func (m *QueryParamsRequest) XXX_Size() int {
 	return m.Size()
@@ -461,7 +1078,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_256fa148a9e7f65f, []int{9}
+	return fileDescriptor_256fa148a9e7f65f, []int{21}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,10 +1119,22 @@ func init() {
 	proto.RegisterType((*QueryTotalVotingPowerResponse)(nil), "gridiron.ve.v1.QueryTotalVotingPowerResponse")
 	proto.RegisterType((*QueryVotingPowerRequest)(nil), "gridiron.ve.v1.QueryVotingPowerRequest")
 	proto.RegisterType((*QueryVotingPowerResponse)(nil), "gridiron.ve.v1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryVotingPowerHistoryRequest)(nil), "gridiron.ve.v1.QueryVotingPowerHistoryRequest")
+	proto.RegisterType((*VotingPowerPoint)(nil), "gridiron.ve.v1.VotingPowerPoint")
+	proto.RegisterType((*QueryVotingPowerHistoryResponse)(nil), "gridiron.ve.v1.QueryVotingPowerHistoryResponse")
+	proto.RegisterType((*QuerySlopeChangesRequest)(nil), "gridiron.ve.v1.QuerySlopeChangesRequest")
+	proto.RegisterType((*QuerySlopeChangesResponse)(nil), "gridiron.ve.v1.QuerySlopeChangesResponse")
+	proto.RegisterType((*QueryLockedDistributionRequest)(nil), "gridiron.ve.v1.QueryLockedDistributionRequest")
+	proto.RegisterType((*LockedAmountByTime)(nil), "gridiron.ve.v1.LockedAmountByTime")
+	proto.RegisterType((*QueryLockedDistributionResponse)(nil), "gridiron.ve.v1.QueryLockedDistributionResponse")
 	proto.RegisterType((*QueryVeNftsRequest)(nil), "gridiron.ve.v1.QueryVeNftsRequest")
 	proto.RegisterType((*QueryVeNftsResponse)(nil), "gridiron.ve.v1.QueryVeNftsResponse")
 	proto.RegisterType((*QueryVeNftRequest)(nil), "gridiron.ve.v1.QueryVeNftRequest")
 	proto.RegisterType((*QueryVeNftResponse)(nil), "gridiron.ve.v1.QueryVeNftResponse")
+	proto.RegisterType((*QueryVeNftContractRequest)(nil), "gridiron.ve.v1.QueryVeNftContractRequest")
+	proto.RegisterType((*QueryVeNftContractResponse)(nil), "gridiron.ve.v1.QueryVeNftContractResponse")
+	proto.RegisterType((*QueryVotingDelegationsRequest)(nil), "gridiron.ve.v1.QueryVotingDelegationsRequest")
+	proto.RegisterType((*QueryVotingDelegationsResponse)(nil), "gridiron.ve.v1.QueryVotingDelegationsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "gridiron.ve.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "gridiron.ve.v1.QueryParamsResponse")
 }
@@ -513,51 +1142,82 @@ func init() {
 func init() { proto.RegisterFile("gridiron/ve/v1/query.proto", fileDescriptor_256fa148a9e7f65f) }
 
 var fileDescriptor_256fa148a9e7f65f = []byte{
-	// 698 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0xdb, 0x30,
-	0x18, 0x6e, 0xfa, 0xc5, 0x66, 0xb4, 0x69, 0x33, 0xa0, 0x96, 0x0e, 0x4a, 0x09, 0x1a, 0x9f, 0x23,
-	0x56, 0xe1, 0x07, 0x4c, 0xaa, 0x10, 0x13, 0x3b, 0x20, 0x16, 0xa1, 0x1d, 0x76, 0xe9, 0x5c, 0xea,
-	0x66, 0x11, 0xad, 0x1d, 0x62, 0x13, 0xc6, 0xd0, 0x2e, 0x5c, 0xb9, 0x4c, 0xda, 0x65, 0x3f, 0x89,
-	0x23, 0xd2, 0x2e, 0xd3, 0x0e, 0x68, 0x82, 0xfd, 0x90, 0x29, 0xb6, 0x53, 0x92, 0x2e, 0x2d, 0x3b,
-	0xec, 0x04, 0x89, 0x9f, 0xf7, 0xf9, 0xb0, 0x1f, 0xa7, 0x60, 0xba, 0x47, 0xfc, 0xae, 0xcb, 0x28,
-	0x0a, 0x08, 0x0a, 0xea, 0xe8, 0xe8, 0x98, 0xf8, 0xa7, 0x96, 0xe7, 0x33, 0xc1, 0xe0, 0x23, 0xbd,
-	0x64, 0x05, 0xc4, 0x0a, 0xea, 0x95, 0x49, 0x87, 0x39, 0x4c, 0xae, 0xa0, 0xf0, 0x3f, 0x05, 0xaa,
-	0xcc, 0x38, 0x8c, 0x39, 0x5d, 0x82, 0xb0, 0xe7, 0x22, 0x4c, 0x29, 0x13, 0x58, 0xb8, 0x8c, 0x72,
-	0xbd, 0xba, 0x7a, 0xc0, 0x78, 0x8f, 0x71, 0xd4, 0xc2, 0x9c, 0x28, 0x6e, 0x14, 0xd4, 0x5b, 0x44,
-	0xe0, 0x3a, 0xf2, 0xb0, 0xe3, 0x52, 0x09, 0x8e, 0x98, 0x34, 0x96, 0x76, 0x44, 0x1f, 0x44, 0x3b,
-	0x42, 0xaf, 0x3e, 0x4b, 0xfa, 0x74, 0x08, 0x25, 0xdc, 0xd5, 0x32, 0xa6, 0x0d, 0x66, 0xde, 0x84,
-	0xe4, 0xfb, 0x4c, 0xe0, 0xee, 0x5b, 0x26, 0x5c, 0xea, 0xec, 0xb1, 0x13, 0xe2, 0xdb, 0xe4, 0xe8,
-	0x98, 0x70, 0x01, 0x4b, 0x60, 0x0c, 0x8b, 0xa6, 0x70, 0x7b, 0xa4, 0x6c, 0xd4, 0x8c, 0xe5, 0xbc,
-	0x5d, 0xc4, 0x62, 0xdf, 0xed, 0x11, 0x38, 0x0d, 0x1e, 0x60, 0xd1, 0x6c, 0x75, 0xd9, 0xc1, 0x61,
-	0x39, 0x5b, 0x33, 0x96, 0x73, 0xf6, 0x18, 0x16, 0x8d, 0xf0, 0xd1, 0x24, 0x60, 0x76, 0x08, 0x27,
-	0xf7, 0x18, 0xe5, 0x04, 0x6e, 0x81, 0x82, 0x17, 0xbe, 0x90, 0x94, 0x0f, 0x1b, 0xd6, 0xe5, 0xf5,
-	0x5c, 0xe6, 0xe7, 0xf5, 0xdc, 0xa2, 0xe3, 0x8a, 0x0f, 0xc7, 0x2d, 0xeb, 0x80, 0xf5, 0x90, 0x4e,
-	0xa4, 0xfe, 0xac, 0xf3, 0xf6, 0x21, 0x12, 0xa7, 0x1e, 0xe1, 0xd6, 0x0e, 0x15, 0xb6, 0x1a, 0x36,
-	0x5b, 0xa0, 0x24, 0x65, 0x52, 0x5c, 0x4f, 0x80, 0x42, 0x40, 0x9a, 0x6e, 0x5b, 0x09, 0xd8, 0xf9,
-	0x80, 0xec, 0xb4, 0xe3, 0x51, 0xb2, 0x43, 0xa3, 0xe4, 0x92, 0x51, 0xde, 0x83, 0xf2, 0xdf, 0x1a,
-	0xff, 0x35, 0x85, 0x0f, 0xa0, 0x52, 0x20, 0xbb, 0x1d, 0xc1, 0xa3, 0x00, 0x93, 0xa0, 0xc0, 0x4e,
-	0x68, 0xc4, 0x6d, 0xab, 0x07, 0xb8, 0x0d, 0xc0, 0xdd, 0xd9, 0xcb, 0x10, 0xe3, 0x1b, 0x8b, 0x96,
-	0x62, 0xb7, 0xc2, 0xa2, 0x58, 0xaa, 0x84, 0xba, 0x03, 0xd6, 0x1e, 0x76, 0x88, 0x66, 0xb4, 0x63,
-	0x93, 0xe6, 0x85, 0x01, 0x26, 0x12, 0xa2, 0x3a, 0xd1, 0x1a, 0xc8, 0xd3, 0x8e, 0xe0, 0x65, 0xa3,
-	0x96, 0x5b, 0x1e, 0xdf, 0x28, 0x45, 0xcc, 0x61, 0x95, 0x22, 0xca, 0xdd, 0xed, 0x7d, 0x5b, 0x82,
-	0xe0, 0xab, 0x14, 0x33, 0x4b, 0xf7, 0x9a, 0x51, 0x4a, 0x09, 0x37, 0x0b, 0xe0, 0xe9, 0x9d, 0x99,
-	0x68, 0x03, 0x1e, 0x83, 0x6c, 0xff, 0xf8, 0xb2, 0x6e, 0xdb, 0x7c, 0x19, 0xdf, 0xa6, 0xbe, 0xe1,
-	0x15, 0x90, 0xa3, 0x1d, 0x21, 0x61, 0x23, 0xfc, 0x86, 0x18, 0x73, 0x52, 0x13, 0xec, 0x61, 0x1f,
-	0xf7, 0xa2, 0x7d, 0x36, 0x5f, 0xeb, 0x8d, 0x88, 0xde, 0x6a, 0xde, 0x4d, 0x50, 0xf4, 0xe4, 0x1b,
-	0x4d, 0x3d, 0x65, 0x25, 0x2e, 0xb4, 0xa5, 0xe0, 0x8d, 0x7c, 0x78, 0xe4, 0xb6, 0x86, 0x6e, 0x9c,
-	0x17, 0x40, 0x41, 0x92, 0xc1, 0x6f, 0x06, 0x78, 0x32, 0x58, 0x7e, 0xb8, 0x36, 0xc0, 0x31, 0xea,
-	0xda, 0x55, 0x5e, 0xfc, 0x1b, 0x58, 0xd9, 0x35, 0x57, 0xce, 0xbf, 0xff, 0xfe, 0x9a, 0x5d, 0x80,
-	0xf3, 0x28, 0x79, 0xd5, 0x45, 0x38, 0xd0, 0x0c, 0xe4, 0x44, 0x53, 0xd6, 0x0d, 0x5e, 0x18, 0x60,
-	0x3c, 0xee, 0x6a, 0x31, 0x4d, 0x28, 0xc5, 0xd0, 0xd2, 0xbd, 0x38, 0xed, 0x65, 0x4d, 0x7a, 0x79,
-	0x0e, 0x17, 0x06, 0xbc, 0xc4, 0x5d, 0xa0, 0x33, 0x79, 0x3b, 0x3f, 0x43, 0x0a, 0x8a, 0xaa, 0x82,
-	0x70, 0x3e, 0x95, 0x3f, 0x7e, 0x27, 0x2a, 0xe6, 0x28, 0x88, 0x56, 0x9f, 0x95, 0xea, 0x25, 0x38,
-	0x35, 0xa8, 0x4e, 0x64, 0x67, 0x3d, 0x50, 0x90, 0x03, 0xb0, 0x36, 0x94, 0x2b, 0x52, 0x9b, 0x1f,
-	0x81, 0xd0, 0x62, 0xa6, 0x14, 0x9b, 0x81, 0x95, 0x54, 0x31, 0x74, 0xa6, 0x13, 0xaa, 0xb2, 0xa4,
-	0x27, 0x4c, 0xb4, 0x31, 0x3d, 0x61, 0xb2, 0x9a, 0x43, 0x13, 0xaa, 0x12, 0x36, 0xb6, 0x2e, 0x6f,
-	0xaa, 0xc6, 0xd5, 0x4d, 0xd5, 0xf8, 0x75, 0x53, 0x35, 0xbe, 0xdc, 0x56, 0x33, 0x57, 0xb7, 0xd5,
-	0xcc, 0x8f, 0xdb, 0x6a, 0xe6, 0xdd, 0x6a, 0xec, 0xbb, 0xa4, 0x47, 0xd7, 0x3f, 0x31, 0x4a, 0xfa,
-	0x3c, 0x1f, 0x43, 0x26, 0xf9, 0x7d, 0x6a, 0x15, 0xe5, 0x8f, 0xc3, 0xe6, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x2d, 0xe3, 0xc7, 0x25, 0xe3, 0x06, 0x00, 0x00,
+	// 1197 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0xc0, 0x59, 0x03, 0x06, 0x9e, 0x43, 0x94, 0x0c, 0x88, 0x3f, 0x0b, 0x18, 0x77, 0x49, 0x08,
+	0x10, 0xd8, 0x15, 0xb4, 0x95, 0x7a, 0x6a, 0x55, 0x07, 0xd1, 0x44, 0x8d, 0x22, 0xea, 0xa2, 0x1e,
+	0x72, 0x71, 0xc7, 0xf6, 0xb0, 0xac, 0xb0, 0x67, 0x96, 0xdd, 0xb1, 0x53, 0x8a, 0xb8, 0xb4, 0x87,
+	0x4a, 0xad, 0x14, 0x55, 0xea, 0xb1, 0xb7, 0x56, 0xaa, 0xd4, 0x73, 0xbe, 0x44, 0x8e, 0x91, 0x7a,
+	0xa9, 0x7a, 0x88, 0x2a, 0xe8, 0x07, 0xa9, 0x76, 0x66, 0xd6, 0xde, 0x5d, 0xaf, 0x8d, 0x93, 0x70,
+	0x82, 0x79, 0xf3, 0xfe, 0xfc, 0xde, 0x9b, 0xd9, 0xf7, 0xc6, 0xa0, 0xdb, 0x9e, 0x53, 0x73, 0x3c,
+	0x46, 0xad, 0x16, 0xb1, 0x5a, 0xdb, 0xd6, 0x49, 0x93, 0x78, 0xa7, 0xa6, 0xeb, 0x31, 0xce, 0xd0,
+	0xcd, 0x70, 0xcf, 0x6c, 0x11, 0xb3, 0xb5, 0xad, 0x4f, 0xdb, 0xcc, 0x66, 0x62, 0xcb, 0x0a, 0xfe,
+	0x93, 0x5a, 0xfa, 0xa2, 0xcd, 0x98, 0x5d, 0x27, 0x16, 0x76, 0x1d, 0x0b, 0x53, 0xca, 0x38, 0xe6,
+	0x0e, 0xa3, 0xbe, 0xda, 0xdd, 0xa8, 0x32, 0xbf, 0xc1, 0x7c, 0xab, 0x82, 0x7d, 0x22, 0x9d, 0x5b,
+	0xad, 0xed, 0x0a, 0xe1, 0x78, 0xdb, 0x72, 0xb1, 0xed, 0x50, 0xa1, 0x1c, 0x7a, 0x52, 0xba, 0xf4,
+	0x90, 0xb7, 0x95, 0xe8, 0x21, 0x6f, 0xc7, 0x89, 0x93, 0xda, 0x84, 0x12, 0xdf, 0x09, 0xe3, 0xcc,
+	0x26, 0x76, 0x5b, 0x44, 0x6e, 0x18, 0x25, 0x58, 0xfc, 0x22, 0x08, 0x7b, 0xc0, 0x38, 0xae, 0x7f,
+	0xc5, 0xb8, 0x43, 0xed, 0x7d, 0xf6, 0x8c, 0x78, 0x25, 0x72, 0xd2, 0x24, 0x3e, 0x47, 0xb3, 0x30,
+	0x86, 0x79, 0x99, 0x3b, 0x0d, 0x32, 0xa7, 0x15, 0xb4, 0xb5, 0x91, 0x52, 0x16, 0xf3, 0x03, 0xa7,
+	0x41, 0xd0, 0x3c, 0x8c, 0x63, 0x5e, 0xae, 0xd4, 0x59, 0xf5, 0x78, 0x2e, 0x53, 0xd0, 0xd6, 0x86,
+	0x4b, 0x63, 0x98, 0x17, 0x83, 0xa5, 0x41, 0x60, 0xa9, 0x87, 0x4f, 0xdf, 0x65, 0xd4, 0x27, 0x68,
+	0x17, 0x46, 0xdd, 0x40, 0x20, 0x5c, 0x4e, 0x14, 0xcd, 0x97, 0xaf, 0x97, 0x87, 0xfe, 0x79, 0xbd,
+	0xbc, 0x6a, 0x3b, 0xfc, 0xa8, 0x59, 0x31, 0xab, 0xac, 0x61, 0xa9, 0x5c, 0xe5, 0x9f, 0x2d, 0xbf,
+	0x76, 0x6c, 0xf1, 0x53, 0x97, 0xf8, 0xe6, 0x23, 0xca, 0x4b, 0xd2, 0xd8, 0xa8, 0xc0, 0xac, 0x08,
+	0x93, 0x42, 0x3d, 0x05, 0xa3, 0x2d, 0x52, 0x76, 0x6a, 0x32, 0x40, 0x69, 0xa4, 0x45, 0x1e, 0xd5,
+	0xa2, 0xa9, 0x64, 0x7a, 0xa6, 0x32, 0x1c, 0x4f, 0xe5, 0x6b, 0x98, 0xeb, 0x8e, 0x71, 0xad, 0x59,
+	0xfc, 0xa0, 0x41, 0x3e, 0x19, 0xe2, 0xa1, 0xe3, 0x73, 0xe6, 0x9d, 0xf6, 0xcd, 0x66, 0x09, 0xc0,
+	0xe7, 0xd8, 0x8b, 0x25, 0x34, 0x21, 0x24, 0x61, 0x4e, 0x84, 0xd6, 0xe4, 0xe6, 0xb0, 0xd8, 0x1c,
+	0x23, 0xb4, 0x26, 0xb6, 0x66, 0x20, 0xeb, 0x32, 0x87, 0x72, 0x7f, 0x6e, 0xa4, 0xa0, 0xad, 0x4d,
+	0x96, 0xd4, 0xca, 0x68, 0xc1, 0xad, 0x08, 0xc3, 0x7e, 0x20, 0x44, 0x8b, 0x30, 0x11, 0xb8, 0xf0,
+	0x39, 0x6e, 0xb8, 0xea, 0x02, 0x74, 0x04, 0x9d, 0x0a, 0x64, 0xde, 0xa5, 0x02, 0x18, 0x96, 0x7b,
+	0x16, 0x40, 0x95, 0xfa, 0xe3, 0x36, 0xb2, 0x56, 0x18, 0x5e, 0xcb, 0xed, 0x14, 0xcc, 0xf8, 0xb7,
+	0x67, 0x26, 0xc1, 0x8b, 0x23, 0x01, 0x4b, 0x3b, 0xb5, 0x03, 0x75, 0x8c, 0x5f, 0xd6, 0x99, 0x4b,
+	0x1e, 0x1c, 0x61, 0x6a, 0x13, 0x3f, 0xac, 0x6e, 0xbc, 0x90, 0x5a, 0xbf, 0x42, 0x66, 0x62, 0x85,
+	0x34, 0xaa, 0x30, 0x9f, 0xe2, 0x55, 0x21, 0xef, 0xc1, 0xa4, 0x1f, 0xc8, 0xcb, 0x55, 0xb9, 0xa1,
+	0xc8, 0x17, 0x92, 0xe4, 0x11, 0x63, 0x05, 0x7d, 0xc3, 0x8f, 0xf8, 0x33, 0x9e, 0xaa, 0xeb, 0xf1,
+	0x98, 0x55, 0x8f, 0x49, 0x6d, 0xd7, 0xf1, 0xb9, 0xe7, 0x54, 0x9a, 0xdc, 0x61, 0xf4, 0xdd, 0x13,
+	0x38, 0x07, 0x24, 0xdd, 0x7e, 0xda, 0x60, 0x4d, 0xca, 0x8b, 0xa7, 0xc2, 0x60, 0x19, 0x72, 0x4d,
+	0x1a, 0xdc, 0xfe, 0xa8, 0x43, 0x90, 0x22, 0xa1, 0xb0, 0x07, 0x59, 0x2c, 0x0c, 0xde, 0xf2, 0xdc,
+	0x95, 0xb5, 0xf1, 0x42, 0x53, 0x27, 0x9f, 0x96, 0x9b, 0x2a, 0x63, 0x11, 0xc6, 0xa4, 0x76, 0x58,
+	0x40, 0x23, 0x59, 0xc0, 0xee, 0x0c, 0x54, 0x1d, 0x43, 0x43, 0xf4, 0x18, 0x26, 0x5c, 0xe2, 0x35,
+	0x30, 0x25, 0x6f, 0x8d, 0xdc, 0x71, 0x60, 0x78, 0x80, 0xe4, 0x75, 0x25, 0x4f, 0x0e, 0x79, 0xfb,
+	0x16, 0x4d, 0xc3, 0x28, 0x7b, 0x46, 0xc3, 0x66, 0x50, 0x92, 0x0b, 0xb4, 0x07, 0xd0, 0x69, 0xe3,
+	0x22, 0x74, 0x6e, 0x67, 0xd5, 0x94, 0x11, 0xcc, 0xa0, 0xe7, 0x9b, 0x72, 0xa0, 0xa8, 0x76, 0x6e,
+	0xee, 0x63, 0x9b, 0x28, 0x8f, 0xa5, 0x88, 0xa5, 0xf1, 0x93, 0x06, 0x53, 0xb1, 0xa0, 0xaa, 0x3a,
+	0xf7, 0x61, 0x84, 0x1e, 0xb6, 0x4b, 0x33, 0x1b, 0x7a, 0x0e, 0xa6, 0x42, 0xe8, 0xf2, 0xc9, 0xde,
+	0x41, 0x49, 0x28, 0xa1, 0xcf, 0x52, 0x60, 0xee, 0x5d, 0x09, 0x23, 0x23, 0xc5, 0x68, 0x56, 0xe0,
+	0x76, 0x07, 0x26, 0x2c, 0xc0, 0x4d, 0xc8, 0xb4, 0x3b, 0x54, 0xc6, 0xa9, 0x19, 0x9f, 0x44, 0xcb,
+	0xd4, 0x06, 0x5e, 0x87, 0x61, 0x7a, 0xc8, 0x85, 0x5a, 0x1f, 0xde, 0x40, 0xc7, 0x58, 0x80, 0xf9,
+	0x8e, 0x83, 0x07, 0x8c, 0x72, 0x0f, 0x57, 0xc3, 0x68, 0xc6, 0x47, 0xa0, 0xa7, 0x6d, 0xaa, 0x28,
+	0x3a, 0x8c, 0x57, 0x95, 0x4c, 0x11, 0xb5, 0xd7, 0xc6, 0xf7, 0x9a, 0x9a, 0x4e, 0xb2, 0x65, 0xec,
+	0x92, 0x3a, 0xb1, 0xe5, 0x48, 0x0e, 0x33, 0xd1, 0x61, 0xbc, 0x26, 0xa5, 0x24, 0xb4, 0x0e, 0xd7,
+	0xd7, 0x76, 0xa0, 0x2f, 0xe2, 0x5d, 0x3f, 0x46, 0xa1, 0x92, 0x78, 0x08, 0xb9, 0x5a, 0x47, 0xdc,
+	0xbf, 0xf1, 0x75, 0xec, 0xd5, 0xdd, 0x8f, 0x9a, 0x5e, 0xdf, 0xc1, 0x4f, 0xab, 0x33, 0xdd, 0xc7,
+	0x1e, 0x6e, 0x84, 0xf5, 0x32, 0x3e, 0x87, 0xa9, 0x98, 0x54, 0xf1, 0x7f, 0x00, 0x59, 0x57, 0x48,
+	0xd4, 0x69, 0xcf, 0x24, 0xd1, 0xa5, 0x7e, 0xbb, 0x53, 0x8b, 0xd5, 0xce, 0x1f, 0x39, 0x18, 0x15,
+	0xde, 0xd0, 0xaf, 0x1a, 0xdc, 0x4a, 0xbe, 0x20, 0xd0, 0x66, 0xd2, 0x49, 0xbf, 0xc7, 0x8b, 0xbe,
+	0x35, 0xa0, 0xb6, 0x24, 0x36, 0x36, 0xbe, 0xfb, 0xeb, 0xbf, 0x5f, 0x32, 0x77, 0x90, 0x61, 0x25,
+	0x5e, 0x4b, 0x3c, 0xb0, 0x28, 0xb7, 0x84, 0x49, 0x59, 0x0c, 0x2d, 0xf4, 0x5c, 0x83, 0x5c, 0x14,
+	0xec, 0x5e, 0x6a, 0xa8, 0x14, 0xa6, 0xb5, 0xab, 0x15, 0x15, 0xce, 0xa6, 0xc0, 0x59, 0x45, 0x77,
+	0x92, 0x38, 0x51, 0x10, 0xeb, 0x4c, 0x3c, 0x0d, 0xce, 0xd1, 0xef, 0x1a, 0xa0, 0xee, 0x09, 0x8a,
+	0xcc, 0xab, 0xc2, 0xc5, 0xdf, 0x1a, 0xba, 0x35, 0xb0, 0xfe, 0x9b, 0x50, 0x96, 0x8f, 0x14, 0xce,
+	0x8f, 0x1a, 0xdc, 0x88, 0x8e, 0x4b, 0x94, 0x5e, 0x8e, 0x94, 0x39, 0xad, 0xaf, 0x0f, 0xa0, 0xa9,
+	0x98, 0xee, 0x0a, 0xa6, 0x65, 0xb4, 0x94, 0x64, 0x8a, 0x4d, 0x64, 0xf4, 0x9b, 0x06, 0xa8, 0x7b,
+	0xf4, 0xf4, 0x28, 0x59, 0xcf, 0xf9, 0xab, 0x5b, 0x03, 0xeb, 0x2b, 0xbc, 0xfb, 0x02, 0xef, 0x2e,
+	0x5a, 0x49, 0xe2, 0xd5, 0x85, 0x4d, 0xb9, 0x16, 0xa5, 0x39, 0x81, 0xac, 0x6c, 0xfa, 0xc8, 0x48,
+	0x3f, 0x9a, 0xe8, 0x18, 0xd2, 0x57, 0xfa, 0xea, 0xa8, 0xf8, 0x79, 0x11, 0x7f, 0x0e, 0xcd, 0x74,
+	0x1d, 0x19, 0x11, 0x83, 0xc2, 0x87, 0x51, 0x61, 0x81, 0xde, 0xeb, 0xed, 0x2d, 0x0c, 0x68, 0xf4,
+	0x53, 0x51, 0xf1, 0x56, 0x44, 0xbc, 0x25, 0xb4, 0x90, 0x1e, 0xcf, 0x3a, 0x0b, 0xee, 0xef, 0x73,
+	0x0d, 0x26, 0x63, 0xdd, 0x1c, 0xad, 0xf7, 0x76, 0x9d, 0x18, 0x07, 0xfa, 0xc6, 0x20, 0xaa, 0x8a,
+	0x66, 0x55, 0xd0, 0x14, 0x50, 0x3e, 0x95, 0xa6, 0x1c, 0x0e, 0x0a, 0xf4, 0xa7, 0x06, 0xb7, 0xbb,
+	0xba, 0x33, 0xda, 0xea, 0xf3, 0x7d, 0x74, 0xcf, 0x12, 0xdd, 0x1c, 0x54, 0x5d, 0xc1, 0x7d, 0x28,
+	0xe0, 0x2c, 0xb4, 0xd5, 0xe3, 0x6b, 0x8a, 0xb4, 0x75, 0xeb, 0x4c, 0x2d, 0xc8, 0x79, 0x70, 0x49,
+	0x64, 0x37, 0xed, 0x71, 0x49, 0x62, 0x0d, 0x5b, 0x5f, 0xe9, 0xab, 0x73, 0xd5, 0x25, 0x91, 0x8d,
+	0xba, 0xb8, 0xf7, 0xf2, 0x22, 0xaf, 0xbd, 0xba, 0xc8, 0x6b, 0xff, 0x5e, 0xe4, 0xb5, 0x9f, 0x2f,
+	0xf3, 0x43, 0xaf, 0x2e, 0xf3, 0x43, 0x7f, 0x5f, 0xe6, 0x87, 0x9e, 0x6e, 0x46, 0xde, 0x54, 0xa1,
+	0xed, 0xd6, 0xb7, 0x8c, 0x92, 0x8e, 0xa7, 0x6f, 0x02, 0x5f, 0xe2, 0x75, 0x55, 0xc9, 0x8a, 0xdf,
+	0xa1, 0xef, 0xff, 0x3f, 0x00, 0x0d, 0x29, 0xaa, 0xbf, 0x6a, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -576,10 +1236,25 @@ type QueryClient interface {
 	TotalVotingPower(ctx context.Context, in *QueryTotalVotingPowerRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerResponse, error)
 	// VotingPower queries the voting power of a veNFT.
 	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// VotingPowerHistory queries the voting power of a veNFT, or the total
+	// voting power if no veNFT is specified, sampled at evenly spaced times
+	// over a range in the past or the future.
+	VotingPowerHistory(ctx context.Context, in *QueryVotingPowerHistoryRequest, opts ...grpc.CallOption) (*QueryVotingPowerHistoryResponse, error)
+	// SlopeChanges queries the scheduled changes of the decay slope of the
+	// total voting power.
+	SlopeChanges(ctx context.Context, in *QuerySlopeChangesRequest, opts ...grpc.CallOption) (*QuerySlopeChangesResponse, error)
+	// LockedDistribution queries the unexpired locked amounts grouped by the
+	// unlocking time.
+	LockedDistribution(ctx context.Context, in *QueryLockedDistributionRequest, opts ...grpc.CallOption) (*QueryLockedDistributionResponse, error)
 	// VeNfts queries all veNFTs of a given owner.
 	VeNfts(ctx context.Context, in *QueryVeNftsRequest, opts ...grpc.CallOption) (*QueryVeNftsResponse, error)
 	// VeNft queries an veNFT based on its id.
 	VeNft(ctx context.Context, in *QueryVeNftRequest, opts ...grpc.CallOption) (*QueryVeNftResponse, error)
+	// VeNftContract queries the address of the ERC-721 mirror contract of
+	// veNFTs in the EVM.
+	VeNftContract(ctx context.Context, in *QueryVeNftContractRequest, opts ...grpc.CallOption) (*QueryVeNftContractResponse, error)
+	// VotingDelegations queries the unexpired voting delegations to a delegate.
+	VotingDelegations(ctx context.Context, in *QueryVotingDelegationsRequest, opts ...grpc.CallOption) (*QueryVotingDelegationsResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -610,6 +1285,33 @@ func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerReque
 	return out, nil
 }
 
+func (c *queryClient) VotingPowerHistory(ctx context.Context, in *QueryVotingPowerHistoryRequest, opts ...grpc.CallOption) (*QueryVotingPowerHistoryResponse, error) {
+	out := new(QueryVotingPowerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Query/VotingPowerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlopeChanges(ctx context.Context, in *QuerySlopeChangesRequest, opts ...grpc.CallOption) (*QuerySlopeChangesResponse, error) {
+	out := new(QuerySlopeChangesResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Query/SlopeChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedDistribution(ctx context.Context, in *QueryLockedDistributionRequest, opts ...grpc.CallOption) (*QueryLockedDistributionResponse, error) {
+	out := new(QueryLockedDistributionResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Query/LockedDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) VeNfts(ctx context.Context, in *QueryVeNftsRequest, opts ...grpc.CallOption) (*QueryVeNftsResponse, error) {
 	out := new(QueryVeNftsResponse)
 	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Query/VeNfts", in, out, opts...)
@@ -628,6 +1330,24 @@ func (c *queryClient) VeNft(ctx context.Context, in *QueryVeNftRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) VeNftContract(ctx context.Context, in *QueryVeNftContractRequest, opts ...grpc.CallOption) (*QueryVeNftContractResponse, error) {
+	out := new(QueryVeNftContractResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Query/VeNftContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingDelegations(ctx context.Context, in *QueryVotingDelegationsRequest, opts ...grpc.CallOption) (*QueryVotingDelegationsResponse, error) {
+	out := new(QueryVotingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Query/VotingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Query/Params", in, out, opts...)
@@ -643,10 +1363,25 @@ type QueryServer interface {
 	TotalVotingPower(context.Context, *QueryTotalVotingPowerRequest) (*QueryTotalVotingPowerResponse, error)
 	// VotingPower queries the voting power of a veNFT.
 	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// VotingPowerHistory queries the voting power of a veNFT, or the total
+	// voting power if no veNFT is specified, sampled at evenly spaced times
+	// over a range in the past or the future.
+	VotingPowerHistory(context.Context, *QueryVotingPowerHistoryRequest) (*QueryVotingPowerHistoryResponse, error)
+	// SlopeChanges queries the scheduled changes of the decay slope of the
+	// total voting power.
+	SlopeChanges(context.Context, *QuerySlopeChangesRequest) (*QuerySlopeChangesResponse, error)
+	// LockedDistribution queries the unexpired locked amounts grouped by the
+	// unlocking time.
+	LockedDistribution(context.Context, *QueryLockedDistributionRequest) (*QueryLockedDistributionResponse, error)
 	// VeNfts queries all veNFTs of a given owner.
 	VeNfts(context.Context, *QueryVeNftsRequest) (*QueryVeNftsResponse, error)
 	// VeNft queries an veNFT based on its id.
 	VeNft(context.Context, *QueryVeNftRequest) (*QueryVeNftResponse, error)
+	// VeNftContract queries the address of the ERC-721 mirror contract of
+	// veNFTs in the EVM.
+	VeNftContract(context.Context, *QueryVeNftContractRequest) (*QueryVeNftContractResponse, error)
+	// VotingDelegations queries the unexpired voting delegations to a delegate.
+	VotingDelegations(context.Context, *QueryVotingDelegationsRequest) (*QueryVotingDelegationsResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -661,12 +1396,27 @@ func (*UnimplementedQueryServer) TotalVotingPower(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
 }
+func (*UnimplementedQueryServer) VotingPowerHistory(ctx context.Context, req *QueryVotingPowerHistoryRequest) (*QueryVotingPowerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerHistory not implemented")
+}
+func (*UnimplementedQueryServer) SlopeChanges(ctx context.Context, req *QuerySlopeChangesRequest) (*QuerySlopeChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlopeChanges not implemented")
+}
+func (*UnimplementedQueryServer) LockedDistribution(ctx context.Context, req *QueryLockedDistributionRequest) (*QueryLockedDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedDistribution not implemented")
+}
 func (*UnimplementedQueryServer) VeNfts(ctx context.Context, req *QueryVeNftsRequest) (*QueryVeNftsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VeNfts not implemented")
 }
 func (*UnimplementedQueryServer) VeNft(ctx context.Context, req *QueryVeNftRequest) (*QueryVeNftResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VeNft not implemented")
 }
+func (*UnimplementedQueryServer) VeNftContract(ctx context.Context, req *QueryVeNftContractRequest) (*QueryVeNftContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeNftContract not implemented")
+}
+func (*UnimplementedQueryServer) VotingDelegations(ctx context.Context, req *QueryVotingDelegationsRequest) (*QueryVotingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingDelegations not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -711,6 +1461,60 @@ func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotingPowerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Query/VotingPowerHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerHistory(ctx, req.(*QueryVotingPowerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlopeChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlopeChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlopeChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Query/SlopeChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlopeChanges(ctx, req.(*QuerySlopeChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Query/LockedDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedDistribution(ctx, req.(*QueryLockedDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_VeNfts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVeNftsRequest)
 	if err := dec(in); err != nil {
@@ -747,6 +1551,42 @@ func _Query_VeNft_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VeNftContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeNftContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeNftContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Query/VeNftContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeNftContract(ctx, req.(*QueryVeNftContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Query/VotingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingDelegations(ctx, req.(*QueryVotingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -777,6 +1617,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VotingPower",
 			Handler:    _Query_VotingPower_Handler,
 		},
+		{
+			MethodName: "VotingPowerHistory",
+			Handler:    _Query_VotingPowerHistory_Handler,
+		},
+		{
+			MethodName: "SlopeChanges",
+			Handler:    _Query_SlopeChanges_Handler,
+		},
+		{
+			MethodName: "LockedDistribution",
+			Handler:    _Query_LockedDistribution_Handler,
+		},
 		{
 			MethodName: "VeNfts",
 			Handler:    _Query_VeNfts_Handler,
@@ -785,6 +1637,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VeNft",
 			Handler:    _Query_VeNft_Handler,
 		},
+		{
+			MethodName: "VeNftContract",
+			Handler:    _Query_VeNftContract_Handler,
+		},
+		{
+			MethodName: "VotingDelegations",
+			Handler:    _Query_VotingDelegations_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -933,7 +1793,7 @@ func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVeNftsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVotingPowerHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -943,39 +1803,42 @@ func (m *QueryVeNftsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVeNftsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVotingPowerHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVeNftsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVotingPowerHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Points != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Points))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryVeNftsResponse) Marshal() (dAtA []byte, err error) {
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -985,32 +1848,58 @@ func (m *QueryVeNftsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVeNftsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *VotingPowerPoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVeNftsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VotingPowerPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x8
 	}
-	if len(m.Nfts) > 0 {
-		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1024,7 +1913,7 @@ func (m *QueryVeNftsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVeNftRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySlopeChangesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1034,27 +1923,30 @@ func (m *QueryVeNftRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVeNftRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySlopeChangesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVeNftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySlopeChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVeNftResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySlopeChangesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1064,32 +1956,34 @@ func (m *QueryVeNftResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVeNftResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySlopeChangesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVeNftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySlopeChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nft != nil {
-		{
-			size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.SlopeChanges) > 0 {
+		for iNdEx := len(m.SlopeChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlopeChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLockedDistributionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1099,20 +1993,30 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLockedDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLockedDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *LockedAmountByTime) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1122,185 +2026,2079 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockedAmountByTime) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockedAmountByTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if m.UnlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryTotalVotingPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AtTime != 0 {
-		n += 1 + sovQuery(uint64(m.AtTime))
-	}
-	if m.AtBlock != 0 {
-		n += 1 + sovQuery(uint64(m.AtBlock))
+func (m *QueryLockedDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalVotingPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Power.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryLockedDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVotingPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLockedDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AtTime != 0 {
-		n += 1 + sovQuery(uint64(m.AtTime))
+	{
+		size := m.Permanent.Size()
+		i -= size
+		if _, err := m.Permanent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.AtBlock != 0 {
-		n += 1 + sovQuery(uint64(m.AtBlock))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVotingPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVeNftsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Power.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryVeNftsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryVeNftsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVeNftsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVeNftsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nfts) > 0 {
-		for _, e := range m.Nfts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVeNftRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVeNftsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nft != nil {
+		{
+			size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeNftContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeNftContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeNftContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTotalVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AtTime != 0 {
+		n += 1 + sovQuery(uint64(m.AtTime))
+	}
+	if m.AtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.AtBlock))
+	}
+	return n
+}
+
+func (m *QueryTotalVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Power.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AtTime != 0 {
+		n += 1 + sovQuery(uint64(m.AtTime))
+	}
+	if m.AtBlock != 0 {
+		n += 1 + sovQuery(uint64(m.AtBlock))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Power.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Points != 0 {
+		n += 1 + sovQuery(uint64(m.Points))
+	}
+	return n
+}
+
+func (m *VotingPowerPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlopeChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *QuerySlopeChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlopeChanges) > 0 {
+		for _, e := range m.SlopeChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockedDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *LockedAmountByTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnlockTime))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLockedDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Permanent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVeNftsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeNftsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeNftRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeNftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nft != nil {
+		l = m.Nft.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeNftContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVeNftContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+			}
+			m.AtTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			}
+			m.AtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+			}
+			m.AtTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			}
+			m.AtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingPowerPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, VotingPowerPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlopeChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlopeChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlopeChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlopeChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlopeChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlopeChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlopeChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlopeChanges = append(m.SlopeChanges, SlopeChange{})
+			if err := m.SlopeChanges[len(m.SlopeChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedAmountByTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedAmountByTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedAmountByTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, LockedAmountByTime{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permanent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryVeNftResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nft != nil {
-		l = m.Nft.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeNftsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeNftsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1323,17 +4121,17 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeNftsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeNftsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
 			}
-			m.AtTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1343,16 +4141,31 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AtTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nfts = append(m.Nfts, &nft.NFT{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.AtBlock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1362,11 +4175,28 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AtBlock |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1388,7 +4218,7 @@ func (m *QueryTotalVotingPowerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1411,15 +4241,15 @@ func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeNftRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeNftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1447,9 +4277,7 @@ func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1472,7 +4300,7 @@ func (m *QueryTotalVotingPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1495,17 +4323,17 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeNftResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeNftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1515,62 +4343,28 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtTime", wireType)
-			}
-			m.AtTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AtTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtBlock", wireType)
+			if m.Nft == nil {
+				m.Nft = &nft.NFT{}
 			}
-			m.AtBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AtBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1592,7 +4386,7 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVeNftContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,46 +4409,12 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeNftContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeNftContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1676,7 +4436,7 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVeNftContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1699,15 +4459,15 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVeNftContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVeNftContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1735,43 +4495,7 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1794,7 +4518,7 @@ func (m *QueryVeNftsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1817,17 +4541,17 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1837,25 +4561,23 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nfts = append(m.Nfts, &nft.NFT{})
-			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1887,7 +4609,7 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1914,7 +4636,7 @@ func (m *QueryVeNftsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1937,17 +4659,17 @@ func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1957,77 +4679,29 @@ func (m *QueryVeNftRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Delegations = append(m.Delegations, VotingDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVeNftResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVeNftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2054,10 +4728,10 @@ func (m *QueryVeNftResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nft == nil {
-				m.Nft = &nft.NFT{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -349,23 +350,26 @@ func (m *MsgMergeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMergeResponse proto.InternalMessageInfo
 
-type MsgWithdraw struct {
+type MsgSplit struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	// Amounts to split into new veNFTs, each must be greater than 0, and their
+	// sum must be less than the locked amount of the veNFT
+	Amounts []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,rep,name=amounts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amounts" yaml:"amounts"`
 }
 
-func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
-func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdraw) ProtoMessage()    {}
-func (*MsgWithdraw) Descriptor() ([]byte, []int) {
+func (m *MsgSplit) Reset()         { *m = MsgSplit{} }
+func (m *MsgSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgSplit) ProtoMessage()    {}
+func (*MsgSplit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a7eb0badc4e133e0, []int{8}
 }
-func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
+func (m *MsgSplit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdraw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSplit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -375,33 +379,34 @@ func (m *MsgWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdraw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdraw.Merge(m, src)
+func (m *MsgSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplit.Merge(m, src)
 }
-func (m *MsgWithdraw) XXX_Size() int {
+func (m *MsgSplit) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdraw) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdraw.DiscardUnknown(m)
+func (m *MsgSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdraw proto.InternalMessageInfo
+var xxx_messageInfo_MsgSplit proto.InternalMessageInfo
 
-type MsgWithdrawResponse struct {
+type MsgSplitResponse struct {
+	VeIds []string `protobuf:"bytes,1,rep,name=ve_ids,json=veIds,proto3" json:"ve_ids,omitempty"`
 }
 
-func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
-func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawResponse) ProtoMessage()    {}
-func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSplitResponse) Reset()         { *m = MsgSplitResponse{} }
+func (m *MsgSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitResponse) ProtoMessage()    {}
+func (*MsgSplitResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a7eb0badc4e133e0, []int{9}
 }
-func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSplitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -411,834 +416,3236 @@ func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawResponse.Merge(m, src)
+func (m *MsgSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitResponse.Merge(m, src)
 }
-func (m *MsgWithdrawResponse) XXX_Size() int {
+func (m *MsgSplitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawResponse.DiscardUnknown(m)
+func (m *MsgSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSplitResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreate)(nil), "gridiron.ve.v1.MsgCreate")
-	proto.RegisterType((*MsgCreateResponse)(nil), "gridiron.ve.v1.MsgCreateResponse")
-	proto.RegisterType((*MsgDeposit)(nil), "gridiron.ve.v1.MsgDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "gridiron.ve.v1.MsgDepositResponse")
-	proto.RegisterType((*MsgExtendTime)(nil), "gridiron.ve.v1.MsgExtendTime")
-	proto.RegisterType((*MsgExtendTimeResponse)(nil), "gridiron.ve.v1.MsgExtendTimeResponse")
-	proto.RegisterType((*MsgMerge)(nil), "gridiron.ve.v1.MsgMerge")
-	proto.RegisterType((*MsgMergeResponse)(nil), "gridiron.ve.v1.MsgMergeResponse")
-	proto.RegisterType((*MsgWithdraw)(nil), "gridiron.ve.v1.MsgWithdraw")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "gridiron.ve.v1.MsgWithdrawResponse")
+func (m *MsgSplitResponse) GetVeIds() []string {
+	if m != nil {
+		return m.VeIds
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("gridiron/ve/v1/tx.proto", fileDescriptor_a7eb0badc4e133e0) }
-
-var fileDescriptor_a7eb0badc4e133e0 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x4f, 0xdb, 0x40,
-	0x14, 0x8f, 0x93, 0x90, 0x86, 0x07, 0x51, 0xe1, 0x20, 0x25, 0x71, 0x21, 0x0e, 0x56, 0x91, 0x68,
-	0x25, 0x6c, 0x01, 0x1b, 0x52, 0x97, 0x40, 0xa5, 0x32, 0x78, 0xb1, 0xaa, 0x56, 0xea, 0x82, 0x9c,
-	0xf8, 0x30, 0x6e, 0x63, 0x5f, 0xe4, 0xbb, 0x04, 0xe8, 0xd8, 0xa9, 0x63, 0xa5, 0x7e, 0x01, 0xa4,
-	0x4a, 0x1d, 0x3a, 0xf6, 0x53, 0x30, 0x22, 0x75, 0xe9, 0x64, 0x55, 0xd0, 0x81, 0x39, 0x9f, 0xa0,
-	0xf2, 0x9d, 0xed, 0x84, 0x5a, 0xa9, 0x44, 0x45, 0xb7, 0xdc, 0xfd, 0xde, 0xfb, 0xfd, 0x79, 0xbe,
-	0xbb, 0xc0, 0x03, 0x0f, 0x07, 0x5d, 0x97, 0xf8, 0xfa, 0x00, 0xeb, 0x83, 0x4d, 0x9d, 0x9d, 0x68,
-	0xbd, 0x80, 0x30, 0x82, 0x2a, 0xf1, 0xbe, 0x36, 0xc0, 0xda, 0x60, 0x53, 0x5e, 0x74, 0x88, 0x43,
-	0x38, 0xa2, 0x47, 0xbf, 0x44, 0x91, 0xbc, 0xec, 0x10, 0xe2, 0x74, 0xb1, 0x6e, 0xf5, 0x5c, 0xdd,
-	0xf2, 0x7d, 0xc2, 0x2c, 0xe6, 0x12, 0x9f, 0xc6, 0x68, 0xa3, 0x43, 0xa8, 0x47, 0xa8, 0xde, 0xb6,
-	0x68, 0xc4, 0xdd, 0xc6, 0xcc, 0xda, 0xd4, 0x3b, 0xc4, 0xf5, 0x05, 0xae, 0x5e, 0x4b, 0x30, 0x6d,
-	0x50, 0x67, 0x37, 0xc0, 0x16, 0xc3, 0xe8, 0x31, 0x94, 0x28, 0xf6, 0x6d, 0x1c, 0xd4, 0xa4, 0xa6,
-	0xb4, 0x3e, 0xdd, 0x9a, 0x1f, 0x86, 0x4a, 0xe5, 0xd4, 0xf2, 0xba, 0x3b, 0xaa, 0xd8, 0x57, 0xcd,
-	0xb8, 0x00, 0xad, 0x40, 0x9e, 0x91, 0x5a, 0x9e, 0x97, 0x55, 0x86, 0xa1, 0x32, 0x2d, 0xca, 0x18,
-	0x51, 0xcd, 0x3c, 0x23, 0xe8, 0x39, 0x94, 0x2c, 0x8f, 0xf4, 0x7d, 0x56, 0x2b, 0x34, 0xa5, 0xf5,
-	0x99, 0xad, 0xba, 0x26, 0x8c, 0x68, 0x91, 0x11, 0x2d, 0x36, 0xa2, 0xed, 0x12, 0xd7, 0x6f, 0x55,
-	0xcf, 0x43, 0x25, 0x37, 0x12, 0x12, 0x6d, 0xaa, 0x19, 0xf7, 0xa3, 0xa7, 0x50, 0xe9, 0x92, 0xce,
-	0xdb, 0x03, 0xbb, 0x1f, 0xf0, 0x64, 0xb5, 0x62, 0x53, 0x5a, 0x2f, 0xb6, 0x6a, 0xc3, 0x50, 0x59,
-	0x14, 0x1d, 0x37, 0x60, 0xd5, 0x9c, 0x8d, 0xd6, 0x7b, 0xf1, 0x72, 0xa7, 0xfc, 0xe1, 0x4c, 0xc9,
-	0x5d, 0x9f, 0x29, 0x39, 0x75, 0x1f, 0xe6, 0xd3, 0xa4, 0x26, 0xa6, 0x3d, 0xe2, 0x53, 0x8c, 0x16,
-	0x60, 0x6a, 0x80, 0x0f, 0x5c, 0x5b, 0x04, 0x36, 0x8b, 0x03, 0xbc, 0x6f, 0x23, 0x05, 0x66, 0xfa,
-	0x3e, 0x67, 0x65, 0xae, 0x87, 0x79, 0xc8, 0xa2, 0x09, 0x62, 0xeb, 0x85, 0xeb, 0x61, 0xf5, 0x9b,
-	0x04, 0x60, 0x50, 0x67, 0x0f, 0xf7, 0x08, 0x75, 0xd9, 0x6d, 0xc6, 0xb6, 0x96, 0xe8, 0x89, 0xc9,
-	0xcd, 0x0d, 0x43, 0x65, 0x56, 0x54, 0xf2, 0x6d, 0x35, 0x76, 0x70, 0x67, 0xe3, 0x1b, 0xcb, 0xbf,
-	0x08, 0x68, 0xe4, 0x39, 0x19, 0x80, 0xfa, 0x55, 0x82, 0x8a, 0x41, 0x9d, 0x67, 0x27, 0x0c, 0xfb,
-	0x76, 0x14, 0xee, 0x3f, 0xa4, 0xc9, 0x7c, 0xc2, 0xc2, 0x3f, 0x7e, 0xc2, 0x25, 0xa8, 0xde, 0xf0,
-	0x9a, 0xa6, 0xf8, 0x2c, 0x41, 0xd9, 0xa0, 0x8e, 0x81, 0x03, 0xe7, 0x56, 0x01, 0xb6, 0x01, 0x0e,
-	0x03, 0xe2, 0x1d, 0x8c, 0xa7, 0xa8, 0x0e, 0x43, 0x65, 0x5e, 0x94, 0x8f, 0x30, 0xd5, 0x2c, 0x47,
-	0x8b, 0x97, 0x51, 0x9c, 0x0d, 0x28, 0x33, 0x12, 0xb7, 0x14, 0x78, 0xcb, 0xc2, 0x30, 0x54, 0xee,
-	0x27, 0x17, 0x20, 0x69, 0x28, 0x31, 0x12, 0x95, 0x8f, 0xd9, 0x47, 0x30, 0x97, 0x98, 0x4c, 0x9d,
-	0xbb, 0x30, 0x63, 0x50, 0xe7, 0x95, 0xcb, 0x8e, 0xec, 0xc0, 0x3a, 0xbe, 0xfb, 0xe1, 0x8f, 0xc9,
-	0x57, 0x61, 0x61, 0x4c, 0x2a, 0x71, 0xb0, 0xf5, 0xa5, 0x08, 0x05, 0x83, 0x3a, 0xe8, 0x10, 0x4a,
-	0xf1, 0x33, 0x50, 0xd3, 0x6e, 0x3c, 0x3c, 0x5a, 0x7a, 0x6d, 0xe4, 0xe6, 0x24, 0x24, 0xcd, 0xd3,
-	0x7c, 0xff, 0xfd, 0xd7, 0xa7, 0xbc, 0x8c, 0x6a, 0xfa, 0x9f, 0x8f, 0x9a, 0xde, 0x11, 0xec, 0x6f,
-	0xe0, 0x5e, 0x72, 0x71, 0xea, 0x59, 0xba, 0x18, 0x92, 0x57, 0x27, 0x42, 0xa9, 0xd4, 0x2a, 0x97,
-	0x7a, 0x88, 0xea, 0x59, 0x29, 0x3b, 0x16, 0x38, 0x06, 0x18, 0x3b, 0xd9, 0xcb, 0x59, 0xce, 0x11,
-	0x2a, 0x3f, 0xfa, 0x1b, 0x9a, 0x8a, 0xae, 0x71, 0x51, 0x05, 0xad, 0x64, 0x45, 0x31, 0xaf, 0xe6,
-	0x6f, 0x06, 0x6a, 0xc3, 0x94, 0x38, 0x8c, 0x4b, 0x59, 0x56, 0x0e, 0xc8, 0xca, 0x04, 0x20, 0x55,
-	0x52, 0xb8, 0x52, 0x1d, 0x2d, 0x65, 0x95, 0x3c, 0x4e, 0xed, 0x43, 0x39, 0x3d, 0x37, 0x72, 0x96,
-	0x2d, 0xc1, 0x64, 0x75, 0x32, 0x96, 0x8a, 0xa9, 0x5c, 0x6c, 0x19, 0xc9, 0x59, 0xb1, 0xe3, 0xb8,
-	0xb6, 0xb5, 0x77, 0x7e, 0xd9, 0x90, 0x2e, 0x2e, 0x1b, 0xd2, 0xcf, 0xcb, 0x86, 0xf4, 0xf1, 0xaa,
-	0x91, 0xbb, 0xb8, 0x6a, 0xe4, 0x7e, 0x5c, 0x35, 0x72, 0xaf, 0x9f, 0x38, 0x2e, 0x3b, 0xea, 0xb7,
-	0xb5, 0x0e, 0xf1, 0x92, 0xfe, 0x8d, 0x77, 0xc4, 0xc7, 0x29, 0xd9, 0x49, 0x44, 0xc7, 0x4e, 0x7b,
-	0x98, 0xb6, 0x4b, 0xfc, 0x8f, 0x67, 0xfb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0xb3, 0xfe,
-	0x0e, 0xf5, 0x06, 0x00, 0x00,
+type MsgLockPermanent struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgLockPermanent) Reset()         { *m = MsgLockPermanent{} }
+func (m *MsgLockPermanent) String() string { return proto.CompactTextString(m) }
+func (*MsgLockPermanent) ProtoMessage()    {}
+func (*MsgLockPermanent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{10}
+}
+func (m *MsgLockPermanent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockPermanent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockPermanent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockPermanent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockPermanent.Merge(m, src)
+}
+func (m *MsgLockPermanent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockPermanent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockPermanent.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgLockPermanent proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Create creates a veNFT.
-	Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error)
-	// Deposit deposits some coin amount for a veNFT.
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	// ExtendTime extends locking duration for a veNFT.
-	ExtendTime(ctx context.Context, in *MsgExtendTime, opts ...grpc.CallOption) (*MsgExtendTimeResponse, error)
-	// Merge merges a veNFT (burn it) to another veNFT.
-	Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error)
-	// Withdraw withdraws all coin amount of a veNFT.
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+type MsgLockPermanentResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgLockPermanentResponse) Reset()         { *m = MsgLockPermanentResponse{} }
+func (m *MsgLockPermanentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockPermanentResponse) ProtoMessage()    {}
+func (*MsgLockPermanentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{11}
+}
+func (m *MsgLockPermanentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockPermanentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockPermanentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockPermanentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockPermanentResponse.Merge(m, src)
+}
+func (m *MsgLockPermanentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockPermanentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockPermanentResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgLockPermanentResponse proto.InternalMessageInfo
+
+type MsgUnlockPermanent struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-func (c *msgClient) Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error) {
-	out := new(MsgCreateResponse)
-	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnlockPermanent) Reset()         { *m = MsgUnlockPermanent{} }
+func (m *MsgUnlockPermanent) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockPermanent) ProtoMessage()    {}
+func (*MsgUnlockPermanent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{12}
+}
+func (m *MsgUnlockPermanent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockPermanent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockPermanent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnlockPermanent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockPermanent.Merge(m, src)
+}
+func (m *MsgUnlockPermanent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockPermanent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockPermanent.DiscardUnknown(m)
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUnlockPermanent proto.InternalMessageInfo
+
+type MsgUnlockPermanentResponse struct {
 }
 
-func (c *msgClient) ExtendTime(ctx context.Context, in *MsgExtendTime, opts ...grpc.CallOption) (*MsgExtendTimeResponse, error) {
-	out := new(MsgExtendTimeResponse)
-	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/ExtendTime", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnlockPermanentResponse) Reset()         { *m = MsgUnlockPermanentResponse{} }
+func (m *MsgUnlockPermanentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockPermanentResponse) ProtoMessage()    {}
+func (*MsgUnlockPermanentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{13}
+}
+func (m *MsgUnlockPermanentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockPermanentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockPermanentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnlockPermanentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockPermanentResponse.Merge(m, src)
+}
+func (m *MsgUnlockPermanentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockPermanentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockPermanentResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error) {
-	out := new(MsgMergeResponse)
-	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Merge", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUnlockPermanentResponse proto.InternalMessageInfo
+
+type MsgDelegateVeVoting struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
+	// Delegate authorized to vote for the veNFT, until its unlocking time
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty" yaml:"delegate"`
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateVeVoting) Reset()         { *m = MsgDelegateVeVoting{} }
+func (m *MsgDelegateVeVoting) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVeVoting) ProtoMessage()    {}
+func (*MsgDelegateVeVoting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{14}
+}
+func (m *MsgDelegateVeVoting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVeVoting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVeVoting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Create creates a veNFT.
-	Create(context.Context, *MsgCreate) (*MsgCreateResponse, error)
-	// Deposit deposits some coin amount for a veNFT.
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	// ExtendTime extends locking duration for a veNFT.
-	ExtendTime(context.Context, *MsgExtendTime) (*MsgExtendTimeResponse, error)
-	// Merge merges a veNFT (burn it) to another veNFT.
-	Merge(context.Context, *MsgMerge) (*MsgMergeResponse, error)
-	// Withdraw withdraws all coin amount of a veNFT.
-	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+func (m *MsgDelegateVeVoting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVeVoting.Merge(m, src)
+}
+func (m *MsgDelegateVeVoting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVeVoting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVeVoting.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgDelegateVeVoting proto.InternalMessageInfo
+
+type MsgDelegateVeVotingResponse struct {
 }
 
-func (*UnimplementedMsgServer) Create(ctx context.Context, req *MsgCreate) (*MsgCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func (m *MsgDelegateVeVotingResponse) Reset()         { *m = MsgDelegateVeVotingResponse{} }
+func (m *MsgDelegateVeVotingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVeVotingResponse) ProtoMessage()    {}
+func (*MsgDelegateVeVotingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{15}
 }
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgDelegateVeVotingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ExtendTime(ctx context.Context, req *MsgExtendTime) (*MsgExtendTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtendTime not implemented")
+func (m *MsgDelegateVeVotingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVeVotingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Merge(ctx context.Context, req *MsgMerge) (*MsgMergeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Merge not implemented")
+func (m *MsgDelegateVeVotingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVeVotingResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+func (m *MsgDelegateVeVotingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVeVotingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVeVotingResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgDelegateVeVotingResponse proto.InternalMessageInfo
+
+type MsgRevokeVeVoting struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-func _Msg_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gridiron.ve.v1.Msg/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Create(ctx, req.(*MsgCreate))
+func (m *MsgRevokeVeVoting) Reset()         { *m = MsgRevokeVeVoting{} }
+func (m *MsgRevokeVeVoting) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVeVoting) ProtoMessage()    {}
+func (*MsgRevokeVeVoting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{16}
+}
+func (m *MsgRevokeVeVoting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVeVoting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVeVoting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gridiron.ve.v1.Msg/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRevokeVeVoting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVeVoting.Merge(m, src)
 }
-
-func _Msg_ExtendTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExtendTime)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExtendTime(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gridiron.ve.v1.Msg/ExtendTime",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExtendTime(ctx, req.(*MsgExtendTime))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRevokeVeVoting) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMerge)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Merge(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gridiron.ve.v1.Msg/Merge",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Merge(ctx, req.(*MsgMerge))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRevokeVeVoting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVeVoting.DiscardUnknown(m)
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gridiron.ve.v1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgRevokeVeVoting proto.InternalMessageInfo
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gridiron.ve.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _Msg_Create_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "ExtendTime",
-			Handler:    _Msg_ExtendTime_Handler,
-		},
-		{
-			MethodName: "Merge",
-			Handler:    _Msg_Merge_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gridiron/ve/v1/tx.proto",
+type MsgRevokeVeVotingResponse struct {
 }
 
-func (m *MsgCreate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeVeVotingResponse) Reset()         { *m = MsgRevokeVeVotingResponse{} }
+func (m *MsgRevokeVeVotingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVeVotingResponse) ProtoMessage()    {}
+func (*MsgRevokeVeVotingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{17}
 }
-
-func (m *MsgCreate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeVeVotingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRevokeVeVotingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVeVotingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeVeVotingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVeVotingResponse.Merge(m, src)
 }
-
-func (m *MsgCreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeVeVotingResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnlockTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnlockTime))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRevokeVeVotingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVeVotingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRevokeVeVotingResponse proto.InternalMessageInfo
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdraw struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
+func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdraw) ProtoMessage()    {}
+func (*MsgWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{18}
+}
+func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdraw.Merge(m, src)
 }
-
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdraw.DiscardUnknown(m)
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgWithdraw proto.InternalMessageInfo
+
+type MsgWithdrawResponse struct {
 }
 
-func (m *MsgExtendTime) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
+func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawResponse) ProtoMessage()    {}
+func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{19}
+}
+func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawResponse.Merge(m, src)
+}
+func (m *MsgWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtendTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawResponse proto.InternalMessageInfo
+
+type MsgEarlyWithdraw struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	VeId   string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty" yaml:"ve_id"`
 }
 
-func (m *MsgExtendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
-		i--
-		dAtA[i] = 0x18
+func (m *MsgEarlyWithdraw) Reset()         { *m = MsgEarlyWithdraw{} }
+func (m *MsgEarlyWithdraw) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyWithdraw) ProtoMessage()    {}
+func (*MsgEarlyWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{20}
+}
+func (m *MsgEarlyWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEarlyWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgEarlyWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyWithdraw.Merge(m, src)
+}
+func (m *MsgEarlyWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEarlyWithdraw proto.InternalMessageInfo
+
+type MsgEarlyWithdrawResponse struct {
+	// amount withdrawn to the sender
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// penalty distributed to the remaining ve holders
+	Penalty types.Coin `protobuf:"bytes,2,opt,name=penalty,proto3" json:"penalty"`
+}
+
+func (m *MsgEarlyWithdrawResponse) Reset()         { *m = MsgEarlyWithdrawResponse{} }
+func (m *MsgEarlyWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyWithdrawResponse) ProtoMessage()    {}
+func (*MsgEarlyWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7eb0badc4e133e0, []int{21}
+}
+func (m *MsgEarlyWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEarlyWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgEarlyWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyWithdrawResponse.Merge(m, src)
+}
+func (m *MsgEarlyWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEarlyWithdrawResponse proto.InternalMessageInfo
+
+func (m *MsgEarlyWithdrawResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgExtendTimeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgEarlyWithdrawResponse) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgExtendTimeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgCreate)(nil), "gridiron.ve.v1.MsgCreate")
+	proto.RegisterType((*MsgCreateResponse)(nil), "gridiron.ve.v1.MsgCreateResponse")
+	proto.RegisterType((*MsgDeposit)(nil), "gridiron.ve.v1.MsgDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "gridiron.ve.v1.MsgDepositResponse")
+	proto.RegisterType((*MsgExtendTime)(nil), "gridiron.ve.v1.MsgExtendTime")
+	proto.RegisterType((*MsgExtendTimeResponse)(nil), "gridiron.ve.v1.MsgExtendTimeResponse")
+	proto.RegisterType((*MsgMerge)(nil), "gridiron.ve.v1.MsgMerge")
+	proto.RegisterType((*MsgMergeResponse)(nil), "gridiron.ve.v1.MsgMergeResponse")
+	proto.RegisterType((*MsgSplit)(nil), "gridiron.ve.v1.MsgSplit")
+	proto.RegisterType((*MsgSplitResponse)(nil), "gridiron.ve.v1.MsgSplitResponse")
+	proto.RegisterType((*MsgLockPermanent)(nil), "gridiron.ve.v1.MsgLockPermanent")
+	proto.RegisterType((*MsgLockPermanentResponse)(nil), "gridiron.ve.v1.MsgLockPermanentResponse")
+	proto.RegisterType((*MsgUnlockPermanent)(nil), "gridiron.ve.v1.MsgUnlockPermanent")
+	proto.RegisterType((*MsgUnlockPermanentResponse)(nil), "gridiron.ve.v1.MsgUnlockPermanentResponse")
+	proto.RegisterType((*MsgDelegateVeVoting)(nil), "gridiron.ve.v1.MsgDelegateVeVoting")
+	proto.RegisterType((*MsgDelegateVeVotingResponse)(nil), "gridiron.ve.v1.MsgDelegateVeVotingResponse")
+	proto.RegisterType((*MsgRevokeVeVoting)(nil), "gridiron.ve.v1.MsgRevokeVeVoting")
+	proto.RegisterType((*MsgRevokeVeVotingResponse)(nil), "gridiron.ve.v1.MsgRevokeVeVotingResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "gridiron.ve.v1.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "gridiron.ve.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgEarlyWithdraw)(nil), "gridiron.ve.v1.MsgEarlyWithdraw")
+	proto.RegisterType((*MsgEarlyWithdrawResponse)(nil), "gridiron.ve.v1.MsgEarlyWithdrawResponse")
 }
 
-func (m *MsgExtendTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("gridiron/ve/v1/tx.proto", fileDescriptor_a7eb0badc4e133e0) }
+
+var fileDescriptor_a7eb0badc4e133e0 = []byte{
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x4f, 0xdc, 0xc6,
+	0x1b, 0x5e, 0xb3, 0xb0, 0x2c, 0x2f, 0x7f, 0x02, 0x03, 0x28, 0xc6, 0xc0, 0x7a, 0x31, 0x3f, 0xa2,
+	0x25, 0xf9, 0x61, 0x8b, 0xe4, 0x50, 0x35, 0x52, 0xa5, 0x8a, 0x24, 0x55, 0x91, 0xba, 0x52, 0xe5,
+	0xb6, 0x54, 0xca, 0x05, 0x99, 0xdd, 0xa9, 0x63, 0xb1, 0x9e, 0xd9, 0x7a, 0x86, 0x0d, 0xe4, 0xd8,
+	0x43, 0xd4, 0x53, 0x54, 0xa9, 0x5f, 0x20, 0x6a, 0x6f, 0x3d, 0xf6, 0x23, 0xf4, 0x94, 0x63, 0xa4,
+	0x5e, 0xda, 0x1e, 0x56, 0x15, 0xf4, 0x90, 0xf3, 0x7e, 0x82, 0xca, 0x33, 0xf6, 0xe0, 0x65, 0x4d,
+	0x20, 0x55, 0xb7, 0x27, 0xb0, 0x9f, 0x67, 0xde, 0xe7, 0x79, 0xc7, 0xef, 0xbc, 0xef, 0x2c, 0xdc,
+	0xf4, 0xa3, 0xa0, 0x19, 0x44, 0x94, 0x38, 0x1d, 0xec, 0x74, 0xb6, 0x1d, 0x7e, 0x6c, 0xb7, 0x23,
+	0xca, 0x29, 0x9a, 0x49, 0x01, 0xbb, 0x83, 0xed, 0xce, 0xb6, 0xb1, 0xe0, 0x53, 0x9f, 0x0a, 0xc8,
+	0x89, 0xff, 0x93, 0x2c, 0x63, 0xc5, 0xa7, 0xd4, 0x6f, 0x61, 0xc7, 0x6b, 0x07, 0x8e, 0x47, 0x08,
+	0xe5, 0x1e, 0x0f, 0x28, 0x61, 0x09, 0x5a, 0x69, 0x50, 0x16, 0x52, 0xe6, 0x1c, 0x78, 0x2c, 0x0e,
+	0x7e, 0x80, 0xb9, 0xb7, 0xed, 0x34, 0x68, 0x40, 0x24, 0x6e, 0xbd, 0xd1, 0x60, 0xa2, 0xce, 0xfc,
+	0x07, 0x11, 0xf6, 0x38, 0x46, 0x9b, 0x50, 0x62, 0x98, 0x34, 0x71, 0xa4, 0x6b, 0x55, 0xad, 0x36,
+	0xb1, 0x33, 0xd7, 0xeb, 0x9a, 0xd3, 0x27, 0x5e, 0xd8, 0xba, 0x6f, 0xc9, 0xf7, 0x96, 0x9b, 0x10,
+	0xd0, 0x2a, 0x8c, 0x70, 0xaa, 0x8f, 0x08, 0xda, 0x74, 0xaf, 0x6b, 0x4e, 0x48, 0x1a, 0xa7, 0x96,
+	0x3b, 0xc2, 0x29, 0xfa, 0x18, 0x4a, 0x5e, 0x48, 0x8f, 0x08, 0xd7, 0x8b, 0x55, 0xad, 0x36, 0x79,
+	0x77, 0xc9, 0x96, 0x46, 0xec, 0xd8, 0x88, 0x9d, 0x18, 0xb1, 0x1f, 0xd0, 0x80, 0xec, 0x2c, 0xbe,
+	0xea, 0x9a, 0x85, 0x73, 0x21, 0xb9, 0xcc, 0x72, 0x93, 0xf5, 0xe8, 0x03, 0x98, 0x6e, 0xd1, 0xc6,
+	0xe1, 0x7e, 0xf3, 0x28, 0x12, 0x99, 0xe9, 0xa3, 0x55, 0xad, 0x36, 0xba, 0xa3, 0xf7, 0xba, 0xe6,
+	0x82, 0x5c, 0xd1, 0x07, 0x5b, 0xee, 0x54, 0xfc, 0xfc, 0x30, 0x79, 0xbc, 0x5f, 0xfe, 0xf6, 0xa5,
+	0x59, 0x78, 0xf3, 0xd2, 0x2c, 0x58, 0xbb, 0x30, 0xa7, 0x32, 0x75, 0x31, 0x6b, 0x53, 0xc2, 0x30,
+	0x9a, 0x87, 0xb1, 0x0e, 0xde, 0x0f, 0x9a, 0x32, 0x61, 0x77, 0xb4, 0x83, 0x77, 0x9b, 0xc8, 0x84,
+	0xc9, 0x23, 0x22, 0xa2, 0xf2, 0x20, 0xc4, 0x22, 0xc9, 0x51, 0x17, 0xe4, 0xab, 0xcf, 0x83, 0x10,
+	0x5b, 0x3f, 0x6b, 0x00, 0x75, 0xe6, 0x3f, 0xc4, 0x6d, 0xca, 0x02, 0xfe, 0x2e, 0xdb, 0xb6, 0x91,
+	0xea, 0xc9, 0x9d, 0x9b, 0xed, 0x75, 0xcd, 0x29, 0xc9, 0x14, 0xaf, 0xad, 0xc4, 0xc1, 0xbf, 0xb6,
+	0x7d, 0x99, 0xfc, 0x17, 0x00, 0x9d, 0x7b, 0x4e, 0x37, 0xc0, 0xfa, 0x49, 0x83, 0xe9, 0x3a, 0xf3,
+	0x1f, 0x1d, 0x73, 0x4c, 0x9a, 0x71, 0x72, 0x43, 0xc8, 0x66, 0xe0, 0x13, 0x16, 0xff, 0xe1, 0x27,
+	0xbc, 0x09, 0x8b, 0x7d, 0x5e, 0x55, 0x16, 0x3f, 0x6a, 0x50, 0xae, 0x33, 0xbf, 0x8e, 0x23, 0xff,
+	0x9d, 0x12, 0xb8, 0x07, 0xf0, 0x55, 0x44, 0xc3, 0xfd, 0x6c, 0x16, 0x8b, 0xbd, 0xae, 0x39, 0x27,
+	0xe9, 0xe7, 0x98, 0xe5, 0x96, 0xe3, 0x87, 0xbd, 0x38, 0x9d, 0x2d, 0x28, 0x73, 0x9a, 0x2c, 0x29,
+	0x8a, 0x25, 0xf3, 0xbd, 0xae, 0x79, 0x23, 0x3d, 0x00, 0xe9, 0x82, 0x12, 0xa7, 0x31, 0x3d, 0x63,
+	0x1f, 0xc1, 0x6c, 0x6a, 0x52, 0x39, 0xff, 0x45, 0x3a, 0xff, 0xac, 0xdd, 0x1a, 0x4a, 0x21, 0x3d,
+	0x86, 0x71, 0x59, 0x08, 0x4c, 0x2f, 0x56, 0x8b, 0xb5, 0x89, 0x9d, 0x0f, 0xe3, 0x72, 0xf9, 0xa3,
+	0x6b, 0xde, 0xf2, 0x03, 0xfe, 0xe4, 0xe8, 0xc0, 0x6e, 0xd0, 0xd0, 0x49, 0x7a, 0x84, 0xfc, 0xb3,
+	0xc5, 0x9a, 0x87, 0x0e, 0x3f, 0x69, 0x63, 0x66, 0xef, 0x12, 0xde, 0xeb, 0x9a, 0x33, 0xd9, 0xc2,
+	0x62, 0x96, 0x9b, 0x06, 0xcc, 0x24, 0xb6, 0x09, 0xb3, 0x69, 0x0e, 0xea, 0x64, 0x2d, 0x42, 0x49,
+	0x38, 0x61, 0xba, 0x16, 0x0b, 0xbb, 0x63, 0xb1, 0x1f, 0x66, 0x11, 0x41, 0xfd, 0x84, 0x36, 0x0e,
+	0x3f, 0xc5, 0x51, 0xe8, 0x11, 0x4c, 0x86, 0x90, 0x76, 0xc6, 0x9a, 0x01, 0xfa, 0x45, 0x3d, 0xb5,
+	0xf7, 0x6d, 0x71, 0x22, 0xbe, 0x20, 0xad, 0x2c, 0x3a, 0x54, 0x37, 0x2b, 0x60, 0x0c, 0x2a, 0x2a,
+	0x3f, 0x3f, 0x68, 0x30, 0x2f, 0x8e, 0x68, 0x0b, 0xfb, 0x1e, 0xc7, 0x7b, 0x78, 0x8f, 0xf2, 0x80,
+	0xf8, 0x43, 0x28, 0x0b, 0x07, 0xca, 0xcd, 0x44, 0x65, 0xb0, 0x84, 0x53, 0xc4, 0x72, 0x15, 0x29,
+	0x93, 0xc2, 0x2a, 0x2c, 0xe7, 0x78, 0x54, 0x39, 0x50, 0xd1, 0x65, 0x5d, 0xdc, 0xa1, 0x87, 0x43,
+	0x4c, 0x20, 0xe3, 0x67, 0x19, 0x96, 0x06, 0x04, 0x95, 0x9b, 0x00, 0x26, 0xeb, 0xcc, 0xff, 0x32,
+	0xe0, 0x4f, 0x9a, 0x91, 0xf7, 0x74, 0xa8, 0x3e, 0x16, 0x61, 0x3e, 0x23, 0xa5, 0x1c, 0xc8, 0x7a,
+	0x7f, 0xe4, 0x45, 0xad, 0x93, 0xff, 0xc4, 0xc6, 0x0b, 0x0d, 0xf4, 0x8b, 0x82, 0xea, 0x4c, 0xbe,
+	0xa7, 0xc6, 0x8a, 0x76, 0xd5, 0x58, 0x19, 0x8d, 0xfb, 0x84, 0x1a, 0xc2, 0xef, 0xc3, 0x78, 0x1b,
+	0x13, 0xaf, 0xc5, 0x4f, 0xf4, 0x91, 0xeb, 0xad, 0x4c, 0xf9, 0x77, 0x7f, 0x07, 0x28, 0xd6, 0x99,
+	0x8f, 0x02, 0x28, 0x25, 0xb7, 0x8c, 0x25, 0xbb, 0xff, 0x62, 0x63, 0xab, 0xb1, 0x6c, 0xac, 0x5d,
+	0x0a, 0xa9, 0x0d, 0x5d, 0xfb, 0xe6, 0xd7, 0xbf, 0xbe, 0x1f, 0x59, 0x46, 0x4b, 0xce, 0xc0, 0xbd,
+	0xc9, 0x69, 0x48, 0x81, 0x10, 0xc6, 0xd3, 0xd1, 0x6c, 0xe4, 0x04, 0x4c, 0x30, 0xc3, 0xba, 0x1c,
+	0x53, 0x6a, 0x96, 0x50, 0x5b, 0x41, 0x46, 0x8e, 0x5a, 0x33, 0xd1, 0x78, 0x06, 0x90, 0x19, 0x9f,
+	0xab, 0x39, 0x51, 0xcf, 0x61, 0x63, 0xe3, 0xad, 0xb0, 0xd2, 0xbd, 0x25, 0x74, 0xab, 0xa8, 0x92,
+	0xa3, 0x8b, 0x05, 0x5d, 0xdc, 0x4d, 0x10, 0x86, 0x31, 0x39, 0xf4, 0xf4, 0x9c, 0xb8, 0x02, 0x31,
+	0xaa, 0x97, 0x21, 0x4a, 0xac, 0x2a, 0xc4, 0x0c, 0xa4, 0xe7, 0x88, 0x85, 0x22, 0x3a, 0x86, 0x31,
+	0x39, 0xa1, 0xf2, 0x64, 0x04, 0x62, 0x54, 0x2f, 0x43, 0xae, 0x25, 0xc3, 0x44, 0xf4, 0xe7, 0x1a,
+	0x4c, 0xf7, 0x8f, 0x86, 0xbc, 0xa8, 0x7d, 0x0c, 0xa3, 0x76, 0x15, 0x43, 0xe9, 0x6f, 0x0a, 0xfd,
+	0x75, 0xb4, 0x96, 0xa3, 0x2f, 0x2e, 0x20, 0x6d, 0x25, 0xfb, 0x42, 0x83, 0x1b, 0x17, 0xe7, 0x42,
+	0x5e, 0xb9, 0x5c, 0xe0, 0x18, 0xb7, 0xaf, 0xe6, 0x28, 0x3b, 0x77, 0x84, 0x9d, 0x0d, 0xb4, 0x9e,
+	0x63, 0xe7, 0x88, 0x0c, 0x1a, 0x9a, 0x51, 0x3d, 0x57, 0x36, 0xd5, 0xf5, 0xdc, 0xf2, 0xed, 0x6f,
+	0xcb, 0xc6, 0x9d, 0x6b, 0x90, 0x94, 0xa3, 0xdb, 0xc2, 0xd1, 0xff, 0x90, 0x95, 0x5b, 0xec, 0x72,
+	0xd1, 0x7e, 0x47, 0xaa, 0x3f, 0xd7, 0x60, 0x2a, 0x69, 0xba, 0xf2, 0x45, 0xde, 0xd1, 0xed, 0xef,
+	0xca, 0xc6, 0xe6, 0x95, 0x14, 0x65, 0xa5, 0x26, 0xac, 0x58, 0xa8, 0x9a, 0x63, 0x25, 0x12, 0x4b,
+	0x52, 0x23, 0x5f, 0x43, 0x59, 0x35, 0xd6, 0xe5, 0x1c, 0x81, 0x14, 0x34, 0xd6, 0xdf, 0x02, 0x2a,
+	0xdd, 0x75, 0xa1, 0xbb, 0x8a, 0x96, 0x73, 0x74, 0x9f, 0xa6, 0x32, 0x71, 0x99, 0xf6, 0x77, 0xf4,
+	0xbc, 0x32, 0xed, 0x63, 0x18, 0xb5, 0xab, 0x18, 0xd7, 0x2a, 0x53, 0x1c, 0xaf, 0xd8, 0x4f, 0x8d,
+	0xec, 0x7c, 0xf4, 0xea, 0xb4, 0xa2, 0xbd, 0x3e, 0xad, 0x68, 0x7f, 0x9e, 0x56, 0xb4, 0xef, 0xce,
+	0x2a, 0x85, 0xd7, 0x67, 0x95, 0xc2, 0x6f, 0x67, 0x95, 0xc2, 0xe3, 0xff, 0x67, 0xae, 0x77, 0x69,
+	0x98, 0xad, 0x67, 0x94, 0xe0, 0xf3, 0xa0, 0xc7, 0x71, 0x58, 0x71, 0xd1, 0x3b, 0x28, 0x89, 0x1f,
+	0x83, 0xf7, 0xfe, 0x1e, 0x00, 0x26, 0x9b, 0x6d, 0x9f, 0x8b, 0x0e, 0x00, 0x00,
 }
 
-func (m *MsgMerge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Create creates a veNFT.
+	Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error)
+	// Deposit deposits some coin amount for a veNFT.
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// ExtendTime extends locking duration for a veNFT.
+	ExtendTime(ctx context.Context, in *MsgExtendTime, opts ...grpc.CallOption) (*MsgExtendTimeResponse, error)
+	// Merge merges a veNFT (burn it) to another veNFT.
+	Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error)
+	// Split splits some locked amounts of a veNFT into new veNFTs.
+	Split(ctx context.Context, in *MsgSplit, opts ...grpc.CallOption) (*MsgSplitResponse, error)
+	// LockPermanent permanently locks a veNFT, so that its voting power will
+	// not decay.
+	LockPermanent(ctx context.Context, in *MsgLockPermanent, opts ...grpc.CallOption) (*MsgLockPermanentResponse, error)
+	// UnlockPermanent unlocks a permanently locked veNFT, so that its voting
+	// power will decay from the maximum lock time on.
+	UnlockPermanent(ctx context.Context, in *MsgUnlockPermanent, opts ...grpc.CallOption) (*MsgUnlockPermanentResponse, error)
+	// DelegateVoting authorizes a delegate to vote for a veNFT.
+	DelegateVoting(ctx context.Context, in *MsgDelegateVeVoting, opts ...grpc.CallOption) (*MsgDelegateVeVotingResponse, error)
+	// RevokeVoting revokes the voting delegation of a veNFT.
+	RevokeVoting(ctx context.Context, in *MsgRevokeVeVoting, opts ...grpc.CallOption) (*MsgRevokeVeVotingResponse, error)
+	// Withdraw withdraws all coin amount of a veNFT.
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// EarlyWithdraw withdraws all coin amount of an unexpired veNFT, with a
+	// penalty proportional to the remaining locking time.
+	EarlyWithdraw(ctx context.Context, in *MsgEarlyWithdraw, opts ...grpc.CallOption) (*MsgEarlyWithdrawResponse, error)
 }
 
-func (m *MsgMerge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToVeId) > 0 {
-		i -= len(m.ToVeId)
-		copy(dAtA[i:], m.ToVeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToVeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FromVeId) > 0 {
-		i -= len(m.FromVeId)
-		copy(dAtA[i:], m.FromVeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromVeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error) {
+	out := new(MsgCreateResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMergeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Deposit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMergeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExtendTime(ctx context.Context, in *MsgExtendTime, opts ...grpc.CallOption) (*MsgExtendTimeResponse, error) {
+	out := new(MsgExtendTimeResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/ExtendTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) Merge(ctx context.Context, in *MsgMerge, opts ...grpc.CallOption) (*MsgMergeResponse, error) {
+	out := new(MsgMergeResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Merge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Split(ctx context.Context, in *MsgSplit, opts ...grpc.CallOption) (*MsgSplitResponse, error) {
+	out := new(MsgSplitResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Split", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LockPermanent(ctx context.Context, in *MsgLockPermanent, opts ...grpc.CallOption) (*MsgLockPermanentResponse, error) {
+	out := new(MsgLockPermanentResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/LockPermanent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UnlockPermanent(ctx context.Context, in *MsgUnlockPermanent, opts ...grpc.CallOption) (*MsgUnlockPermanentResponse, error) {
+	out := new(MsgUnlockPermanentResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/UnlockPermanent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DelegateVoting(ctx context.Context, in *MsgDelegateVeVoting, opts ...grpc.CallOption) (*MsgDelegateVeVotingResponse, error) {
+	out := new(MsgDelegateVeVotingResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/DelegateVoting", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokeVoting(ctx context.Context, in *MsgRevokeVeVoting, opts ...grpc.CallOption) (*MsgRevokeVeVotingResponse, error) {
+	out := new(MsgRevokeVeVotingResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/RevokeVoting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) EarlyWithdraw(ctx context.Context, in *MsgEarlyWithdraw, opts ...grpc.CallOption) (*MsgEarlyWithdrawResponse, error) {
+	out := new(MsgEarlyWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.ve.v1.Msg/EarlyWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreate) Size() (n int) {
-	if m == nil {
-		return 0
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Create creates a veNFT.
+	Create(context.Context, *MsgCreate) (*MsgCreateResponse, error)
+	// Deposit deposits some coin amount for a veNFT.
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// ExtendTime extends locking duration for a veNFT.
+	ExtendTime(context.Context, *MsgExtendTime) (*MsgExtendTimeResponse, error)
+	// Merge merges a veNFT (burn it) to another veNFT.
+	Merge(context.Context, *MsgMerge) (*MsgMergeResponse, error)
+	// Split splits some locked amounts of a veNFT into new veNFTs.
+	Split(context.Context, *MsgSplit) (*MsgSplitResponse, error)
+	// LockPermanent permanently locks a veNFT, so that its voting power will
+	// not decay.
+	LockPermanent(context.Context, *MsgLockPermanent) (*MsgLockPermanentResponse, error)
+	// UnlockPermanent unlocks a permanently locked veNFT, so that its voting
+	// power will decay from the maximum lock time on.
+	UnlockPermanent(context.Context, *MsgUnlockPermanent) (*MsgUnlockPermanentResponse, error)
+	// DelegateVoting authorizes a delegate to vote for a veNFT.
+	DelegateVoting(context.Context, *MsgDelegateVeVoting) (*MsgDelegateVeVotingResponse, error)
+	// RevokeVoting revokes the voting delegation of a veNFT.
+	RevokeVoting(context.Context, *MsgRevokeVeVoting) (*MsgRevokeVeVotingResponse, error)
+	// Withdraw withdraws all coin amount of a veNFT.
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// EarlyWithdraw withdraws all coin amount of an unexpired veNFT, with a
+	// penalty proportional to the remaining locking time.
+	EarlyWithdraw(context.Context, *MsgEarlyWithdraw) (*MsgEarlyWithdrawResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Create(ctx context.Context, req *MsgCreate) (*MsgCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) ExtendTime(ctx context.Context, req *MsgExtendTime) (*MsgExtendTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendTime not implemented")
+}
+func (*UnimplementedMsgServer) Merge(ctx context.Context, req *MsgMerge) (*MsgMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Merge not implemented")
+}
+func (*UnimplementedMsgServer) Split(ctx context.Context, req *MsgSplit) (*MsgSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Split not implemented")
+}
+func (*UnimplementedMsgServer) LockPermanent(ctx context.Context, req *MsgLockPermanent) (*MsgLockPermanentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockPermanent not implemented")
+}
+func (*UnimplementedMsgServer) UnlockPermanent(ctx context.Context, req *MsgUnlockPermanent) (*MsgUnlockPermanentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockPermanent not implemented")
+}
+func (*UnimplementedMsgServer) DelegateVoting(ctx context.Context, req *MsgDelegateVeVoting) (*MsgDelegateVeVotingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVoting not implemented")
+}
+func (*UnimplementedMsgServer) RevokeVoting(ctx context.Context, req *MsgRevokeVeVoting) (*MsgRevokeVeVotingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVoting not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) EarlyWithdraw(ctx context.Context, req *MsgEarlyWithdraw) (*MsgEarlyWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarlyWithdraw not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Create(ctx, in)
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/Create",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.LockDuration != 0 {
-		n += 1 + sovTx(uint64(m.LockDuration))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Create(ctx, req.(*MsgCreate))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
 	}
-	if m.UnlockTime != 0 {
-		n += 1 + sovTx(uint64(m.UnlockTime))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/Deposit",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExtendTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendTime)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendTime(ctx, in)
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/ExtendTime",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendTime(ctx, req.(*MsgExtendTime))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExtendTime) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMerge)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Merge(ctx, in)
 	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/Merge",
 	}
-	if m.LockDuration != 0 {
-		n += 1 + sovTx(uint64(m.LockDuration))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Merge(ctx, req.(*MsgMerge))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExtendTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Split_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).Split(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/Split",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Split(ctx, req.(*MsgSplit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMerge) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LockPermanent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockPermanent)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).LockPermanent(ctx, in)
 	}
-	l = len(m.FromVeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/LockPermanent",
 	}
-	l = len(m.ToVeId)
-	if l > 0 {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockPermanent(ctx, req.(*MsgLockPermanent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockPermanent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockPermanent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockPermanent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/UnlockPermanent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockPermanent(ctx, req.(*MsgUnlockPermanent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVoting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVeVoting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVoting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/DelegateVoting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVoting(ctx, req.(*MsgDelegateVeVoting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeVoting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVeVoting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeVoting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/RevokeVoting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeVoting(ctx, req.(*MsgRevokeVeVoting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EarlyWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEarlyWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EarlyWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.ve.v1.Msg/EarlyWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EarlyWithdraw(ctx, req.(*MsgEarlyWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gridiron.ve.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Msg_Create_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "ExtendTime",
+			Handler:    _Msg_ExtendTime_Handler,
+		},
+		{
+			MethodName: "Merge",
+			Handler:    _Msg_Merge_Handler,
+		},
+		{
+			MethodName: "Split",
+			Handler:    _Msg_Split_Handler,
+		},
+		{
+			MethodName: "LockPermanent",
+			Handler:    _Msg_LockPermanent_Handler,
+		},
+		{
+			MethodName: "UnlockPermanent",
+			Handler:    _Msg_UnlockPermanent_Handler,
+		},
+		{
+			MethodName: "DelegateVoting",
+			Handler:    _Msg_DelegateVoting_Handler,
+		},
+		{
+			MethodName: "RevokeVoting",
+			Handler:    _Msg_RevokeVoting_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "EarlyWithdraw",
+			Handler:    _Msg_EarlyWithdraw_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gridiron/ve/v1/tx.proto",
+}
+
+func (m *MsgCreate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMerge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMerge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToVeId) > 0 {
+		i -= len(m.ToVeId)
+		copy(dAtA[i:], m.ToVeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToVeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromVeId) > 0 {
+		i -= len(m.FromVeId)
+		copy(dAtA[i:], m.FromVeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromVeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amounts[iNdEx].Size()
+				i -= size
+				if _, err := m.Amounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeIds) > 0 {
+		for iNdEx := len(m.VeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VeIds[iNdEx])
+			copy(dAtA[i:], m.VeIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockPermanent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockPermanent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockPermanent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockPermanentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockPermanentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockPermanentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockPermanent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockPermanent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockPermanent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockPermanentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockPermanentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockPermanentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVeVoting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVeVoting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVeVoting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVeVotingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVeVotingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVeVotingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVeVoting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVeVoting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVeVoting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVeVotingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVeVotingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVeVotingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LockDuration != 0 {
+		n += 1 + sovTx(uint64(m.LockDuration))
+	}
+	return n
+}
+
+func (m *MsgCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovTx(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockDuration != 0 {
+		n += 1 + sovTx(uint64(m.LockDuration))
+	}
+	return n
+}
+
+func (m *MsgExtendTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMerge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromVeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToVeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VeIds) > 0 {
+		for _, s := range m.VeIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLockPermanent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockPermanentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlockPermanent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnlockPermanentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateVeVoting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVeVotingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeVeVoting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeVeVotingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEarlyWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgEarlyWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			m.LockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			m.LockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMerge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMerge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMergeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amounts = append(m.Amounts, v)
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgMergeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeIds = append(m.VeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+func (m *MsgLockPermanent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +3668,10 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockPermanent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockPermanent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1301,7 +3708,139 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockPermanentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockPermanentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockPermanentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockPermanent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockPermanent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockPermanent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1329,13 +3868,13 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1345,44 +3884,24 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
-			}
-			m.LockDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockDuration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1404,7 +3923,7 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockPermanentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1427,63 +3946,12 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockPermanentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockPermanentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
-			}
-			m.UnlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1505,7 +3973,7 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVeVoting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1528,10 +3996,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVeVoting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVeVoting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1600,9 +4068,9 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1612,24 +4080,23 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1652,7 +4119,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVeVotingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1675,10 +4142,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVeVotingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVeVotingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1702,7 +4169,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVeVoting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,10 +4192,10 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVeVoting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVeVoting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1795,25 +4262,6 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 			}
 			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
-			}
-			m.LockDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LockDuration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1835,7 +4283,7 @@ func (m *MsgExtendTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVeVotingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1858,10 +4306,10 @@ func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVeVotingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVeVotingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1885,7 +4333,7 @@ func (m *MsgExtendTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMerge) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,10 +4356,10 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMerge: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1948,39 +4396,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FromVeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2008,7 +4424,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToVeId = string(dAtA[iNdEx:postIndex])
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2031,7 +4447,7 @@ func (m *MsgMerge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2054,10 +4470,10 @@ func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMergeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2081,7 +4497,7 @@ func (m *MsgMergeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgEarlyWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,10 +4520,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEarlyWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEarlyWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2195,7 +4611,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEarlyWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,12 +4634,78 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEarlyWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEarlyWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -29,8 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type LockedBalance struct {
 	// locked amount
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	// unlocking unix time
+	// unlocking unix time, zero for permanent lock
 	End uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// whether permanently locked, i.e., voting power not decaying
+	Permanent bool `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"`
 }
 
 func (m *LockedBalance) Reset()         { *m = LockedBalance{} }
@@ -73,6 +75,13 @@ func (m *LockedBalance) GetEnd() uint64 {
 	return 0
 }
 
+func (m *LockedBalance) GetPermanent() bool {
+	if m != nil {
+		return m.Permanent
+	}
+	return false
+}
+
 // Checkpoint defines a checkpoint of voting power.
 type Checkpoint struct {
 	// voting power at checkpoint
@@ -84,6 +93,9 @@ type Checkpoint struct {
 	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	// block height at checkpoint
 	Block int64 `protobuf:"varint,4,opt,name=block,proto3" json:"block,omitempty"`
+	// non-decaying voting power of permanent locks
+	// so voting power at time t: bias - slope * (t - timestamp) + permanent
+	Permanent github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=permanent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"permanent"`
 }
 
 func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
@@ -133,35 +145,241 @@ func (m *Checkpoint) GetBlock() int64 {
 	return 0
 }
 
+// SlopeChange is the change of the decay slope of the total voting power at
+// an unlocking time, which is negative since the unlocked ves stop decaying.
+type SlopeChange struct {
+	Timestamp   uint64                                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SlopeChange github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=slope_change,json=slopeChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slope_change"`
+}
+
+func (m *SlopeChange) Reset()         { *m = SlopeChange{} }
+func (m *SlopeChange) String() string { return proto.CompactTextString(m) }
+func (*SlopeChange) ProtoMessage()    {}
+func (*SlopeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05643485793599a7, []int{2}
+}
+func (m *SlopeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlopeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlopeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlopeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlopeChange.Merge(m, src)
+}
+func (m *SlopeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlopeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlopeChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlopeChange proto.InternalMessageInfo
+
+func (m *SlopeChange) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// VotingDelegation represents the authorization of a delegate to vote for a
+// ve on behalf of its owner.
+type VotingDelegation struct {
+	VeId string `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	// delegate address
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// unlocking unix time of the ve, when the delegation expires,
+	// zero if the ve is permanently locked
+	Expiry uint64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (m *VotingDelegation) Reset()         { *m = VotingDelegation{} }
+func (m *VotingDelegation) String() string { return proto.CompactTextString(m) }
+func (*VotingDelegation) ProtoMessage()    {}
+func (*VotingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05643485793599a7, []int{3}
+}
+func (m *VotingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingDelegation.Merge(m, src)
+}
+func (m *VotingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingDelegation proto.InternalMessageInfo
+
+func (m *VotingDelegation) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *VotingDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *VotingDelegation) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+// VeNftMetadata is the data of a veNFT. Only the lock data is stored in the nft
+// module, refreshed whenever the lock is changed, while the voting power and
+// the attached/voted flags are rendered on queries.
+type VeNftMetadata struct {
+	// locked amount
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// unlocking unix time, zero for permanent lock
+	UnlockTime uint64 `protobuf:"varint,2,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// whether permanently locked
+	Permanent bool `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	// current voting power, rendered on queries
+	VotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power"`
+	// whether attached to gauges, rendered on queries
+	Attached bool `protobuf:"varint,5,opt,name=attached,proto3" json:"attached,omitempty"`
+	// whether voted for gauges, rendered on queries
+	Voted bool `protobuf:"varint,6,opt,name=voted,proto3" json:"voted,omitempty"`
+}
+
+func (m *VeNftMetadata) Reset()         { *m = VeNftMetadata{} }
+func (m *VeNftMetadata) String() string { return proto.CompactTextString(m) }
+func (*VeNftMetadata) ProtoMessage()    {}
+func (*VeNftMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05643485793599a7, []int{4}
+}
+func (m *VeNftMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeNftMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeNftMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeNftMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeNftMetadata.Merge(m, src)
+}
+func (m *VeNftMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeNftMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeNftMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeNftMetadata proto.InternalMessageInfo
+
+func (m *VeNftMetadata) GetUnlockTime() uint64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func (m *VeNftMetadata) GetPermanent() bool {
+	if m != nil {
+		return m.Permanent
+	}
+	return false
+}
+
+func (m *VeNftMetadata) GetAttached() bool {
+	if m != nil {
+		return m.Attached
+	}
+	return false
+}
+
+func (m *VeNftMetadata) GetVoted() bool {
+	if m != nil {
+		return m.Voted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LockedBalance)(nil), "gridiron.ve.v1.LockedBalance")
 	proto.RegisterType((*Checkpoint)(nil), "gridiron.ve.v1.Checkpoint")
+	proto.RegisterType((*SlopeChange)(nil), "gridiron.ve.v1.SlopeChange")
+	proto.RegisterType((*VotingDelegation)(nil), "gridiron.ve.v1.VotingDelegation")
+	proto.RegisterType((*VeNftMetadata)(nil), "gridiron.ve.v1.VeNftMetadata")
 }
 
 func init() { proto.RegisterFile("gridiron/ve/v1/ve.proto", fileDescriptor_05643485793599a7) }
 
 var fileDescriptor_05643485793599a7 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcb, 0x4a, 0x03, 0x31,
-	0x14, 0x86, 0x27, 0xf6, 0x02, 0x0d, 0x14, 0x24, 0x14, 0x19, 0x8a, 0xa4, 0xa5, 0x0b, 0x29, 0x42,
-	0x27, 0x14, 0xdf, 0x60, 0x2c, 0x82, 0xe0, 0xaa, 0x4b, 0x77, 0x99, 0xf4, 0xd0, 0x86, 0x99, 0xc9,
-	0x19, 0x9a, 0x74, 0x50, 0x9f, 0xc2, 0xc7, 0x2a, 0xae, 0xba, 0x14, 0x17, 0x45, 0x3a, 0x2f, 0x22,
-	0x73, 0x11, 0xdd, 0xea, 0x2a, 0xe7, 0x92, 0xef, 0xfc, 0x3f, 0xfc, 0xf4, 0x22, 0x85, 0x6d, 0xa2,
-	0xd1, 0x88, 0x1c, 0x44, 0x3e, 0x17, 0x39, 0x04, 0xd9, 0x16, 0x1d, 0xb2, 0x7e, 0x33, 0x0f, 0x72,
-	0x08, 0xf2, 0xf9, 0x70, 0xb0, 0xc6, 0x35, 0x56, 0x1b, 0x51, 0x56, 0xf5, 0xa7, 0x21, 0x57, 0x68,
-	0x53, 0xb4, 0x22, 0x92, 0xb6, 0xa4, 0x23, 0x70, 0x72, 0x2e, 0x14, 0x6a, 0x53, 0xef, 0x27, 0x9a,
-	0xf6, 0x1f, 0x50, 0xc5, 0xb0, 0x0a, 0x65, 0x22, 0x8d, 0x02, 0x76, 0x47, 0xbb, 0x32, 0xc5, 0x9d,
-	0x71, 0x3e, 0x19, 0x93, 0x69, 0x2f, 0x0c, 0xf6, 0xc7, 0x91, 0xf7, 0x71, 0x1c, 0x5d, 0xad, 0xb5,
-	0xdb, 0xec, 0xa2, 0x40, 0x61, 0x2a, 0x9a, 0x9b, 0xf5, 0x33, 0xb3, 0xab, 0x58, 0xb8, 0xe7, 0x0c,
-	0x6c, 0x70, 0x6f, 0xdc, 0xb2, 0xa1, 0xd9, 0x39, 0x6d, 0x81, 0x59, 0xf9, 0x67, 0x63, 0x32, 0x6d,
-	0x2f, 0xcb, 0x72, 0xf2, 0x46, 0x28, 0xbd, 0xdd, 0x80, 0x8a, 0x33, 0xd4, 0xc6, 0xb1, 0x90, 0xb6,
-	0x23, 0x2d, 0xed, 0x3f, 0x65, 0x2a, 0x96, 0x2d, 0x68, 0xc7, 0x26, 0x98, 0x41, 0x25, 0xf3, 0xf7,
-	0x23, 0x35, 0xcc, 0x2e, 0x69, 0xcf, 0xe9, 0x14, 0xac, 0x93, 0x69, 0xe6, 0xb7, 0x2a, 0xc3, 0x3f,
-	0x03, 0x36, 0xa0, 0x9d, 0x28, 0x41, 0x15, 0xfb, 0xed, 0x31, 0x99, 0xb6, 0x96, 0x75, 0x13, 0x2e,
-	0xf6, 0x27, 0x4e, 0x0e, 0x27, 0x4e, 0x3e, 0x4f, 0x9c, 0xbc, 0x16, 0xdc, 0x3b, 0x14, 0xdc, 0x7b,
-	0x2f, 0xb8, 0xf7, 0x78, 0xfd, 0x4b, 0xbc, 0x49, 0x68, 0xf6, 0x82, 0x06, 0xbe, 0x1b, 0xf1, 0x54,
-	0x06, 0x59, 0x99, 0x88, 0xba, 0x55, 0x08, 0x37, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x6b,
-	0x0d, 0x12, 0xe3, 0x01, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xaf, 0xdb, 0xb4, 0xea, 0x5c, 0x86, 0x26, 0x33, 0x41, 0x54, 0xa1, 0xb4, 0xea, 0x01, 0xf5,
+	0xc0, 0x12, 0x55, 0x7c, 0x83, 0x6e, 0x9a, 0x34, 0x69, 0x20, 0x08, 0x68, 0x07, 0x38, 0x54, 0x4e,
+	0xfc, 0x48, 0xad, 0x36, 0x76, 0x94, 0xb8, 0x61, 0xe3, 0xc0, 0x95, 0x2b, 0x47, 0x24, 0xbe, 0xd0,
+	0x8e, 0x3b, 0x22, 0x0e, 0x13, 0x6a, 0xbf, 0x08, 0xb2, 0x9d, 0xb5, 0xd5, 0x0e, 0x1c, 0xaa, 0x9d,
+	0xe2, 0xdf, 0xfb, 0xfb, 0x7b, 0xcf, 0xbf, 0x18, 0x3f, 0x4b, 0x72, 0xce, 0x78, 0x2e, 0x45, 0x50,
+	0x42, 0x50, 0x8e, 0x82, 0x12, 0xfc, 0x2c, 0x97, 0x4a, 0x92, 0xc7, 0x77, 0x0e, 0xbf, 0x04, 0xbf,
+	0x1c, 0x75, 0x0f, 0x13, 0x99, 0x48, 0xe3, 0x0a, 0xf4, 0xc9, 0x46, 0x75, 0xbd, 0x58, 0x16, 0xa9,
+	0x2c, 0x82, 0x88, 0x16, 0x3a, 0x3d, 0x02, 0x45, 0x47, 0x41, 0x2c, 0xb9, 0xb0, 0xfe, 0xc1, 0x77,
+	0x84, 0xf7, 0xcf, 0x65, 0x3c, 0x03, 0x36, 0xa6, 0x73, 0x2a, 0x62, 0x20, 0xa7, 0xb8, 0x45, 0x53,
+	0xb9, 0x10, 0xca, 0x45, 0x7d, 0x34, 0xdc, 0x1b, 0xfb, 0xd7, 0xb7, 0xbd, 0xda, 0x9f, 0xdb, 0xde,
+	0x8b, 0x84, 0xab, 0xe9, 0x22, 0xf2, 0x63, 0x99, 0x06, 0x55, 0x51, 0xfb, 0x39, 0x2a, 0xd8, 0x2c,
+	0x50, 0x57, 0x19, 0x14, 0xfe, 0x99, 0x50, 0x61, 0x95, 0x4d, 0x0e, 0x70, 0x03, 0x04, 0x73, 0xeb,
+	0x7d, 0x34, 0x74, 0x42, 0x7d, 0x24, 0xcf, 0xf1, 0x5e, 0x06, 0x79, 0x4a, 0x05, 0x08, 0xe5, 0x36,
+	0xfa, 0x68, 0xd8, 0x0e, 0x37, 0x86, 0xc1, 0xcf, 0x3a, 0xc6, 0xc7, 0x53, 0x88, 0x67, 0x99, 0xe4,
+	0x42, 0x91, 0x31, 0x76, 0x22, 0x4e, 0x8b, 0x1d, 0x49, 0x98, 0x5c, 0x72, 0x82, 0x9b, 0xc5, 0x5c,
+	0x66, 0xe0, 0xd6, 0x77, 0x2a, 0x62, 0x93, 0x35, 0x6d, 0xc5, 0x53, 0x28, 0x14, 0x4d, 0x33, 0x43,
+	0xdb, 0x09, 0x37, 0x06, 0x72, 0x88, 0x9b, 0xd1, 0x5c, 0xc6, 0x33, 0xd7, 0xe9, 0xa3, 0x61, 0x23,
+	0xb4, 0x80, 0x9c, 0x6f, 0x8f, 0xda, 0xdc, 0xa9, 0xfb, 0xd6, 0x6a, 0xbe, 0xe1, 0xce, 0x7b, 0x4d,
+	0xe5, 0x78, 0x4a, 0x45, 0x72, 0x8f, 0x10, 0xba, 0x4f, 0xe8, 0x1d, 0x7e, 0x64, 0x78, 0x4f, 0x62,
+	0x13, 0xbd, 0xe3, 0xec, 0x9d, 0x62, 0xd3, 0x70, 0xf0, 0x09, 0x1f, 0x5c, 0x48, 0xc5, 0x45, 0x72,
+	0x02, 0x73, 0x48, 0xa8, 0xe2, 0x52, 0x90, 0x27, 0xb8, 0x59, 0xc2, 0x84, 0x33, 0x7b, 0x41, 0xa1,
+	0x53, 0xc2, 0x19, 0x23, 0x5d, 0xdc, 0x66, 0x36, 0xa4, 0xea, 0x1b, 0xae, 0x31, 0x79, 0x8a, 0x5b,
+	0x70, 0x99, 0xf1, 0xfc, 0xaa, 0xda, 0x61, 0x85, 0x06, 0xbf, 0xea, 0x78, 0xff, 0x02, 0xde, 0x7c,
+	0x56, 0xaf, 0x41, 0x51, 0x46, 0x15, 0x7d, 0x30, 0x05, 0xf6, 0x70, 0x67, 0x21, 0xf4, 0x75, 0x4c,
+	0xf4, 0x76, 0x2a, 0x25, 0x62, 0x6b, 0xfa, 0xc0, 0x53, 0xf8, 0xbf, 0x20, 0xf5, 0x22, 0x4b, 0x33,
+	0xf5, 0x24, 0x93, 0x5f, 0x20, 0x77, 0x9d, 0x9d, 0xc8, 0x74, 0x6c, 0x8d, 0xb7, 0xba, 0x84, 0xde,
+	0x0f, 0x55, 0x8a, 0xc6, 0x53, 0x60, 0x46, 0x15, 0xed, 0x70, 0x8d, 0xb5, 0x90, 0x4a, 0xa9, 0x80,
+	0xb9, 0x2d, 0xe3, 0xb0, 0x60, 0x7c, 0x7a, 0xbd, 0xf4, 0xd0, 0xcd, 0xd2, 0x43, 0x7f, 0x97, 0x1e,
+	0xfa, 0xb1, 0xf2, 0x6a, 0x37, 0x2b, 0xaf, 0xf6, 0x7b, 0xe5, 0xd5, 0x3e, 0xbe, 0xdc, 0x22, 0x70,
+	0xf7, 0x14, 0x1c, 0x7d, 0x95, 0x02, 0xd6, 0x28, 0xb8, 0xd4, 0x6f, 0x86, 0xa1, 0x12, 0xb5, 0xcc,
+	0xef, 0xfe, 0xea, 0xdf, 0x00, 0xd1, 0xc5, 0x6d, 0x94, 0x4f, 0x04, 0x00, 0x00,
 }
 
 func (m *LockedBalance) Marshal() (dAtA []byte, err error) {
@@ -184,6 +402,16 @@ func (m *LockedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Permanent {
+		i--
+		if m.Permanent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.End != 0 {
 		i = encodeVarintVe(dAtA, i, uint64(m.End))
 		i--
@@ -222,6 +450,16 @@ func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Permanent.Size()
+		i -= size
+		if _, err := m.Permanent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.Block != 0 {
 		i = encodeVarintVe(dAtA, i, uint64(m.Block))
 		i--
@@ -248,64 +486,702 @@ func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.Bias.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintVe(dAtA, i, uint64(size))
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SlopeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlopeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlopeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlopeChange.Size()
+		i -= size
+		if _, err := m.SlopeChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintVe(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != 0 {
+		i = encodeVarintVe(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintVe(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintVe(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VeNftMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeNftMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeNftMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Voted {
+		i--
+		if m.Voted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attached {
+		i--
+		if m.Attached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Permanent {
+		i--
+		if m.Permanent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UnlockTime != 0 {
+		i = encodeVarintVe(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVe(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVe(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LockedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovVe(uint64(l))
+	if m.End != 0 {
+		n += 1 + sovVe(uint64(m.End))
+	}
+	if m.Permanent {
+		n += 2
+	}
+	return n
+}
+
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bias.Size()
+	n += 1 + l + sovVe(uint64(l))
+	l = m.Slope.Size()
+	n += 1 + l + sovVe(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovVe(uint64(m.Timestamp))
+	}
+	if m.Block != 0 {
+		n += 1 + sovVe(uint64(m.Block))
+	}
+	l = m.Permanent.Size()
+	n += 1 + l + sovVe(uint64(l))
+	return n
+}
+
+func (m *SlopeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovVe(uint64(m.Timestamp))
+	}
+	l = m.SlopeChange.Size()
+	n += 1 + l + sovVe(uint64(l))
+	return n
+}
+
+func (m *VotingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovVe(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovVe(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovVe(uint64(m.Expiry))
+	}
+	return n
+}
+
+func (m *VeNftMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovVe(uint64(l))
+	if m.UnlockTime != 0 {
+		n += 1 + sovVe(uint64(m.UnlockTime))
+	}
+	if m.Permanent {
+		n += 2
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovVe(uint64(l))
+	if m.Attached {
+		n += 2
+	}
+	if m.Voted {
+		n += 2
+	}
+	return n
+}
+
+func sovVe(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVe(x uint64) (n int) {
+	return sovVe(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LockedBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permanent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bias.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permanent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintVe(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVe(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *LockedBalance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovVe(uint64(l))
-	if m.End != 0 {
-		n += 1 + sovVe(uint64(m.End))
+func (m *SlopeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlopeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlopeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlopeChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlopeChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Checkpoint) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Bias.Size()
-	n += 1 + l + sovVe(uint64(l))
-	l = m.Slope.Size()
-	n += 1 + l + sovVe(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovVe(uint64(m.Timestamp))
-	}
-	if m.Block != 0 {
-		n += 1 + sovVe(uint64(m.Block))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVe(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVe(x uint64) (n int) {
-	return sovVe(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *LockedBalance) Unmarshal(dAtA []byte) error {
+func (m *VotingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -328,15 +1204,15 @@ func (m *LockedBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -364,15 +1240,45 @@ func (m *LockedBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			m.End = 0
+			m.Expiry = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVe
@@ -382,7 +1288,7 @@ func (m *LockedBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= uint64(b&0x7F) << shift
+				m.Expiry |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -408,7 +1314,7 @@ func (m *LockedBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Checkpoint) Unmarshal(dAtA []byte) error {
+func (m *VeNftMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -431,15 +1337,15 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Checkpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: VeNftMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Checkpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VeNftMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -467,13 +1373,52 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bias.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permanent = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -501,15 +1446,15 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Slope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attached", wireType)
 			}
-			m.Timestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVe
@@ -519,16 +1464,17 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Attached = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
 			}
-			m.Block = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVe
@@ -538,11 +1484,12 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Voted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVe(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -21,7 +22,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryMerkleAirdrops(),
+		CmdQueryMerkleAirdrop(),
+		CmdQueryAirdropClaim(),
+	)
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -50,3 +56,94 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryMerkleAirdrops() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merkle-airdrops",
+		Short: "shows all merkle airdrops",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MerkleAirdrops(context.Background(), &types.QueryMerkleAirdropsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "merkle-airdrops")
+
+	return cmd
+}
+
+func CmdQueryMerkleAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merkle-airdrop [airdrop-id]",
+		Short: "shows a merkle airdrop",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MerkleAirdrop(context.Background(), &types.QueryMerkleAirdropRequest{AirdropId: airdropID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAirdropClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop-claim [airdrop-id] [address]",
+		Short: "shows the claim of an address in a merkle airdrop",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AirdropClaim(context.Background(), &types.QueryAirdropClaimRequest{
+				AirdropId: airdropID,
+				Address:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,8 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gridiron-zone/gridiron/x/vesting/types"
 	"github.com/spf13/cobra"
 )
@@ -18,5 +23,174 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(
+		CmdCreateMerkleAirdrop(),
+		CmdClaimAirdrop(),
+		CmdClawbackAirdrop(),
+	)
+
 	return cmd
 }
+
+func CmdCreateMerkleAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-merkle-airdrop [merkle-root] [cap] [deadline]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create an airdrop claimed by the recipients with merkle proofs",
+		Long: strings.TrimSpace(`
+Create an airdrop claimed by the recipients with merkle proofs. Only the team vesting address can create it.
+The cap of the leaf amounts is minted at creation along with the ve bonus pool, i.e., cap * (ve-bonus-multiplier - 1),
+and the unclaimed amounts can be clawed back to the community pool after the deadline (unix time).
+Each merkle leaf is sha256(0x00 || address || amount as 32-byte big-endian), and each parent is sha256(0x01 || sorted children).
+
+$ gridirond tx vesting create-merkle-airdrop 5f2c...e1 1000000000000000000000 1700000000 --ve-bonus-multiplier 1.5 --ve-min-lock-duration 31449600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cap, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid cap: %s", args[1])
+			}
+
+			deadline, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			multiplierStr, err := cmd.Flags().GetString(FlagVeBonusMultiplier)
+			if err != nil {
+				return err
+			}
+			multiplier, err := sdk.NewDecFromStr(multiplierStr)
+			if err != nil {
+				return err
+			}
+
+			minLockDuration, err := cmd.Flags().GetUint64(FlagVeMinLockDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateMerkleAirdrop{
+				Sender:            clientCtx.GetFromAddress().String(),
+				MerkleRoot:        args[0],
+				Cap:               cap,
+				Deadline:          deadline,
+				VeBonusMultiplier: multiplier,
+				VeMinLockDuration: minLockDuration,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVeBonusMultiplier, "1", "multiplier of the amount claimed into a ve lock")
+	cmd.Flags().Uint64(FlagVeMinLockDuration, 0, "minimum lock duration in seconds of the claims into ve")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClaimAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-airdrop [airdrop-id] [amount] [proof]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Claim the amount of the sender in a merkle airdrop",
+		Long: strings.TrimSpace(`
+Claim the amount of the sender in a merkle airdrop, with the comma-separated hex hashes of the merkle proof.
+If the lock duration (in seconds) is given, the claim goes straight into a new ve lock with the bonus multiplier of the airdrop applied.
+
+$ gridirond tx vesting claim-airdrop 1 1000000000000000000 9a1c...07,4be2...d3 --lock-duration 31449600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			var proof []string
+			if len(args) == 3 && len(args[2]) > 0 {
+				proof = strings.Split(args[2], ",")
+			}
+
+			lockDuration, err := cmd.Flags().GetUint64(FlagLockDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimAirdrop{
+				Sender:       clientCtx.GetFromAddress().String(),
+				AirdropId:    airdropID,
+				Amount:       amount,
+				Proof:        proof,
+				LockDuration: lockDuration,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagLockDuration, 0, "lock duration in seconds to claim into a new ve lock")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClawbackAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback-airdrop [airdrop-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Send the unclaimed amount of an expired merkle airdrop to the community pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClawbackAirdrop{
+				Sender:    clientCtx.GetFromAddress().String(),
+				AirdropId: airdropID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	FlagVeBonusMultiplier = "ve-bonus-multiplier"
+	FlagVeMinLockDuration = "ve-min-lock-duration"
+	FlagLockDuration      = "lock-duration"
+)
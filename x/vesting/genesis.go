@@ -28,9 +28,29 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 	k.SetAllocationAddresses(ctx, allocAddresses)
 
-	if ctx.BlockHeight() <= 1 {
+	if genState.Allocated {
+		k.SetAllocated(ctx)
+	} else {
 		k.AllocateAtGenesis(ctx, genState)
 	}
+
+	k.SetAirdropTotalAmount(ctx, genState.AirdropsTotalAmount)
+	for _, airdrop := range genState.Airdrops {
+		k.SetAirdrop(ctx, airdrop.GetTargetAddr(), airdrop)
+	}
+	for _, airdrop := range genState.CompletedAirdrops {
+		k.SetAirdropCompleted(ctx, airdrop.GetTargetAddr(), airdrop)
+	}
+
+	for _, airdrop := range genState.MerkleAirdrops {
+		k.SetMerkleAirdrop(ctx, airdrop)
+	}
+	for _, claim := range genState.MerkleAirdropClaims {
+		k.SetMerkleAirdropClaim(ctx, claim.AirdropId, claim.GetAddress(), claim.Amount)
+	}
+	if genState.NextMerkleAirdropId != 0 {
+		k.SetNextMerkleAirdropID(ctx, genState.NextMerkleAirdropId)
+	}
 }
 
 // ExportGenesis returns the vesting module's exported genesis.
@@ -39,6 +59,31 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.Params = k.GetParams(ctx)
 	genesis.AllocationAddresses = k.GetAllocationAddresses(ctx)
+	genesis.Allocated = k.IsAllocated(ctx)
+
+	genesis.AirdropsTotalAmount = k.GetAirdropTotalAmount(ctx)
+	k.IterateAirdrops(ctx, func(airdrop types.Airdrop) (stop bool) {
+		genesis.Airdrops = append(genesis.Airdrops, airdrop)
+		return false
+	})
+	k.IterateAirdropsCompleted(ctx, func(airdrop types.Airdrop) (stop bool) {
+		genesis.CompletedAirdrops = append(genesis.CompletedAirdrops, airdrop)
+		return false
+	})
+
+	k.IterateMerkleAirdrops(ctx, func(airdrop types.MerkleAirdrop) (stop bool) {
+		genesis.MerkleAirdrops = append(genesis.MerkleAirdrops, airdrop)
+		return false
+	})
+	k.IterateMerkleAirdropClaims(ctx, func(id uint64, acc sdk.AccAddress, amount sdk.Int) (stop bool) {
+		genesis.MerkleAirdropClaims = append(genesis.MerkleAirdropClaims, types.MerkleAirdropClaim{
+			AirdropId: id,
+			Address:   acc.String(),
+			Amount:    amount,
+		})
+		return false
+	})
+	genesis.NextMerkleAirdropId = k.GetNextMerkleAirdropID(ctx)
 
 	return genesis
 }
@@ -0,0 +1,201 @@
+package vesting_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/gridiron-zone/gridiron/app"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/vesting"
+	"github.com/gridiron-zone/gridiron/x/vesting/keeper"
+	"github.com/gridiron-zone/gridiron/x/vesting/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *app.Gridiron
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+}
+
+func (suite *GenesisTestSuite) TestExportInitGenesis() {
+	require := suite.Require()
+
+	// The bank module cannot export the strategic reserve sent to the empty
+	// custodian address of the default genesis
+	suite.app = app.Setup(true)
+	genesisState := app.NewDefaultGenesisState()
+	vestingGenesis := types.DefaultGenesis()
+	vestingGenesis.AllocationAddresses.StrategicReserveCustodianAddr = sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	genesisState[types.ModuleName] = suite.app.AppCodec().MustMarshalJSON(vestingGenesis)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(err)
+	suite.app.InitChain(abci.RequestInitChain{
+		ChainId:         "gridiron_5000-101",
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	k := suite.app.VestingKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	teamAddr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	k.SetAllocationAddresses(suite.ctx, types.AllocationAddresses{
+		TeamVestingAddr: teamAddr.String(),
+	})
+
+	// Pending and completed airdrops
+	_, err = impl.AddAirdrops(ctx, &types.MsgAddAirdrops{
+		Sender: teamAddr.String(),
+		Airdrops: []types.Airdrop{
+			{TargetAddr: sdk.AccAddress(tests.GenerateAddress().Bytes()).String(), Amount: sdk.NewInt64Coin(gridiron.BaseDenom, 100)},
+			{TargetAddr: sdk.AccAddress(tests.GenerateAddress().Bytes()).String(), Amount: sdk.NewInt64Coin(gridiron.BaseDenom, 200)},
+		},
+	})
+	require.NoError(err)
+	_, err = impl.ExecuteAirdrops(ctx, &types.MsgExecuteAirdrops{
+		Sender:   teamAddr.String(),
+		MaxCount: 1,
+	})
+	require.NoError(err)
+
+	// Merkle airdrop with a claim
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	leaf := types.MerkleAirdropLeaf(recipient, sdk.NewInt(1000))
+	airdrop, err := k.CreateMerkleAirdrop(suite.ctx, leaf, sdk.NewInt(3000), uint64(suite.ctx.BlockTime().Add(time.Hour).Unix()), sdk.OneDec(), 0)
+	require.NoError(err)
+	_, _, err = k.ClaimMerkleAirdrop(suite.ctx, airdrop.Id, recipient, sdk.NewInt(1000), nil, 0)
+	require.NoError(err)
+
+	genesis := vesting.ExportGenesis(suite.ctx, k)
+	require.NoError(genesis.Validate())
+	require.True(genesis.Allocated)
+	require.Len(genesis.Airdrops, 1)
+	require.Len(genesis.CompletedAirdrops, 1)
+	require.Equal(sdk.NewInt(3300), genesis.AirdropsTotalAmount)
+	require.Len(genesis.MerkleAirdrops, 1)
+	require.Equal([]types.MerkleAirdropClaim{{
+		AirdropId: airdrop.Id,
+		Address:   recipient.String(),
+		Amount:    sdk.NewInt(1000),
+	}}, genesis.MerkleAirdropClaims)
+	require.Equal(uint64(2), genesis.NextMerkleAirdropId)
+
+	// Export the whole app state and start another chain from it
+	suite.app.Commit()
+	exported, err := suite.app.ExportAppStateAndValidators(false, nil)
+	require.NoError(err)
+
+	newApp := app.Setup(true)
+	newApp.InitChain(abci.RequestInitChain{
+		ChainId:         "gridiron_5000-101",
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   exported.AppState,
+		InitialHeight:   exported.Height,
+	})
+	newApp.Commit()
+
+	header := tmproto.Header{Height: newApp.LastBlockHeight()}
+	oldCtx := suite.app.NewContext(true, header)
+	newCtx := newApp.NewContext(true, header)
+
+	// The imported chain does not allocate again
+	require.Equal(
+		suite.app.BankKeeper.GetSupply(oldCtx, gridiron.BaseDenom),
+		newApp.BankKeeper.GetSupply(newCtx, gridiron.BaseDenom),
+	)
+	require.Equal(genesis, vesting.ExportGenesis(newCtx, newApp.VestingKeeper))
+
+	for _, storeKey := range []string{types.StoreKey, banktypes.StoreKey} {
+		storeA := oldCtx.KVStore(suite.app.GetKey(storeKey))
+		storeB := newCtx.KVStore(newApp.GetKey(storeKey))
+		kvAs, kvBs := sdk.DiffKVStores(storeA, storeB, nil)
+		require.Empty(kvAs, "store %s differs after import", storeKey)
+		require.Empty(kvBs, "store %s differs after import", storeKey)
+	}
+}
+
+func (suite *GenesisTestSuite) TestInitGenesisAllocation() {
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+
+	// The chain is set up with the allocation
+	require.True(k.IsAllocated(suite.ctx))
+
+	newApp := app.Setup(true)
+	newCtx := newApp.BaseApp.NewUncachedContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	require.False(newApp.VestingKeeper.IsAllocated(newCtx))
+
+	genesis := types.DefaultGenesis()
+	genesis.Allocated = true
+	vesting.InitGenesis(newCtx, newApp.VestingKeeper, *genesis)
+	require.True(newApp.VestingKeeper.IsAllocated(newCtx))
+	require.True(newApp.BankKeeper.GetSupply(newCtx, gridiron.BaseDenom).IsZero())
+}
+
+func (suite *GenesisTestSuite) TestGenesisValidate() {
+	require := suite.Require()
+
+	genesis := types.DefaultGenesis()
+	require.NoError(genesis.Validate())
+
+	genesis.Airdrops = []types.Airdrop{{TargetAddr: "invalid", Amount: sdk.NewInt64Coin(gridiron.BaseDenom, 100)}}
+	require.Error(genesis.Validate())
+
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	genesis.Airdrops = []types.Airdrop{
+		{TargetAddr: addr.String(), Amount: sdk.NewInt64Coin(gridiron.BaseDenom, 100)},
+		{TargetAddr: addr.String(), Amount: sdk.NewInt64Coin(gridiron.BaseDenom, 100)},
+	}
+	require.Error(genesis.Validate())
+
+	genesis = types.DefaultGenesis()
+	genesis.AirdropsTotalAmount = genesis.Params.Allocation.AirdropAmount.AddRaw(1)
+	require.Error(genesis.Validate())
+
+	genesis = types.DefaultGenesis()
+	genesis.NextMerkleAirdropId = 2
+	genesis.MerkleAirdrops = []types.MerkleAirdrop{{
+		Id:                1,
+		MerkleRoot:        hex.EncodeToString(types.MerkleAirdropLeaf(addr, sdk.NewInt(100))),
+		Cap:               sdk.NewInt(100),
+		Claimed:           sdk.ZeroInt(),
+		VeBonusMultiplier: sdk.OneDec(),
+		VeBonusCap:        sdk.ZeroInt(),
+		VeBonusClaimed:    sdk.ZeroInt(),
+	}}
+	require.NoError(genesis.Validate())
+
+	genesis.MerkleAirdrops[0].VeBonusClaimed = sdk.OneInt()
+	require.Error(genesis.Validate())
+	genesis.MerkleAirdrops[0].VeBonusClaimed = sdk.ZeroInt()
+
+	genesis.MerkleAirdropClaims = []types.MerkleAirdropClaim{{AirdropId: 2, Address: addr.String(), Amount: sdk.NewInt(100)}}
+	require.Error(genesis.Validate())
+
+	genesis.NextMerkleAirdropId = 1
+	genesis.MerkleAirdropClaims = nil
+	require.Error(genesis.Validate())
+}
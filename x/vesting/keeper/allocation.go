@@ -28,6 +28,24 @@ func (k Keeper) AllocateAtGenesis(ctx sdk.Context, genState types.GenesisState)
 	if err != nil {
 		panic(err)
 	}
+
+	k.SetAllocated(ctx)
+}
+
+// SetAllocated records that the allocation at genesis has been done
+func (k Keeper) SetAllocated(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AllocatedKey(), []byte{1})
+}
+
+// IsAllocated returns whether the allocation at genesis has been done
+func (k Keeper) IsAllocated(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.AllocatedKey()) {
+		return true
+	}
+	// the allocation was not recorded before, but it always created the vesting accounts
+	return k.accountKeeper.GetAccount(ctx, k.getVestingAddress(types.StakingRewardVestingName)) != nil
 }
 
 func (k Keeper) ClaimVested(ctx sdk.Context) {
@@ -75,6 +75,73 @@ func (k Keeper) Airdrop(c context.Context, msg *types.QueryAirdropRequest) (*typ
 	}, nil
 }
 
+func (k Keeper) MerkleAirdrops(c context.Context, msg *types.QueryMerkleAirdropsRequest) (*types.QueryMerkleAirdropsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var airdrops []types.MerkleAirdrop
+	store := ctx.KVStore(k.storeKey)
+	airdropStore := prefix.NewStore(store, types.KeyPrefixMerkleAirdrops)
+	pageRes, err := query.Paginate(airdropStore, msg.Pagination, func(key []byte, value []byte) error {
+		var airdrop types.MerkleAirdrop
+		k.cdc.MustUnmarshal(value, &airdrop)
+		airdrops = append(airdrops, airdrop)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMerkleAirdropsResponse{
+		Airdrops:   airdrops,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) MerkleAirdrop(c context.Context, msg *types.QueryMerkleAirdropRequest) (*types.QueryMerkleAirdropResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	airdrop, found := k.GetMerkleAirdrop(ctx, msg.AirdropId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "merkle airdrop not found")
+	}
+
+	return &types.QueryMerkleAirdropResponse{
+		Airdrop: airdrop,
+	}, nil
+}
+
+func (k Keeper) AirdropClaim(c context.Context, msg *types.QueryAirdropClaimRequest) (*types.QueryAirdropClaimResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, found := k.GetMerkleAirdrop(ctx, msg.AirdropId); !found {
+		return nil, status.Error(codes.NotFound, "merkle airdrop not found")
+	}
+
+	amount, claimed := k.GetMerkleAirdropClaim(ctx, msg.AirdropId, addr)
+
+	return &types.QueryAirdropClaimResponse{
+		Claimed: claimed,
+		Amount:  amount,
+	}, nil
+}
+
 func (k Keeper) Params(c context.Context, msg *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	"github.com/gridiron-zone/gridiron/x/vesting/types"
+)
+
+// CreateMerkleAirdrop creates a merkle airdrop, and mints its cap into the module account along
+// with the ve bonus pool, which is sized for all the leaves claimed into ve. Both are counted into
+// the airdrop total amount, which should not be greater than the allocation.
+func (k Keeper) CreateMerkleAirdrop(ctx sdk.Context, merkleRoot []byte, cap sdk.Int, deadline uint64, veBonusMultiplier sdk.Dec, veMinLockDuration uint64) (types.MerkleAirdrop, error) {
+	if deadline <= uint64(ctx.BlockTime().Unix()) {
+		return types.MerkleAirdrop{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deadline %d is not in the future", deadline)
+	}
+
+	veBonusCap := veBonusMultiplier.Sub(sdk.OneDec()).MulInt(cap).TruncateInt()
+	amount := cap.Add(veBonusCap)
+	total := k.GetAirdropTotalAmount(ctx).Add(amount)
+	if total.GT(k.GetParams(ctx).Allocation.AirdropAmount) {
+		return types.MerkleAirdrop{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "total amount should not be greater than its cap")
+	}
+	k.SetAirdropTotalAmount(ctx, total)
+
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(gridiron.BaseDenom, amount)))
+	if err != nil {
+		return types.MerkleAirdrop{}, err
+	}
+
+	id := k.GetNextMerkleAirdropID(ctx)
+	k.SetNextMerkleAirdropID(ctx, id+1)
+
+	airdrop := types.MerkleAirdrop{
+		Id:                id,
+		MerkleRoot:        hex.EncodeToString(merkleRoot),
+		Cap:               cap,
+		Claimed:           sdk.ZeroInt(),
+		Deadline:          deadline,
+		VeBonusMultiplier: veBonusMultiplier,
+		VeMinLockDuration: veMinLockDuration,
+		VeBonusCap:        veBonusCap,
+		VeBonusClaimed:    sdk.ZeroInt(),
+	}
+	k.SetMerkleAirdrop(ctx, airdrop)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCreateMerkleAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(gridiron.BaseDenom, amount).String()),
+		),
+	)
+
+	return airdrop, nil
+}
+
+// ClaimMerkleAirdrop pays out the amount of the recipient proved to be in the merkle airdrop.
+// If lockDuration is non-zero, the amount multiplied by the ve bonus multiplier is locked
+// into a new ve owned by the recipient, and the ve id is returned. The cap bounds the amounts
+// of the leaves only, while the bonuses are paid out of the ve bonus pool.
+func (k Keeper) ClaimMerkleAirdrop(ctx sdk.Context, id uint64, recipient sdk.AccAddress, amount sdk.Int, proof [][]byte, lockDuration uint64) (payout sdk.Coin, veID uint64, err error) {
+	airdrop, found := k.GetMerkleAirdrop(ctx, id)
+	if !found {
+		return payout, 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "merkle airdrop %d not found", id)
+	}
+	if uint64(ctx.BlockTime().Unix()) >= airdrop.Deadline {
+		return payout, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "merkle airdrop %d has expired", id)
+	}
+	if _, claimed := k.GetMerkleAirdropClaim(ctx, id, recipient); claimed {
+		return payout, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "already claimed merkle airdrop %d", id)
+	}
+
+	root, err := types.ParseMerkleHash(airdrop.MerkleRoot)
+	if err != nil {
+		return payout, 0, err
+	}
+	if !types.VerifyMerkleProof(root, types.MerkleAirdropLeaf(recipient, amount), proof) {
+		return payout, 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid merkle proof")
+	}
+
+	bonus := sdk.ZeroInt()
+	if lockDuration > 0 {
+		if lockDuration < airdrop.VeMinLockDuration {
+			return payout, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lock duration should not be less than %d", airdrop.VeMinLockDuration)
+		}
+		bonus = airdrop.VeBonusMultiplier.MulInt(amount).TruncateInt().Sub(amount)
+	}
+
+	airdrop.Claimed = airdrop.Claimed.Add(amount)
+	if airdrop.Claimed.GT(airdrop.Cap) {
+		return payout, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "merkle airdrop %d exceeds its cap", id)
+	}
+	airdrop.VeBonusClaimed = airdrop.VeBonusClaimed.Add(bonus)
+	if airdrop.VeBonusClaimed.GT(airdrop.VeBonusCap) {
+		return payout, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "merkle airdrop %d exceeds its ve bonus cap", id)
+	}
+	payoutAmount := amount.Add(bonus)
+	k.SetMerkleAirdrop(ctx, airdrop)
+	k.SetMerkleAirdropClaim(ctx, id, recipient, payoutAmount)
+
+	payout = sdk.NewCoin(gridiron.BaseDenom, payoutAmount)
+	if lockDuration > 0 {
+		veID, _, err = k.veKeeper.CreateLock(ctx, authtypes.NewModuleAddress(types.ModuleName), recipient, payout, lockDuration)
+	} else {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(payout))
+	}
+	if err != nil {
+		return payout, 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClaimAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, payout.String()),
+			sdk.NewAttribute(types.AttributeKeyVeID, strconv.FormatUint(veID, 10)),
+		),
+	)
+
+	return payout, veID, nil
+}
+
+// ClawbackMerkleAirdrop sends the unclaimed amount of the merkle airdrop, including the unclaimed
+// ve bonuses, to the community pool. It is allowed after the deadline only.
+func (k Keeper) ClawbackMerkleAirdrop(ctx sdk.Context, id uint64) (sdk.Coin, error) {
+	airdrop, found := k.GetMerkleAirdrop(ctx, id)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "merkle airdrop %d not found", id)
+	}
+	if uint64(ctx.BlockTime().Unix()) < airdrop.Deadline {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "merkle airdrop %d has not expired", id)
+	}
+	if airdrop.ClawedBack {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "merkle airdrop %d has been clawed back", id)
+	}
+
+	airdrop.ClawedBack = true
+	k.SetMerkleAirdrop(ctx, airdrop)
+
+	unclaimed := sdk.NewCoin(gridiron.BaseDenom, airdrop.Cap.Sub(airdrop.Claimed).Add(airdrop.VeBonusCap.Sub(airdrop.VeBonusClaimed)))
+	if unclaimed.IsPositive() {
+		err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(unclaimed), authtypes.NewModuleAddress(types.ModuleName))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClawbackAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, unclaimed.String()),
+		),
+	)
+
+	return unclaimed, nil
+}
+
+// SetNextMerkleAirdropID sets the next merkle airdrop id
+func (k Keeper) SetNextMerkleAirdropID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.NextMerkleAirdropIDKey(), bz)
+}
+
+// GetNextMerkleAirdropID gets the next merkle airdrop id
+func (k Keeper) GetNextMerkleAirdropID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextMerkleAirdropIDKey())
+	if bz == nil {
+		return 1
+	}
+	var id gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &id)
+	return id.Value
+}
+
+// SetMerkleAirdrop sets merkle airdrop
+func (k Keeper) SetMerkleAirdrop(ctx sdk.Context, airdrop types.MerkleAirdrop) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&airdrop)
+	store.Set(types.MerkleAirdropKey(airdrop.Id), bz)
+}
+
+// GetMerkleAirdrop gets merkle airdrop
+func (k Keeper) GetMerkleAirdrop(ctx sdk.Context, id uint64) (airdrop types.MerkleAirdrop, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MerkleAirdropKey(id))
+	if bz == nil {
+		return airdrop, false
+	}
+	k.cdc.MustUnmarshal(bz, &airdrop)
+	return airdrop, true
+}
+
+// IterateMerkleAirdrops iterates merkle airdrops
+func (k Keeper) IterateMerkleAirdrops(ctx sdk.Context, handler func(airdrop types.MerkleAirdrop) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixMerkleAirdrops)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var airdrop types.MerkleAirdrop
+		k.cdc.MustUnmarshal(iter.Value(), &airdrop)
+		if handler(airdrop) {
+			break
+		}
+	}
+}
+
+// SetMerkleAirdropClaim sets the amount paid out to the recipient of merkle airdrop
+func (k Keeper) SetMerkleAirdropClaim(ctx sdk.Context, id uint64, acc sdk.AccAddress, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: amount})
+	store.Set(types.MerkleAirdropClaimKey(id, acc), bz)
+}
+
+// GetMerkleAirdropClaim gets the amount paid out to the recipient of merkle airdrop
+func (k Keeper) GetMerkleAirdropClaim(ctx sdk.Context, id uint64, acc sdk.AccAddress) (sdk.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MerkleAirdropClaimKey(id, acc))
+	if bz == nil {
+		return sdk.ZeroInt(), false
+	}
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount.Int, true
+}
+
+// IterateMerkleAirdropClaims iterates the amounts paid out to the recipients of all merkle airdrops
+func (k Keeper) IterateMerkleAirdropClaims(ctx sdk.Context, handler func(id uint64, acc sdk.AccAddress, amount sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixMerkleAirdropClaims)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.KeyPrefixMerkleAirdropClaims):]
+		id := sdk.BigEndianToUint64(key[:8])
+		acc := sdk.AccAddress(key[9:])
+
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &amount)
+		if handler(id, acc, amount.Int) {
+			break
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gridiron "github.com/gridiron-zone/gridiron/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+	"github.com/gridiron-zone/gridiron/x/vesting/keeper"
+	"github.com/gridiron-zone/gridiron/x/vesting/types"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+)
+
+func (suite *KeeperTestSuite) TestMerkleAirdrop() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VestingKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	teamAddr := sdk.AccAddress(suite.address.Bytes())
+	k.SetAllocationAddresses(suite.ctx, types.AllocationAddresses{
+		TeamVestingAddr: teamAddr.String(),
+	})
+
+	var recipients []sdk.AccAddress
+	var leaves [][]byte
+	for i := 0; i < 4; i++ {
+		priv, err := ethsecp256k1.GenerateKey()
+		require.NoError(err)
+		recipient := sdk.AccAddress(priv.PubKey().Address())
+		recipients = append(recipients, recipient)
+		leaves = append(leaves, types.MerkleAirdropLeaf(recipient, sdk.NewInt(1000)))
+	}
+	root := hex.EncodeToString(types.MerkleRoot(leaves))
+	proofOf := func(i int) (proof []string) {
+		for _, node := range types.MerkleProof(leaves, i) {
+			proof = append(proof, hex.EncodeToString(node))
+		}
+		return
+	}
+
+	deadline := uint64(suite.ctx.BlockTime().Add(time.Hour).Unix())
+	createMsg := &types.MsgCreateMerkleAirdrop{
+		Sender:            recipients[0].String(),
+		MerkleRoot:        root,
+		Cap:               sdk.NewInt(4000),
+		Deadline:          deadline,
+		VeBonusMultiplier: sdk.NewDecWithPrec(15, 1),
+		VeMinLockDuration: vetypes.RegulatedPeriod * 4,
+	}
+
+	// Only the team can create
+	_, err := impl.CreateMerkleAirdrop(ctx, createMsg)
+	require.Error(err)
+
+	// Cap and ve bonus pool are counted into the airdrop allocation
+	createMsg.Sender = teamAddr.String()
+	allocation := k.GetParams(suite.ctx).Allocation.AirdropAmount
+	createMsg.Cap = allocation
+	_, err = impl.CreateMerkleAirdrop(ctx, createMsg)
+	require.Error(err)
+
+	createMsg.Cap = sdk.NewInt(4000)
+	res, err := impl.CreateMerkleAirdrop(ctx, createMsg)
+	require.NoError(err)
+	require.Equal(uint64(1), res.AirdropId)
+	require.Equal(sdk.NewInt(6000), k.GetAirdropTotalAmount(suite.ctx))
+	airdrop, found := k.GetMerkleAirdrop(suite.ctx, 1)
+	require.True(found)
+	require.Equal(sdk.NewInt(2000), airdrop.VeBonusCap)
+
+	// Invalid proof
+	_, err = impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+		Sender:    recipients[0].String(),
+		AirdropId: 1,
+		Amount:    sdk.NewInt(2000),
+		Proof:     proofOf(0),
+	})
+	require.Error(err)
+
+	// Liquid claim
+	claimRes, err := impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+		Sender:    recipients[0].String(),
+		AirdropId: 1,
+		Amount:    sdk.NewInt(1000),
+		Proof:     proofOf(0),
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(gridiron.BaseDenom, 1000), claimRes.Amount)
+	require.Empty(claimRes.VeId)
+	require.Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(suite.ctx, recipients[0], gridiron.BaseDenom).Amount)
+
+	// Double claim
+	_, err = impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+		Sender:    recipients[0].String(),
+		AirdropId: 1,
+		Amount:    sdk.NewInt(1000),
+		Proof:     proofOf(0),
+	})
+	require.Error(err)
+
+	// Lock duration too short for ve claim
+	_, err = impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+		Sender:       recipients[1].String(),
+		AirdropId:    1,
+		Amount:       sdk.NewInt(1000),
+		Proof:        proofOf(1),
+		LockDuration: vetypes.RegulatedPeriod,
+	})
+	require.Error(err)
+
+	// Ve claim with bonus
+	claimRes, err = impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+		Sender:       recipients[1].String(),
+		AirdropId:    1,
+		Amount:       sdk.NewInt(1000),
+		Proof:        proofOf(1),
+		LockDuration: vetypes.RegulatedPeriod * 4,
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(gridiron.BaseDenom, 1500), claimRes.Amount)
+	veID := vetypes.Uint64FromVeID(claimRes.VeId)
+	require.Equal(sdk.NewInt(1500), suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, veID).Amount)
+	require.Equal(recipients[1], suite.app.NftKeeper.GetOwner(suite.ctx, vetypes.VeNftClass.Id, claimRes.VeId))
+
+	claimed, found := k.GetMerkleAirdropClaim(suite.ctx, 1, recipients[1])
+	require.True(found)
+	require.Equal(sdk.NewInt(1500), claimed)
+
+	// Bonuses are paid out of the ve bonus pool, and never exhaust the cap
+	claimRes, err = impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+		Sender:       recipients[2].String(),
+		AirdropId:    1,
+		Amount:       sdk.NewInt(1000),
+		Proof:        proofOf(2),
+		LockDuration: vetypes.RegulatedPeriod * 4,
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(gridiron.BaseDenom, 1500), claimRes.Amount)
+	airdrop, _ = k.GetMerkleAirdrop(suite.ctx, 1)
+	require.Equal(sdk.NewInt(3000), airdrop.Claimed)
+	require.Equal(sdk.NewInt(1000), airdrop.VeBonusClaimed)
+
+	// Clawback before deadline
+	_, err = impl.ClawbackAirdrop(ctx, &types.MsgClawbackAirdrop{Sender: recipients[2].String(), AirdropId: 1})
+	require.Error(err)
+
+	// Claim after deadline
+	expiredCtx := suite.ctx.WithBlockTime(time.Unix(int64(deadline), 0))
+	_, err = impl.ClaimAirdrop(sdk.WrapSDKContext(expiredCtx), &types.MsgClaimAirdrop{
+		Sender:    recipients[3].String(),
+		AirdropId: 1,
+		Amount:    sdk.NewInt(1000),
+		Proof:     proofOf(3),
+	})
+	require.Error(err)
+
+	// Clawback after deadline, including the unclaimed ve bonuses
+	poolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(expiredCtx).AmountOf(gridiron.BaseDenom)
+	clawbackRes, err := impl.ClawbackAirdrop(sdk.WrapSDKContext(expiredCtx), &types.MsgClawbackAirdrop{Sender: recipients[2].String(), AirdropId: 1})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(gridiron.BaseDenom, 2000), clawbackRes.Amount)
+	poolAfter := suite.app.DistrKeeper.GetFeePoolCommunityCoins(expiredCtx).AmountOf(gridiron.BaseDenom)
+	require.Equal(sdk.NewDec(2000), poolAfter.Sub(poolBefore))
+
+	_, err = impl.ClawbackAirdrop(sdk.WrapSDKContext(expiredCtx), &types.MsgClawbackAirdrop{Sender: recipients[2].String(), AirdropId: 1})
+	require.Error(err)
+
+	// Queries
+	airdropRes, err := k.MerkleAirdrop(ctx, &types.QueryMerkleAirdropRequest{AirdropId: 1})
+	require.NoError(err)
+	require.True(airdropRes.Airdrop.ClawedBack)
+	require.Equal(sdk.NewInt(3000), airdropRes.Airdrop.Claimed)
+
+	airdropsRes, err := k.MerkleAirdrops(ctx, &types.QueryMerkleAirdropsRequest{})
+	require.NoError(err)
+	require.Len(airdropsRes.Airdrops, 1)
+
+	claimQueryRes, err := k.AirdropClaim(ctx, &types.QueryAirdropClaimRequest{AirdropId: 1, Address: recipients[3].String()})
+	require.NoError(err)
+	require.False(claimQueryRes.Claimed)
+
+	_, err = k.MerkleAirdrop(ctx, &types.QueryMerkleAirdropRequest{AirdropId: 2})
+	require.Error(err)
+
+	// Cap bounds the amounts of the leaves
+	createMsg.Cap = sdk.NewInt(1000)
+	_, err = impl.CreateMerkleAirdrop(ctx, createMsg)
+	require.NoError(err)
+	_, err = impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+		Sender:       recipients[0].String(),
+		AirdropId:    2,
+		Amount:       sdk.NewInt(1000),
+		Proof:        proofOf(0),
+		LockDuration: vetypes.RegulatedPeriod * 4,
+	})
+	require.NoError(err)
+	_, err = impl.ClaimAirdrop(ctx, &types.MsgClaimAirdrop{
+		Sender:    recipients[1].String(),
+		AirdropId: 2,
+		Amount:    sdk.NewInt(1000),
+		Proof:     proofOf(1),
+	})
+	require.Error(err)
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 	"github.com/gridiron-zone/gridiron/x/vesting/types"
 )
 
@@ -145,3 +146,70 @@ func (m msgServer) SetAllocationAddress(c context.Context, msg *types.MsgSetAllo
 
 	return &types.MsgSetAllocationAddressResponse{}, nil
 }
+
+func (m msgServer) CreateMerkleAirdrop(c context.Context, msg *types.MsgCreateMerkleAirdrop) (*types.MsgCreateMerkleAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Airdrops can only be created by team vesting address
+	teamAddr := m.Keeper.GetAllocationAddresses(ctx).GetTeamVestingAddr()
+	if !sender.Equals(teamAddr) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
+	}
+
+	root, err := types.ParseMerkleHash(msg.MerkleRoot)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	airdrop, err := m.Keeper.CreateMerkleAirdrop(ctx, root, msg.Cap, msg.Deadline, msg.VeBonusMultiplier, msg.VeMinLockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMerkleAirdropResponse{AirdropId: airdrop.Id}, nil
+}
+
+func (m msgServer) ClaimAirdrop(c context.Context, msg *types.MsgClaimAirdrop) (*types.MsgClaimAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := make([][]byte, len(msg.Proof))
+	for i, node := range msg.Proof {
+		proof[i], err = types.ParseMerkleHash(node)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	payout, veID, err := m.Keeper.ClaimMerkleAirdrop(ctx, msg.AirdropId, sender, msg.Amount, proof, msg.LockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgClaimAirdropResponse{Amount: payout}
+	if veID != 0 {
+		res.VeId = vetypes.VeIDFromUint64(veID)
+	}
+	return res, nil
+}
+
+// ClawbackAirdrop can be called by anyone, since the unclaimed amount always goes to the community pool.
+func (m msgServer) ClawbackAirdrop(c context.Context, msg *types.MsgClawbackAirdrop) (*types.MsgClawbackAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	unclaimed, err := m.Keeper.ClawbackMerkleAirdrop(ctx, msg.AirdropId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackAirdropResponse{Amount: unclaimed}, nil
+}
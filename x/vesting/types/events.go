@@ -0,0 +1,15 @@
+package types
+
+// x/vesting module event types
+const (
+	EventTypeCreateMerkleAirdrop = "create_merkle_airdrop"
+	EventTypeClaimAirdrop        = "claim_airdrop"
+	EventTypeClawbackAirdrop     = "clawback_airdrop"
+
+	AttributeKeyAirdropID = "airdrop_id"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyVeID      = "ve_id"
+
+	AttributeValueCategory = ModuleName
+)
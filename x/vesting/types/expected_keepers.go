@@ -8,6 +8,7 @@ import (
 // AccountKeeper defines the expected account keeper
 type AccountKeeper interface {
 	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 	SetAccount(ctx sdk.Context, acc authtypes.AccountI)
 }
 
@@ -31,4 +32,5 @@ type DistributionKeeper interface {
 // VeKeeper defines the expected ve keeper.
 type VeKeeper interface {
 	AddTotalEmission(ctx sdk.Context, emission sdk.Int)
+	CreateLock(ctx sdk.Context, sender, receiver sdk.AccAddress, amount sdk.Coin, lockDuration uint64) (veID uint64, unlockTime uint64, err error)
 }
@@ -1,18 +1,95 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default vesting genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:              DefaultParams(),
+		AirdropsTotalAmount: sdk.ZeroInt(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.AirdropsTotalAmount.IsNil() || gs.AirdropsTotalAmount.IsNegative() {
+		return fmt.Errorf("invalid airdrops total amount: %s", gs.AirdropsTotalAmount)
+	}
+
+	for _, airdrops := range [][]Airdrop{gs.Airdrops, gs.CompletedAirdrops} {
+		seen := make(map[string]bool)
+		for _, airdrop := range airdrops {
+			if _, err := sdk.AccAddressFromBech32(airdrop.TargetAddr); err != nil {
+				return fmt.Errorf("invalid airdrop target address: %w", err)
+			}
+			if seen[airdrop.TargetAddr] {
+				return fmt.Errorf("duplicate airdrop target address: %s", airdrop.TargetAddr)
+			}
+			seen[airdrop.TargetAddr] = true
+			if err := airdrop.Amount.Validate(); err != nil {
+				return fmt.Errorf("invalid airdrop amount: %w", err)
+			}
+		}
+	}
+
+	merkleAirdrops := make(map[uint64]bool)
+	for _, airdrop := range gs.MerkleAirdrops {
+		if airdrop.Id == 0 || airdrop.Id >= gs.NextMerkleAirdropId {
+			return fmt.Errorf("invalid merkle airdrop id: %d", airdrop.Id)
+		}
+		if merkleAirdrops[airdrop.Id] {
+			return fmt.Errorf("duplicate merkle airdrop id: %d", airdrop.Id)
+		}
+		merkleAirdrops[airdrop.Id] = true
+		if _, err := ParseMerkleHash(airdrop.MerkleRoot); err != nil {
+			return fmt.Errorf("invalid merkle root of merkle airdrop %d: %w", airdrop.Id, err)
+		}
+		if airdrop.Cap.IsNil() || !airdrop.Cap.IsPositive() ||
+			airdrop.Claimed.IsNil() || airdrop.Claimed.IsNegative() || airdrop.Claimed.GT(airdrop.Cap) {
+			return fmt.Errorf("invalid cap or claimed amount of merkle airdrop %d", airdrop.Id)
+		}
+		if airdrop.VeBonusCap.IsNil() || airdrop.VeBonusCap.IsNegative() ||
+			airdrop.VeBonusClaimed.IsNil() || airdrop.VeBonusClaimed.IsNegative() || airdrop.VeBonusClaimed.GT(airdrop.VeBonusCap) {
+			return fmt.Errorf("invalid ve bonus cap or claimed amount of merkle airdrop %d", airdrop.Id)
+		}
+	}
+
+	type claimKey struct {
+		id      uint64
+		address string
+	}
+	claims := make(map[claimKey]bool)
+	for _, claim := range gs.MerkleAirdropClaims {
+		if !merkleAirdrops[claim.AirdropId] {
+			return fmt.Errorf("claim of unknown merkle airdrop: %d", claim.AirdropId)
+		}
+		if _, err := sdk.AccAddressFromBech32(claim.Address); err != nil {
+			return fmt.Errorf("invalid merkle airdrop claim address: %w", err)
+		}
+		key := claimKey{claim.AirdropId, claim.Address}
+		if claims[key] {
+			return fmt.Errorf("duplicate claim of merkle airdrop %d: %s", claim.AirdropId, claim.Address)
+		}
+		claims[key] = true
+		if claim.Amount.IsNil() || claim.Amount.IsNegative() {
+			return fmt.Errorf("invalid merkle airdrop claim amount: %s", claim.Amount)
+		}
+	}
+
+	if gs.AirdropsTotalAmount.GT(gs.Params.Allocation.AirdropAmount) {
+		return fmt.Errorf("airdrops total amount %s exceeds the airdrop allocation %s", gs.AirdropsTotalAmount, gs.Params.Allocation.AirdropAmount)
+	}
+
+	return nil
 }
 
 func (a AllocationAddresses) GetStrategicReserveCustodianAddr() sdk.AccAddress {
@@ -51,3 +128,11 @@ func (a Airdrop) GetTargetAddr() sdk.AccAddress {
 	}
 	return ta
 }
+
+func (c MerkleAirdropClaim) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(c.Address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
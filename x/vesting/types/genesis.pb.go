@@ -28,6 +28,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params              Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	AllocationAddresses AllocationAddresses `protobuf:"bytes,2,opt,name=allocation_addresses,json=allocationAddresses,proto3" json:"allocation_addresses"`
+	// whether the allocation at genesis has already been done, e.g., in the
+	// state exported from a running chain
+	Allocated           bool                                   `protobuf:"varint,3,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Airdrops            []Airdrop                              `protobuf:"bytes,4,rep,name=airdrops,proto3" json:"airdrops"`
+	CompletedAirdrops   []Airdrop                              `protobuf:"bytes,5,rep,name=completed_airdrops,json=completedAirdrops,proto3" json:"completed_airdrops" yaml:"completed_airdrops"`
+	AirdropsTotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=airdrops_total_amount,json=airdropsTotalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"airdrops_total_amount" yaml:"airdrops_total_amount"`
+	MerkleAirdrops      []MerkleAirdrop                        `protobuf:"bytes,7,rep,name=merkle_airdrops,json=merkleAirdrops,proto3" json:"merkle_airdrops" yaml:"merkle_airdrops"`
+	MerkleAirdropClaims []MerkleAirdropClaim                   `protobuf:"bytes,8,rep,name=merkle_airdrop_claims,json=merkleAirdropClaims,proto3" json:"merkle_airdrop_claims" yaml:"merkle_airdrop_claims"`
+	NextMerkleAirdropId uint64                                 `protobuf:"varint,9,opt,name=next_merkle_airdrop_id,json=nextMerkleAirdropId,proto3" json:"next_merkle_airdrop_id,omitempty" yaml:"next_merkle_airdrop_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +86,89 @@ func (m *GenesisState) GetAllocationAddresses() AllocationAddresses {
 	return AllocationAddresses{}
 }
 
+func (m *GenesisState) GetAllocated() bool {
+	if m != nil {
+		return m.Allocated
+	}
+	return false
+}
+
+func (m *GenesisState) GetAirdrops() []Airdrop {
+	if m != nil {
+		return m.Airdrops
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompletedAirdrops() []Airdrop {
+	if m != nil {
+		return m.CompletedAirdrops
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleAirdrops() []MerkleAirdrop {
+	if m != nil {
+		return m.MerkleAirdrops
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleAirdropClaims() []MerkleAirdropClaim {
+	if m != nil {
+		return m.MerkleAirdropClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextMerkleAirdropId() uint64 {
+	if m != nil {
+		return m.NextMerkleAirdropId
+	}
+	return 0
+}
+
+// MerkleAirdropClaim defines the amount paid out to a recipient of a merkle
+// airdrop.
+type MerkleAirdropClaim struct {
+	AirdropId uint64                                 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty" yaml:"airdrop_id"`
+	Address   string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MerkleAirdropClaim) Reset()         { *m = MerkleAirdropClaim{} }
+func (m *MerkleAirdropClaim) String() string { return proto.CompactTextString(m) }
+func (*MerkleAirdropClaim) ProtoMessage()    {}
+func (*MerkleAirdropClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb55c5690e68744e, []int{1}
+}
+func (m *MerkleAirdropClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAirdropClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAirdropClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleAirdropClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAirdropClaim.Merge(m, src)
+}
+func (m *MerkleAirdropClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAirdropClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAirdropClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAirdropClaim proto.InternalMessageInfo
+
 // Params defines the parameters for the module.
 type Params struct {
 	Allocation AllocationAmounts `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation"`
@@ -85,7 +177,7 @@ type Params struct {
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb55c5690e68744e, []int{1}
+	return fileDescriptor_bb55c5690e68744e, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,7 +227,7 @@ func (m *AllocationAmounts) Reset()         { *m = AllocationAmounts{} }
 func (m *AllocationAmounts) String() string { return proto.CompactTextString(m) }
 func (*AllocationAmounts) ProtoMessage()    {}
 func (*AllocationAmounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb55c5690e68744e, []int{2}
+	return fileDescriptor_bb55c5690e68744e, []int{3}
 }
 func (m *AllocationAmounts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +265,7 @@ func (m *AllocationAddresses) Reset()         { *m = AllocationAddresses{} }
 func (m *AllocationAddresses) String() string { return proto.CompactTextString(m) }
 func (*AllocationAddresses) ProtoMessage()    {}
 func (*AllocationAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb55c5690e68744e, []int{3}
+	return fileDescriptor_bb55c5690e68744e, []int{4}
 }
 func (m *AllocationAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,6 +296,7 @@ var xxx_messageInfo_AllocationAddresses proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gridiron.vesting.v1.GenesisState")
+	proto.RegisterType((*MerkleAirdropClaim)(nil), "gridiron.vesting.v1.MerkleAirdropClaim")
 	proto.RegisterType((*Params)(nil), "gridiron.vesting.v1.Params")
 	proto.RegisterType((*AllocationAmounts)(nil), "gridiron.vesting.v1.AllocationAmounts")
 	proto.RegisterType((*AllocationAddresses)(nil), "gridiron.vesting.v1.AllocationAddresses")
@@ -212,40 +305,57 @@ func init() {
 func init() { proto.RegisterFile("gridiron/vesting/v1/genesis.proto", fileDescriptor_bb55c5690e68744e) }
 
 var fileDescriptor_bb55c5690e68744e = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd4, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x07, 0x70, 0xbb, 0xa4, 0x41, 0x5c, 0x0b, 0x55, 0x9c, 0x82, 0xa2, 0x4a, 0x38, 0x51, 0x24,
-	0x5e, 0x84, 0x54, 0x5b, 0x85, 0x05, 0x75, 0x6b, 0x18, 0xaa, 0x8a, 0xa5, 0x18, 0xc1, 0x50, 0x24,
-	0xc2, 0xc5, 0x77, 0x72, 0x4f, 0x8d, 0xfd, 0x58, 0x77, 0x17, 0x43, 0xf9, 0x04, 0x8c, 0x1d, 0x19,
-	0x3b, 0xc3, 0x17, 0xe9, 0xd8, 0x11, 0x31, 0x54, 0x28, 0xf9, 0x22, 0xc8, 0xf7, 0xe2, 0xa6, 0x4d,
-	0xa5, 0x4a, 0x9e, 0xf2, 0xe2, 0xbf, 0x7f, 0x7f, 0x3f, 0x67, 0x9f, 0x51, 0x2f, 0xa5, 0x7c, 0xcc,
-	0x20, 0x0b, 0x0b, 0x2a, 0x24, 0xcb, 0x92, 0xb0, 0xd8, 0x0a, 0x13, 0x9a, 0x51, 0xc1, 0x44, 0x90,
-	0x73, 0x90, 0xe0, 0x79, 0x26, 0x11, 0x98, 0x44, 0x50, 0x6c, 0x6d, 0xac, 0x27, 0x90, 0x80, 0x3a,
-	0x1c, 0x96, 0xdf, 0x74, 0xb2, 0xff, 0xcb, 0x45, 0xab, 0xbb, 0xfa, 0xdc, 0xf7, 0x12, 0x4b, 0xea,
-	0xbd, 0x46, 0xcd, 0x1c, 0x73, 0x9c, 0x8a, 0x8e, 0xdb, 0x73, 0x9f, 0xaf, 0xbc, 0xdc, 0x08, 0x16,
-	0xad, 0x60, 0x5f, 0x25, 0x06, 0x8d, 0xb3, 0x8b, 0xae, 0x13, 0x99, 0xbc, 0xf7, 0x05, 0xad, 0xe3,
-	0xf1, 0x18, 0x62, 0x2c, 0x19, 0x64, 0x43, 0x4c, 0x08, 0xa7, 0x42, 0x50, 0xd1, 0x59, 0x52, 0xce,
-	0xb3, 0x9b, 0x9c, 0x9d, 0x2a, 0xbf, 0x63, 0xe3, 0x06, 0x6d, 0xe3, 0xc5, 0x43, 0xfd, 0x4f, 0xa8,
-	0xa9, 0x9b, 0xbd, 0xb7, 0x08, 0x5d, 0x06, 0xcc, 0x95, 0x3e, 0xb9, 0xa5, 0x21, 0x85, 0x49, 0x26,
-	0xad, 0x3f, 0x77, 0xfa, 0x76, 0xe3, 0xe7, 0x69, 0xd7, 0xe9, 0xff, 0x5e, 0x46, 0xad, 0x85, 0xb4,
-	0xf7, 0x0e, 0xad, 0x4a, 0x90, 0x78, 0x3c, 0xc4, 0xea, 0x0f, 0x55, 0x75, 0x6f, 0x10, 0x94, 0xc6,
-	0xdf, 0x8b, 0xee, 0xd3, 0x84, 0xc9, 0xc3, 0xc9, 0x28, 0x88, 0x21, 0x0d, 0x63, 0x10, 0x29, 0x08,
-	0xf3, 0xb1, 0x29, 0xc8, 0x51, 0x28, 0x8f, 0x73, 0x2a, 0x82, 0xbd, 0x4c, 0x46, 0x2b, 0xca, 0xd0,
-	0xa6, 0xf7, 0x01, 0x3d, 0xc0, 0x8c, 0x13, 0x0e, 0xb9, 0x45, 0x97, 0x6a, 0xa1, 0xf7, 0x8d, 0x62,
-	0xd8, 0x03, 0xd4, 0x2a, 0xe8, 0xd0, 0x8c, 0x6e, 0xe5, 0x3b, 0xb5, 0xe4, 0xb5, 0x82, 0x7e, 0xd4,
-	0x8e, 0xb1, 0x47, 0xe8, 0xa1, 0x90, 0xf8, 0xa8, 0x84, 0x39, 0xfd, 0x8a, 0x39, 0xb1, 0x7e, 0xa3,
-	0x96, 0xdf, 0x36, 0x58, 0xa4, 0xac, 0xcb, 0x8e, 0x18, 0xd2, 0x74, 0x92, 0x31, 0x79, 0x3c, 0xcc,
-	0x01, 0xaa, 0x25, 0x5f, 0xae, 0xd7, 0x51, 0x61, 0xfb, 0x00, 0x76, 0xe9, 0x0f, 0x51, 0x47, 0x48,
-	0x8e, 0x25, 0x4d, 0x58, 0x3c, 0xe4, 0x54, 0x50, 0x5e, 0x50, 0x5b, 0xd3, 0xac, 0x55, 0xf3, 0xa8,
-	0xf2, 0x22, 0xcd, 0x99, 0xa6, 0xcf, 0xa8, 0x2d, 0x29, 0x4e, 0xaf, 0xdf, 0x8f, 0xbb, 0xb5, 0x4a,
-	0x5a, 0x25, 0x75, 0xe5, 0x8e, 0xf4, 0x4f, 0x5c, 0xd4, 0xbe, 0x61, 0xf7, 0x78, 0x2f, 0x50, 0xeb,
-	0x6a, 0x2f, 0x21, 0x5c, 0x3f, 0xb4, 0xd1, 0xda, 0xbc, 0x42, 0x08, 0xf7, 0x76, 0x51, 0x6f, 0x71,
-	0x35, 0xe2, 0x89, 0x90, 0x40, 0x18, 0xd6, 0x3b, 0x58, 0x3f, 0x9a, 0xd1, 0xe3, 0xeb, 0x53, 0xbe,
-	0xb1, 0xa9, 0x12, 0xda, 0x6e, 0xfc, 0x38, 0xed, 0x3a, 0x83, 0xbd, 0xb3, 0xa9, 0xef, 0x9e, 0x4f,
-	0x7d, 0xf7, 0xdf, 0xd4, 0x77, 0x4f, 0x66, 0xbe, 0x73, 0x3e, 0xf3, 0x9d, 0x3f, 0x33, 0xdf, 0x39,
-	0x08, 0xe7, 0xe6, 0x34, 0x7b, 0x74, 0xf3, 0x3b, 0x64, 0xd4, 0xfe, 0x08, 0xbf, 0x55, 0xaf, 0x32,
-	0x35, 0xf4, 0xa8, 0xa9, 0x5e, 0x4e, 0xaf, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x8a, 0x43,
-	0x60, 0xea, 0x04, 0x00, 0x00,
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0x08, 0x81, 0x0c, 0x2c, 0x28, 0x63, 0x82, 0xbc, 0x6c, 0x48, 0x82, 0xb5, 0x62,
+	0xa3, 0x95, 0x70, 0x04, 0xbb, 0x97, 0x72, 0xa8, 0x94, 0x20, 0x15, 0xa1, 0xfe, 0x10, 0x75, 0x5b,
+	0x0e, 0xa8, 0xaa, 0x35, 0xd8, 0xa3, 0x60, 0x25, 0xf6, 0x44, 0x9e, 0x49, 0x0a, 0xbd, 0xb5, 0xa7,
+	0x1e, 0x39, 0xf6, 0xd8, 0x7b, 0x6f, 0xfd, 0x2b, 0x38, 0x72, 0xac, 0x7a, 0x88, 0x2a, 0xf8, 0x0f,
+	0xf2, 0x17, 0x54, 0x1e, 0xcf, 0x38, 0xbf, 0x2c, 0x51, 0xd2, 0x53, 0xe2, 0x37, 0xdf, 0xf7, 0xf9,
+	0xfa, 0xcd, 0xbc, 0xe7, 0x01, 0x9b, 0x8d, 0xc0, 0x75, 0xdc, 0x80, 0xf8, 0xd5, 0x2e, 0xa6, 0xcc,
+	0xf5, 0x1b, 0xd5, 0xee, 0x4e, 0xb5, 0x81, 0x7d, 0x4c, 0x5d, 0x6a, 0xb4, 0x03, 0xc2, 0x08, 0x54,
+	0xa5, 0xc4, 0x10, 0x12, 0xa3, 0xbb, 0xb3, 0xbe, 0xda, 0x20, 0x0d, 0xc2, 0xd7, 0xab, 0xe1, 0xbf,
+	0x48, 0xba, 0x9e, 0x48, 0x93, 0x59, 0x5c, 0xa2, 0x5f, 0x65, 0xc0, 0xd2, 0x41, 0xc4, 0x7f, 0xc1,
+	0x10, 0xc3, 0xf0, 0x01, 0xc8, 0xb4, 0x51, 0x80, 0x3c, 0xaa, 0x29, 0x65, 0xa5, 0xb2, 0xb8, 0xfb,
+	0x97, 0x91, 0xe0, 0x67, 0x1c, 0x71, 0x49, 0x3d, 0x7d, 0xd5, 0x2b, 0xa5, 0x4c, 0x91, 0x00, 0x11,
+	0x58, 0x45, 0xad, 0x16, 0xb1, 0x11, 0x73, 0x89, 0x6f, 0x21, 0xc7, 0x09, 0x30, 0xa5, 0x98, 0x6a,
+	0x33, 0x1c, 0x54, 0x49, 0x04, 0xd5, 0xe2, 0x84, 0x9a, 0xd4, 0x0b, 0xaa, 0x8a, 0x26, 0x97, 0x60,
+	0x01, 0x64, 0x45, 0x18, 0x3b, 0xda, 0x6c, 0x59, 0xa9, 0x2c, 0x98, 0x83, 0x00, 0x7c, 0x08, 0x16,
+	0x90, 0x1b, 0x38, 0x01, 0x69, 0x53, 0x2d, 0x5d, 0x9e, 0xad, 0x2c, 0xee, 0x16, 0x92, 0x4d, 0x23,
+	0x91, 0x30, 0x8a, 0x73, 0xa0, 0x0f, 0xa0, 0x4d, 0xbc, 0x76, 0x0b, 0x33, 0xec, 0x58, 0x31, 0x69,
+	0xee, 0x17, 0x48, 0x9b, 0x21, 0xa9, 0xdf, 0x2b, 0xfd, 0x79, 0x81, 0xbc, 0xd6, 0x9e, 0x3e, 0x49,
+	0xd1, 0xcd, 0x5c, 0x1c, 0xac, 0x49, 0xbf, 0x0f, 0x0a, 0xc8, 0x4b, 0x81, 0xc5, 0x08, 0x43, 0x2d,
+	0x0b, 0x79, 0xa4, 0xe3, 0x33, 0x2d, 0x53, 0x56, 0x2a, 0xd9, 0xfa, 0xb3, 0x90, 0xfa, 0xbd, 0x57,
+	0xda, 0x6a, 0xb8, 0xec, 0xac, 0x73, 0x6a, 0xd8, 0xc4, 0xab, 0xda, 0x84, 0x7a, 0x84, 0x8a, 0x9f,
+	0x6d, 0xea, 0x34, 0xab, 0xec, 0xa2, 0x8d, 0xa9, 0x71, 0xe8, 0xb3, 0x7e, 0xaf, 0x54, 0x88, 0xfc,
+	0x13, 0xa1, 0xba, 0xa9, 0xca, 0xf8, 0xcb, 0x30, 0x5c, 0xe3, 0x51, 0xd8, 0x04, 0x2b, 0x1e, 0x0e,
+	0x9a, 0x2d, 0x3c, 0xa8, 0x78, 0x9e, 0x57, 0xac, 0x27, 0x56, 0xfc, 0x94, 0x6b, 0x65, 0xdd, 0x45,
+	0x51, 0xf7, 0x5a, 0xe4, 0x3b, 0x06, 0xd2, 0xcd, 0x65, 0x6f, 0x58, 0x4e, 0xe1, 0x7b, 0x05, 0xe4,
+	0x47, 0x45, 0x96, 0xdd, 0x42, 0xae, 0x47, 0xb5, 0x05, 0xee, 0xf9, 0xcf, 0xdd, 0x9e, 0xfb, 0xa1,
+	0xbe, 0xfe, 0xb7, 0x30, 0x2e, 0x24, 0x19, 0x0b, 0xa6, 0x6e, 0xaa, 0xde, 0x44, 0x26, 0x85, 0xc7,
+	0x60, 0xcd, 0xc7, 0xe7, 0xcc, 0x1a, 0xcb, 0x71, 0x1d, 0x2d, 0x5b, 0x56, 0x2a, 0xe9, 0xfa, 0x66,
+	0xbf, 0x57, 0xda, 0x88, 0xb0, 0xc9, 0x3a, 0xdd, 0x54, 0xc3, 0x85, 0x91, 0xb7, 0x3a, 0x74, 0xf4,
+	0xaf, 0x0a, 0x80, 0x93, 0x6f, 0x0a, 0xff, 0x07, 0x60, 0xc8, 0x42, 0xe1, 0x16, 0xf9, 0x7e, 0xaf,
+	0x94, 0x1b, 0x39, 0x2a, 0x8e, 0xcd, 0x22, 0x09, 0x83, 0x1a, 0x98, 0x17, 0x03, 0xc4, 0xc7, 0x27,
+	0x6b, 0xca, 0x47, 0xf8, 0x08, 0x64, 0x44, 0x93, 0xcc, 0xf2, 0x26, 0x31, 0xee, 0xd7, 0x24, 0xa6,
+	0xc8, 0xde, 0x4b, 0x7f, 0xfc, 0x5c, 0x4a, 0xe9, 0xaf, 0x41, 0x26, 0x9a, 0x65, 0xf8, 0x04, 0x80,
+	0xc1, 0xc4, 0x89, 0xe1, 0xdf, 0xba, 0x6b, 0x66, 0x39, 0x4a, 0x4e, 0xec, 0x50, 0xfe, 0x5e, 0xfa,
+	0x53, 0x48, 0xff, 0x32, 0x07, 0x72, 0x13, 0x6a, 0xf8, 0x1c, 0x2c, 0x8d, 0x34, 0xbb, 0x32, 0x55,
+	0x1d, 0x8b, 0x6c, 0xa8, 0x89, 0x5f, 0x81, 0x65, 0xb9, 0x91, 0x02, 0x3a, 0x33, 0x15, 0xf4, 0x0f,
+	0x41, 0x11, 0xd8, 0x13, 0x90, 0xeb, 0x62, 0x4b, 0x94, 0x6e, 0xfd, 0xd6, 0xb6, 0xaf, 0x74, 0xf1,
+	0x71, 0xc4, 0x11, 0xec, 0x53, 0x90, 0xa7, 0x0c, 0x35, 0x43, 0x70, 0x80, 0xdf, 0xa2, 0xc0, 0x91,
+	0xfc, 0xf4, 0x54, 0x7c, 0x55, 0xc0, 0x4c, 0xce, 0x1a, 0x78, 0xd8, 0xc4, 0xf3, 0x3a, 0xbe, 0xcb,
+	0x2e, 0xac, 0x36, 0x21, 0xf1, 0x96, 0xcf, 0x4d, 0xe7, 0x11, 0xc3, 0x8e, 0x08, 0x91, 0x5b, 0x7f,
+	0x06, 0x34, 0xca, 0x02, 0xc4, 0x70, 0xc3, 0xb5, 0xad, 0x00, 0x53, 0x1c, 0x74, 0xf1, 0xe8, 0x67,
+	0xec, 0xbe, 0x36, 0x6b, 0x31, 0xcf, 0x8c, 0x70, 0xc2, 0xe9, 0x0d, 0x50, 0x19, 0x46, 0xde, 0xf8,
+	0x79, 0xcc, 0x4f, 0x65, 0x92, 0x0b, 0x51, 0x23, 0x27, 0xa2, 0x5f, 0x2a, 0x40, 0x4d, 0xb8, 0x8f,
+	0xe0, 0xbf, 0x20, 0x37, 0xea, 0xeb, 0x38, 0x41, 0xd4, 0xb4, 0xe6, 0xca, 0x30, 0xc5, 0x71, 0x02,
+	0x78, 0x00, 0xca, 0x93, 0xbb, 0x61, 0x77, 0x28, 0x23, 0x8e, 0x8b, 0xa2, 0x4b, 0x51, 0x0c, 0xf4,
+	0xc6, 0x78, 0x95, 0xfb, 0x52, 0x15, 0x82, 0xa2, 0xf1, 0xac, 0x3f, 0xbe, 0xba, 0x29, 0x2a, 0xd7,
+	0x37, 0x45, 0xe5, 0xc7, 0x4d, 0x51, 0xb9, 0xbc, 0x2d, 0xa6, 0xae, 0x6f, 0x8b, 0xa9, 0x6f, 0xb7,
+	0xc5, 0xd4, 0xc9, 0xce, 0x50, 0x9d, 0x72, 0x48, 0xb7, 0xdf, 0x11, 0x1f, 0xc7, 0x4f, 0xd5, 0xf3,
+	0xf8, 0xda, 0xe7, 0x65, 0x9f, 0x66, 0xf8, 0x95, 0xff, 0xdf, 0xcf, 0x01, 0x00, 0xad, 0xe0, 0xe4,
+	0xc0, 0x65, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -268,6 +378,87 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextMerkleAirdropId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextMerkleAirdropId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MerkleAirdropClaims) > 0 {
+		for iNdEx := len(m.MerkleAirdropClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleAirdropClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MerkleAirdrops) > 0 {
+		for iNdEx := len(m.MerkleAirdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleAirdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.AirdropsTotalAmount.Size()
+		i -= size
+		if _, err := m.AirdropsTotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.CompletedAirdrops) > 0 {
+		for iNdEx := len(m.CompletedAirdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletedAirdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Airdrops) > 0 {
+		for iNdEx := len(m.Airdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Airdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Allocated {
+		i--
+		if m.Allocated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.AllocationAddresses.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -291,6 +482,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MerkleAirdropClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAirdropClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAirdropClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -475,6 +711,56 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.AllocationAddresses.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.Allocated {
+		n += 2
+	}
+	if len(m.Airdrops) > 0 {
+		for _, e := range m.Airdrops {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompletedAirdrops) > 0 {
+		for _, e := range m.CompletedAirdrops {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AirdropsTotalAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MerkleAirdrops) > 0 {
+		for _, e := range m.MerkleAirdrops {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleAirdropClaims) > 0 {
+		for _, e := range m.MerkleAirdropClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextMerkleAirdropId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextMerkleAirdropId))
+	}
+	return n
+}
+
+func (m *MerkleAirdropClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovGenesis(uint64(m.AirdropId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -630,6 +916,350 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allocated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Airdrops = append(m.Airdrops, Airdrop{})
+			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAirdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletedAirdrops = append(m.CompletedAirdrops, Airdrop{})
+			if err := m.CompletedAirdrops[len(m.CompletedAirdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropsTotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AirdropsTotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAirdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleAirdrops = append(m.MerkleAirdrops, MerkleAirdrop{})
+			if err := m.MerkleAirdrops[len(m.MerkleAirdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAirdropClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleAirdropClaims = append(m.MerkleAirdropClaims, MerkleAirdropClaim{})
+			if err := m.MerkleAirdropClaims[len(m.MerkleAirdropClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMerkleAirdropId", wireType)
+			}
+			m.NextMerkleAirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextMerkleAirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleAirdropClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleAirdropClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleAirdropClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
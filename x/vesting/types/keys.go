@@ -25,6 +25,10 @@ const (
 	prefixAirdropsTotalAmount
 	prefixAirdrops
 	prefixAirdropsCompleted
+	prefixMerkleAirdrops
+	prefixMerkleAirdropClaims
+	prefixNextMerkleAirdropID
+	prefixAllocated
 )
 
 var (
@@ -32,6 +36,10 @@ var (
 	KeyPrefixAirdropsTotalAmount = []byte{prefixAirdropsTotalAmount}
 	KeyPrefixAirdrops            = []byte{prefixAirdrops}
 	KeyPrefixAirdropsCompleted   = []byte{prefixAirdropsCompleted}
+	KeyPrefixMerkleAirdrops      = []byte{prefixMerkleAirdrops}
+	KeyPrefixMerkleAirdropClaims = []byte{prefixMerkleAirdropClaims}
+	KeyPrefixNextMerkleAirdropID = []byte{prefixNextMerkleAirdropID}
+	KeyPrefixAllocated           = []byte{prefixAllocated}
 )
 
 func AllocationAddrKey() []byte {
@@ -49,3 +57,23 @@ func AirdropsKey(acc sdk.AccAddress) []byte {
 func AirdropsCompletedKey(acc sdk.AccAddress) []byte {
 	return append(KeyPrefixAirdropsCompleted, address.MustLengthPrefix(acc)...)
 }
+
+func MerkleAirdropKey(id uint64) []byte {
+	return append(KeyPrefixMerkleAirdrops, sdk.Uint64ToBigEndian(id)...)
+}
+
+func MerkleAirdropClaimsKey(id uint64) []byte {
+	return append(KeyPrefixMerkleAirdropClaims, sdk.Uint64ToBigEndian(id)...)
+}
+
+func MerkleAirdropClaimKey(id uint64, acc sdk.AccAddress) []byte {
+	return append(MerkleAirdropClaimsKey(id), address.MustLengthPrefix(acc)...)
+}
+
+func NextMerkleAirdropIDKey() []byte {
+	return KeyPrefixNextMerkleAirdropID
+}
+
+func AllocatedKey() []byte {
+	return KeyPrefixAllocated
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Prefixes of the hashed merkle leaves and internal nodes, which keep a leaf
+// from being proved as an internal node and vice versa.
+const (
+	merkleLeafPrefix byte = 0x00
+	merkleNodePrefix byte = 0x01
+)
+
+// MerkleAirdropLeaf returns the merkle leaf of the airdrop amount of the
+// recipient, i.e., sha256(0x00 || address || amount as 32-byte big-endian).
+func MerkleAirdropLeaf(recipient sdk.AccAddress, amount sdk.Int) []byte {
+	bz := make([]byte, 32)
+	amount.BigInt().FillBytes(bz)
+
+	h := sha256.New()
+	h.Write([]byte{merkleLeafPrefix})
+	h.Write(recipient)
+	h.Write(bz)
+	return h.Sum(nil)
+}
+
+// hashPair hashes the two nodes in sorted order, so that a proof does not need
+// to tell the position of each sibling, i.e., sha256(0x01 || min || max).
+func hashPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h := sha256.New()
+	h.Write([]byte{merkleNodePrefix})
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// VerifyMerkleProof returns whether the leaf is included in the merkle tree of the root.
+func VerifyMerkleProof(root []byte, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashPair(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+// MerkleRoot returns the root of the merkle tree built from the leaves.
+// The last node of a level with odd number of nodes is promoted to the next level.
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+	return level[0]
+}
+
+// MerkleProof returns the proof of the leaf at the index in the merkle tree built from the leaves.
+func MerkleProof(leaves [][]byte, index int) [][]byte {
+	var proof [][]byte
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextMerkleLevel(level)
+		index /= 2
+	}
+	return proof
+}
+
+func nextMerkleLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 < len(level) {
+			next = append(next, hashPair(level[i], level[i+1]))
+		} else {
+			next = append(next, level[i])
+		}
+	}
+	return next
+}
+
+// ParseMerkleHash parses the hex-encoded merkle node hash.
+func ParseMerkleHash(s string) ([]byte, error) {
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != sha256.Size {
+		return nil, fmt.Errorf("invalid merkle hash length %d", len(bz))
+	}
+	return bz, nil
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gridiron-zone/gridiron/x/vesting/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleProof(t *testing.T) {
+	var leaves [][]byte
+	for i := 1; i <= 5; i++ {
+		addr := sdk.AccAddress([]byte{byte(i)})
+		leaves = append(leaves, types.MerkleAirdropLeaf(addr, sdk.NewInt(int64(i*100))))
+	}
+	root := types.MerkleRoot(leaves)
+	require.Len(t, root, 32)
+
+	for i, leaf := range leaves {
+		proof := types.MerkleProof(leaves, i)
+		require.True(t, types.VerifyMerkleProof(root, leaf, proof), "leaf %d", i)
+	}
+
+	// Wrong amount
+	proof := types.MerkleProof(leaves, 0)
+	require.False(t, types.VerifyMerkleProof(root, types.MerkleAirdropLeaf(sdk.AccAddress([]byte{1}), sdk.NewInt(101)), proof))
+
+	// An internal node cannot be proved as a leaf of the preimage of the node
+	forged := types.MerkleAirdropLeaf(sdk.AccAddress(leaves[0]), sdk.NewIntFromBigInt(new(big.Int).SetBytes(leaves[1])))
+	require.False(t, types.VerifyMerkleProof(root, forged, proof[1:]))
+
+	// Single leaf
+	require.Equal(t, leaves[0], types.MerkleRoot(leaves[:1]))
+	require.Empty(t, types.MerkleProof(leaves[:1], 0))
+	require.True(t, types.VerifyMerkleProof(leaves[0], leaves[0], nil))
+}
+
+func TestParseMerkleHash(t *testing.T) {
+	_, err := types.ParseMerkleHash("xyz")
+	require.Error(t, err)
+	_, err = types.ParseMerkleHash("abcd")
+	require.Error(t, err)
+	bz, err := types.ParseMerkleHash("0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	require.Len(t, bz, 32)
+}
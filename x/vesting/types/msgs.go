@@ -9,12 +9,18 @@ const (
 	TypeMsgAddAirdrops          = "add_airdrops"
 	TypeMsgExecuteAirdrops      = "execute_airdrops"
 	TypeMsgSetAllocationAddress = "set_allocation_address"
+	TypeMsgCreateMerkleAirdrop  = "create_merkle_airdrop"
+	TypeMsgClaimAirdrop         = "claim_airdrop"
+	TypeMsgClawbackAirdrop      = "clawback_airdrop"
 )
 
 var (
 	_ sdk.Msg = &MsgAddAirdrops{}
 	_ sdk.Msg = &MsgExecuteAirdrops{}
 	_ sdk.Msg = &MsgSetAllocationAddress{}
+	_ sdk.Msg = &MsgCreateMerkleAirdrop{}
+	_ sdk.Msg = &MsgClaimAirdrop{}
+	_ sdk.Msg = &MsgClawbackAirdrop{}
 )
 
 // Route implements sdk.Msg
@@ -133,3 +139,112 @@ func (m *MsgSetAllocationAddress) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgCreateMerkleAirdrop) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgCreateMerkleAirdrop) Type() string { return TypeMsgCreateMerkleAirdrop }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgCreateMerkleAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgCreateMerkleAirdrop) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = ParseMerkleHash(m.MerkleRoot)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merkle root (%s)", err)
+	}
+	if m.Cap.IsNil() || !m.Cap.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cap must be > 0")
+	}
+	if m.Deadline == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deadline must be > 0")
+	}
+	if m.VeBonusMultiplier.IsNil() || m.VeBonusMultiplier.LT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve bonus multiplier must be >= 1")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgCreateMerkleAirdrop) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgClaimAirdrop) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClaimAirdrop) Type() string { return TypeMsgClaimAirdrop }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClaimAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimAirdrop) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "amount must be > 0")
+	}
+	for _, node := range m.Proof {
+		_, err = ParseMerkleHash(node)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merkle proof (%s)", err)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimAirdrop) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgClawbackAirdrop) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClawbackAirdrop) Type() string { return TypeMsgClawbackAirdrop }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClawbackAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClawbackAirdrop) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClawbackAirdrop) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
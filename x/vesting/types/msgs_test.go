@@ -173,3 +173,45 @@ func TestMsgSetAllocationAddress_GetSigners(t *testing.T) {
 	signers := msg.GetSigners()
 	require.Equal(t, addr, signers[0].String())
 }
+
+func TestMsgCreateMerkleAirdrop_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	sender := sdk.AccAddress([]byte("sender")).String()
+	root := "5f2c6c3d0a6b1a8e4bd4bc2e4e1d7c0f8c3d1e2f3a4b5c6d7e8f90a1b2c3d4e5"
+	for _, tc := range []struct {
+		desc  string
+		msg   types.MsgCreateMerkleAirdrop
+		valid bool
+	}{
+		{
+			desc: "invalid sender address",
+			msg:  types.MsgCreateMerkleAirdrop{Sender: "", MerkleRoot: root, Cap: sdk.NewInt(1), Deadline: 1, VeBonusMultiplier: sdk.OneDec()},
+		},
+		{
+			desc: "invalid merkle root",
+			msg:  types.MsgCreateMerkleAirdrop{Sender: sender, MerkleRoot: "abcd", Cap: sdk.NewInt(1), Deadline: 1, VeBonusMultiplier: sdk.OneDec()},
+		},
+		{
+			desc: "zero cap",
+			msg:  types.MsgCreateMerkleAirdrop{Sender: sender, MerkleRoot: root, Cap: sdk.ZeroInt(), Deadline: 1, VeBonusMultiplier: sdk.OneDec()},
+		},
+		{
+			desc: "ve bonus multiplier less than one",
+			msg:  types.MsgCreateMerkleAirdrop{Sender: sender, MerkleRoot: root, Cap: sdk.NewInt(1), Deadline: 1, VeBonusMultiplier: sdk.NewDecWithPrec(5, 1)},
+		},
+		{
+			desc:  "valid",
+			msg:   types.MsgCreateMerkleAirdrop{Sender: sender, MerkleRoot: root, Cap: sdk.NewInt(1), Deadline: 1, VeBonusMultiplier: sdk.OneDec()},
+			valid: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
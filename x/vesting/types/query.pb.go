@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -233,6 +234,291 @@ func (m *QueryAirdropResponse) GetAirdrop() Airdrop {
 	return Airdrop{}
 }
 
+type QueryMerkleAirdropsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerkleAirdropsRequest) Reset()         { *m = QueryMerkleAirdropsRequest{} }
+func (m *QueryMerkleAirdropsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropsRequest) ProtoMessage()    {}
+func (*QueryMerkleAirdropsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed7c8be0346c387, []int{4}
+}
+func (m *QueryMerkleAirdropsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleAirdropsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleAirdropsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropsRequest.Merge(m, src)
+}
+func (m *QueryMerkleAirdropsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleAirdropsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleAirdropsRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleAirdropsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMerkleAirdropsResponse struct {
+	Airdrops []MerkleAirdrop `protobuf:"bytes,1,rep,name=airdrops,proto3" json:"airdrops"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerkleAirdropsResponse) Reset()         { *m = QueryMerkleAirdropsResponse{} }
+func (m *QueryMerkleAirdropsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropsResponse) ProtoMessage()    {}
+func (*QueryMerkleAirdropsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed7c8be0346c387, []int{5}
+}
+func (m *QueryMerkleAirdropsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleAirdropsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleAirdropsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropsResponse.Merge(m, src)
+}
+func (m *QueryMerkleAirdropsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleAirdropsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleAirdropsResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleAirdropsResponse) GetAirdrops() []MerkleAirdrop {
+	if m != nil {
+		return m.Airdrops
+	}
+	return nil
+}
+
+func (m *QueryMerkleAirdropsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMerkleAirdropRequest struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+}
+
+func (m *QueryMerkleAirdropRequest) Reset()         { *m = QueryMerkleAirdropRequest{} }
+func (m *QueryMerkleAirdropRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropRequest) ProtoMessage()    {}
+func (*QueryMerkleAirdropRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed7c8be0346c387, []int{6}
+}
+func (m *QueryMerkleAirdropRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleAirdropRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleAirdropRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropRequest.Merge(m, src)
+}
+func (m *QueryMerkleAirdropRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleAirdropRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleAirdropRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleAirdropRequest) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
+	}
+	return 0
+}
+
+type QueryMerkleAirdropResponse struct {
+	Airdrop MerkleAirdrop `protobuf:"bytes,1,opt,name=airdrop,proto3" json:"airdrop"`
+}
+
+func (m *QueryMerkleAirdropResponse) Reset()         { *m = QueryMerkleAirdropResponse{} }
+func (m *QueryMerkleAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleAirdropResponse) ProtoMessage()    {}
+func (*QueryMerkleAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed7c8be0346c387, []int{7}
+}
+func (m *QueryMerkleAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleAirdropResponse.Merge(m, src)
+}
+func (m *QueryMerkleAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleAirdropResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleAirdropResponse) GetAirdrop() MerkleAirdrop {
+	if m != nil {
+		return m.Airdrop
+	}
+	return MerkleAirdrop{}
+}
+
+type QueryAirdropClaimRequest struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAirdropClaimRequest) Reset()         { *m = QueryAirdropClaimRequest{} }
+func (m *QueryAirdropClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropClaimRequest) ProtoMessage()    {}
+func (*QueryAirdropClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed7c8be0346c387, []int{8}
+}
+func (m *QueryAirdropClaimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAirdropClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAirdropClaimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAirdropClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropClaimRequest.Merge(m, src)
+}
+func (m *QueryAirdropClaimRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAirdropClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropClaimRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAirdropClaimRequest proto.InternalMessageInfo
+
+func (m *QueryAirdropClaimRequest) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
+	}
+	return 0
+}
+
+func (m *QueryAirdropClaimRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryAirdropClaimResponse struct {
+	// whether the address has claimed
+	Claimed bool `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	// amount paid out to the address, including the ve bonus
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *QueryAirdropClaimResponse) Reset()         { *m = QueryAirdropClaimResponse{} }
+func (m *QueryAirdropClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAirdropClaimResponse) ProtoMessage()    {}
+func (*QueryAirdropClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed7c8be0346c387, []int{9}
+}
+func (m *QueryAirdropClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAirdropClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAirdropClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAirdropClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAirdropClaimResponse.Merge(m, src)
+}
+func (m *QueryAirdropClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAirdropClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAirdropClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAirdropClaimResponse proto.InternalMessageInfo
+
+func (m *QueryAirdropClaimResponse) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -241,7 +527,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fed7c8be0346c387, []int{4}
+	return fileDescriptor_fed7c8be0346c387, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +566,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fed7c8be0346c387, []int{5}
+	return fileDescriptor_fed7c8be0346c387, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,6 +607,12 @@ func init() {
 	proto.RegisterType((*QueryAirdropsResponse)(nil), "gridiron.vesting.v1.QueryAirdropsResponse")
 	proto.RegisterType((*QueryAirdropRequest)(nil), "gridiron.vesting.v1.QueryAirdropRequest")
 	proto.RegisterType((*QueryAirdropResponse)(nil), "gridiron.vesting.v1.QueryAirdropResponse")
+	proto.RegisterType((*QueryMerkleAirdropsRequest)(nil), "gridiron.vesting.v1.QueryMerkleAirdropsRequest")
+	proto.RegisterType((*QueryMerkleAirdropsResponse)(nil), "gridiron.vesting.v1.QueryMerkleAirdropsResponse")
+	proto.RegisterType((*QueryMerkleAirdropRequest)(nil), "gridiron.vesting.v1.QueryMerkleAirdropRequest")
+	proto.RegisterType((*QueryMerkleAirdropResponse)(nil), "gridiron.vesting.v1.QueryMerkleAirdropResponse")
+	proto.RegisterType((*QueryAirdropClaimRequest)(nil), "gridiron.vesting.v1.QueryAirdropClaimRequest")
+	proto.RegisterType((*QueryAirdropClaimResponse)(nil), "gridiron.vesting.v1.QueryAirdropClaimResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "gridiron.vesting.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "gridiron.vesting.v1.QueryParamsResponse")
 }
@@ -328,41 +620,57 @@ func init() {
 func init() { proto.RegisterFile("gridiron/vesting/v1/query.proto", fileDescriptor_fed7c8be0346c387) }
 
 var fileDescriptor_fed7c8be0346c387 = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x0d, 0xba, 0xce, 0xbd, 0x99, 0x22, 0x55, 0xa1, 0xca, 0xaa, 0x08, 0xad, 0x61,
-	0x02, 0x5b, 0x2d, 0x17, 0x24, 0xc4, 0x61, 0x3b, 0x80, 0x38, 0x31, 0x02, 0x27, 0x2e, 0xc8, 0x6d,
-	0x2c, 0x13, 0xa9, 0x89, 0x33, 0xdb, 0xad, 0x18, 0xb0, 0x0b, 0x12, 0xf7, 0x49, 0x7c, 0x00, 0xc4,
-	0xb7, 0xd9, 0x71, 0x12, 0x17, 0x4e, 0x08, 0xb5, 0x7c, 0x10, 0x54, 0xdb, 0xc9, 0xda, 0x11, 0xd6,
-	0xdd, 0xa2, 0xe7, 0xff, 0x7b, 0xff, 0x9f, 0xff, 0xcf, 0x0a, 0xf4, 0x53, 0x26, 0xc7, 0x89, 0xc8,
-	0xc8, 0x94, 0x29, 0x9d, 0x64, 0x9c, 0x4c, 0xfb, 0xe4, 0x68, 0xc2, 0xe4, 0x31, 0xce, 0xa5, 0xd0,
-	0x02, 0x21, 0x77, 0x8e, 0xdd, 0x39, 0x9e, 0xf6, 0xbd, 0x16, 0x17, 0x5c, 0x98, 0x63, 0xb2, 0xf8,
-	0xb2, 0x4a, 0xaf, 0xc3, 0x85, 0xe0, 0x63, 0x46, 0x68, 0x9e, 0x10, 0x9a, 0x65, 0x42, 0x53, 0x9d,
-	0x88, 0x4c, 0xb9, 0xd3, 0xbd, 0x91, 0x50, 0xa9, 0x50, 0x64, 0x48, 0x15, 0xb3, 0x06, 0x64, 0xda,
-	0x1f, 0x32, 0x4d, 0xfb, 0x24, 0xa7, 0x3c, 0xc9, 0x8c, 0xd8, 0x69, 0xbb, 0x15, 0x4c, 0x9c, 0x65,
-	0x4c, 0x25, 0xea, 0x0a, 0x45, 0x01, 0x68, 0x14, 0xc1, 0x27, 0xd8, 0x7a, 0xb9, 0x70, 0xd9, 0x4f,
-	0x64, 0x2c, 0x45, 0xae, 0x22, 0x76, 0x34, 0x61, 0x4a, 0xa3, 0x0e, 0xdc, 0x1e, 0x89, 0x34, 0x1f,
-	0x33, 0xcd, 0xe2, 0x36, 0xe8, 0x82, 0xb0, 0x11, 0x5d, 0x14, 0xd0, 0x53, 0x08, 0x2f, 0x68, 0xda,
-	0x1b, 0x5d, 0x10, 0x36, 0x07, 0xbb, 0xd8, 0xa2, 0xe3, 0x05, 0x3a, 0xb6, 0xd9, 0x38, 0x74, 0x7c,
-	0x48, 0x39, 0x73, 0x93, 0xa3, 0xa5, 0xce, 0xe0, 0x1b, 0x80, 0xb7, 0x2f, 0xd9, 0xab, 0x5c, 0x64,
-	0x8a, 0xa1, 0x27, 0xb0, 0x41, 0x5d, 0xad, 0x0d, 0xba, 0x9b, 0x61, 0x73, 0x70, 0x07, 0xff, 0x1b,
-	0x31, 0x76, 0x7d, 0x07, 0x37, 0xce, 0x7e, 0xed, 0xd4, 0xa2, 0xb2, 0x05, 0x3d, 0xab, 0x00, 0xec,
-	0xad, 0x05, 0xb4, 0xde, 0x2b, 0x84, 0xaf, 0xe1, 0xad, 0x65, 0xc0, 0x22, 0x9e, 0x1d, 0xd8, 0xd4,
-	0x54, 0x72, 0xa6, 0xdf, 0xd2, 0x38, 0x96, 0x26, 0xa0, 0xed, 0x08, 0xda, 0xd2, 0x7e, 0x1c, 0xcb,
-	0xd5, 0xfc, 0x36, 0x2e, 0xe5, 0x17, 0xbc, 0x5a, 0x4d, 0xbd, 0xbc, 0xf5, 0x63, 0xb8, 0xe5, 0xae,
-	0x60, 0x46, 0x5e, 0xeb, 0xd2, 0x45, 0x47, 0xd0, 0x82, 0xc8, 0x0c, 0x3d, 0xa4, 0x92, 0xa6, 0xc5,
-	0x22, 0x83, 0x17, 0xee, 0x02, 0x45, 0xd5, 0x39, 0x3d, 0x82, 0xf5, 0xdc, 0x54, 0x9c, 0x91, 0x57,
-	0x65, 0x64, 0x7b, 0x9c, 0x8f, 0xd3, 0x0f, 0xbe, 0x6f, 0xc2, 0x9b, 0x66, 0x22, 0xfa, 0x02, 0x60,
-	0xa3, 0x58, 0x1c, 0x0a, 0xab, 0x06, 0x54, 0x3d, 0x2d, 0xef, 0xde, 0x35, 0x94, 0x96, 0x32, 0xb8,
-	0xfb, 0xf9, 0xc7, 0x9f, 0xaf, 0x1b, 0x3e, 0xea, 0x90, 0x8a, 0x87, 0x5c, 0x2e, 0xfb, 0x14, 0xc0,
-	0x2d, 0xd7, 0x8a, 0x7a, 0xeb, 0x86, 0x17, 0x14, 0xe1, 0x7a, 0xa1, 0x83, 0x18, 0x18, 0x88, 0xfb,
-	0x68, 0xef, 0x2a, 0x08, 0xf2, 0x71, 0xe9, 0x3d, 0x9c, 0xa0, 0x13, 0x58, 0xb7, 0xe1, 0xa1, 0xdd,
-	0xff, 0xfa, 0xac, 0xec, 0xc9, 0xeb, 0xad, 0xd5, 0x39, 0x9c, 0xc0, 0xe0, 0x74, 0x90, 0x57, 0x85,
-	0x63, 0x77, 0x74, 0xf0, 0xfc, 0x6c, 0xe6, 0x83, 0xf3, 0x99, 0x0f, 0x7e, 0xcf, 0x7c, 0x70, 0x3a,
-	0xf7, 0x6b, 0xe7, 0x73, 0xbf, 0xf6, 0x73, 0xee, 0xd7, 0xde, 0x10, 0x9e, 0xe8, 0x77, 0x93, 0x21,
-	0x1e, 0x89, 0xb4, 0xe8, 0x7f, 0xf0, 0x41, 0x64, 0xac, 0x1c, 0xf6, 0xbe, 0x1c, 0xa7, 0x8f, 0x73,
-	0xa6, 0x86, 0x75, 0xf3, 0x9f, 0x78, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xc4, 0x7b, 0x9f,
-	0x01, 0x05, 0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x4f, 0x13, 0x5b,
+	0x14, 0xc7, 0x7b, 0x79, 0xbc, 0x96, 0x1e, 0xde, 0x7b, 0x8b, 0x0b, 0x2f, 0x29, 0x05, 0x5a, 0xde,
+	0x3c, 0x85, 0x82, 0x76, 0xae, 0x85, 0x15, 0xc6, 0x98, 0x50, 0x09, 0x06, 0x8d, 0x09, 0x8e, 0xac,
+	0xdc, 0xe0, 0xb4, 0x73, 0x33, 0x4e, 0x68, 0xe7, 0x0e, 0x73, 0xa7, 0x8d, 0x88, 0x4d, 0x8c, 0x2b,
+	0x97, 0x26, 0xfc, 0x03, 0xba, 0xd6, 0x7f, 0x80, 0xff, 0x80, 0x25, 0x89, 0x1b, 0xe3, 0x82, 0x18,
+	0xf0, 0x0f, 0x31, 0xbd, 0x73, 0x67, 0xda, 0x29, 0x03, 0x1d, 0x4d, 0x5c, 0x41, 0x4f, 0xcf, 0x8f,
+	0xcf, 0xf9, 0x9e, 0x7b, 0x4e, 0x0a, 0x45, 0xd3, 0xb5, 0x0c, 0xcb, 0x65, 0x36, 0x69, 0x53, 0xee,
+	0x59, 0xb6, 0x49, 0xda, 0x15, 0xb2, 0xd7, 0xa2, 0xee, 0xbe, 0xea, 0xb8, 0xcc, 0x63, 0x78, 0x22,
+	0x70, 0x50, 0xa5, 0x83, 0xda, 0xae, 0xe4, 0x27, 0x4d, 0x66, 0x32, 0xf1, 0x3d, 0xe9, 0xfe, 0xe7,
+	0xbb, 0xe6, 0x67, 0x4c, 0xc6, 0xcc, 0x06, 0x25, 0xba, 0x63, 0x11, 0xdd, 0xb6, 0x99, 0xa7, 0x7b,
+	0x16, 0xb3, 0xb9, 0xfc, 0x76, 0xa9, 0xce, 0x78, 0x93, 0x71, 0x52, 0xd3, 0x39, 0xf5, 0x2b, 0x90,
+	0x76, 0xa5, 0x46, 0x3d, 0xbd, 0x42, 0x1c, 0xdd, 0xb4, 0x6c, 0xe1, 0x2c, 0x7d, 0xff, 0x8b, 0xa3,
+	0x32, 0xa9, 0x4d, 0xb9, 0xc5, 0xaf, 0x72, 0x09, 0x10, 0x85, 0x8b, 0xf2, 0x0a, 0x26, 0x1f, 0x77,
+	0xeb, 0xac, 0x59, 0xae, 0xe1, 0x32, 0x87, 0x6b, 0x74, 0xaf, 0x45, 0xb9, 0x87, 0x67, 0x20, 0x5b,
+	0x67, 0x4d, 0xa7, 0x41, 0x3d, 0x6a, 0xe4, 0xd0, 0x1c, 0x2a, 0x8d, 0x69, 0x3d, 0x03, 0xde, 0x00,
+	0xe8, 0xf1, 0xe4, 0x46, 0xe6, 0x50, 0x69, 0x7c, 0x79, 0x5e, 0xf5, 0xe1, 0xd5, 0x2e, 0xbc, 0xea,
+	0xcb, 0x23, 0xe1, 0xd5, 0x2d, 0xdd, 0xa4, 0x32, 0xb3, 0xd6, 0x17, 0xa9, 0xbc, 0x47, 0xf0, 0xef,
+	0x40, 0x79, 0xee, 0x30, 0x9b, 0x53, 0x7c, 0x17, 0xc6, 0x74, 0x69, 0xcb, 0xa1, 0xb9, 0x3f, 0x4a,
+	0xe3, 0xcb, 0x33, 0x6a, 0x8c, 0xca, 0xaa, 0x0c, 0xac, 0x8e, 0x1e, 0x9f, 0x16, 0x53, 0x5a, 0x18,
+	0x83, 0xef, 0xc7, 0x10, 0x2e, 0x0c, 0x25, 0xf4, 0x8b, 0x47, 0x10, 0xb7, 0x61, 0xa2, 0x9f, 0x30,
+	0xd0, 0xa7, 0x08, 0xe3, 0x9e, 0xee, 0x9a, 0xd4, 0xdb, 0xd1, 0x0d, 0xc3, 0x15, 0x0a, 0x65, 0x35,
+	0xf0, 0x4d, 0x6b, 0x86, 0xe1, 0x46, 0x05, 0x1c, 0x19, 0x10, 0x50, 0xd9, 0x8e, 0xca, 0x1e, 0xb6,
+	0x7d, 0x07, 0x32, 0xb2, 0x05, 0x91, 0x32, 0x59, 0xd7, 0x41, 0x88, 0x62, 0x40, 0x5e, 0x64, 0x7d,
+	0x44, 0xdd, 0xdd, 0x06, 0x1d, 0x1c, 0x69, 0x74, 0x68, 0xe8, 0x97, 0x87, 0xf6, 0x09, 0xc1, 0x74,
+	0x6c, 0x19, 0xd9, 0xc3, 0xfa, 0x85, 0xd1, 0x29, 0xb1, 0x4d, 0x44, 0xc2, 0x7f, 0xdf, 0x00, 0x6f,
+	0xc3, 0xd4, 0x45, 0xda, 0x40, 0x93, 0x59, 0x00, 0x59, 0x71, 0xc7, 0xf2, 0xdf, 0xf9, 0xa8, 0x96,
+	0x95, 0x96, 0x4d, 0x43, 0x79, 0x16, 0x27, 0x68, 0xd8, 0x68, 0x75, 0x70, 0x58, 0xc9, 0xfb, 0x0c,
+	0x47, 0xf6, 0x04, 0x72, 0xfd, 0x0f, 0xe1, 0x5e, 0x43, 0xb7, 0x9a, 0xc9, 0xe0, 0x70, 0x0e, 0x32,
+	0xdd, 0xb7, 0x47, 0x39, 0x17, 0xf2, 0x64, 0xb5, 0xe0, 0xa3, 0xd2, 0x81, 0xa9, 0x98, 0xa4, 0x92,
+	0x3a, 0x07, 0x99, 0x7a, 0xd7, 0x10, 0xee, 0x75, 0xf0, 0x11, 0x6f, 0x40, 0x5a, 0x6f, 0xb2, 0x96,
+	0xed, 0xf9, 0xf9, 0xaa, 0x6a, 0x17, 0xf5, 0xeb, 0x69, 0x71, 0xde, 0xb4, 0xbc, 0xe7, 0xad, 0x9a,
+	0x5a, 0x67, 0x4d, 0x22, 0x0f, 0x94, 0xff, 0xa7, 0xcc, 0x8d, 0x5d, 0xe2, 0xed, 0x3b, 0x94, 0xab,
+	0x9b, 0xb6, 0xa7, 0xc9, 0x68, 0x65, 0x12, 0xb0, 0x28, 0xbf, 0xa5, 0xbb, 0x7a, 0x33, 0x78, 0x7e,
+	0xca, 0x16, 0x4c, 0x44, 0xac, 0x12, 0x67, 0x15, 0xd2, 0x8e, 0xb0, 0x48, 0x0d, 0xa7, 0x63, 0x35,
+	0xf4, 0x83, 0xa4, 0x78, 0x32, 0x60, 0xf9, 0x28, 0x03, 0x7f, 0x8a, 0x94, 0xf8, 0x2d, 0x82, 0xb1,
+	0xe0, 0x1d, 0xe2, 0xc5, 0xd8, 0x0c, 0x71, 0x57, 0x2e, 0xbf, 0x94, 0xc4, 0xd5, 0x07, 0x55, 0xae,
+	0xbf, 0xf9, 0xfc, 0xfd, 0x70, 0xa4, 0x88, 0x67, 0x49, 0xdc, 0x55, 0x0d, 0xdf, 0xed, 0x21, 0x82,
+	0x8c, 0x8c, 0xc5, 0xa5, 0xa1, 0xe9, 0x03, 0x90, 0xc5, 0x04, 0x9e, 0x92, 0x63, 0x45, 0x70, 0x94,
+	0xf1, 0x8d, 0x2b, 0x39, 0xc8, 0x41, 0xdf, 0x79, 0xea, 0xe0, 0x0f, 0x08, 0xfe, 0x89, 0xae, 0x2b,
+	0x26, 0x97, 0x97, 0x8c, 0xbd, 0x1f, 0xf9, 0x5b, 0xc9, 0x03, 0x24, 0xea, 0x4d, 0x81, 0x3a, 0x8f,
+	0xaf, 0xc5, 0xa2, 0x36, 0x45, 0xd0, 0x4e, 0xa8, 0xdc, 0x47, 0x04, 0x7f, 0x47, 0x12, 0x61, 0x35,
+	0x61, 0xc5, 0x80, 0x90, 0x24, 0xf6, 0x97, 0x80, 0xab, 0x02, 0x70, 0x05, 0x57, 0x92, 0x00, 0x92,
+	0x83, 0xde, 0x36, 0x76, 0xf0, 0x11, 0x82, 0xbf, 0xfa, 0xf7, 0x0b, 0x97, 0x87, 0x8e, 0xb0, 0x7f,
+	0xb9, 0xf3, 0x6a, 0x52, 0x77, 0x89, 0xfa, 0x40, 0xa0, 0xae, 0xe3, 0xea, 0x4f, 0xa3, 0x12, 0xb1,
+	0xdf, 0x5d, 0x9b, 0x7f, 0x1e, 0x3a, 0xf8, 0x35, 0x82, 0xb4, 0xbf, 0x51, 0x78, 0xe1, 0x72, 0x8c,
+	0xc8, 0xfa, 0xe6, 0x4b, 0xc3, 0x1d, 0x25, 0xe9, 0xff, 0x82, 0x74, 0x16, 0x4f, 0xc7, 0x92, 0xfa,
+	0xbb, 0x5b, 0x7d, 0x78, 0x7c, 0x56, 0x40, 0x27, 0x67, 0x05, 0xf4, 0xed, 0xac, 0x80, 0xde, 0x9d,
+	0x17, 0x52, 0x27, 0xe7, 0x85, 0xd4, 0x97, 0xf3, 0x42, 0xea, 0x69, 0xa5, 0xef, 0xda, 0x04, 0x09,
+	0xca, 0x2f, 0x99, 0x4d, 0x7b, 0xe9, 0x5e, 0x84, 0x09, 0xc5, 0xf1, 0xa9, 0xa5, 0xc5, 0x6f, 0x99,
+	0x95, 0x1f, 0x03, 0x00, 0xd1, 0xd7, 0x2e, 0xe7, 0xa9, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -381,6 +689,12 @@ type QueryClient interface {
 	Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error)
 	// Airdrops queries airdrop target for given address.
 	Airdrop(ctx context.Context, in *QueryAirdropRequest, opts ...grpc.CallOption) (*QueryAirdropResponse, error)
+	// MerkleAirdrops queries all merkle airdrops.
+	MerkleAirdrops(ctx context.Context, in *QueryMerkleAirdropsRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropsResponse, error)
+	// MerkleAirdrop queries a merkle airdrop by id.
+	MerkleAirdrop(ctx context.Context, in *QueryMerkleAirdropRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropResponse, error)
+	// AirdropClaim queries the claim of an address in a merkle airdrop.
+	AirdropClaim(ctx context.Context, in *QueryAirdropClaimRequest, opts ...grpc.CallOption) (*QueryAirdropClaimResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -411,6 +725,33 @@ func (c *queryClient) Airdrop(ctx context.Context, in *QueryAirdropRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) MerkleAirdrops(ctx context.Context, in *QueryMerkleAirdropsRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropsResponse, error) {
+	out := new(QueryMerkleAirdropsResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.vesting.v1.Query/MerkleAirdrops", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MerkleAirdrop(ctx context.Context, in *QueryMerkleAirdropRequest, opts ...grpc.CallOption) (*QueryMerkleAirdropResponse, error) {
+	out := new(QueryMerkleAirdropResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.vesting.v1.Query/MerkleAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AirdropClaim(ctx context.Context, in *QueryAirdropClaimRequest, opts ...grpc.CallOption) (*QueryAirdropClaimResponse, error) {
+	out := new(QueryAirdropClaimResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.vesting.v1.Query/AirdropClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/gridiron.vesting.v1.Query/Params", in, out, opts...)
@@ -426,6 +767,12 @@ type QueryServer interface {
 	Airdrops(context.Context, *QueryAirdropsRequest) (*QueryAirdropsResponse, error)
 	// Airdrops queries airdrop target for given address.
 	Airdrop(context.Context, *QueryAirdropRequest) (*QueryAirdropResponse, error)
+	// MerkleAirdrops queries all merkle airdrops.
+	MerkleAirdrops(context.Context, *QueryMerkleAirdropsRequest) (*QueryMerkleAirdropsResponse, error)
+	// MerkleAirdrop queries a merkle airdrop by id.
+	MerkleAirdrop(context.Context, *QueryMerkleAirdropRequest) (*QueryMerkleAirdropResponse, error)
+	// AirdropClaim queries the claim of an address in a merkle airdrop.
+	AirdropClaim(context.Context, *QueryAirdropClaimRequest) (*QueryAirdropClaimResponse, error)
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -440,6 +787,15 @@ func (*UnimplementedQueryServer) Airdrops(ctx context.Context, req *QueryAirdrop
 func (*UnimplementedQueryServer) Airdrop(ctx context.Context, req *QueryAirdropRequest) (*QueryAirdropResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Airdrop not implemented")
 }
+func (*UnimplementedQueryServer) MerkleAirdrops(ctx context.Context, req *QueryMerkleAirdropsRequest) (*QueryMerkleAirdropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleAirdrops not implemented")
+}
+func (*UnimplementedQueryServer) MerkleAirdrop(ctx context.Context, req *QueryMerkleAirdropRequest) (*QueryMerkleAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleAirdrop not implemented")
+}
+func (*UnimplementedQueryServer) AirdropClaim(ctx context.Context, req *QueryAirdropClaimRequest) (*QueryAirdropClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirdropClaim not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -484,6 +840,60 @@ func _Query_Airdrop_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MerkleAirdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleAirdropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleAirdrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.vesting.v1.Query/MerkleAirdrops",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleAirdrops(ctx, req.(*QueryMerkleAirdropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerkleAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleAirdropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.vesting.v1.Query/MerkleAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleAirdrop(ctx, req.(*QueryMerkleAirdropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AirdropClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AirdropClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.vesting.v1.Query/AirdropClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AirdropClaim(ctx, req.(*QueryAirdropClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -514,6 +924,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Airdrop",
 			Handler:    _Query_Airdrop_Handler,
 		},
+		{
+			MethodName: "MerkleAirdrops",
+			Handler:    _Query_MerkleAirdrops_Handler,
+		},
+		{
+			MethodName: "MerkleAirdrop",
+			Handler:    _Query_MerkleAirdrop_Handler,
+		},
+		{
+			MethodName: "AirdropClaim",
+			Handler:    _Query_AirdropClaim_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -690,7 +1112,7 @@ func (m *QueryAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMerkleAirdropsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -700,20 +1122,243 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMerkleAirdropsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMerkleAirdropsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMerkleAirdropsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Airdrops) > 0 {
+		for iNdEx := len(m.Airdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Airdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleAirdropRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Airdrop.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropClaimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropClaimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropClaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAirdropClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAirdropClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAirdropClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Claimed {
+		i--
+		if m.Claimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -819,6 +1464,91 @@ func (m *QueryAirdropResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMerkleAirdropsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleAirdropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Airdrops) > 0 {
+		for _, e := range m.Airdrops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleAirdropRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovQuery(uint64(m.AirdropId))
+	}
+	return n
+}
+
+func (m *QueryMerkleAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Airdrop.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAirdropClaimRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovQuery(uint64(m.AirdropId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAirdropClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimed {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1256,6 +1986,569 @@ func (m *QueryAirdropResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMerkleAirdropsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleAirdropsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleAirdropsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleAirdropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleAirdropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Airdrops = append(m.Airdrops, MerkleAirdrop{})
+			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleAirdropRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleAirdropRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleAirdropRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Airdrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropClaimRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropClaimRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropClaimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAirdropClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAirdropClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAirdropClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Claimed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
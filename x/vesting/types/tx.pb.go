@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -258,6 +260,286 @@ func (m *MsgSetAllocationAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetAllocationAddressResponse proto.InternalMessageInfo
 
+// MsgCreateMerkleAirdrop represents a message to create a merkle airdrop.
+type MsgCreateMerkleAirdrop struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hex-encoded merkle root
+	MerkleRoot string `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// max total amount paid out, which is minted at creation
+	Cap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap"`
+	// unix time of the claim deadline
+	Deadline uint64 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// multiplier of the amount claimed straight into a ve lock; must be >= 1
+	VeBonusMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=ve_bonus_multiplier,json=veBonusMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ve_bonus_multiplier"`
+	// minimum lock duration in seconds of the claims into ve
+	VeMinLockDuration uint64 `protobuf:"varint,6,opt,name=ve_min_lock_duration,json=veMinLockDuration,proto3" json:"ve_min_lock_duration,omitempty"`
+}
+
+func (m *MsgCreateMerkleAirdrop) Reset()         { *m = MsgCreateMerkleAirdrop{} }
+func (m *MsgCreateMerkleAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMerkleAirdrop) ProtoMessage()    {}
+func (*MsgCreateMerkleAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ff1dd0df923de90, []int{6}
+}
+func (m *MsgCreateMerkleAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMerkleAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMerkleAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateMerkleAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMerkleAirdrop.Merge(m, src)
+}
+func (m *MsgCreateMerkleAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMerkleAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMerkleAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateMerkleAirdrop proto.InternalMessageInfo
+
+// MsgCreateMerkleAirdropResponse defines the Msg/CreateMerkleAirdrop response
+// type.
+type MsgCreateMerkleAirdropResponse struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+}
+
+func (m *MsgCreateMerkleAirdropResponse) Reset()         { *m = MsgCreateMerkleAirdropResponse{} }
+func (m *MsgCreateMerkleAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMerkleAirdropResponse) ProtoMessage()    {}
+func (*MsgCreateMerkleAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ff1dd0df923de90, []int{7}
+}
+func (m *MsgCreateMerkleAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMerkleAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMerkleAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateMerkleAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMerkleAirdropResponse.Merge(m, src)
+}
+func (m *MsgCreateMerkleAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMerkleAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMerkleAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateMerkleAirdropResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMerkleAirdropResponse) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
+	}
+	return 0
+}
+
+// MsgClaimAirdrop represents a message to claim from a merkle airdrop.
+type MsgClaimAirdrop struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	AirdropId uint64 `protobuf:"varint,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	// amount of the sender in the merkle leaf
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// hex-encoded sibling hashes from the leaf up to the root
+	Proof []string `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+	// if non-zero, the claim goes straight into a new ve lock of the duration in
+	// seconds, with the bonus multiplier applied
+	LockDuration uint64 `protobuf:"varint,5,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"`
+}
+
+func (m *MsgClaimAirdrop) Reset()         { *m = MsgClaimAirdrop{} }
+func (m *MsgClaimAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAirdrop) ProtoMessage()    {}
+func (*MsgClaimAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ff1dd0df923de90, []int{8}
+}
+func (m *MsgClaimAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAirdrop.Merge(m, src)
+}
+func (m *MsgClaimAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAirdrop proto.InternalMessageInfo
+
+// MsgClaimAirdropResponse defines the Msg/ClaimAirdrop response type.
+type MsgClaimAirdropResponse struct {
+	// amount paid out
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// ve id of the created lock, if any
+	VeId string `protobuf:"bytes,2,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+}
+
+func (m *MsgClaimAirdropResponse) Reset()         { *m = MsgClaimAirdropResponse{} }
+func (m *MsgClaimAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAirdropResponse) ProtoMessage()    {}
+func (*MsgClaimAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ff1dd0df923de90, []int{9}
+}
+func (m *MsgClaimAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAirdropResponse.Merge(m, src)
+}
+func (m *MsgClaimAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAirdropResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAirdropResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgClaimAirdropResponse) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+// MsgClawbackAirdrop represents a message to claw back the unclaimed amount of
+// a merkle airdrop.
+type MsgClawbackAirdrop struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	AirdropId uint64 `protobuf:"varint,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+}
+
+func (m *MsgClawbackAirdrop) Reset()         { *m = MsgClawbackAirdrop{} }
+func (m *MsgClawbackAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackAirdrop) ProtoMessage()    {}
+func (*MsgClawbackAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ff1dd0df923de90, []int{10}
+}
+func (m *MsgClawbackAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackAirdrop.Merge(m, src)
+}
+func (m *MsgClawbackAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackAirdrop proto.InternalMessageInfo
+
+// MsgClawbackAirdropResponse defines the Msg/ClawbackAirdrop response type.
+type MsgClawbackAirdropResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgClawbackAirdropResponse) Reset()         { *m = MsgClawbackAirdropResponse{} }
+func (m *MsgClawbackAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackAirdropResponse) ProtoMessage()    {}
+func (*MsgClawbackAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ff1dd0df923de90, []int{11}
+}
+func (m *MsgClawbackAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackAirdropResponse.Merge(m, src)
+}
+func (m *MsgClawbackAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackAirdropResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackAirdropResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgAddAirdrops)(nil), "gridiron.vesting.v1.MsgAddAirdrops")
 	proto.RegisterType((*MsgAddAirdropsResponse)(nil), "gridiron.vesting.v1.MsgAddAirdropsResponse")
@@ -265,46 +547,78 @@ func init() {
 	proto.RegisterType((*MsgExecuteAirdropsResponse)(nil), "gridiron.vesting.v1.MsgExecuteAirdropsResponse")
 	proto.RegisterType((*MsgSetAllocationAddress)(nil), "gridiron.vesting.v1.MsgSetAllocationAddress")
 	proto.RegisterType((*MsgSetAllocationAddressResponse)(nil), "gridiron.vesting.v1.MsgSetAllocationAddressResponse")
+	proto.RegisterType((*MsgCreateMerkleAirdrop)(nil), "gridiron.vesting.v1.MsgCreateMerkleAirdrop")
+	proto.RegisterType((*MsgCreateMerkleAirdropResponse)(nil), "gridiron.vesting.v1.MsgCreateMerkleAirdropResponse")
+	proto.RegisterType((*MsgClaimAirdrop)(nil), "gridiron.vesting.v1.MsgClaimAirdrop")
+	proto.RegisterType((*MsgClaimAirdropResponse)(nil), "gridiron.vesting.v1.MsgClaimAirdropResponse")
+	proto.RegisterType((*MsgClawbackAirdrop)(nil), "gridiron.vesting.v1.MsgClawbackAirdrop")
+	proto.RegisterType((*MsgClawbackAirdropResponse)(nil), "gridiron.vesting.v1.MsgClawbackAirdropResponse")
 }
 
 func init() { proto.RegisterFile("gridiron/vesting/v1/tx.proto", fileDescriptor_7ff1dd0df923de90) }
 
 var fileDescriptor_7ff1dd0df923de90 = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x77, 0x9a, 0x52, 0x92, 0x29, 0x58, 0x1c, 0x4a, 0x0d, 0x9b, 0xba, 0x89, 0x51, 0x4b,
-	0x68, 0xed, 0x0e, 0x6d, 0xf1, 0x22, 0x78, 0x48, 0x8b, 0x88, 0x87, 0x5c, 0xb6, 0xe0, 0xc1, 0xcb,
-	0x32, 0xdd, 0x79, 0x8c, 0x0b, 0xc9, 0x4c, 0x9c, 0x99, 0x84, 0xd5, 0xa3, 0x27, 0xf1, 0x24, 0x78,
-	0xf0, 0xda, 0x6f, 0xa0, 0x1f, 0xa3, 0xc7, 0x82, 0x17, 0x4f, 0x45, 0x12, 0x0f, 0x7e, 0x0c, 0xc9,
-	0x66, 0xb3, 0xa6, 0x6d, 0x96, 0xea, 0x6d, 0x67, 0xe6, 0xff, 0xfe, 0xff, 0xdf, 0xcc, 0x7b, 0x2c,
-	0xae, 0xf5, 0x40, 0x77, 0x63, 0x25, 0xe9, 0x10, 0x8c, 0x8d, 0xa5, 0xa0, 0xc3, 0x3d, 0x6a, 0x13,
-	0xbf, 0xaf, 0x95, 0x55, 0x84, 0x64, 0x87, 0x7e, 0x76, 0xe8, 0x0f, 0xf7, 0xdc, 0x4d, 0xa1, 0x94,
-	0xe8, 0x02, 0x65, 0xfd, 0x98, 0x32, 0x29, 0x95, 0x65, 0x36, 0x56, 0xd2, 0x4c, 0x2b, 0xdc, 0x75,
-	0xa1, 0x84, 0x4a, 0x3f, 0xe9, 0xe4, 0x2b, 0xdb, 0x6d, 0x2c, 0x08, 0x99, 0x59, 0xa6, 0x8a, 0xe6,
-	0x1b, 0x7c, 0xab, 0x63, 0x44, 0x9b, 0xf3, 0x76, 0xac, 0xb9, 0x56, 0x7d, 0x43, 0x36, 0xf0, 0x8a,
-	0x01, 0xc9, 0x41, 0x57, 0x51, 0x03, 0xb5, 0x2a, 0x41, 0xb6, 0x22, 0x4f, 0x71, 0x99, 0x65, 0x9a,
-	0xea, 0x52, 0xa3, 0xd4, 0x5a, 0xdd, 0xaf, 0xf9, 0xd7, 0x31, 0xfd, 0xcc, 0xe7, 0x70, 0xf9, 0xec,
-	0xa2, 0xee, 0x04, 0x79, 0xc9, 0x93, 0xf2, 0x87, 0xd3, 0xba, 0xf3, 0xfb, 0xb4, 0xee, 0x34, 0xab,
-	0x78, 0xe3, 0x72, 0x64, 0x00, 0xa6, 0xaf, 0xa4, 0x81, 0xe6, 0x31, 0x26, 0x1d, 0x23, 0x9e, 0x25,
-	0x10, 0x0d, 0x2c, 0xdc, 0x08, 0x54, 0xc3, 0x95, 0x1e, 0x4b, 0xc2, 0x48, 0x0d, 0xa4, 0xad, 0x2e,
-	0x35, 0x50, 0x6b, 0x39, 0x28, 0xf7, 0x58, 0x72, 0x34, 0x59, 0xcf, 0xc5, 0x6d, 0x62, 0xf7, 0xba,
-	0x69, 0x1e, 0xf9, 0x0d, 0xe1, 0x3b, 0x1d, 0x23, 0x8e, 0xc1, 0xb6, 0xbb, 0x5d, 0x15, 0xa5, 0x6f,
-	0xda, 0xe6, 0x5c, 0x83, 0x29, 0x0e, 0xde, 0xc6, 0xb7, 0x2d, 0xb0, 0x5e, 0x98, 0xdd, 0x3a, 0x64,
-	0x9c, 0xeb, 0x14, 0xa0, 0x12, 0xac, 0x4d, 0x0e, 0x5e, 0x4e, 0xf7, 0x27, 0x36, 0xe4, 0x39, 0x6e,
-	0x18, 0xab, 0x99, 0x05, 0x11, 0x47, 0xa1, 0x06, 0x03, 0x7a, 0x08, 0x61, 0x34, 0x30, 0x56, 0xf1,
-	0x98, 0xc9, 0x69, 0x69, 0x29, 0x2d, 0xbd, 0x9b, 0xeb, 0x82, 0xa9, 0xec, 0x68, 0xa6, 0x9a, 0x18,
-	0xcd, 0x5d, 0xe8, 0x1e, 0xae, 0x17, 0x10, 0xcf, 0x6e, 0xb5, 0x7f, 0x51, 0xc2, 0xa5, 0x8e, 0x11,
-	0xe4, 0x23, 0xc2, 0xab, 0xf3, 0xbd, 0x6d, 0x2e, 0xea, 0xd8, 0xe5, 0x66, 0xb8, 0xdb, 0x37, 0x6b,
-	0xf2, 0xd7, 0xdb, 0x79, 0xff, 0xfd, 0xd7, 0xe7, 0xa5, 0x87, 0xe4, 0x3e, 0x5d, 0x38, 0xcd, 0x94,
-	0x71, 0x1e, 0xce, 0x26, 0x80, 0x7c, 0x41, 0x78, 0xed, 0x6a, 0x6f, 0xb7, 0x0a, 0xc2, 0xae, 0xe8,
-	0x5c, 0xff, 0xdf, 0x74, 0x39, 0xd8, 0xa3, 0x14, 0x6c, 0x8b, 0x3c, 0x28, 0x00, 0x83, 0x04, 0xa2,
-	0xbf, 0x64, 0x5f, 0x11, 0x5e, 0x5f, 0x38, 0x01, 0x3b, 0x05, 0xb1, 0x8b, 0xc4, 0xee, 0xc1, 0x7f,
-	0x88, 0x73, 0xd0, 0xc7, 0x29, 0x28, 0x25, 0xbb, 0x05, 0xa0, 0x06, 0x6c, 0xc8, 0xf2, 0xea, 0x74,
-	0x5e, 0xc0, 0x98, 0xc3, 0x17, 0x67, 0x23, 0x0f, 0x9d, 0x8f, 0x3c, 0xf4, 0x73, 0xe4, 0xa1, 0x4f,
-	0x63, 0xcf, 0x39, 0x1f, 0x7b, 0xce, 0x8f, 0xb1, 0xe7, 0xbc, 0xa2, 0x22, 0xb6, 0xaf, 0x07, 0x27,
-	0x7e, 0xa4, 0x7a, 0x33, 0xcb, 0xdd, 0x77, 0x4a, 0x42, 0xee, 0x9f, 0xe4, 0x09, 0xf6, 0x6d, 0x1f,
-	0xcc, 0xc9, 0x4a, 0xfa, 0x23, 0x38, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x46, 0x45, 0xcf,
-	0x91, 0x04, 0x00, 0x00,
+	// 947 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0xb1, 0x13, 0xe2, 0x49, 0x21, 0xca, 0x24, 0x6a, 0xcd, 0x92, 0xda, 0xae, 0x5b,
+	0x1a, 0xab, 0x24, 0xbb, 0x75, 0xca, 0x0f, 0x89, 0x03, 0x90, 0xb8, 0x80, 0x22, 0xf0, 0x65, 0xab,
+	0x72, 0xe0, 0xc0, 0x6a, 0xbc, 0x33, 0x2c, 0x23, 0xef, 0xee, 0x58, 0x33, 0xe3, 0xc5, 0x70, 0xe4,
+	0xc4, 0x05, 0x09, 0xc4, 0x0d, 0x09, 0xa9, 0x7f, 0x01, 0x42, 0xfc, 0x15, 0x15, 0xe2, 0x50, 0x09,
+	0x21, 0x21, 0x0e, 0x15, 0x4a, 0x38, 0xf0, 0x67, 0xa0, 0x9d, 0x9d, 0xdd, 0xda, 0xee, 0x6e, 0xeb,
+	0xd0, 0x53, 0x76, 0x66, 0xbe, 0xef, 0xbd, 0xcf, 0x7b, 0xb3, 0xfb, 0x8d, 0xc1, 0xae, 0xcf, 0x29,
+	0xa6, 0x9c, 0x45, 0x76, 0x4c, 0x84, 0xa4, 0x91, 0x6f, 0xc7, 0x3d, 0x5b, 0x4e, 0xad, 0x31, 0x67,
+	0x92, 0xc1, 0xed, 0xec, 0xd4, 0xd2, 0xa7, 0x56, 0xdc, 0x33, 0x77, 0x7d, 0xc6, 0xfc, 0x80, 0xd8,
+	0x68, 0x4c, 0x6d, 0x14, 0x45, 0x4c, 0x22, 0x49, 0x59, 0x24, 0xd2, 0x10, 0x73, 0xc7, 0x67, 0x3e,
+	0x53, 0x8f, 0x76, 0xf2, 0xa4, 0x77, 0x9b, 0x1e, 0x13, 0x21, 0x13, 0xf6, 0x10, 0x09, 0x62, 0xc7,
+	0xbd, 0x21, 0x91, 0xa8, 0x67, 0x7b, 0x8c, 0x46, 0xfa, 0xfc, 0x4a, 0x11, 0x46, 0x56, 0x53, 0x49,
+	0x3a, 0x1c, 0xbc, 0x30, 0x10, 0xfe, 0x11, 0xc6, 0x47, 0x94, 0x63, 0xce, 0xc6, 0x02, 0x5e, 0x04,
+	0x6b, 0x82, 0x44, 0x98, 0xf0, 0x86, 0xd1, 0x36, 0xba, 0x75, 0x47, 0xaf, 0xe0, 0x5b, 0x60, 0x1d,
+	0x69, 0x4d, 0x63, 0xa5, 0x5d, 0xed, 0x6e, 0x1c, 0xee, 0x5a, 0x05, 0x8d, 0x58, 0x3a, 0xd1, 0x71,
+	0xed, 0xfe, 0xc3, 0x56, 0xc5, 0xc9, 0x63, 0xde, 0x5c, 0xff, 0xfa, 0x5e, 0xab, 0xf2, 0xef, 0xbd,
+	0x56, 0xa5, 0xd3, 0x00, 0x17, 0xe7, 0x6b, 0x3a, 0x44, 0x8c, 0x59, 0x24, 0x48, 0xe7, 0x0e, 0x80,
+	0x03, 0xe1, 0xbf, 0x3b, 0x25, 0xde, 0x44, 0x92, 0xa7, 0x12, 0xbd, 0x04, 0xea, 0x21, 0x9a, 0xba,
+	0x1e, 0x9b, 0x44, 0xb2, 0xb1, 0xd2, 0x36, 0xba, 0x35, 0x67, 0x3d, 0x44, 0xd3, 0x7e, 0xb2, 0x9e,
+	0x29, 0xb7, 0x0b, 0xcc, 0xc7, 0x93, 0xe6, 0x25, 0x7f, 0x36, 0xc0, 0xa5, 0x81, 0xf0, 0xef, 0x10,
+	0x79, 0x14, 0x04, 0xcc, 0x53, 0x53, 0x3f, 0xc2, 0x98, 0x13, 0x51, 0x5e, 0xf8, 0x06, 0xd8, 0x92,
+	0x04, 0x85, 0xae, 0xee, 0xda, 0x45, 0x18, 0x73, 0x05, 0x50, 0x77, 0x36, 0x93, 0x83, 0x8f, 0xd2,
+	0xfd, 0x24, 0x0d, 0x7c, 0x1f, 0xb4, 0x85, 0xe4, 0x48, 0x12, 0x9f, 0x7a, 0x2e, 0x27, 0x82, 0xf0,
+	0x98, 0xb8, 0xde, 0x44, 0x48, 0x86, 0x29, 0x8a, 0xd2, 0xd0, 0xaa, 0x0a, 0xbd, 0x9c, 0xeb, 0x9c,
+	0x54, 0xd6, 0xcf, 0x54, 0x49, 0xa2, 0x99, 0x86, 0xae, 0x80, 0x56, 0x09, 0x71, 0xde, 0xd5, 0xaf,
+	0x2b, 0x6a, 0xc6, 0x7d, 0x4e, 0x90, 0x24, 0x03, 0xc2, 0x47, 0x41, 0xd6, 0x79, 0x69, 0x53, 0x2d,
+	0xb0, 0x11, 0x2a, 0xa1, 0xcb, 0x19, 0x93, 0xba, 0x1d, 0x90, 0x6e, 0x39, 0x8c, 0x49, 0xf8, 0x0e,
+	0xa8, 0x7a, 0x68, 0x9c, 0xc2, 0x1e, 0x5b, 0xc9, 0xed, 0xfe, 0xf5, 0xb0, 0x75, 0xdd, 0xa7, 0xf2,
+	0xb3, 0xc9, 0xd0, 0xf2, 0x58, 0x68, 0xeb, 0xb7, 0x31, 0xfd, 0x73, 0x20, 0xf0, 0xc8, 0x96, 0x5f,
+	0x8c, 0x89, 0xb0, 0x4e, 0x22, 0xe9, 0x24, 0xa1, 0xd0, 0x04, 0xeb, 0x98, 0x20, 0x1c, 0xd0, 0x88,
+	0x34, 0x6a, 0xe9, 0x7d, 0x65, 0x6b, 0xf8, 0x09, 0xd8, 0x8e, 0x89, 0x3b, 0x64, 0xd1, 0x44, 0xb8,
+	0xe1, 0x24, 0x90, 0x74, 0x1c, 0x50, 0xc2, 0x1b, 0xab, 0xe7, 0xae, 0x76, 0x9b, 0x78, 0xce, 0x56,
+	0x4c, 0x8e, 0x93, 0x4c, 0x83, 0x3c, 0x11, 0xb4, 0xc1, 0x4e, 0x4c, 0xdc, 0x90, 0x46, 0x6e, 0xc0,
+	0xbc, 0x91, 0x8b, 0x27, 0x5c, 0x0d, 0xae, 0xb1, 0xa6, 0x38, 0xb6, 0x62, 0x32, 0xa0, 0xd1, 0x87,
+	0xcc, 0x1b, 0xdd, 0xd6, 0x07, 0x33, 0xf3, 0x7e, 0x1b, 0x34, 0x8b, 0x67, 0x99, 0x8d, 0x1b, 0x5e,
+	0x06, 0x40, 0xbf, 0xe7, 0x2e, 0xc5, 0x6a, 0xae, 0x35, 0xa7, 0xae, 0x77, 0x4e, 0x70, 0xe7, 0x0f,
+	0x03, 0x6c, 0x26, 0x19, 0x02, 0x44, 0xc3, 0xa7, 0x5d, 0xc3, 0x7c, 0xaa, 0x95, 0x85, 0x54, 0xf0,
+	0x3d, 0xb0, 0x86, 0x42, 0xf5, 0xc2, 0xff, 0xbf, 0x7b, 0xd0, 0xd1, 0x70, 0x07, 0xac, 0x8e, 0x39,
+	0x63, 0x9f, 0x36, 0x6a, 0xed, 0x6a, 0xb7, 0xee, 0xa4, 0x0b, 0x78, 0x15, 0x3c, 0x3f, 0x3f, 0x9d,
+	0x55, 0x55, 0xff, 0x42, 0x50, 0x3c, 0x18, 0x1f, 0x5c, 0x5a, 0x68, 0x2b, 0x9f, 0xc8, 0x1b, 0x39,
+	0x67, 0xd2, 0xde, 0xc6, 0xe1, 0x8b, 0x56, 0x8a, 0x63, 0x25, 0x5e, 0x65, 0x69, 0xaf, 0xb2, 0xfa,
+	0x8c, 0x46, 0xda, 0x28, 0x32, 0xb0, 0x6d, 0xb0, 0x1a, 0x93, 0xac, 0xf5, 0xba, 0x53, 0x8b, 0xc9,
+	0x09, 0xee, 0xdc, 0x55, 0xbe, 0xd0, 0x0f, 0xd0, 0xe7, 0x43, 0xe4, 0x8d, 0x9e, 0x6d, 0x84, 0x33,
+	0xfc, 0x77, 0x81, 0xf9, 0x78, 0xda, 0x67, 0x6e, 0xe1, 0xf0, 0xb7, 0xe7, 0x40, 0x75, 0x20, 0x7c,
+	0xf8, 0x8d, 0x01, 0x36, 0x66, 0x9d, 0xf5, 0x6a, 0xa1, 0x5f, 0xce, 0x5b, 0xa1, 0xf9, 0xca, 0x12,
+	0xa2, 0xfc, 0x33, 0xdf, 0xff, 0xea, 0xf7, 0x7f, 0xbe, 0x5f, 0xb9, 0x0e, 0xaf, 0xd9, 0xc5, 0xff,
+	0x70, 0x6c, 0x84, 0xb1, 0x9b, 0x39, 0x30, 0xfc, 0xc1, 0x00, 0x9b, 0x8b, 0xde, 0xba, 0x57, 0x56,
+	0x6e, 0x41, 0x68, 0xda, 0x4b, 0x0a, 0x73, 0xb6, 0x03, 0xc5, 0xb6, 0x07, 0x5f, 0x2e, 0x63, 0x23,
+	0x53, 0xe2, 0x3d, 0x82, 0xfb, 0xc5, 0x00, 0x3b, 0x85, 0x26, 0xbc, 0x5f, 0x56, 0xb8, 0x48, 0x6d,
+	0xbe, 0x7a, 0x1e, 0x75, 0xce, 0xfa, 0xba, 0x62, 0xbd, 0x09, 0xad, 0x32, 0x56, 0x41, 0xa4, 0x8b,
+	0xf2, 0x70, 0x17, 0x69, 0xb6, 0x9f, 0x0c, 0xb0, 0x5d, 0xe4, 0xb1, 0xa5, 0x97, 0x58, 0x20, 0x36,
+	0x6f, 0x9d, 0x43, 0x9c, 0x13, 0xbf, 0xa6, 0x88, 0x6d, 0x78, 0x50, 0x46, 0xec, 0xa9, 0x60, 0x57,
+	0x5b, 0xba, 0x1e, 0x33, 0xfc, 0xce, 0x00, 0x17, 0xe6, 0x6c, 0xe8, 0x5a, 0x69, 0xf1, 0x19, 0x95,
+	0xb9, 0xbf, 0x8c, 0x6a, 0xf9, 0x9b, 0xf7, 0x92, 0xa8, 0x9c, 0xe9, 0x47, 0x03, 0x6c, 0x2e, 0x7e,
+	0xda, 0x7b, 0x4f, 0x28, 0x38, 0x2b, 0x34, 0xed, 0x25, 0x85, 0x39, 0xdc, 0x4d, 0x05, 0x77, 0x03,
+	0x76, 0x9f, 0x00, 0xa7, 0x02, 0x33, 0xbe, 0xe3, 0x0f, 0xee, 0x9f, 0x36, 0x8d, 0x07, 0xa7, 0x4d,
+	0xe3, 0xef, 0xd3, 0xa6, 0xf1, 0xed, 0x59, 0xb3, 0xf2, 0xe0, 0xac, 0x59, 0xf9, 0xf3, 0xac, 0x59,
+	0xf9, 0xb8, 0x37, 0x63, 0xba, 0x59, 0xb6, 0x83, 0x2f, 0x59, 0x44, 0x1e, 0xe5, 0x9e, 0xe6, 0xd9,
+	0x95, 0x07, 0x0f, 0xd7, 0xd4, 0xcf, 0xae, 0x5b, 0xff, 0x0d, 0x00, 0xf3, 0x26, 0x13, 0x97, 0x22,
+	0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -328,6 +642,14 @@ type MsgClient interface {
 	// SetAllocationAddress sets allocation address of team vesting or
 	// strategic_reserve_custodian.
 	SetAllocationAddress(ctx context.Context, in *MsgSetAllocationAddress, opts ...grpc.CallOption) (*MsgSetAllocationAddressResponse, error)
+	// CreateMerkleAirdrop creates an airdrop claimed with merkle proofs.
+	// Should only be called by core team multisig.
+	CreateMerkleAirdrop(ctx context.Context, in *MsgCreateMerkleAirdrop, opts ...grpc.CallOption) (*MsgCreateMerkleAirdropResponse, error)
+	// ClaimAirdrop claims the amount of the sender in a merkle airdrop.
+	ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error)
+	// ClawbackAirdrop sends the unclaimed amount of a merkle airdrop to the
+	// community pool after its deadline.
+	ClawbackAirdrop(ctx context.Context, in *MsgClawbackAirdrop, opts ...grpc.CallOption) (*MsgClawbackAirdropResponse, error)
 }
 
 type msgClient struct {
@@ -365,6 +687,33 @@ func (c *msgClient) SetAllocationAddress(ctx context.Context, in *MsgSetAllocati
 	return out, nil
 }
 
+func (c *msgClient) CreateMerkleAirdrop(ctx context.Context, in *MsgCreateMerkleAirdrop, opts ...grpc.CallOption) (*MsgCreateMerkleAirdropResponse, error) {
+	out := new(MsgCreateMerkleAirdropResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.vesting.v1.Msg/CreateMerkleAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error) {
+	out := new(MsgClaimAirdropResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.vesting.v1.Msg/ClaimAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackAirdrop(ctx context.Context, in *MsgClawbackAirdrop, opts ...grpc.CallOption) (*MsgClawbackAirdropResponse, error) {
+	out := new(MsgClawbackAirdropResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.vesting.v1.Msg/ClawbackAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddAirdrops adds airdrop targets.
@@ -376,6 +725,14 @@ type MsgServer interface {
 	// SetAllocationAddress sets allocation address of team vesting or
 	// strategic_reserve_custodian.
 	SetAllocationAddress(context.Context, *MsgSetAllocationAddress) (*MsgSetAllocationAddressResponse, error)
+	// CreateMerkleAirdrop creates an airdrop claimed with merkle proofs.
+	// Should only be called by core team multisig.
+	CreateMerkleAirdrop(context.Context, *MsgCreateMerkleAirdrop) (*MsgCreateMerkleAirdropResponse, error)
+	// ClaimAirdrop claims the amount of the sender in a merkle airdrop.
+	ClaimAirdrop(context.Context, *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error)
+	// ClawbackAirdrop sends the unclaimed amount of a merkle airdrop to the
+	// community pool after its deadline.
+	ClawbackAirdrop(context.Context, *MsgClawbackAirdrop) (*MsgClawbackAirdropResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -391,6 +748,15 @@ func (*UnimplementedMsgServer) ExecuteAirdrops(ctx context.Context, req *MsgExec
 func (*UnimplementedMsgServer) SetAllocationAddress(ctx context.Context, req *MsgSetAllocationAddress) (*MsgSetAllocationAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAllocationAddress not implemented")
 }
+func (*UnimplementedMsgServer) CreateMerkleAirdrop(ctx context.Context, req *MsgCreateMerkleAirdrop) (*MsgCreateMerkleAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMerkleAirdrop not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAirdrop(ctx context.Context, req *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAirdrop not implemented")
+}
+func (*UnimplementedMsgServer) ClawbackAirdrop(ctx context.Context, req *MsgClawbackAirdrop) (*MsgClawbackAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackAirdrop not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -450,6 +816,60 @@ func _Msg_SetAllocationAddress_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateMerkleAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMerkleAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMerkleAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.vesting.v1.Msg/CreateMerkleAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMerkleAirdrop(ctx, req.(*MsgCreateMerkleAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.vesting.v1.Msg/ClaimAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAirdrop(ctx, req.(*MsgClaimAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.vesting.v1.Msg/ClawbackAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackAirdrop(ctx, req.(*MsgClawbackAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.vesting.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -466,6 +886,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetAllocationAddress",
 			Handler:    _Msg_SetAllocationAddress_Handler,
 		},
+		{
+			MethodName: "CreateMerkleAirdrop",
+			Handler:    _Msg_CreateMerkleAirdrop_Handler,
+		},
+		{
+			MethodName: "ClaimAirdrop",
+			Handler:    _Msg_ClaimAirdrop_Handler,
+		},
+		{
+			MethodName: "ClawbackAirdrop",
+			Handler:    _Msg_ClawbackAirdrop_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/vesting/v1/tx.proto",
@@ -663,50 +1095,312 @@ func (m *MsgSetAllocationAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateMerkleAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddAirdrops) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateMerkleAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.VeMinLockDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VeMinLockDuration))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Airdrops) > 0 {
-		for _, e := range m.Airdrops {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.VeBonusMultiplier.Size()
+		i -= size
+		if _, err := m.VeBonusMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MsgAddAirdropsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgExecuteAirdrops) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AirdropId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAirdrops) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Airdrops) > 0 {
+		for _, e := range m.Airdrops {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAirdropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteAirdrops) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Sender)
 	if l > 0 {
@@ -715,55 +1409,676 @@ func (m *MsgExecuteAirdrops) Size() (n int) {
 	if m.MaxCount != 0 {
 		n += 1 + sovTx(uint64(m.MaxCount))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgExecuteAirdropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAllocationAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TeamVestingAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StrategicReserveCustodianAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAllocationAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateMerkleAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	l = m.VeBonusMultiplier.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.VeMinLockDuration != 0 {
+		n += 1 + sovTx(uint64(m.VeMinLockDuration))
+	}
+	return n
+}
+
+func (m *MsgCreateMerkleAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovTx(uint64(m.AirdropId))
+	}
+	return n
+}
+
+func (m *MsgClaimAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AirdropId != 0 {
+		n += 1 + sovTx(uint64(m.AirdropId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LockDuration != 0 {
+		n += 1 + sovTx(uint64(m.LockDuration))
+	}
+	return n
+}
+
+func (m *MsgClaimAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AirdropId != 0 {
+		n += 1 + sovTx(uint64(m.AirdropId))
+	}
+	return n
+}
+
+func (m *MsgClawbackAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAirdrops: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Airdrops = append(m.Airdrops, Airdrop{})
+			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAirdropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAirdropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteAirdrops: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+			}
+			m.MaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteAirdropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteAirdropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocationAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocationAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamVestingAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamVestingAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserveCustodianAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategicReserveCustodianAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgExecuteAirdropsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgSetAllocationAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TeamVestingAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StrategicReserveCustodianAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetAllocationAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocationAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocationAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSetAllocationAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMerkleAirdrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,10 +2101,10 @@ func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAirdrops: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMerkleAirdrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMerkleAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -826,9 +2141,9 @@ func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -838,26 +2153,130 @@ func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Airdrops = append(m.Airdrops, Airdrop{})
-			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeBonusMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeBonusMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeMinLockDuration", wireType)
+			}
+			m.VeMinLockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeMinLockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -879,7 +2298,7 @@ func (m *MsgAddAirdrops) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAirdropsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMerkleAirdropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -902,12 +2321,31 @@ func (m *MsgAddAirdropsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAirdropsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMerkleAirdropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMerkleAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -929,7 +2367,7 @@ func (m *MsgAddAirdropsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAirdrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -952,10 +2390,10 @@ func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteAirdrops: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAirdrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteAirdrops: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -992,9 +2430,9 @@ func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
-			m.MaxCount = 0
+			m.AirdropId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1004,7 +2442,92 @@ func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCount |= uint64(b&0x7F) << shift
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			m.LockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockDuration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1030,7 +2553,7 @@ func (m *MsgExecuteAirdrops) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteAirdropsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAirdropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1053,12 +2576,77 @@ func (m *MsgExecuteAirdropsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteAirdropsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAirdropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteAirdropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1080,7 +2668,7 @@ func (m *MsgExecuteAirdropsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackAirdrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1103,10 +2691,10 @@ func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllocationAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackAirdrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllocationAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1142,42 +2730,10 @@ func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamVestingAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TeamVestingAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserveCustodianAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
-			var stringLen uint64
+			m.AirdropId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1187,24 +2743,11 @@ func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AirdropId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StrategicReserveCustodianAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1226,7 +2769,7 @@ func (m *MsgSetAllocationAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAllocationAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackAirdropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,12 +2792,45 @@ func (m *MsgSetAllocationAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllocationAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackAirdropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllocationAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
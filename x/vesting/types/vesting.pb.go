@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -62,30 +63,110 @@ func (m *Airdrop) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Airdrop proto.InternalMessageInfo
 
+// MerkleAirdrop defines an airdrop claimed by the recipients themselves with
+// the merkle proofs of their amounts.
+type MerkleAirdrop struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// hex-encoded merkle root of the leaves sha256(0x00 || address || uint256
+	// amount)
+	MerkleRoot string `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty" yaml:"merkle_root"`
+	// max total amount of the leaves paid out, excluding the ve bonuses
+	Cap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap"`
+	// total amount of the leaves paid out so far
+	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed"`
+	// unix time after which no more claims are accepted and the unclaimed
+	// amount can be clawed back to the community pool
+	Deadline uint64 `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// multiplier of the amount claimed straight into a ve lock
+	VeBonusMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=ve_bonus_multiplier,json=veBonusMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ve_bonus_multiplier" yaml:"ve_bonus_multiplier"`
+	// minimum lock duration in seconds of the claims into ve
+	VeMinLockDuration uint64 `protobuf:"varint,7,opt,name=ve_min_lock_duration,json=veMinLockDuration,proto3" json:"ve_min_lock_duration,omitempty" yaml:"ve_min_lock_duration"`
+	// whether the unclaimed amount has been clawed back
+	ClawedBack bool `protobuf:"varint,8,opt,name=clawed_back,json=clawedBack,proto3" json:"clawed_back,omitempty" yaml:"clawed_back"`
+	// bonus pool of the claims into ve, funded separately from the cap so that
+	// the bonuses never exhaust the amounts of the leaves
+	VeBonusCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=ve_bonus_cap,json=veBonusCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ve_bonus_cap" yaml:"ve_bonus_cap"`
+	// total ve bonus paid out so far
+	VeBonusClaimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=ve_bonus_claimed,json=veBonusClaimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ve_bonus_claimed" yaml:"ve_bonus_claimed"`
+}
+
+func (m *MerkleAirdrop) Reset()         { *m = MerkleAirdrop{} }
+func (m *MerkleAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MerkleAirdrop) ProtoMessage()    {}
+func (*MerkleAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e59ec182944cd7f, []int{1}
+}
+func (m *MerkleAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAirdrop.Merge(m, src)
+}
+func (m *MerkleAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAirdrop proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Airdrop)(nil), "gridiron.vesting.v1.Airdrop")
+	proto.RegisterType((*MerkleAirdrop)(nil), "gridiron.vesting.v1.MerkleAirdrop")
 }
 
 func init() { proto.RegisterFile("gridiron/vesting/v1/vesting.proto", fileDescriptor_9e59ec182944cd7f) }
 
 var fileDescriptor_9e59ec182944cd7f = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x4b, 0x2d, 0x2e, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x84, 0x31,
-	0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0xa0, 0x2a, 0xf4, 0x60, 0xc2, 0x65, 0x86, 0x52,
-	0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x69, 0x7d, 0x10, 0x0b, 0xa2, 0x52, 0x4a, 0x2e, 0x39, 0xbf,
-	0x38, 0x37, 0xbf, 0x58, 0x3f, 0x29, 0xb1, 0x38, 0x55, 0xbf, 0xcc, 0x30, 0x29, 0xb5, 0x24, 0xd1,
-	0x50, 0x3f, 0x39, 0x3f, 0x33, 0x0f, 0x22, 0xaf, 0x94, 0xc9, 0xc5, 0xee, 0x98, 0x59, 0x94, 0x52,
-	0x94, 0x5f, 0x20, 0x24, 0xcf, 0xc5, 0x5d, 0x92, 0x58, 0x94, 0x9e, 0x5a, 0x12, 0x9f, 0x98, 0x92,
-	0x52, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x05, 0x11, 0x72, 0x4c, 0x49, 0x29, 0x12,
-	0x32, 0xe7, 0x62, 0x4b, 0xcc, 0xcd, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36,
-	0x92, 0xd4, 0x83, 0x18, 0xae, 0x07, 0x32, 0x5c, 0x0f, 0x6a, 0xb8, 0x9e, 0x73, 0x7e, 0x66, 0x9e,
-	0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xe5, 0x56, 0x2c, 0x1d, 0x0b, 0xe4, 0x19, 0x9c,
-	0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
-	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x33, 0xdd, 0xaa, 0xfc, 0xbc, 0x54, 0x18,
-	0x47, 0xbf, 0x02, 0x1e, 0x14, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xc7, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x7d, 0xea, 0x17, 0x15, 0x2a, 0x01, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd4, 0x3a,
+	0x14, 0xc6, 0x27, 0xed, 0xf4, 0x9f, 0x7b, 0x6f, 0x05, 0x6e, 0x05, 0x61, 0x90, 0x92, 0x92, 0x05,
+	0xea, 0xa6, 0x89, 0x06, 0x16, 0x95, 0xba, 0xa2, 0x69, 0x91, 0xa8, 0xe8, 0x48, 0x28, 0x4b, 0x36,
+	0x91, 0x63, 0x5b, 0xc1, 0x4a, 0x62, 0x47, 0x8e, 0x27, 0x50, 0xc4, 0x03, 0xb0, 0xe4, 0x11, 0x78,
+	0x9c, 0x2e, 0xbb, 0x44, 0x2c, 0x22, 0x34, 0xb3, 0x66, 0x33, 0x4f, 0x80, 0x12, 0x27, 0x61, 0x54,
+	0xd8, 0x0c, 0xab, 0xf8, 0x7c, 0xe7, 0xf8, 0xfb, 0xd9, 0xe7, 0xc4, 0xe0, 0x49, 0x2c, 0x19, 0x61,
+	0x52, 0x70, 0xaf, 0xa4, 0x85, 0x62, 0x3c, 0xf6, 0xca, 0x71, 0xb7, 0x74, 0x73, 0x29, 0x94, 0x80,
+	0xfb, 0x5d, 0x89, 0xdb, 0xe9, 0xe5, 0x78, 0x74, 0x10, 0x8b, 0x58, 0x34, 0x79, 0xaf, 0x5e, 0xe9,
+	0xd2, 0x91, 0x85, 0x45, 0x91, 0x89, 0xc2, 0x8b, 0x50, 0x41, 0xbd, 0x72, 0x1c, 0x51, 0x85, 0xc6,
+	0x1e, 0x16, 0x8c, 0xeb, 0xbc, 0xc3, 0xc0, 0xd6, 0x19, 0x93, 0x44, 0x8a, 0x1c, 0xda, 0x60, 0x57,
+	0x21, 0x19, 0x53, 0x15, 0x22, 0x42, 0xa4, 0x69, 0x1c, 0x1a, 0x47, 0x3b, 0x01, 0xd0, 0xd2, 0x19,
+	0x21, 0x12, 0x9e, 0x80, 0x4d, 0x94, 0x89, 0x29, 0x57, 0xe6, 0xda, 0xa1, 0x71, 0xb4, 0xfb, 0xec,
+	0x91, 0xab, 0xcd, 0xdd, 0xda, 0xdc, 0x6d, 0xcd, 0xdd, 0x73, 0xc1, 0xb8, 0x3f, 0xbc, 0xa9, 0xec,
+	0x41, 0xd0, 0x96, 0x9f, 0x0e, 0x3f, 0x7f, 0xb5, 0x07, 0xce, 0xcf, 0x0d, 0xf0, 0xff, 0x84, 0xca,
+	0x24, 0xa5, 0x1d, 0x71, 0x0f, 0xac, 0x31, 0xd2, 0x80, 0x86, 0xc1, 0x1a, 0x23, 0xf0, 0x04, 0xec,
+	0x66, 0x4d, 0x41, 0x28, 0x85, 0xd0, 0x94, 0x1d, 0xff, 0xc1, 0xa2, 0xb2, 0xe1, 0x35, 0xca, 0xd2,
+	0x53, 0x67, 0x29, 0xe9, 0x04, 0x40, 0x47, 0x81, 0x10, 0x0a, 0xbe, 0x00, 0xeb, 0x18, 0xe5, 0xe6,
+	0x7a, 0xb3, 0xc1, 0xad, 0xd9, 0xdf, 0x2b, 0xfb, 0x69, 0xcc, 0xd4, 0xbb, 0x69, 0xe4, 0x62, 0x91,
+	0x79, 0x6d, 0x17, 0xf4, 0xe7, 0xb8, 0x20, 0x89, 0xa7, 0xae, 0x73, 0x5a, 0xb8, 0x97, 0x5c, 0x05,
+	0xf5, 0x56, 0xf8, 0x0a, 0x6c, 0xe1, 0x14, 0xb1, 0x8c, 0x12, 0x73, 0xf8, 0x4f, 0x2e, 0xdd, 0x76,
+	0x38, 0x02, 0xdb, 0x84, 0x22, 0x92, 0x32, 0x4e, 0xcd, 0x8d, 0xe6, 0x6a, 0x7d, 0x0c, 0x3f, 0x81,
+	0xfd, 0x92, 0x86, 0x91, 0xe0, 0xd3, 0x22, 0xcc, 0xa6, 0xa9, 0x62, 0x79, 0xca, 0xa8, 0x34, 0x37,
+	0x1b, 0xe2, 0xd5, 0x0a, 0xc4, 0x0b, 0x8a, 0x17, 0x95, 0x3d, 0xd2, 0x6d, 0xf9, 0x8b, 0xa5, 0x13,
+	0xdc, 0x2f, 0xa9, 0x5f, 0x8b, 0x93, 0x5e, 0x83, 0x6f, 0xc0, 0x41, 0x49, 0xc3, 0x8c, 0xf1, 0x30,
+	0x15, 0x38, 0x09, 0xc9, 0x54, 0x22, 0xc5, 0x04, 0x37, 0xb7, 0xea, 0x53, 0xfa, 0xf6, 0xa2, 0xb2,
+	0x1f, 0xf7, 0x86, 0x7f, 0x54, 0x35, 0x8e, 0x13, 0xc6, 0xaf, 0x04, 0x4e, 0x2e, 0x5a, 0xad, 0x1e,
+	0x18, 0x4e, 0xd1, 0x7b, 0x4a, 0xc2, 0x08, 0xe1, 0xc4, 0xdc, 0x3e, 0x34, 0x8e, 0xb6, 0x97, 0x07,
+	0xb6, 0x94, 0x74, 0x02, 0xa0, 0x23, 0x1f, 0xe1, 0x04, 0xc6, 0xe0, 0xbf, 0xfe, 0xd4, 0xf5, 0xe4,
+	0x76, 0x9a, 0x0e, 0xbc, 0x5c, 0xad, 0xe7, 0x8b, 0xca, 0xde, 0xbf, 0xd3, 0x01, 0x8c, 0x72, 0x27,
+	0x00, 0xed, 0xd5, 0xcf, 0x51, 0x0e, 0x0b, 0x70, 0xef, 0x77, 0xb2, 0x1d, 0x30, 0x68, 0x60, 0x97,
+	0x2b, 0xc3, 0x1e, 0xde, 0x85, 0x69, 0x3f, 0x27, 0xd8, 0xeb, 0x80, 0x5a, 0xd0, 0xff, 0xbb, 0xff,
+	0xfa, 0x66, 0x66, 0x19, 0xb7, 0x33, 0xcb, 0xf8, 0x31, 0xb3, 0x8c, 0x2f, 0x73, 0x6b, 0x70, 0x3b,
+	0xb7, 0x06, 0xdf, 0xe6, 0xd6, 0xe0, 0xed, 0x78, 0x09, 0xd9, 0x3d, 0xe5, 0xe3, 0x8f, 0x82, 0xd3,
+	0x3e, 0xf2, 0x3e, 0xf4, 0xaf, 0xbf, 0x39, 0x41, 0xb4, 0xd9, 0x3c, 0xd7, 0xe7, 0xbf, 0x06, 0x00,
+	0x8b, 0x95, 0x84, 0x66, 0x1e, 0x04, 0x00, 0x00,
 }
 
 func (m *Airdrop) Marshal() (dAtA []byte, err error) {
@@ -128,6 +209,111 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MerkleAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VeBonusClaimed.Size()
+		i -= size
+		if _, err := m.VeBonusClaimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.VeBonusCap.Size()
+		i -= size
+		if _, err := m.VeBonusCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ClawedBack {
+		i--
+		if m.ClawedBack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VeMinLockDuration != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.VeMinLockDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.VeBonusMultiplier.Size()
+		i -= size
+		if _, err := m.VeBonusMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Deadline != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -154,6 +340,41 @@ func (m *Airdrop) Size() (n int) {
 	return n
 }
 
+func (m *MerkleAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVesting(uint64(m.Id))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovVesting(uint64(m.Deadline))
+	}
+	l = m.VeBonusMultiplier.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	if m.VeMinLockDuration != 0 {
+		n += 1 + sovVesting(uint64(m.VeMinLockDuration))
+	}
+	if m.ClawedBack {
+		n += 2
+	}
+	l = m.VeBonusCap.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	l = m.VeBonusClaimed.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -275,6 +496,335 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MerkleAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeBonusMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeBonusMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeMinLockDuration", wireType)
+			}
+			m.VeMinLockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeMinLockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawedBack = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeBonusCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeBonusCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeBonusClaimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeBonusClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,4 +14,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	k.EmitReward(ctx)
+
+	k.SettleBribes(ctx)
+
+	k.DepositGaugeFees(ctx)
 }
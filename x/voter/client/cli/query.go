@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	// "strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -15,6 +16,10 @@ import (
 	"github.com/gridiron-zone/gridiron/x/voter/types"
 )
 
+const (
+	FlagPoolDenom = "pool-denom"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group voter queries under a subcommand
@@ -27,6 +32,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryBribes())
+	cmd.AddCommand(CmdQueryBribesPerVote())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -55,3 +62,73 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryBribes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bribes [pool-denom] [epoch-time]",
+		Short: "lists the unsettled bribes for the pool in the epoch, the current epoch by default",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBribesRequest{PoolDenom: args[0]}
+			if len(args) > 1 {
+				epochTime, err := strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+				req.EpochTime = epochTime
+			}
+
+			res, err := queryClient.Bribes(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBribesPerVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bribes-per-vote [epoch-time]",
+		Short: "shows the bribe rewards per vote for each pool in the epoch, the current epoch by default",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolDenom, err := cmd.Flags().GetString(FlagPoolDenom)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryBribesPerVoteRequest{PoolDenom: poolDenom}
+			if len(args) > 0 {
+				req.EpochTime, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.BribesPerVote(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagPoolDenom, "", "pool denom, all pools if not specified")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
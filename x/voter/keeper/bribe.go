@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+	"github.com/gridiron-zone/gridiron/x/voter/types"
+)
+
+// CurrentEpochTime returns the start unix time of the current epoch
+func CurrentEpochTime(ctx sdk.Context) uint64 {
+	return vetypes.RegulatedUnixTime(uint64(ctx.BlockTime().Unix()))
+}
+
+// addEpochVotes adds the concurring votes of the ve for the pool in the
+// current epoch, which can be negative for canceling votes
+func (k Keeper) addEpochVotes(ctx sdk.Context, veID uint64, poolDenom string, votes sdk.Int) {
+	epochTime := CurrentEpochTime(ctx)
+	k.SetEpochVotesByUser(ctx, veID, poolDenom, epochTime, k.GetEpochVotesByUser(ctx, veID, poolDenom, epochTime).Add(votes))
+	k.SetEpochVotes(ctx, poolDenom, epochTime, k.GetEpochVotes(ctx, poolDenom, epochTime).Add(votes))
+}
+
+// PostBribe posts the bribe rewards for the concurring voters of the pool in
+// the epoch, which must be no less than the minimum bribe of the denom
+func (k Keeper) PostBribe(ctx sdk.Context, briber sdk.AccAddress, poolDenom string, epochTime uint64, amount sdk.Coin, maxPerVote sdk.Dec, rollover bool) (uint64, error) {
+	minBribe := k.MinBribes(ctx).AmountOf(amount.Denom)
+	if !minBribe.IsPositive() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidBribe, "denom %s is not accepted", amount.Denom)
+	}
+	if amount.Amount.LT(minBribe) {
+		return 0, sdkerrors.Wrapf(types.ErrBribeTooSmall, "%s is less than %s%s", amount, minBribe, amount.Denom)
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, briber, types.BribePoolName, sdk.NewCoins(amount))
+	if err != nil {
+		return 0, err
+	}
+
+	bribeID := k.GetNextBribeID(ctx)
+	k.SetNextBribeID(ctx, bribeID+1)
+
+	k.SetBribe(ctx, types.Bribe{
+		Id:         bribeID,
+		Briber:     briber.String(),
+		PoolDenom:  poolDenom,
+		EpochTime:  epochTime,
+		Amount:     amount,
+		MaxPerVote: maxPerVote,
+		Rollover:   rollover,
+	})
+	return bribeID, nil
+}
+
+// distributedBribeAmount calculates the amount of the bribe distributed to the
+// votes, capped by the max reward amount per vote
+func distributedBribeAmount(bribe types.Bribe, votes sdk.Int) sdk.Int {
+	if !votes.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	amount := bribe.Amount.Amount
+	if bribe.MaxPerVote.IsPositive() {
+		amount = sdk.MinInt(amount, bribe.MaxPerVote.MulInt(votes).TruncateInt())
+	}
+	return amount
+}
+
+// SettleBribes settles the bribes of the ended epochs, i.e., accounts them to
+// the concurring votes at the end of the epochs, and rolls over the
+// undistributed amounts to the current epoch or refunds them to the bribers.
+// At most MaxBribesSettledPerBlock bribes are settled in a block, and the
+// epochs are marked as settled after all their bribes are settled.
+func (k Keeper) SettleBribes(ctx sdk.Context) {
+	currentEpochTime := CurrentEpochTime(ctx)
+	if k.GetBribeSettledEpochTime(ctx) >= currentEpochTime {
+		return
+	}
+
+	limit := int(k.MaxBribesSettledPerBlock(ctx))
+	maxRollovers := k.MaxBribeRollovers(ctx)
+
+	// collect one more bribe than the limit to know whether any remains
+	var bribes []types.Bribe
+	k.IterateBribes(ctx, 0, currentEpochTime, func(bribe types.Bribe) (stop bool) {
+		bribes = append(bribes, bribe)
+		return len(bribes) > limit
+	})
+	settled := len(bribes) <= limit
+	if !settled {
+		bribes = bribes[:limit]
+	}
+
+	for _, bribe := range bribes {
+		k.DeleteBribe(ctx, bribe)
+
+		epochBribe, found := k.GetEpochBribe(ctx, bribe.PoolDenom, bribe.EpochTime)
+		if !found {
+			epochBribe = types.EpochBribe{
+				PoolDenom: bribe.PoolDenom,
+				EpochTime: bribe.EpochTime,
+				Votes:     k.GetEpochVotes(ctx, bribe.PoolDenom, bribe.EpochTime),
+			}
+		}
+
+		distributed := distributedBribeAmount(bribe, epochBribe.Votes)
+		if distributed.IsPositive() {
+			epochBribe.Rewards = epochBribe.Rewards.Add(sdk.NewCoin(bribe.Amount.Denom, distributed))
+			k.SetEpochBribe(ctx, epochBribe)
+		}
+
+		remaining := bribe.Amount.SubAmount(distributed)
+		if !remaining.IsPositive() {
+			continue
+		}
+
+		if bribe.Rollover && bribe.Rollovers < maxRollovers {
+			bribe.EpochTime = currentEpochTime
+			bribe.Amount = remaining
+			bribe.Rollovers++
+			k.SetBribe(ctx, bribe)
+
+			err := ctx.EventManager().EmitTypedEvent(&types.EventBribeRollover{
+				BribeId:   bribe.Id,
+				EpochTime: currentEpochTime,
+				Amount:    remaining,
+			})
+			if err != nil {
+				panic(err)
+			}
+		} else {
+			briber, err := sdk.AccAddressFromBech32(bribe.Briber)
+			if err != nil {
+				panic(err)
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.BribePoolName, briber, sdk.NewCoins(remaining))
+			if err != nil {
+				panic(err)
+			}
+
+			err = ctx.EventManager().EmitTypedEvent(&types.EventBribeRefund{
+				Briber:  bribe.Briber,
+				BribeId: bribe.Id,
+				Amount:  remaining,
+			})
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if settled {
+		k.SetBribeSettledEpochTime(ctx, currentEpochTime)
+	}
+}
+
+// ClaimEpochBribes claims the settled epoch bribes of the ve for all pools
+// to its owner, pro rata to its concurring votes at the end of each epoch
+func (k Keeper) ClaimEpochBribes(ctx sdk.Context, veID uint64) (sdk.Coins, error) {
+	settledEpochTime := k.GetBribeSettledEpochTime(ctx)
+
+	rewards := sdk.NewCoins()
+	for _, poolDenom := range k.gaugeKeeper.GetGauges(ctx) {
+		claimedEpochTime := k.GetBribeClaimedEpochTimeByUser(ctx, veID, poolDenom)
+		if claimedEpochTime >= settledEpochTime {
+			continue
+		}
+
+		k.IterateEpochBribes(ctx, poolDenom, claimedEpochTime, settledEpochTime, func(epochBribe types.EpochBribe) (stop bool) {
+			votes := k.GetEpochVotesByUser(ctx, veID, poolDenom, epochBribe.EpochTime)
+			if !votes.IsPositive() {
+				return false
+			}
+			for _, reward := range epochBribe.Rewards {
+				// <reward> = <epoch reward> * <votes of user> / <total votes>
+				amount := reward.Amount.Mul(votes).Quo(epochBribe.Votes)
+				rewards = rewards.Add(sdk.NewCoin(reward.Denom, amount))
+			}
+			return false
+		})
+
+		k.SetBribeClaimedEpochTimeByUser(ctx, veID, poolDenom, settledEpochTime)
+	}
+
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	owner := k.nftKeeper.GetOwner(ctx, vetypes.VeNftClass.Id, vetypes.VeIDFromUint64(veID))
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.BribePoolName, owner, rewards)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaimEpochBribes{
+		VeId:   vetypes.VeIDFromUint64(veID),
+		Owner:  owner.String(),
+		Amount: rewards,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gridiron-zone/gridiron/app"
+	vekeeper "github.com/gridiron-zone/gridiron/x/ve/keeper"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+	"github.com/gridiron-zone/gridiron/x/voter/keeper"
+	"github.com/gridiron-zone/gridiron/x/voter/types"
+	"github.com/tharsis/ethermint/tests"
+)
+
+func (suite *KeeperTestSuite) TestEpochBribes() {
+	require := suite.Require()
+	ctx := suite.ctx
+	k := suite.app.VoterKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	veImpl := vekeeper.NewMsgServerImpl(suite.app.VeKeeper)
+	epoch0 := keeper.CurrentEpochTime(ctx)
+	epoch1 := epoch0 + vetypes.RegulatedPeriod
+	epoch2 := epoch1 + vetypes.RegulatedPeriod
+
+	pool, pool2, pool3 := "pool", "pool2", "pool3"
+	for _, denom := range []string{pool, pool2, pool3} {
+		k.CreateGauge(ctx, denom)
+	}
+
+	// ve-1 votes for pool, and ve-2 votes for pool and pool2 equally
+	user1 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	user2 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	briber := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	lockAmt := sdk.NewCoin("airon", sdk.NewInt(1000*vetypes.MaxLockTime))
+	for _, user := range []sdk.AccAddress{user1, user2} {
+		require.NoError(app.FundAccount(suite.app.BankKeeper, ctx, user, sdk.NewCoins(lockAmt)))
+		_, err := veImpl.Create(sdk.WrapSDKContext(ctx), &vetypes.MsgCreate{
+			Sender:       user.String(),
+			To:           user.String(),
+			Amount:       lockAmt,
+			LockDuration: vetypes.MaxLockTime,
+		})
+		require.NoError(err)
+	}
+	_, err := impl.Vote(sdk.WrapSDKContext(ctx), &types.MsgVote{
+		Sender:      user1.String(),
+		VeId:        "ve-1",
+		PoolWeights: []types.PoolWeight{{PoolDenom: pool, Weight: sdk.OneDec()}},
+	})
+	require.NoError(err)
+	_, err = impl.Vote(sdk.WrapSDKContext(ctx), &types.MsgVote{
+		Sender: user2.String(),
+		VeId:   "ve-2",
+		PoolWeights: []types.PoolWeight{
+			{PoolDenom: pool, Weight: sdk.NewDecWithPrec(5, 1)},
+			{PoolDenom: pool2, Weight: sdk.NewDecWithPrec(5, 1)},
+		},
+	})
+	require.NoError(err)
+	votes1 := k.GetEpochVotesByUser(ctx, 1, pool, epoch0)
+	votes2 := k.GetEpochVotesByUser(ctx, 2, pool, epoch0)
+	votes := k.GetEpochVotes(ctx, pool, epoch0)
+	require.True(votes1.IsPositive())
+	require.True(votes2.IsPositive())
+	require.Equal(votes1.Add(votes2), votes)
+	require.Equal(votes, k.GetEpochVotes(ctx, pool, epoch2))
+
+	// post bribes
+	denom := "ubribe"
+	suite.app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "bribe",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: uint32(0)},
+			{Denom: "bribe", Exponent: uint32(6)},
+		},
+		Base:    denom,
+		Display: "bribe",
+		Name:    "BRIBE",
+		Symbol:  "BRIBE",
+	})
+	require.NoError(app.FundAccount(suite.app.BankKeeper, ctx, briber, sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))))
+	params := k.GetParams(ctx)
+	params.MinBribes = sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	k.SetParams(ctx, params)
+	maxPerVote := sdk.NewDec(100).QuoInt(votes)
+	capped := maxPerVote.MulInt(votes).TruncateInt()
+	for _, tc := range []struct {
+		name       string
+		pass       bool
+		poolDenom  string
+		epochTime  uint64
+		amount     int64
+		maxPerVote sdk.Dec
+		rollover   bool
+	}{
+		{"gauge not found", false, "pool4", epoch0, 1000, sdk.ZeroDec(), false},
+		{"past epoch", false, pool, epoch0 - vetypes.RegulatedPeriod, 1000, sdk.ZeroDec(), false},
+		{"too far epoch", false, pool, epoch0 + vetypes.MaxLockTime + vetypes.RegulatedPeriod, 1000, sdk.ZeroDec(), false},
+		{"insufficient funds", false, pool, epoch0, 100000, sdk.ZeroDec(), false},
+		{"less than min bribe", false, pool, epoch0, 99, sdk.ZeroDec(), false},
+		{"no cap", true, pool, epoch0, 1000, sdk.ZeroDec(), false},
+		{"capped with rollover", true, pool, epoch0, 500, maxPerVote, true},
+		{"next epoch", true, pool2, epoch1, 300, sdk.ZeroDec(), false},
+		{"no votes to refund", true, pool3, epoch0, 200, sdk.ZeroDec(), false},
+	} {
+		_, err := impl.Bribe(sdk.WrapSDKContext(ctx), &types.MsgBribe{
+			Sender:     briber.String(),
+			PoolDenom:  tc.poolDenom,
+			EpochTime:  tc.epochTime,
+			Amount:     sdk.NewInt64Coin(denom, tc.amount),
+			MaxPerVote: tc.maxPerVote,
+			Rollover:   tc.rollover,
+		})
+		if tc.pass {
+			require.NoError(err, tc.name)
+		} else {
+			require.Error(err, tc.name)
+		}
+	}
+	require.Equal(int64(10000-2000), suite.app.BankKeeper.GetBalance(ctx, briber, denom).Amount.Int64())
+
+	bribesRes, err := k.Bribes(sdk.WrapSDKContext(ctx), &types.QueryBribesRequest{PoolDenom: pool})
+	require.NoError(err)
+	require.Len(bribesRes.Bribes, 2)
+	perVoteRes, err := k.BribesPerVote(sdk.WrapSDKContext(ctx), &types.QueryBribesPerVoteRequest{PoolDenom: pool})
+	require.NoError(err)
+	require.Len(perVoteRes.Bribes, 1)
+	require.False(perVoteRes.Bribes[0].Settled)
+	require.Equal(votes, perVoteRes.Bribes[0].Votes)
+	require.Equal(sdk.NewInt(1000).Add(capped), perVoteRes.Bribes[0].Rewards.AmountOf(denom))
+	require.Equal(sdk.NewInt(1000).Add(capped).ToDec().Quo(votes.ToDec()), perVoteRes.Bribes[0].RewardsPerVote.AmountOf(denom))
+
+	// bribes in the current epoch are not settled
+	k.SettleBribes(ctx)
+	require.Equal(epoch0, k.GetBribeSettledEpochTime(ctx))
+	bribesRes, err = k.Bribes(sdk.WrapSDKContext(ctx), &types.QueryBribesRequest{PoolDenom: pool})
+	require.NoError(err)
+	require.Len(bribesRes.Bribes, 2)
+
+	// votes changed in the next epoch don't affect the settled epoch
+	ctx = ctx.WithBlockTime(time.Unix(int64(epoch1), 0).UTC())
+	k.SettleBribes(ctx)
+	require.Equal(epoch1, k.GetBribeSettledEpochTime(ctx))
+	_, err = impl.Abstain(sdk.WrapSDKContext(ctx), &types.MsgAbstain{Sender: user1.String(), VeId: "ve-1"})
+	require.NoError(err)
+	require.True(k.GetEpochVotesByUser(ctx, 1, pool, epoch1).IsZero())
+	require.Equal(votes1, k.GetEpochVotesByUser(ctx, 1, pool, epoch0))
+
+	epochBribe, found := k.GetEpochBribe(ctx, pool, epoch0)
+	require.True(found)
+	require.Equal(votes, epochBribe.Votes)
+	require.Equal(sdk.NewInt(1000).Add(capped), epochBribe.Rewards.AmountOf(denom))
+	_, found = k.GetEpochBribe(ctx, pool3, epoch0)
+	require.False(found)
+	perVoteRes, err = k.BribesPerVote(sdk.WrapSDKContext(ctx), &types.QueryBribesPerVoteRequest{EpochTime: epoch0})
+	require.NoError(err)
+	require.Len(perVoteRes.Bribes, 3)
+	for _, bribe := range perVoteRes.Bribes {
+		require.True(bribe.Settled)
+	}
+
+	// undistributed amount of the capped bribe rolls over, and the bribe
+	// without votes is refunded
+	bribesRes, err = k.Bribes(sdk.WrapSDKContext(ctx), &types.QueryBribesRequest{PoolDenom: pool, EpochTime: epoch1})
+	require.NoError(err)
+	require.Len(bribesRes.Bribes, 1)
+	require.Equal(sdk.NewInt(500).Sub(capped), bribesRes.Bribes[0].Amount.Amount)
+	require.Equal(int64(10000-2000+200), suite.app.BankKeeper.GetBalance(ctx, briber, denom).Amount.Int64())
+
+	// claim pro rata to the votes
+	for _, claim := range []struct {
+		user  sdk.AccAddress
+		veID  string
+		votes sdk.Int
+	}{
+		{user1, "ve-1", votes1},
+		{user2, "ve-2", votes2},
+	} {
+		_, err = impl.ClaimBribes(sdk.WrapSDKContext(ctx), &types.MsgClaimBribes{Sender: claim.user.String(), VeId: claim.veID})
+		require.NoError(err)
+		expected := epochBribe.Rewards.AmountOf(denom).Mul(claim.votes).Quo(votes)
+		require.Equal(expected, suite.app.BankKeeper.GetBalance(ctx, claim.user, denom).Amount)
+
+		// no double claiming
+		_, err = impl.ClaimBribes(sdk.WrapSDKContext(ctx), &types.MsgClaimBribes{Sender: claim.user.String(), VeId: claim.veID})
+		require.NoError(err)
+		require.Equal(expected, suite.app.BankKeeper.GetBalance(ctx, claim.user, denom).Amount)
+	}
+
+	// only ve-2 votes in the next epoch
+	ctx = ctx.WithBlockTime(time.Unix(int64(epoch2), 0).UTC())
+	k.SettleBribes(ctx)
+	balance2 := suite.app.BankKeeper.GetBalance(ctx, user2, denom).Amount
+	_, err = impl.ClaimBribes(sdk.WrapSDKContext(ctx), &types.MsgClaimBribes{Sender: user1.String(), VeId: "ve-1"})
+	require.NoError(err)
+	_, err = impl.ClaimBribes(sdk.WrapSDKContext(ctx), &types.MsgClaimBribes{Sender: user2.String(), VeId: "ve-2"})
+	require.NoError(err)
+	epochBribe, found = k.GetEpochBribe(ctx, pool, epoch1)
+	require.True(found)
+	require.Equal(votes2, epochBribe.Votes)
+	rewards2 := epochBribe.Rewards.AmountOf(denom)
+	epochBribe, found = k.GetEpochBribe(ctx, pool2, epoch1)
+	require.True(found)
+	require.Equal(sdk.NewInt(300), epochBribe.Rewards.AmountOf(denom))
+	rewards2 = rewards2.Add(sdk.NewInt(300))
+	require.Equal(balance2.Add(rewards2), suite.app.BankKeeper.GetBalance(ctx, user2, denom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestPostBribe_MinBribes() {
+	require := suite.Require()
+	ctx := suite.ctx
+	k := suite.app.VoterKeeper
+	epoch0 := keeper.CurrentEpochTime(ctx)
+	k.CreateGauge(ctx, "pool")
+
+	briber := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	minBribe := types.DefaultMinBribes[0]
+	require.NoError(app.FundAccount(suite.app.BankKeeper, ctx, briber, sdk.NewCoins(minBribe.AddAmount(minBribe.Amount))))
+
+	// bribes in the denoms without min bribes are not accepted
+	_, err := k.PostBribe(ctx, briber, "pool", epoch0, sdk.NewInt64Coin("ubribe", 10000), sdk.ZeroDec(), false)
+	require.ErrorIs(err, types.ErrInvalidBribe)
+
+	_, err = k.PostBribe(ctx, briber, "pool", epoch0, minBribe.SubAmount(sdk.OneInt()), sdk.ZeroDec(), false)
+	require.ErrorIs(err, types.ErrBribeTooSmall)
+
+	_, err = k.PostBribe(ctx, briber, "pool", epoch0, minBribe, sdk.ZeroDec(), false)
+	require.NoError(err)
+	require.Equal(minBribe, suite.app.BankKeeper.GetBalance(ctx, briber, minBribe.Denom))
+}
+
+func (suite *KeeperTestSuite) TestSettleBribes_MaxRollovers() {
+	require := suite.Require()
+	ctx := suite.ctx
+	k := suite.app.VoterKeeper
+	epoch0 := keeper.CurrentEpochTime(ctx)
+	params := k.GetParams(ctx)
+	params.MaxBribeRollovers = 2
+	k.SetParams(ctx, params)
+
+	// the bribe for the pool without votes rolls over until the cap
+	k.CreateGauge(ctx, "pool")
+	briber := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := types.DefaultMinBribes[0]
+	require.NoError(app.FundAccount(suite.app.BankKeeper, ctx, briber, sdk.NewCoins(amount)))
+	bribeID, err := k.PostBribe(ctx, briber, "pool", epoch0, amount, sdk.ZeroDec(), true)
+	require.NoError(err)
+	k.SettleBribes(ctx)
+
+	for i := uint64(1); i <= 2; i++ {
+		epochTime := epoch0 + i*vetypes.RegulatedPeriod
+		ctx = ctx.WithBlockTime(time.Unix(int64(epochTime), 0).UTC())
+		k.SettleBribes(ctx)
+		res, err := k.Bribes(sdk.WrapSDKContext(ctx), &types.QueryBribesRequest{PoolDenom: "pool", EpochTime: epochTime})
+		require.NoError(err)
+		require.Len(res.Bribes, 1)
+		require.Equal(bribeID, res.Bribes[0].Id)
+		require.Equal(uint32(i), res.Bribes[0].Rollovers)
+		require.Equal(amount, res.Bribes[0].Amount)
+		require.True(suite.app.BankKeeper.GetBalance(ctx, briber, amount.Denom).IsZero())
+	}
+
+	// the bribe which has rolled over the max times is refunded
+	epochTime := epoch0 + 3*vetypes.RegulatedPeriod
+	ctx = ctx.WithBlockTime(time.Unix(int64(epochTime), 0).UTC())
+	k.SettleBribes(ctx)
+	res, err := k.Bribes(sdk.WrapSDKContext(ctx), &types.QueryBribesRequest{PoolDenom: "pool", EpochTime: epochTime})
+	require.NoError(err)
+	require.Empty(res.Bribes)
+	require.Equal(amount, suite.app.BankKeeper.GetBalance(ctx, briber, amount.Denom))
+}
+
+func (suite *KeeperTestSuite) TestSettleBribes_MaxBribesSettledPerBlock() {
+	require := suite.Require()
+	ctx := suite.ctx
+	k := suite.app.VoterKeeper
+	epoch0 := keeper.CurrentEpochTime(ctx)
+	epoch1 := epoch0 + vetypes.RegulatedPeriod
+	params := k.GetParams(ctx)
+	params.MaxBribesSettledPerBlock = 2
+	k.SetParams(ctx, params)
+	k.SettleBribes(ctx)
+	require.Equal(epoch0, k.GetBribeSettledEpochTime(ctx))
+
+	k.CreateGauge(ctx, "pool")
+	briber := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := types.DefaultMinBribes[0]
+	require.NoError(app.FundAccount(suite.app.BankKeeper, ctx, briber, sdk.NewCoins(amount.AddAmount(amount.Amount.MulRaw(4)))))
+	for i := 0; i < 5; i++ {
+		_, err := k.PostBribe(ctx, briber, "pool", epoch0, amount, sdk.ZeroDec(), false)
+		require.NoError(err)
+	}
+
+	// the bribes are settled over blocks, and the epoch is settled with the
+	// last of them
+	ctx = ctx.WithBlockTime(time.Unix(int64(epoch1), 0).UTC())
+	for _, remaining := range []int{3, 1, 0} {
+		k.SettleBribes(ctx)
+		res, err := k.Bribes(sdk.WrapSDKContext(ctx), &types.QueryBribesRequest{PoolDenom: "pool", EpochTime: epoch0})
+		require.NoError(err)
+		require.Len(res.Bribes, remaining)
+		if remaining > 0 {
+			require.Equal(epoch0, k.GetBribeSettledEpochTime(ctx))
+		}
+	}
+	require.Equal(epoch1, k.GetBribeSettledEpochTime(ctx))
+	require.Equal(amount.Amount.MulRaw(5), suite.app.BankKeeper.GetBalance(ctx, briber, amount.Denom).Amount)
+}
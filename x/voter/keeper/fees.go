@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DepositGaugeFees claims the trading fees of the EVM AMM pairs backing the
+// gauges once per epoch, and deposits them as the bribe rewards for the
+// voters of the pools
+func (k Keeper) DepositGaugeFees(ctx sdk.Context) {
+	currentEpochTime := CurrentEpochTime(ctx)
+	if k.GetFeesDepositedEpochTime(ctx) >= currentEpochTime {
+		return
+	}
+
+	for _, poolDenom := range k.gaugeKeeper.GetGauges(ctx) {
+		feeClaimee, err := k.gaugeKeeper.PairFeeClaimee(poolDenom)
+		if err != nil {
+			// the pool is not backed by a pair
+			continue
+		}
+
+		// a failing pair must not affect the other pools
+		cacheCtx, write := ctx.CacheContext()
+		err = k.gaugeKeeper.Gauge(cacheCtx, poolDenom).DepositFees(cacheCtx, feeClaimee)
+		if err != nil {
+			k.Logger(ctx).Error("failed to deposit gauge fees", "pool", poolDenom, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	k.SetFeesDepositedEpochTime(ctx, currentEpochTime)
+}
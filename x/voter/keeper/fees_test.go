@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	erc20types "github.com/gridiron-zone/gridiron/x/erc20/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+	"github.com/gridiron-zone/gridiron/x/voter"
+	"github.com/gridiron-zone/gridiron/x/voter/keeper"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// deployToken deploys an ERC20 token, and registers it if required
+func (suite *KeeperTestSuite) deployToken(ctx sdk.Context, symbol string, register bool) common.Address {
+	require := suite.Require()
+	contract, err := suite.app.Erc20Keeper.DeployERC20Contract(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "u" + symbol, Exponent: 0}},
+		Name:       symbol,
+		Symbol:     symbol,
+	})
+	require.NoError(err)
+	if register {
+		_, err = suite.app.Erc20Keeper.RegisterERC20(ctx, contract, erc20types.REGISTRATION_ORIGIN_MSG)
+		require.NoError(err)
+	}
+	return contract
+}
+
+// deployPair deploys the minimal pair contract of the tokens, whose balances
+// are all claimable as the trading fees
+func (suite *KeeperTestSuite) deployPair(ctx sdk.Context, token0, token1 common.Address) common.Address {
+	require := suite.Require()
+	bz, err := os.ReadFile("testdata/MinimalPair.json")
+	require.NoError(err)
+	var pairContract evmtypes.CompiledContract
+	require.NoError(json.Unmarshal(bz, &pairContract))
+
+	pair, err := suite.app.Erc20Keeper.DeployContract(ctx, erc20types.ModuleAddress, pairContract, token0, token1)
+	require.NoError(err)
+	return pair
+}
+
+// accrueFees mints the tokens to the pair as if they were accrued from trading
+func (suite *KeeperTestSuite) accrueFees(ctx sdk.Context, pair common.Address, fees sdk.Coins) {
+	suite.Require().NoError(suite.app.Erc20Keeper.SendCoins(ctx, nil, pair.Bytes(), nil, fees))
+}
+
+func (suite *KeeperTestSuite) TestDepositGaugeFees() {
+	require := suite.Require()
+	ctx := suite.ctx
+	k := suite.app.VoterKeeper
+	epoch0 := keeper.CurrentEpochTime(ctx)
+	epoch1 := epoch0 + vetypes.RegulatedPeriod
+
+	token0 := suite.deployToken(ctx, "TOKEN0", true)
+	token1 := suite.deployToken(ctx, "TOKEN1", true)
+	unregistered := suite.deployToken(ctx, "TOKEN2", false)
+	denom0 := erc20types.CreateDenom(token0.String())
+	denom1 := erc20types.CreateDenom(token1.String())
+
+	pair := suite.deployPair(ctx, token0, token1)
+	badPair := suite.deployPair(ctx, token0, unregistered)
+	pool := erc20types.CreateDenom(pair.String())
+	badPool := erc20types.CreateDenom(badPair.String())
+	for _, denom := range []string{"pool", pool, badPool} {
+		k.CreateGauge(ctx, denom)
+	}
+
+	amount0 := sdk.NewInt(1e12)
+	amount1 := sdk.NewInt(2e12)
+	suite.accrueFees(ctx, pair, sdk.NewCoins(sdk.NewCoin(denom0, amount0), sdk.NewCoin(denom1, amount1)))
+	suite.accrueFees(ctx, badPair, sdk.NewCoins(sdk.NewCoin(denom0, amount0)))
+
+	// fees are deposited as bribe rewards
+	voter.EndBlocker(ctx, k)
+	require.Equal(epoch0, k.GetFeesDepositedEpochTime(ctx))
+	bribe := suite.app.GaugeKeeper.Bribe(ctx, pool)
+	bribeEscrow := bribe.EscrowPool(ctx).GetAddress()
+	require.Equal(amount0, suite.app.BankKeeper.GetBalance(ctx, bribeEscrow, denom0).Amount)
+	require.Equal(amount1, suite.app.BankKeeper.GetBalance(ctx, bribeEscrow, denom1).Amount)
+	require.Equal(amount0.QuoRaw(vetypes.RegulatedPeriod), bribe.GetReward(ctx, denom0).Rate)
+	require.Equal(amount1.QuoRaw(vetypes.RegulatedPeriod), bribe.GetReward(ctx, denom1).Rate)
+	require.True(suite.app.BankKeeper.GetBalance(ctx, pair.Bytes(), denom0).Amount.IsZero())
+	require.True(suite.app.BankKeeper.GetBalance(ctx, pair.Bytes(), denom1).Amount.IsZero())
+
+	// fees of the pair with an unsupported token are left unclaimed
+	require.Equal(amount0, suite.app.BankKeeper.GetBalance(ctx, badPair.Bytes(), denom0).Amount)
+	badBribe := suite.app.GaugeKeeper.Bribe(ctx, badPool)
+	require.True(badBribe.GetReward(ctx, denom0).Rate.IsZero())
+
+	// fees are claimed once per epoch
+	suite.accrueFees(ctx, pair, sdk.NewCoins(sdk.NewCoin(denom0, amount0.MulRaw(2)), sdk.NewCoin(denom1, sdk.NewInt(1))))
+	voter.EndBlocker(ctx, k)
+	require.Equal(amount0.MulRaw(2), suite.app.BankKeeper.GetBalance(ctx, pair.Bytes(), denom0).Amount)
+
+	// fees not exceeding the remaining bribe rewards are accrued in the gauge
+	ctx = ctx.WithBlockTime(time.Unix(int64(epoch1), 0).UTC())
+	voter.EndBlocker(ctx, k)
+	require.Equal(epoch1, k.GetFeesDepositedEpochTime(ctx))
+	require.Equal(amount0.MulRaw(3), suite.app.BankKeeper.GetBalance(ctx, bribeEscrow, denom0).Amount)
+	require.Equal(amount1, suite.app.BankKeeper.GetBalance(ctx, bribeEscrow, denom1).Amount)
+	gauge := suite.app.GaugeKeeper.Gauge(ctx, pool)
+	require.Equal(sdk.NewInt(1), gauge.GetReward(ctx, denom1).AccruedAmount)
+	require.Equal(sdk.NewInt(1), suite.app.BankKeeper.GetBalance(ctx, gauge.EscrowPool(ctx).GetAddress(), denom1).Amount)
+}
+
+func (suite *KeeperTestSuite) TestPairFeeClaimee_ClaimFees() {
+	require := suite.Require()
+	ctx := suite.ctx
+
+	token0 := suite.deployToken(ctx, "TOKEN0", true)
+	token1 := suite.deployToken(ctx, "TOKEN1", true)
+	denom0 := erc20types.CreateDenom(token0.String())
+	denom1 := erc20types.CreateDenom(token1.String())
+	pair := suite.deployPair(ctx, token0, token1)
+	samePair := suite.deployPair(ctx, token0, token0)
+
+	// the balances held by the claimant before are not claimed fees
+	claimant := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, claimant))
+	held := sdk.NewCoins(sdk.NewInt64Coin(denom0, 7), sdk.NewInt64Coin(denom1, 11))
+	suite.accrueFees(ctx, common.BytesToAddress(claimant), held)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 2000))
+	suite.accrueFees(ctx, pair, fees)
+
+	claimee, err := suite.app.GaugeKeeper.PairFeeClaimee(erc20types.CreateDenom(pair.String()))
+	require.NoError(err)
+	claimed, err := claimee.ClaimFees(ctx, claimant)
+	require.NoError(err)
+	require.Equal(fees, claimed)
+	for _, coin := range held.Add(fees...) {
+		require.Equal(coin, suite.app.BankKeeper.GetBalance(ctx, claimant, coin.Denom))
+	}
+
+	// the fees of a pair of the same token are counted once
+	suite.accrueFees(ctx, samePair, sdk.NewCoins(sdk.NewInt64Coin(denom0, 500)))
+	claimee, err = suite.app.GaugeKeeper.PairFeeClaimee(erc20types.CreateDenom(samePair.String()))
+	require.NoError(err)
+	claimed, err = claimee.ClaimFees(ctx, claimant)
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom0, 500)), claimed)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 	"github.com/gridiron-zone/gridiron/x/voter/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,3 +20,91 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) Bribes(c context.Context, req *types.QueryBribesRequest) (*types.QueryBribesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.gaugeKeeper.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool %s", req.PoolDenom)
+	}
+	epochTime, err := queryEpochTime(ctx, req.EpochTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var bribes []types.Bribe
+	k.IterateBribesByEpochPool(ctx, epochTime, req.PoolDenom, func(bribe types.Bribe) (stop bool) {
+		bribes = append(bribes, bribe)
+		return false
+	})
+
+	return &types.QueryBribesResponse{Bribes: bribes}, nil
+}
+
+func (k Keeper) BribesPerVote(c context.Context, req *types.QueryBribesPerVoteRequest) (*types.QueryBribesPerVoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	poolDenoms := k.gaugeKeeper.GetGauges(ctx)
+	if len(req.PoolDenom) > 0 {
+		if !k.gaugeKeeper.HasGauge(ctx, req.PoolDenom) {
+			return nil, status.Errorf(codes.NotFound, "gauge not found for pool %s", req.PoolDenom)
+		}
+		poolDenoms = []string{req.PoolDenom}
+	}
+	epochTime, err := queryEpochTime(ctx, req.EpochTime)
+	if err != nil {
+		return nil, err
+	}
+	settled := epochTime < k.GetBribeSettledEpochTime(ctx)
+
+	bribes := make([]types.PoolBribesPerVote, 0, len(poolDenoms))
+	for _, poolDenom := range poolDenoms {
+		bribe := types.PoolBribesPerVote{
+			PoolDenom: poolDenom,
+			EpochTime: epochTime,
+			Settled:   settled,
+			Votes:     k.GetEpochVotes(ctx, poolDenom, epochTime),
+			Rewards:   sdk.NewCoins(),
+		}
+
+		if settled {
+			epochBribe, found := k.GetEpochBribe(ctx, poolDenom, epochTime)
+			if found {
+				bribe.Votes = epochBribe.Votes
+				bribe.Rewards = epochBribe.Rewards
+			}
+		} else {
+			// estimate by the current votes
+			k.IterateBribesByEpochPool(ctx, epochTime, poolDenom, func(b types.Bribe) (stop bool) {
+				bribe.Rewards = bribe.Rewards.Add(sdk.NewCoin(b.Amount.Denom, distributedBribeAmount(b, bribe.Votes)))
+				return false
+			})
+		}
+
+		if bribe.Votes.IsPositive() {
+			bribe.RewardsPerVote = sdk.NewDecCoinsFromCoins(bribe.Rewards...).QuoDec(bribe.Votes.ToDec())
+		}
+
+		bribes = append(bribes, bribe)
+	}
+
+	return &types.QueryBribesPerVoteResponse{Bribes: bribes}, nil
+}
+
+// queryEpochTime returns the queried epoch time, or the current epoch time if
+// not specified
+func queryEpochTime(ctx sdk.Context, epochTime uint64) (uint64, error) {
+	if epochTime == 0 {
+		return CurrentEpochTime(ctx), nil
+	}
+	if vetypes.RegulatedUnixTime(epochTime) != epochTime {
+		return 0, status.Errorf(codes.InvalidArgument, "epoch time %d must be aligned to %d seconds", epochTime, vetypes.RegulatedPeriod)
+	}
+	return epochTime, nil
+}
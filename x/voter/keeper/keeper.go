@@ -20,6 +20,7 @@ type (
 
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
+		nftKeeper     types.NftKeeper
 		veKeeper      types.Vekeeper
 		gaugeKeeper   types.GaugeKeeper
 	}
@@ -32,6 +33,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	nftKeeper types.NftKeeper,
 	veKeeper types.Vekeeper,
 	gaugeKeeper types.GaugeKeeper,
 ) *Keeper {
@@ -47,6 +49,7 @@ func NewKeeper(
 		paramstore:    ps,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		nftKeeper:     nftKeeper,
 		veKeeper:      veKeeper,
 		gaugeKeeper:   gaugeKeeper,
 	}
@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gridiron-zone/gridiron/x/voter/keeper"
+	"github.com/gridiron-zone/gridiron/x/voter/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	require := suite.Require()
+	k := suite.app.VoterKeeper
+
+	// the params were not stored before
+	store := suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey))
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		store.Delete(append([]byte(types.ModuleName+"/"), pair.Key...))
+	}
+	require.Panics(func() { k.MaxBribesSettledPerBlock(suite.ctx) })
+
+	err := keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	require.NoError(err)
+	require.Equal(types.DefaultParams(), k.GetParams(suite.ctx))
+}
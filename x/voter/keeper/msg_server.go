@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 	"github.com/gridiron-zone/gridiron/x/voter/types"
 )
 
@@ -15,3 +20,154 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+func (m msgServer) Vote(c context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkVotingAuthorized(ctx, msg.Sender, msg.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	poolWeights := make(map[string]sdk.Dec)
+	for _, pw := range msg.PoolWeights {
+		poolWeights[pw.PoolDenom] = pw.Weight
+	}
+	err = m.Keeper.checkVotes(ctx, veID, poolWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.Vote(ctx, veID, poolWeights)
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+func (m msgServer) Poke(c context.Context, msg *types.MsgPoke) (*types.MsgPokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkVotingAuthorized(ctx, msg.Sender, msg.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	poolWeights, voted := m.Keeper.pokePoolWeights(ctx, veID)
+	if !voted {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve %s has not voted", msg.VeId)
+	}
+	err = m.Keeper.checkVotes(ctx, veID, poolWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.Vote(ctx, veID, poolWeights)
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgPokeResponse{}, nil
+}
+
+func (m msgServer) Abstain(c context.Context, msg *types.MsgAbstain) (*types.MsgAbstainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkVotingAuthorized(ctx, msg.Sender, msg.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Keeper.Abstain(ctx, veID)
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgAbstainResponse{}, nil
+}
+
+func (m msgServer) ClaimBribes(c context.Context, msg *types.MsgClaimBribes) (*types.MsgClaimBribesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID, err := m.checkVotingAuthorized(ctx, msg.Sender, msg.VeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// rewards are always sent to the owner of ve
+	for _, poolDenom := range m.Keeper.gaugeKeeper.GetGauges(ctx) {
+		err = m.Keeper.gaugeKeeper.Bribe(ctx, poolDenom).ClaimReward(ctx, veID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = m.Keeper.ClaimEpochBribes(ctx, veID)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgClaimBribesResponse{}, nil
+}
+
+func (m msgServer) Bribe(c context.Context, msg *types.MsgBribe) (*types.MsgBribeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.Keeper.gaugeKeeper.HasGauge(ctx, msg.PoolDenom) {
+		return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool %s", msg.PoolDenom)
+	}
+
+	currentEpochTime := CurrentEpochTime(ctx)
+	if msg.EpochTime < currentEpochTime || msg.EpochTime > currentEpochTime+vetypes.MaxLockTime {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidEpochTime, "epoch time %d should be in [%d, %d]", msg.EpochTime, currentEpochTime, currentEpochTime+vetypes.MaxLockTime)
+	}
+
+	bribeID, err := m.Keeper.PostBribe(ctx, sender, msg.PoolDenom, msg.EpochTime, msg.Amount, msg.MaxPerVote, msg.Rollover)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBribe{
+		Sender:    msg.Sender,
+		BribeId:   bribeID,
+		PoolDenom: msg.PoolDenom,
+		EpochTime: msg.EpochTime,
+		Amount:    msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx)
+
+	return &types.MsgBribeResponse{BribeId: bribeID}, nil
+}
+
+// checkVotingAuthorized checks whether the sender is the owner or the voting
+// delegate of the ve
+func (m msgServer) checkVotingAuthorized(ctx sdk.Context, senderStr string, veIDStr string) (uint64, error) {
+	sender, err := sdk.AccAddressFromBech32(senderStr)
+	if err != nil {
+		return 0, err
+	}
+	veID := vetypes.Uint64FromVeID(veIDStr)
+	err = m.Keeper.veKeeper.CheckVotingAuthorized(ctx, veID, sender)
+	if err != nil {
+		return 0, err
+	}
+	return veID, nil
+}
+
+func emitMessageEvent(ctx sdk.Context) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+}
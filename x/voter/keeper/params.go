@@ -6,11 +6,32 @@ import (
 )
 
 // GetParams get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// MinBribes returns the minimum amounts of a bribe by denom, and bribes in the
+// other denoms are not accepted
+func (k Keeper) MinBribes(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.KeyMinBribes, &res)
+	return
+}
+
+// MaxBribeRollovers returns the max times the undistributed amount of a bribe
+// rolls over to the next epoch
+func (k Keeper) MaxBribeRollovers(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxBribeRollovers, &res)
+	return
+}
+
+// MaxBribesSettledPerBlock returns the max number of bribes settled in a block
+func (k Keeper) MaxBribesSettledPerBlock(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxBribesSettledPerBlock, &res)
+	return
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gridiron-zone/gridiron/x/voter/types"
 )
@@ -133,3 +134,168 @@ func (k Keeper) GetClaimableRewardByGauge(ctx sdk.Context, poolDenom string) sdk
 	k.cdc.MustUnmarshal(bz, &claimable)
 	return claimable.Int
 }
+
+func (k Keeper) SetNextBribeID(ctx sdk.Context, bribeID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextBribeIDKey(), sdk.Uint64ToBigEndian(bribeID))
+}
+
+func (k Keeper) GetNextBribeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextBribeIDKey())
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetBribe(ctx sdk.Context, bribe types.Bribe) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bribe)
+	store.Set(types.BribeKey(bribe.EpochTime, bribe.PoolDenom, bribe.Id), bz)
+}
+
+func (k Keeper) DeleteBribe(ctx sdk.Context, bribe types.Bribe) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BribeKey(bribe.EpochTime, bribe.PoolDenom, bribe.Id))
+}
+
+// IterateBribes iterates the bribes of the epochs in [startEpochTime, endEpochTime)
+func (k Keeper) IterateBribes(ctx sdk.Context, startEpochTime uint64, endEpochTime uint64, cb func(bribe types.Bribe) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.BribeKeyPrefixByEpoch(startEpochTime), types.BribeKeyPrefixByEpoch(endEpochTime))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bribe types.Bribe
+		k.cdc.MustUnmarshal(iterator.Value(), &bribe)
+		if cb(bribe) {
+			break
+		}
+	}
+}
+
+// IterateBribesByEpochPool iterates the bribes of the pool in the epoch
+func (k Keeper) IterateBribesByEpochPool(ctx sdk.Context, epochTime uint64, poolDenom string, cb func(bribe types.Bribe) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BribeKeyPrefixByEpochPool(epochTime, poolDenom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bribe types.Bribe
+		k.cdc.MustUnmarshal(iterator.Value(), &bribe)
+		if cb(bribe) {
+			break
+		}
+	}
+}
+
+func (k Keeper) SetEpochVotes(ctx sdk.Context, poolDenom string, epochTime uint64, votes sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{votes})
+	store.Set(types.EpochVotesKey(poolDenom, epochTime), bz)
+}
+
+// GetEpochVotes gets the concurring votes for the pool in the epoch, which are
+// recorded at the last change in or before the epoch
+func (k Keeper) GetEpochVotes(ctx sdk.Context, poolDenom string, epochTime uint64) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochVotesKeyPrefix(poolDenom))
+	return k.getPriorVotes(store, epochTime)
+}
+
+func (k Keeper) SetEpochVotesByUser(ctx sdk.Context, veID uint64, poolDenom string, epochTime uint64, votes sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{votes})
+	store.Set(types.EpochVotesByUserKey(veID, poolDenom, epochTime), bz)
+}
+
+// GetEpochVotesByUser gets the concurring votes of the ve for the pool in the
+// epoch, which are recorded at the last change in or before the epoch
+func (k Keeper) GetEpochVotesByUser(ctx sdk.Context, veID uint64, poolDenom string, epochTime uint64) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochVotesByUserKeyPrefix(veID, poolDenom))
+	return k.getPriorVotes(store, epochTime)
+}
+
+func (k Keeper) getPriorVotes(store prefix.Store, epochTime uint64) sdk.Int {
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(epochTime+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return sdk.ZeroInt()
+	}
+	var votes sdk.IntProto
+	k.cdc.MustUnmarshal(iterator.Value(), &votes)
+	return votes.Int
+}
+
+func (k Keeper) SetEpochBribe(ctx sdk.Context, epochBribe types.EpochBribe) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&epochBribe)
+	store.Set(types.EpochBribeKey(epochBribe.PoolDenom, epochBribe.EpochTime), bz)
+}
+
+func (k Keeper) GetEpochBribe(ctx sdk.Context, poolDenom string, epochTime uint64) (epochBribe types.EpochBribe, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochBribeKey(poolDenom, epochTime))
+	if bz == nil {
+		return epochBribe, false
+	}
+	k.cdc.MustUnmarshal(bz, &epochBribe)
+	return epochBribe, true
+}
+
+// IterateEpochBribes iterates the epoch bribes of the pool in [startEpochTime, endEpochTime)
+func (k Keeper) IterateEpochBribes(ctx sdk.Context, poolDenom string, startEpochTime uint64, endEpochTime uint64, cb func(epochBribe types.EpochBribe) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochBribeKeyPrefix(poolDenom))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(startEpochTime), sdk.Uint64ToBigEndian(endEpochTime))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var epochBribe types.EpochBribe
+		k.cdc.MustUnmarshal(iterator.Value(), &epochBribe)
+		if cb(epochBribe) {
+			break
+		}
+	}
+}
+
+func (k Keeper) SetBribeSettledEpochTime(ctx sdk.Context, epochTime uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BribeSettledEpochTimeKey(), sdk.Uint64ToBigEndian(epochTime))
+}
+
+// GetBribeSettledEpochTime gets the epoch time before which all the bribes have been settled
+func (k Keeper) GetBribeSettledEpochTime(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BribeSettledEpochTimeKey())
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetBribeClaimedEpochTimeByUser(ctx sdk.Context, veID uint64, poolDenom string, epochTime uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BribeClaimedEpochTimeByUserKey(veID, poolDenom), sdk.Uint64ToBigEndian(epochTime))
+}
+
+// GetBribeClaimedEpochTimeByUser gets the epoch time before which the ve has claimed the epoch bribes of the pool
+func (k Keeper) GetBribeClaimedEpochTimeByUser(ctx sdk.Context, veID uint64, poolDenom string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BribeClaimedEpochTimeByUserKey(veID, poolDenom))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetFeesDepositedEpochTime(ctx sdk.Context, epochTime uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeesDepositedEpochTimeKey(), sdk.Uint64ToBigEndian(epochTime))
+}
+
+// GetFeesDepositedEpochTime gets the start time of the epoch in which the pool fees have been deposited last
+func (k Keeper) GetFeesDepositedEpochTime(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeesDepositedEpochTimeKey())
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity ^0.8.0;
+
+interface IERC20 {
+  function balanceOf(address account) external view returns (uint256);
+
+  function transfer(address to, uint256 amount) external returns (bool);
+}
+
+/**
+ * @dev Minimal AMM pair used in tests. It does not trade at all; the tokens
+ * held by the pair are regarded as the trading fees, and are all transferred
+ * to whoever claims them.
+ */
+contract MinimalPair {
+  address public token0;
+  address public token1;
+
+  constructor(address token0_, address token1_) {
+    token0 = token0_;
+    token1 = token1_;
+  }
+
+  function claimFees() external returns (uint256 claimed0, uint256 claimed1) {
+    claimed1 = _claim(token1);
+    claimed0 = _claim(token0);
+  }
+
+  function _claim(address token) private returns (uint256 amount) {
+    amount = IERC20(token).balanceOf(address(this));
+    if (amount > 0) {
+      require(IERC20(token).transfer(msg.sender, amount));
+    }
+  }
+}
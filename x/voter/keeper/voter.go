@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	vekeeper "github.com/gridiron-zone/gridiron/x/ve/keeper"
 	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
 	"github.com/gridiron-zone/gridiron/x/voter/types"
@@ -40,6 +43,8 @@ func (k Keeper) Abstain(ctx sdk.Context, veID uint64) {
 			if err != nil {
 				panic(err)
 			}
+
+			k.addEpochVotes(ctx, veID, poolDenom, weightedVotes.Neg())
 		}
 	}
 
@@ -85,6 +90,8 @@ func (k Keeper) Vote(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.De
 		if weightedVotes.IsPositive() {
 			bribe := k.gaugeKeeper.Bribe(ctx, poolDenom)
 			bribe.Deposit(ctx, veID, weightedVotes)
+
+			k.addEpochVotes(ctx, veID, poolDenom, weightedVotes)
 		}
 	}
 
@@ -100,16 +107,26 @@ func (k Keeper) Vote(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.De
 
 // Poke adjusts votes due to updated voting power of user
 func (k Keeper) Poke(ctx sdk.Context, veID uint64) {
-	totalVotesByUser := k.GetTotalVotesByUser(ctx, veID)
-	if !totalVotesByUser.IsPositive() {
+	poolWeights, voted := k.pokePoolWeights(ctx, veID)
+	if !voted {
 		// no voting so no poke
 		return
 	}
 
+	k.Vote(ctx, veID, poolWeights)
+}
+
+// pokePoolWeights derives the pool weights from the existing votes of user
+func (k Keeper) pokePoolWeights(ctx sdk.Context, veID uint64) (poolWeights map[string]sdk.Dec, voted bool) {
+	totalVotesByUser := k.GetTotalVotesByUser(ctx, veID)
+	if !totalVotesByUser.IsPositive() {
+		return nil, false
+	}
+
 	poolDenoms := k.gaugeKeeper.GetGauges(ctx)
 
 	totalWeights := sdk.ZeroDec()
-	poolWeights := make(map[string]sdk.Dec)
+	poolWeights = make(map[string]sdk.Dec)
 
 	var fineTuning string
 	for _, poolDenom := range poolDenoms {
@@ -127,7 +144,31 @@ func (k Keeper) Poke(ctx sdk.Context, veID uint64) {
 		poolWeights[fineTuning] = poolWeights[fineTuning].Add(sdk.OneDec().Sub(totalWeights))
 	}
 
-	k.Vote(ctx, veID, poolWeights)
+	return poolWeights, true
+}
+
+// checkVotes checks whether the pool weights can be voted by user, i.e., the
+// gauges exist and the weighted votes for each gauge are nonzero
+func (k Keeper) checkVotes(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.Dec) error {
+	votingPower := k.veKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0)
+
+	// iterate in order to be deterministic
+	poolDenoms := make([]string, 0, len(poolWeights))
+	for poolDenom := range poolWeights {
+		poolDenoms = append(poolDenoms, poolDenom)
+	}
+	sort.Strings(poolDenoms)
+
+	for _, poolDenom := range poolDenoms {
+		weight := poolWeights[poolDenom]
+		if !k.gaugeKeeper.HasGauge(ctx, poolDenom) {
+			return sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool %s", poolDenom)
+		}
+		if votingPower.ToDec().Mul(weight).TruncateInt().IsZero() {
+			return sdkerrors.Wrapf(types.ErrNoVotes, "voting power %s with weight %s for pool %s", votingPower, weight, poolDenom)
+		}
+	}
+	return nil
 }
 
 func (k Keeper) DepositReward(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Int) {
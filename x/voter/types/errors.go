@@ -8,5 +8,11 @@ import (
 
 // x/voter module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample             = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidPoolWeights = sdkerrors.Register(ModuleName, 2, "invalid pool weights")
+	ErrGaugeNotFound      = sdkerrors.Register(ModuleName, 3, "gauge not found")
+	ErrNoVotes            = sdkerrors.Register(ModuleName, 4, "no votes for gauge")
+	ErrInvalidEpochTime   = sdkerrors.Register(ModuleName, 5, "invalid epoch time")
+	ErrInvalidBribe       = sdkerrors.Register(ModuleName, 6, "invalid bribe")
+	ErrBribeTooSmall      = sdkerrors.Register(ModuleName, 7, "bribe amount too small")
 )
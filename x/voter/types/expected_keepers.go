@@ -16,13 +16,19 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
+type NftKeeper interface {
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+}
+
 type Vekeeper interface {
 	LockDenom(ctx sdk.Context) string
 	GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
 	SetVeVoted(ctx sdk.Context, veID uint64, voted bool)
+	CheckVotingAuthorized(ctx sdk.Context, veID uint64, addr sdk.AccAddress) error
 }
 
 type GaugeKeeper interface {
@@ -31,4 +37,5 @@ type GaugeKeeper interface {
 	GetGauges(ctx sdk.Context) (denoms []string)
 	Gauge(ctx sdk.Context, depoistDenom string) gaugekeeper.Gauge
 	Bribe(ctx sdk.Context, depoistDenom string) gaugekeeper.Bribe
+	PairFeeClaimee(poolDenom string) (gaugekeeper.PairFeeClaimee, error)
 }
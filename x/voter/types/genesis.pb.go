@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -70,6 +72,15 @@ func (m *GenesisState) GetParams() Params {
 
 // Params defines the parameters for the module.
 type Params struct {
+	// minimum amounts of a bribe by denom, and bribes in the other denoms are
+	// not accepted
+	MinBribes github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_bribes,json=minBribes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_bribes" yaml:"min_bribes"`
+	// max times the undistributed amount of a bribe rolls over to the next
+	// epoch, after which it's refunded to the briber
+	MaxBribeRollovers uint32 `protobuf:"varint,2,opt,name=max_bribe_rollovers,json=maxBribeRollovers,proto3" json:"max_bribe_rollovers,omitempty" yaml:"max_bribe_rollovers"`
+	// max number of bribes settled in a block, and the rest are settled in the
+	// following blocks
+	MaxBribesSettledPerBlock uint32 `protobuf:"varint,3,opt,name=max_bribes_settled_per_block,json=maxBribesSettledPerBlock,proto3" json:"max_bribes_settled_per_block,omitempty" yaml:"max_bribes_settled_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -104,6 +115,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMinBribes() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinBribes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBribeRollovers() uint32 {
+	if m != nil {
+		return m.MaxBribeRollovers
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBribesSettledPerBlock() uint32 {
+	if m != nil {
+		return m.MaxBribesSettledPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gridiron.voter.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "gridiron.voter.v1.Params")
@@ -112,20 +144,32 @@ func init() {
 func init() { proto.RegisterFile("gridiron/voter/v1/genesis.proto", fileDescriptor_bda82825c2426bfd) }
 
 var fileDescriptor_bda82825c2426bfd = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0xcb, 0x2f, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xeb, 0x81,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
-	0x9d, 0x92, 0x1b, 0x17, 0x8f, 0x3b, 0x44, 0x63, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x19, 0x17,
-	0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e,
-	0xba, 0x41, 0x7a, 0x01, 0x60, 0x79, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xaa, 0x95,
-	0xf8, 0xb8, 0xd8, 0x20, 0xe2, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0xb9, 0x9f, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x6c, 0xdd, 0xaa, 0xfc, 0xbc, 0x54, 0x18, 0x47, 0xbf, 0x02, 0xea,
-	0xa7, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x3b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x4f, 0x68, 0x61, 0x7f, 0xf1, 0x00, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0x87, 0x93, 0x7b, 0xaf, 0x2a, 0x61, 0x60, 0x68, 0x60, 0x08, 0x15, 0x72, 0xae, 0xc2, 0x40,
+	0x97, 0x6b, 0x2b, 0x30, 0x20, 0x75, 0x0c, 0x42, 0x15, 0x0b, 0xaa, 0xd2, 0x8d, 0x25, 0x72, 0x52,
+	0x2b, 0x58, 0x8d, 0xe3, 0xc8, 0x36, 0x51, 0xcb, 0xc2, 0x2b, 0x30, 0x32, 0x32, 0xf3, 0x24, 0x1d,
+	0x3b, 0x32, 0x05, 0xd4, 0xbe, 0x41, 0x9f, 0x00, 0xc5, 0x4e, 0x0a, 0xa2, 0x4c, 0xf9, 0xf3, 0xfb,
+	0xfc, 0x9d, 0x93, 0x73, 0x02, 0x82, 0x42, 0xb2, 0x15, 0x93, 0xa2, 0xc2, 0x8d, 0xd0, 0x54, 0xe2,
+	0x26, 0xc2, 0x05, 0xad, 0xa8, 0x62, 0x0a, 0xd5, 0x52, 0x68, 0xe1, 0x8d, 0x07, 0x00, 0x19, 0x00,
+	0x35, 0xd1, 0xe4, 0x71, 0x21, 0x0a, 0x61, 0x52, 0xdc, 0xdd, 0x59, 0x70, 0x02, 0x73, 0xa1, 0xb8,
+	0x50, 0x38, 0x23, 0x8a, 0xe2, 0x26, 0xca, 0xa8, 0x26, 0x11, 0xce, 0x05, 0xab, 0x6c, 0x1e, 0xce,
+	0xc1, 0x83, 0xb9, 0x35, 0x2f, 0x35, 0xd1, 0xd4, 0x7b, 0x05, 0x46, 0x35, 0x91, 0x84, 0x2b, 0xdf,
+	0xbd, 0x75, 0xa7, 0xf7, 0x5f, 0x3c, 0x41, 0x17, 0x95, 0xd0, 0xc2, 0x00, 0xf1, 0xcd, 0xae, 0x0d,
+	0x9c, 0xa4, 0xc7, 0xc3, 0xdd, 0x15, 0x18, 0xd9, 0xc0, 0xfb, 0x0c, 0x00, 0x67, 0x55, 0x9a, 0x49,
+	0x96, 0xd1, 0xce, 0x73, 0x6d, 0x3c, 0xb6, 0x11, 0xd4, 0x35, 0x82, 0xfa, 0x46, 0xd0, 0x6b, 0xc1,
+	0xaa, 0xf8, 0x4d, 0xe7, 0x39, 0xb5, 0xc1, 0x78, 0x4b, 0x78, 0x39, 0x0b, 0xff, 0x1c, 0x0d, 0xbf,
+	0xff, 0x0c, 0xa6, 0x05, 0xd3, 0x1f, 0x3e, 0x66, 0x28, 0x17, 0x1c, 0xf7, 0x9f, 0x62, 0x2f, 0x77,
+	0x6a, 0xb5, 0xc6, 0x7a, 0x5b, 0x53, 0x65, 0x2c, 0x2a, 0xb9, 0xc7, 0x59, 0x15, 0x9b, 0x73, 0xde,
+	0x3b, 0xf0, 0x88, 0x93, 0x8d, 0xb5, 0xa4, 0x52, 0x94, 0xa5, 0x68, 0xa8, 0x54, 0xfe, 0xd5, 0xad,
+	0x3b, 0x7d, 0x18, 0xc3, 0x53, 0x1b, 0x4c, 0xfa, 0x52, 0x97, 0x50, 0x98, 0x8c, 0x39, 0xd9, 0x18,
+	0x4f, 0x32, 0xbc, 0xf3, 0x0a, 0xf0, 0xf4, 0x8c, 0xaa, 0x54, 0x51, 0xad, 0x4b, 0xba, 0x4a, 0x6b,
+	0x2a, 0xd3, 0xac, 0x14, 0xf9, 0xda, 0xbf, 0x36, 0xe2, 0xe7, 0xa7, 0x36, 0x78, 0xf6, 0x8f, 0xf8,
+	0x3f, 0x74, 0x98, 0xf8, 0x43, 0x05, 0xb5, 0xb4, 0xe1, 0x82, 0xca, 0xb8, 0x8b, 0x66, 0x37, 0x5f,
+	0xbf, 0x05, 0x4e, 0xfc, 0x76, 0x77, 0x80, 0xee, 0xfe, 0x00, 0xdd, 0x5f, 0x07, 0xe8, 0x7e, 0x39,
+	0x42, 0x67, 0x7f, 0x84, 0xce, 0x8f, 0x23, 0x74, 0xde, 0xe3, 0xbf, 0xa6, 0x31, 0xec, 0xe5, 0xee,
+	0x93, 0xa8, 0xe8, 0xf9, 0x09, 0x6f, 0xfa, 0x5f, 0xc6, 0x8c, 0x26, 0x1b, 0x99, 0x2d, 0xbf, 0xfc,
+	0x3d, 0x00, 0x59, 0xfc, 0x89, 0xac, 0x51, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -181,6 +225,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBribesSettledPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBribesSettledPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBribeRollovers != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBribeRollovers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MinBribes) > 0 {
+		for iNdEx := len(m.MinBribes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBribes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -212,6 +280,18 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.MinBribes) > 0 {
+		for _, e := range m.MinBribes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxBribeRollovers != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBribeRollovers))
+	}
+	if m.MaxBribesSettledPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBribesSettledPerBlock))
+	}
 	return n
 }
 
@@ -333,6 +413,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBribes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBribes = append(m.MinBribes, types.Coin{})
+			if err := m.MinBribes[len(m.MinBribes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBribeRollovers", wireType)
+			}
+			m.MaxBribeRollovers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBribeRollovers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBribesSettledPerBlock", wireType)
+			}
+			m.MaxBribesSettledPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBribesSettledPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
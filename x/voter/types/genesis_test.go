@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gridiron-zone/gridiron/x/voter/types"
 	"github.com/stretchr/testify/require"
 )
@@ -19,13 +20,23 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.NewParams(sdk.NewCoins(), 0, 1),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc:     "no bribes settled per block",
+			genState: &types.GenesisState{Params: types.NewParams(types.DefaultMinBribes, types.DefaultMaxBribeRollovers, 0)},
+			valid:    false,
+		},
+		{
+			desc:     "invalid min bribes",
+			genState: &types.GenesisState{Params: types.NewParams(sdk.Coins{sdk.NewInt64Coin("airon", 0)}, types.DefaultMaxBribeRollovers, 1)},
+			valid:    false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
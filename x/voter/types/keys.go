@@ -17,6 +17,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_voter"
+
+	// BribePoolName defines the module account name holding the epoch bribes
+	BribePoolName = "voter_bribe_pool"
 )
 
 const (
@@ -27,6 +30,14 @@ const (
 	prefixIndex
 	prefixIndexAtLastUpdatedByGauge
 	prefixClaimableRewardByGauge
+	prefixNextBribeID
+	prefixBribe
+	prefixEpochVotes
+	prefixEpochVotesByUser
+	prefixEpochBribe
+	prefixBribeSettledEpochTime
+	prefixBribeClaimedEpochTimeByUser
+	prefixFeesDepositedEpochTime
 )
 
 var (
@@ -37,6 +48,15 @@ var (
 	KeyPrefixIndex                     = []byte{prefixIndex}
 	KeyPrefixIndexAtLastUpdatedByGauge = []byte{prefixIndexAtLastUpdatedByGauge}
 	KeyPrefixClaimableRewardByGauge    = []byte{prefixClaimableRewardByGauge}
+
+	KeyPrefixNextBribeID                 = []byte{prefixNextBribeID}
+	KeyPrefixBribe                       = []byte{prefixBribe}
+	KeyPrefixEpochVotes                  = []byte{prefixEpochVotes}
+	KeyPrefixEpochVotesByUser            = []byte{prefixEpochVotesByUser}
+	KeyPrefixEpochBribe                  = []byte{prefixEpochBribe}
+	KeyPrefixBribeSettledEpochTime       = []byte{prefixBribeSettledEpochTime}
+	KeyPrefixBribeClaimedEpochTimeByUser = []byte{prefixBribeClaimedEpochTimeByUser}
+	KeyPrefixFeesDepositedEpochTime      = []byte{prefixFeesDepositedEpochTime}
 )
 
 func TotalVotesKey() []byte {
@@ -66,3 +86,61 @@ func IndexAtLastUpdatedByGaugeKey(poolDenom string) []byte {
 func ClaimableRewardByGaugeKey(poolDenom string) []byte {
 	return append(KeyPrefixClaimableRewardByGauge, poolDenom...)
 }
+
+func NextBribeIDKey() []byte {
+	return KeyPrefixNextBribeID
+}
+
+// lengthPrefixedDenom prefixes the pool denom with its length, so that the
+// keys of different pools never share the prefix
+func lengthPrefixedDenom(poolDenom string) []byte {
+	return append([]byte{byte(len(poolDenom))}, poolDenom...)
+}
+
+func BribeKeyPrefixByEpoch(epochTime uint64) []byte {
+	return append(KeyPrefixBribe, sdk.Uint64ToBigEndian(epochTime)...)
+}
+
+func BribeKeyPrefixByEpochPool(epochTime uint64, poolDenom string) []byte {
+	return append(BribeKeyPrefixByEpoch(epochTime), lengthPrefixedDenom(poolDenom)...)
+}
+
+func BribeKey(epochTime uint64, poolDenom string, bribeID uint64) []byte {
+	return append(BribeKeyPrefixByEpochPool(epochTime, poolDenom), sdk.Uint64ToBigEndian(bribeID)...)
+}
+
+func EpochVotesKeyPrefix(poolDenom string) []byte {
+	return append(KeyPrefixEpochVotes, lengthPrefixedDenom(poolDenom)...)
+}
+
+func EpochVotesKey(poolDenom string, epochTime uint64) []byte {
+	return append(EpochVotesKeyPrefix(poolDenom), sdk.Uint64ToBigEndian(epochTime)...)
+}
+
+func EpochVotesByUserKeyPrefix(veID uint64, poolDenom string) []byte {
+	return append(append(KeyPrefixEpochVotesByUser, sdk.Uint64ToBigEndian(veID)...), lengthPrefixedDenom(poolDenom)...)
+}
+
+func EpochVotesByUserKey(veID uint64, poolDenom string, epochTime uint64) []byte {
+	return append(EpochVotesByUserKeyPrefix(veID, poolDenom), sdk.Uint64ToBigEndian(epochTime)...)
+}
+
+func EpochBribeKeyPrefix(poolDenom string) []byte {
+	return append(KeyPrefixEpochBribe, lengthPrefixedDenom(poolDenom)...)
+}
+
+func EpochBribeKey(poolDenom string, epochTime uint64) []byte {
+	return append(EpochBribeKeyPrefix(poolDenom), sdk.Uint64ToBigEndian(epochTime)...)
+}
+
+func BribeSettledEpochTimeKey() []byte {
+	return KeyPrefixBribeSettledEpochTime
+}
+
+func BribeClaimedEpochTimeByUserKey(veID uint64, poolDenom string) []byte {
+	return append(append(KeyPrefixBribeClaimedEpochTimeByUser, sdk.Uint64ToBigEndian(veID)...), poolDenom...)
+}
+
+func FeesDepositedEpochTimeKey() []byte {
+	return KeyPrefixFeesDepositedEpochTime
+}
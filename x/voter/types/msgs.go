@@ -0,0 +1,187 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+)
+
+const (
+	TypeMsgVote        = "vote"
+	TypeMsgPoke        = "poke"
+	TypeMsgAbstain     = "abstain"
+	TypeMsgClaimBribes = "claim_bribes"
+	TypeMsgBribe       = "bribe"
+)
+
+var (
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgPoke{}
+	_ sdk.Msg = &MsgAbstain{}
+	_ sdk.Msg = &MsgClaimBribes{}
+	_ sdk.Msg = &MsgBribe{}
+)
+
+// Route implements sdk.Msg
+func (m *MsgVote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgVote) Type() string { return TypeMsgVote }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgVote) ValidateBasic() error {
+	if err := validateSenderVeID(m.Sender, m.VeId); err != nil {
+		return err
+	}
+	if len(m.PoolWeights) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPoolWeights, "empty pool weights")
+	}
+	totalWeights := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, pw := range m.PoolWeights {
+		if err := sdk.ValidateDenom(pw.PoolDenom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPoolWeights, err.Error())
+		}
+		if seen[pw.PoolDenom] {
+			return sdkerrors.Wrapf(ErrInvalidPoolWeights, "duplicate pool %s", pw.PoolDenom)
+		}
+		seen[pw.PoolDenom] = true
+		if pw.Weight.IsNil() || pw.Weight.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidPoolWeights, "zero weight for pool %s", pw.PoolDenom)
+		}
+		totalWeights = totalWeights.Add(pw.Weight.Abs())
+	}
+	if !totalWeights.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidPoolWeights, "sum of absolute weights %s but should be 1", totalWeights)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgVote) GetSigners() []sdk.AccAddress {
+	return getSigners(m.Sender)
+}
+
+// Route implements sdk.Msg
+func (m *MsgPoke) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgPoke) Type() string { return TypeMsgPoke }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgPoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgPoke) ValidateBasic() error {
+	return validateSenderVeID(m.Sender, m.VeId)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgPoke) GetSigners() []sdk.AccAddress {
+	return getSigners(m.Sender)
+}
+
+// Route implements sdk.Msg
+func (m *MsgAbstain) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgAbstain) Type() string { return TypeMsgAbstain }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgAbstain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgAbstain) ValidateBasic() error {
+	return validateSenderVeID(m.Sender, m.VeId)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgAbstain) GetSigners() []sdk.AccAddress {
+	return getSigners(m.Sender)
+}
+
+// Route implements sdk.Msg
+func (m *MsgClaimBribes) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClaimBribes) Type() string { return TypeMsgClaimBribes }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClaimBribes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimBribes) ValidateBasic() error {
+	return validateSenderVeID(m.Sender, m.VeId)
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimBribes) GetSigners() []sdk.AccAddress {
+	return getSigners(m.Sender)
+}
+
+// Route implements sdk.Msg
+func (m *MsgBribe) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgBribe) Type() string { return TypeMsgBribe }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgBribe) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgBribe) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.PoolDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBribe, err.Error())
+	}
+	if m.EpochTime == 0 || vetypes.RegulatedUnixTime(m.EpochTime) != m.EpochTime {
+		return sdkerrors.Wrapf(ErrInvalidEpochTime, "epoch time %d must be aligned to %d seconds", m.EpochTime, vetypes.RegulatedPeriod)
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", m.Amount)
+	}
+	if m.MaxPerVote.IsNil() || m.MaxPerVote.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidBribe, "invalid max per vote %s", m.MaxPerVote)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgBribe) GetSigners() []sdk.AccAddress {
+	return getSigners(m.Sender)
+}
+
+func validateSenderVeID(sender string, veID string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if vetypes.Uint64FromVeID(veID) == vetypes.EmptyVeID {
+		return vetypes.ErrInvalidVeID
+	}
+	return nil
+}
+
+func getSigners(senderStr string) []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(senderStr)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gridiron-zone/gridiron/app"
+	gridtypes "github.com/gridiron-zone/gridiron/types"
+	vetypes "github.com/gridiron-zone/gridiron/x/ve/types"
+	"github.com/gridiron-zone/gridiron/x/voter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBribe_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	var (
+		sender = "grid1mnfm9c7cdgqnkk66sganp78m0ydmcr4pmtxfmy"
+		amount = sdk.NewCoin(gridtypes.AttoIronDenom, sdk.NewInt(100))
+	)
+	for _, tc := range []struct {
+		desc  string
+		msg   types.MsgBribe
+		valid bool
+	}{
+		{
+			desc: "invalid sender address",
+			msg:  types.MsgBribe{Sender: "", PoolDenom: "pool", EpochTime: vetypes.RegulatedPeriod, Amount: amount, MaxPerVote: sdk.ZeroDec()},
+		},
+		{
+			desc: "invalid pool denom",
+			msg:  types.MsgBribe{Sender: sender, PoolDenom: "", EpochTime: vetypes.RegulatedPeriod, Amount: amount, MaxPerVote: sdk.ZeroDec()},
+		},
+		{
+			desc: "zero epoch time",
+			msg:  types.MsgBribe{Sender: sender, PoolDenom: "pool", EpochTime: 0, Amount: amount, MaxPerVote: sdk.ZeroDec()},
+		},
+		{
+			desc: "unaligned epoch time",
+			msg:  types.MsgBribe{Sender: sender, PoolDenom: "pool", EpochTime: vetypes.RegulatedPeriod + 1, Amount: amount, MaxPerVote: sdk.ZeroDec()},
+		},
+		{
+			desc: "zero amount",
+			msg:  types.MsgBribe{Sender: sender, PoolDenom: "pool", EpochTime: vetypes.RegulatedPeriod, Amount: sdk.NewCoin(gridtypes.AttoIronDenom, sdk.ZeroInt()), MaxPerVote: sdk.ZeroDec()},
+		},
+		{
+			desc: "nil max per vote",
+			msg:  types.MsgBribe{Sender: sender, PoolDenom: "pool", EpochTime: vetypes.RegulatedPeriod, Amount: amount},
+		},
+		{
+			desc: "negative max per vote",
+			msg:  types.MsgBribe{Sender: sender, PoolDenom: "pool", EpochTime: vetypes.RegulatedPeriod, Amount: amount, MaxPerVote: sdk.NewDec(-1)},
+		},
+		{
+			desc:  "valid",
+			msg:   types.MsgBribe{Sender: sender, PoolDenom: "pool", EpochTime: vetypes.RegulatedPeriod, Amount: amount, MaxPerVote: sdk.ZeroDec()},
+			valid: true,
+		},
+		{
+			desc:  "valid with max per vote",
+			msg:   types.MsgBribe{Sender: sender, PoolDenom: "pool", EpochTime: vetypes.RegulatedPeriod, Amount: amount, MaxPerVote: sdk.NewDecWithPrec(5, 1), Rollover: true},
+			valid: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,10 +1,28 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gridiron "github.com/gridiron-zone/gridiron/types"
 	"gopkg.in/yaml.v2"
 )
 
+// Parameter keys
+var (
+	KeyMinBribes                = []byte("MinBribes")
+	KeyMaxBribeRollovers        = []byte("MaxBribeRollovers")
+	KeyMaxBribesSettledPerBlock = []byte("MaxBribesSettledPerBlock")
+)
+
+// Default parameter values
+var (
+	DefaultMinBribes                       = sdk.NewCoins(sdk.NewCoin(gridiron.BaseDenom, sdk.NewIntWithDecimal(1, 18))) // 1 iron
+	DefaultMaxBribeRollovers        uint32 = 4
+	DefaultMaxBribesSettledPerBlock uint32 = 100
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,23 +31,37 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(minBribes sdk.Coins, maxBribeRollovers, maxBribesSettledPerBlock uint32) Params {
+	return Params{
+		MinBribes:                minBribes,
+		MaxBribeRollovers:        maxBribeRollovers,
+		MaxBribesSettledPerBlock: maxBribesSettledPerBlock,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMinBribes, DefaultMaxBribeRollovers, DefaultMaxBribesSettledPerBlock)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinBribes, &p.MinBribes, validateMinBribes),
+		paramtypes.NewParamSetPair(KeyMaxBribeRollovers, &p.MaxBribeRollovers, validateMaxBribeRollovers),
+		paramtypes.NewParamSetPair(KeyMaxBribesSettledPerBlock, &p.MaxBribesSettledPerBlock, validateMaxBribesSettledPerBlock),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateMinBribes(p.MinBribes); err != nil {
+		return err
+	}
+	if err := validateMaxBribeRollovers(p.MaxBribeRollovers); err != nil {
+		return err
+	}
+	return validateMaxBribesSettledPerBlock(p.MaxBribesSettledPerBlock)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +69,38 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMinBribes(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid min bribes: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxBribeRollovers(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxBribesSettledPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max bribes settled per block must be positive: %d", v)
+	}
+
+	return nil
+}
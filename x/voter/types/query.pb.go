@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,33 +114,336 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type QueryBribesRequest struct {
+	PoolDenom string `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	// unix time of the epoch start, zero for the current epoch
+	EpochTime uint64 `protobuf:"varint,2,opt,name=epoch_time,json=epochTime,proto3" json:"epoch_time,omitempty"`
+}
+
+func (m *QueryBribesRequest) Reset()         { *m = QueryBribesRequest{} }
+func (m *QueryBribesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBribesRequest) ProtoMessage()    {}
+func (*QueryBribesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{2}
+}
+func (m *QueryBribesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBribesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBribesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBribesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBribesRequest.Merge(m, src)
+}
+func (m *QueryBribesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBribesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBribesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBribesRequest proto.InternalMessageInfo
+
+func (m *QueryBribesRequest) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+func (m *QueryBribesRequest) GetEpochTime() uint64 {
+	if m != nil {
+		return m.EpochTime
+	}
+	return 0
+}
+
+type QueryBribesResponse struct {
+	// unsettled bribes, since the settled bribes are accounted in epoch bribes
+	Bribes []Bribe `protobuf:"bytes,1,rep,name=bribes,proto3" json:"bribes"`
+}
+
+func (m *QueryBribesResponse) Reset()         { *m = QueryBribesResponse{} }
+func (m *QueryBribesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBribesResponse) ProtoMessage()    {}
+func (*QueryBribesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{3}
+}
+func (m *QueryBribesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBribesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBribesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBribesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBribesResponse.Merge(m, src)
+}
+func (m *QueryBribesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBribesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBribesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBribesResponse proto.InternalMessageInfo
+
+func (m *QueryBribesResponse) GetBribes() []Bribe {
+	if m != nil {
+		return m.Bribes
+	}
+	return nil
+}
+
+type QueryBribesPerVoteRequest struct {
+	// pool denom, empty for all pools
+	PoolDenom string `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	// unix time of the epoch start, zero for the current epoch
+	EpochTime uint64 `protobuf:"varint,2,opt,name=epoch_time,json=epochTime,proto3" json:"epoch_time,omitempty"`
+}
+
+func (m *QueryBribesPerVoteRequest) Reset()         { *m = QueryBribesPerVoteRequest{} }
+func (m *QueryBribesPerVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBribesPerVoteRequest) ProtoMessage()    {}
+func (*QueryBribesPerVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{4}
+}
+func (m *QueryBribesPerVoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBribesPerVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBribesPerVoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBribesPerVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBribesPerVoteRequest.Merge(m, src)
+}
+func (m *QueryBribesPerVoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBribesPerVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBribesPerVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBribesPerVoteRequest proto.InternalMessageInfo
+
+func (m *QueryBribesPerVoteRequest) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+func (m *QueryBribesPerVoteRequest) GetEpochTime() uint64 {
+	if m != nil {
+		return m.EpochTime
+	}
+	return 0
+}
+
+// PoolBribesPerVote represents the bribe rewards per concurring vote for a
+// pool in an epoch.
+type PoolBribesPerVote struct {
+	PoolDenom string `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	EpochTime uint64 `protobuf:"varint,2,opt,name=epoch_time,json=epochTime,proto3" json:"epoch_time,omitempty"`
+	// whether the epoch has ended and the bribes have been settled, otherwise
+	// the votes and rewards are estimated by the current votes
+	Settled        bool                                        `protobuf:"varint,3,opt,name=settled,proto3" json:"settled,omitempty"`
+	Votes          github_com_cosmos_cosmos_sdk_types.Int      `protobuf:"bytes,4,opt,name=votes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"votes"`
+	Rewards        github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,5,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	RewardsPerVote github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=rewards_per_vote,json=rewardsPerVote,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_vote"`
+}
+
+func (m *PoolBribesPerVote) Reset()         { *m = PoolBribesPerVote{} }
+func (m *PoolBribesPerVote) String() string { return proto.CompactTextString(m) }
+func (*PoolBribesPerVote) ProtoMessage()    {}
+func (*PoolBribesPerVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{5}
+}
+func (m *PoolBribesPerVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolBribesPerVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolBribesPerVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolBribesPerVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolBribesPerVote.Merge(m, src)
+}
+func (m *PoolBribesPerVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolBribesPerVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolBribesPerVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolBribesPerVote proto.InternalMessageInfo
+
+func (m *PoolBribesPerVote) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+func (m *PoolBribesPerVote) GetEpochTime() uint64 {
+	if m != nil {
+		return m.EpochTime
+	}
+	return 0
+}
+
+func (m *PoolBribesPerVote) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+func (m *PoolBribesPerVote) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *PoolBribesPerVote) GetRewardsPerVote() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerVote
+	}
+	return nil
+}
+
+type QueryBribesPerVoteResponse struct {
+	Bribes []PoolBribesPerVote `protobuf:"bytes,1,rep,name=bribes,proto3" json:"bribes"`
+}
+
+func (m *QueryBribesPerVoteResponse) Reset()         { *m = QueryBribesPerVoteResponse{} }
+func (m *QueryBribesPerVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBribesPerVoteResponse) ProtoMessage()    {}
+func (*QueryBribesPerVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{6}
+}
+func (m *QueryBribesPerVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBribesPerVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBribesPerVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBribesPerVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBribesPerVoteResponse.Merge(m, src)
+}
+func (m *QueryBribesPerVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBribesPerVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBribesPerVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBribesPerVoteResponse proto.InternalMessageInfo
+
+func (m *QueryBribesPerVoteResponse) GetBribes() []PoolBribesPerVote {
+	if m != nil {
+		return m.Bribes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "gridiron.voter.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "gridiron.voter.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBribesRequest)(nil), "gridiron.voter.v1.QueryBribesRequest")
+	proto.RegisterType((*QueryBribesResponse)(nil), "gridiron.voter.v1.QueryBribesResponse")
+	proto.RegisterType((*QueryBribesPerVoteRequest)(nil), "gridiron.voter.v1.QueryBribesPerVoteRequest")
+	proto.RegisterType((*PoolBribesPerVote)(nil), "gridiron.voter.v1.PoolBribesPerVote")
+	proto.RegisterType((*QueryBribesPerVoteResponse)(nil), "gridiron.voter.v1.QueryBribesPerVoteResponse")
 }
 
 func init() { proto.RegisterFile("gridiron/voter/v1/query.proto", fileDescriptor_7683c9c1947b7a2b) }
 
 var fileDescriptor_7683c9c1947b7a2b = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0xcb, 0x2f, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e,
-	0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x0e, 0xc3, 0x8e, 0xf4, 0xd4, 0xbc, 0xd4,
-	0xe2, 0x4c, 0xa8, 0xbc, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xd2, 0x80, 0xc4, 0xa2, 0xc4, 0xdc,
-	0xe2, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x5f, 0x2e, 0x61, 0x14, 0xd1, 0xe2, 0x82,
-	0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x33, 0x2e, 0xb6, 0x02, 0xb0, 0x88, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0xb7, 0x91, 0x84, 0x1e, 0xba, 0x1b, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xaa, 0x36, 0x6a, 0x60, 0xe4, 0x62, 0x05, 0x9b, 0x27, 0x54, 0xce, 0xc5, 0x06, 0x51, 0x21,
-	0xa4, 0x82, 0xa9, 0x17, 0xd3, 0x21, 0x52, 0xaa, 0x04, 0x54, 0x41, 0x1c, 0xa6, 0xa4, 0xd0, 0x74,
-	0xf9, 0xc9, 0x64, 0x26, 0x29, 0x21, 0x09, 0x7d, 0x0c, 0xef, 0x42, 0x9c, 0xe0, 0xe4, 0x7e, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0x30, 0xdd, 0xba, 0x55, 0xf9, 0x79, 0xa9, 0x70, 0xa3, 0x2a, 0xa0, 0x86,
-	0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xcd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x70, 0xe1, 0xa6, 0xf0, 0xbd, 0x01, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x5f,
+	0x14, 0xed, 0x50, 0x28, 0x3f, 0x1e, 0xf9, 0x19, 0x79, 0xb0, 0x98, 0x56, 0x98, 0xd6, 0x09, 0x92,
+	0x46, 0x65, 0x9e, 0x85, 0x44, 0xf7, 0x95, 0x0d, 0x0b, 0x0d, 0x4e, 0x8c, 0x89, 0x6e, 0xea, 0xb4,
+	0xbd, 0x19, 0x26, 0xb6, 0x73, 0x87, 0x79, 0x8f, 0x2a, 0xb8, 0xf3, 0x13, 0x98, 0x18, 0x13, 0xe3,
+	0x47, 0xf0, 0x93, 0xb0, 0x24, 0x71, 0x63, 0x5c, 0xa0, 0xa1, 0x7e, 0x10, 0xf3, 0xfe, 0x8c, 0x32,
+	0xb4, 0x48, 0x13, 0x5d, 0x75, 0x7a, 0xef, 0xb9, 0xe7, 0x9c, 0x37, 0xef, 0xdc, 0x21, 0x2b, 0x61,
+	0x1a, 0x75, 0xa3, 0x14, 0x63, 0x36, 0x40, 0x01, 0x29, 0x1b, 0x34, 0xd8, 0xde, 0x3e, 0xa4, 0x07,
+	0x5e, 0x92, 0xa2, 0x40, 0xba, 0x90, 0xb5, 0x3d, 0xd5, 0xf6, 0x06, 0x8d, 0xca, 0x52, 0x88, 0x21,
+	0xaa, 0x2e, 0x93, 0x4f, 0x1a, 0x58, 0x59, 0x0e, 0x11, 0xc3, 0x1e, 0xb0, 0x20, 0x89, 0x58, 0x10,
+	0xc7, 0x28, 0x02, 0x11, 0x61, 0xcc, 0x4d, 0xd7, 0xe9, 0x20, 0xef, 0x23, 0x67, 0xed, 0x80, 0x03,
+	0x1b, 0x34, 0xda, 0x20, 0x82, 0x06, 0xeb, 0x60, 0x14, 0x9b, 0x7e, 0x75, 0xd4, 0x45, 0x08, 0x31,
+	0xf0, 0x28, 0x23, 0x18, 0x63, 0x53, 0x1b, 0x52, 0x6d, 0x77, 0x89, 0xd0, 0x47, 0xd2, 0xf5, 0x4e,
+	0x90, 0x06, 0x7d, 0xee, 0xc3, 0xde, 0x3e, 0x70, 0xe1, 0x3e, 0x24, 0x8b, 0xb9, 0x2a, 0x4f, 0x30,
+	0xe6, 0x40, 0xef, 0x91, 0x52, 0xa2, 0x2a, 0xb6, 0x55, 0xb3, 0xea, 0xf3, 0x1b, 0x65, 0x6f, 0xe4,
+	0x90, 0x9e, 0x1e, 0x69, 0x4e, 0x1f, 0x9d, 0x54, 0x0b, 0xbe, 0x81, 0xbb, 0xbe, 0x51, 0x69, 0xa6,
+	0x51, 0x1b, 0x32, 0x15, 0xba, 0x42, 0x48, 0x82, 0xd8, 0x6b, 0x75, 0x21, 0xc6, 0xbe, 0xa2, 0x9c,
+	0xf3, 0xe7, 0x64, 0x65, 0x4b, 0x16, 0x64, 0x1b, 0x12, 0xec, 0xec, 0xb6, 0x44, 0xd4, 0x07, 0x7b,
+	0xaa, 0x66, 0xd5, 0xa7, 0xfd, 0x39, 0x55, 0x79, 0x1c, 0xf5, 0xc1, 0x7d, 0x40, 0x16, 0x73, 0x9c,
+	0xc6, 0xe3, 0x5d, 0x52, 0x6a, 0xab, 0x8a, 0x6d, 0xd5, 0x8a, 0xf5, 0xf9, 0x0d, 0x7b, 0x8c, 0x47,
+	0x35, 0x92, 0x59, 0xd4, 0x68, 0xf7, 0x29, 0x29, 0x9f, 0xa1, 0xdb, 0x81, 0xf4, 0x09, 0x0a, 0xf8,
+	0x37, 0x4e, 0xdf, 0x17, 0xc9, 0xc2, 0x0e, 0x62, 0x2f, 0x47, 0xfd, 0x77, 0x9c, 0xd4, 0x26, 0xb3,
+	0x1c, 0x84, 0xe8, 0x41, 0xd7, 0x2e, 0xd6, 0xac, 0xfa, 0x7f, 0x7e, 0xf6, 0x97, 0x6e, 0x91, 0x19,
+	0x79, 0x50, 0x6e, 0x4f, 0x4b, 0xca, 0xa6, 0x27, 0x4f, 0xf9, 0xf5, 0xa4, 0xba, 0x16, 0x46, 0x62,
+	0x77, 0xbf, 0xed, 0x75, 0xb0, 0xcf, 0x4c, 0xa6, 0xf4, 0xcf, 0x3a, 0xef, 0xbe, 0x60, 0xe2, 0x20,
+	0x01, 0xee, 0x6d, 0xc7, 0xc2, 0xd7, 0xc3, 0x14, 0xc8, 0x6c, 0x0a, 0x2f, 0x83, 0xb4, 0xcb, 0xed,
+	0x19, 0xf5, 0x1e, 0xcb, 0x9e, 0x86, 0x7b, 0x32, 0x89, 0x9e, 0x49, 0xa2, 0x77, 0x1f, 0xa3, 0xb8,
+	0x79, 0x47, 0x4a, 0x7c, 0xfa, 0x56, 0xad, 0x4f, 0x20, 0x21, 0x07, 0xb8, 0x9f, 0x71, 0xd3, 0xd7,
+	0xe4, 0xaa, 0x79, 0x6c, 0x25, 0x90, 0xb6, 0xa4, 0xb6, 0x5d, 0x52, 0x7a, 0xcb, 0x63, 0xf5, 0xb6,
+	0xa0, 0xa3, 0x24, 0x37, 0x8d, 0xe4, 0xad, 0x09, 0x24, 0xcd, 0x0c, 0xf7, 0xaf, 0x18, 0x29, 0x73,
+	0x03, 0xee, 0x73, 0x52, 0x19, 0x77, 0xe5, 0x26, 0x48, 0xcd, 0x73, 0x41, 0x5a, 0x1d, 0x17, 0xf6,
+	0xf3, 0xb7, 0x9a, 0x0f, 0xd5, 0xc6, 0xc7, 0x22, 0x99, 0x51, 0x12, 0xf4, 0x90, 0x94, 0xf4, 0x66,
+	0xd0, 0x1b, 0x63, 0x78, 0x46, 0x57, 0xb0, 0xb2, 0x76, 0x19, 0x4c, 0xdb, 0x74, 0xaf, 0xbf, 0xf9,
+	0xfc, 0xe3, 0xdd, 0xd4, 0x35, 0x5a, 0x66, 0xa3, 0x8b, 0xae, 0xb7, 0x4f, 0x6a, 0x6b, 0x93, 0x17,
+	0x6b, 0xe7, 0x16, 0xb3, 0xb2, 0x76, 0x19, 0x6c, 0x02, 0x6d, 0xfd, 0x06, 0xe8, 0x07, 0x8b, 0xfc,
+	0x9f, 0xcf, 0xfd, 0xed, 0x3f, 0x93, 0xe7, 0x37, 0xaf, 0xb2, 0x3e, 0x21, 0xda, 0x38, 0xba, 0xa9,
+	0x1c, 0xad, 0x52, 0xf7, 0x42, 0x47, 0xbf, 0x72, 0xd6, 0xdc, 0x3e, 0x3a, 0x75, 0xac, 0xe3, 0x53,
+	0xc7, 0xfa, 0x7e, 0xea, 0x58, 0x6f, 0x87, 0x4e, 0xe1, 0x78, 0xe8, 0x14, 0xbe, 0x0c, 0x9d, 0xc2,
+	0x33, 0x76, 0x26, 0x55, 0x19, 0xcf, 0xfa, 0x21, 0xc6, 0xf0, 0x9b, 0xf5, 0x95, 0xe1, 0x55, 0x11,
+	0x6b, 0x97, 0xd4, 0xc7, 0x74, 0xf3, 0xe7, 0x00, 0x54, 0x26, 0x31, 0x1d, 0x14, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +460,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Bribes queries the bribes posted for a pool in an epoch.
+	Bribes(ctx context.Context, in *QueryBribesRequest, opts ...grpc.CallOption) (*QueryBribesResponse, error)
+	// BribesPerVote queries the bribe rewards per concurring vote for each pool
+	// in an epoch.
+	BribesPerVote(ctx context.Context, in *QueryBribesPerVoteRequest, opts ...grpc.CallOption) (*QueryBribesPerVoteResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +484,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Bribes(ctx context.Context, in *QueryBribesRequest, opts ...grpc.CallOption) (*QueryBribesResponse, error) {
+	out := new(QueryBribesResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.voter.v1.Query/Bribes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BribesPerVote(ctx context.Context, in *QueryBribesPerVoteRequest, opts ...grpc.CallOption) (*QueryBribesPerVoteResponse, error) {
+	out := new(QueryBribesPerVoteResponse)
+	err := c.cc.Invoke(ctx, "/gridiron.voter.v1.Query/BribesPerVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Bribes queries the bribes posted for a pool in an epoch.
+	Bribes(context.Context, *QueryBribesRequest) (*QueryBribesResponse, error)
+	// BribesPerVote queries the bribe rewards per concurring vote for each pool
+	// in an epoch.
+	BribesPerVote(context.Context, *QueryBribesPerVoteRequest) (*QueryBribesPerVoteResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +520,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Bribes(ctx context.Context, req *QueryBribesRequest) (*QueryBribesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bribes not implemented")
+}
+func (*UnimplementedQueryServer) BribesPerVote(ctx context.Context, req *QueryBribesPerVoteRequest) (*QueryBribesPerVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BribesPerVote not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +549,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Bribes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBribesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bribes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.voter.v1.Query/Bribes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bribes(ctx, req.(*QueryBribesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BribesPerVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBribesPerVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BribesPerVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gridiron.voter.v1.Query/BribesPerVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BribesPerVote(ctx, req.(*QueryBribesPerVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gridiron.voter.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -218,6 +593,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Bribes",
+			Handler:    _Query_Bribes_Handler,
+		},
+		{
+			MethodName: "BribesPerVote",
+			Handler:    _Query_BribesPerVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gridiron/voter/v1/query.proto",
@@ -279,41 +662,363 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBribesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryBribesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBribesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.EpochTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBribesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBribesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBribesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Bribes) > 0 {
+		for iNdEx := len(m.Bribes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bribes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
+func (m *QueryBribesPerVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBribesPerVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBribesPerVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolBribesPerVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolBribesPerVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolBribesPerVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPerVote) > 0 {
+		for iNdEx := len(m.RewardsPerVote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerVote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Votes.Size()
+		i -= size
+		if _, err := m.Votes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBribesPerVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBribesPerVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBribesPerVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bribes) > 0 {
+		for iNdEx := len(m.Bribes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bribes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBribesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochTime != 0 {
+		n += 1 + sovQuery(uint64(m.EpochTime))
+	}
+	return n
+}
+
+func (m *QueryBribesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bribes) > 0 {
+		for _, e := range m.Bribes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBribesPerVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochTime != 0 {
+		n += 1 + sovQuery(uint64(m.EpochTime))
+	}
+	return n
+}
+
+func (m *PoolBribesPerVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochTime != 0 {
+		n += 1 + sovQuery(uint64(m.EpochTime))
+	}
+	if m.Settled {
+		n += 2
+	}
+	l = m.Votes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RewardsPerVote) > 0 {
+		for _, e := range m.RewardsPerVote {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBribesPerVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bribes) > 0 {
+		for _, e := range m.Bribes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
@@ -449,6 +1154,599 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBribesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBribesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBribesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTime", wireType)
+			}
+			m.EpochTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBribesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBribesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBribesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bribes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bribes = append(m.Bribes, Bribe{})
+			if err := m.Bribes[len(m.Bribes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBribesPerVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBribesPerVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBribesPerVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTime", wireType)
+			}
+			m.EpochTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolBribesPerVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolBribesPerVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolBribesPerVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTime", wireType)
+			}
+			m.EpochTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Settled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerVote = append(m.RewardsPerVote, types.DecCoin{})
+			if err := m.RewardsPerVote[len(m.RewardsPerVote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBribesPerVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBribesPerVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBribesPerVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bribes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bribes = append(m.Bribes, PoolBribesPerVote{})
+			if err := m.Bribes[len(m.Bribes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,958 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gridiron/voter/v1/voter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Bribe represents the rewards posted by a briber for the concurring voters of
+// a pool in an epoch.
+type Bribe struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Briber    string `protobuf:"bytes,2,opt,name=briber,proto3" json:"briber,omitempty"`
+	PoolDenom string `protobuf:"bytes,3,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	// unix time of the epoch start, aligned to the regulated period (week)
+	EpochTime uint64     `protobuf:"varint,4,opt,name=epoch_time,json=epochTime,proto3" json:"epoch_time,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// max reward amount per vote, zero means no cap
+	MaxPerVote github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_per_vote,json=maxPerVote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_per_vote"`
+	// whether the undistributed amount rolls over to the next epoch, otherwise
+	// it's refunded to the briber
+	Rollover bool `protobuf:"varint,7,opt,name=rollover,proto3" json:"rollover,omitempty"`
+	// times the undistributed amount has rolled over
+	Rollovers uint32 `protobuf:"varint,8,opt,name=rollovers,proto3" json:"rollovers,omitempty"`
+}
+
+func (m *Bribe) Reset()         { *m = Bribe{} }
+func (m *Bribe) String() string { return proto.CompactTextString(m) }
+func (*Bribe) ProtoMessage()    {}
+func (*Bribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34f3af4a26988227, []int{0}
+}
+func (m *Bribe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bribe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bribe.Merge(m, src)
+}
+func (m *Bribe) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bribe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bribe proto.InternalMessageInfo
+
+func (m *Bribe) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Bribe) GetBriber() string {
+	if m != nil {
+		return m.Briber
+	}
+	return ""
+}
+
+func (m *Bribe) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+func (m *Bribe) GetEpochTime() uint64 {
+	if m != nil {
+		return m.EpochTime
+	}
+	return 0
+}
+
+func (m *Bribe) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Bribe) GetRollover() bool {
+	if m != nil {
+		return m.Rollover
+	}
+	return false
+}
+
+func (m *Bribe) GetRollovers() uint32 {
+	if m != nil {
+		return m.Rollovers
+	}
+	return 0
+}
+
+// EpochBribe represents the settled bribe rewards of a pool in an epoch, which
+// are distributed pro rata to the concurring votes for the pool at the end of
+// the epoch.
+type EpochBribe struct {
+	PoolDenom string `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	// unix time of the epoch start
+	EpochTime uint64 `protobuf:"varint,2,opt,name=epoch_time,json=epochTime,proto3" json:"epoch_time,omitempty"`
+	// concurring votes for the pool at the end of the epoch
+	Votes   github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,3,opt,name=votes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"votes"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *EpochBribe) Reset()         { *m = EpochBribe{} }
+func (m *EpochBribe) String() string { return proto.CompactTextString(m) }
+func (*EpochBribe) ProtoMessage()    {}
+func (*EpochBribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34f3af4a26988227, []int{1}
+}
+func (m *EpochBribe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochBribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochBribe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochBribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochBribe.Merge(m, src)
+}
+func (m *EpochBribe) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochBribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochBribe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochBribe proto.InternalMessageInfo
+
+func (m *EpochBribe) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+func (m *EpochBribe) GetEpochTime() uint64 {
+	if m != nil {
+		return m.EpochTime
+	}
+	return 0
+}
+
+func (m *EpochBribe) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Bribe)(nil), "gridiron.voter.v1.Bribe")
+	proto.RegisterType((*EpochBribe)(nil), "gridiron.voter.v1.EpochBribe")
+}
+
+func init() { proto.RegisterFile("gridiron/voter/v1/voter.proto", fileDescriptor_34f3af4a26988227) }
+
+var fileDescriptor_34f3af4a26988227 = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3b, 0x6f, 0x13, 0x41,
+	0x10, 0xf6, 0x9e, 0x1f, 0xb1, 0x97, 0x87, 0xc4, 0x0a, 0xa1, 0xc5, 0x22, 0xe7, 0x53, 0x0a, 0x74,
+	0x4d, 0x76, 0x31, 0x14, 0xf4, 0xc6, 0x14, 0xe9, 0xa2, 0x13, 0xa2, 0xa0, 0xb1, 0xee, 0x31, 0x72,
+	0x56, 0xf8, 0x6e, 0x4e, 0xbb, 0x9b, 0xc3, 0xf0, 0x2b, 0xf8, 0x1d, 0x94, 0xfc, 0x8a, 0x94, 0x29,
+	0x11, 0x45, 0x40, 0xf6, 0x8f, 0xa0, 0x45, 0x7b, 0x8f, 0xf0, 0x28, 0x22, 0xa8, 0x76, 0xe6, 0xfb,
+	0x66, 0x67, 0xe6, 0xfb, 0x34, 0xf4, 0x70, 0xad, 0x55, 0xa6, 0x34, 0x16, 0xb2, 0x42, 0x0b, 0x5a,
+	0x56, 0xf3, 0x26, 0x10, 0xa5, 0x46, 0x8b, 0xec, 0x5e, 0x47, 0x8b, 0x06, 0xad, 0xe6, 0xd3, 0xfb,
+	0x6b, 0x5c, 0x63, 0xcd, 0x4a, 0x17, 0x35, 0x85, 0x53, 0x3f, 0x45, 0x93, 0xa3, 0x91, 0x49, 0x6c,
+	0x40, 0x56, 0xf3, 0x04, 0x6c, 0x3c, 0x97, 0x29, 0xaa, 0xa2, 0xe1, 0x8f, 0x3e, 0x7b, 0x74, 0xb8,
+	0xd0, 0x2a, 0x01, 0x76, 0x97, 0x7a, 0x2a, 0xe3, 0x24, 0x20, 0xe1, 0x20, 0xf2, 0x54, 0xc6, 0x1e,
+	0xd0, 0x51, 0xe2, 0x08, 0xcd, 0xbd, 0x80, 0x84, 0x93, 0xa8, 0xcd, 0xd8, 0x21, 0xa5, 0x25, 0xe2,
+	0x66, 0x95, 0x41, 0x81, 0x39, 0xef, 0xd7, 0xdc, 0xc4, 0x21, 0x4b, 0x07, 0x38, 0x1a, 0x4a, 0x4c,
+	0xcf, 0x56, 0x56, 0xe5, 0xc0, 0x07, 0x75, 0xbb, 0x49, 0x8d, 0xbc, 0x52, 0x39, 0xb0, 0xe7, 0x74,
+	0x14, 0xe7, 0x78, 0x5e, 0x58, 0x3e, 0x0c, 0x48, 0x78, 0xeb, 0xe9, 0x43, 0xd1, 0x2c, 0x28, 0xdc,
+	0x82, 0xa2, 0x5d, 0x50, 0xbc, 0x40, 0x55, 0x2c, 0x06, 0x17, 0x57, 0xb3, 0x5e, 0xd4, 0x96, 0xb3,
+	0x53, 0x7a, 0x3b, 0x8f, 0xb7, 0xab, 0x12, 0xf4, 0xca, 0x49, 0xe6, 0x23, 0x37, 0x78, 0x21, 0x5c,
+	0xcd, 0xd7, 0xab, 0xd9, 0xe3, 0xb5, 0xb2, 0x67, 0xe7, 0x89, 0x48, 0x31, 0x97, 0xad, 0xe2, 0xe6,
+	0x39, 0x36, 0xd9, 0x5b, 0x69, 0xdf, 0x97, 0x60, 0xc4, 0x12, 0xd2, 0x88, 0xe6, 0xf1, 0xf6, 0x14,
+	0xf4, 0x6b, 0xb4, 0xc0, 0xa6, 0x74, 0xac, 0x71, 0xb3, 0xc1, 0x0a, 0x34, 0x3f, 0x08, 0x48, 0x38,
+	0x8e, 0xae, 0x73, 0xf6, 0x88, 0x4e, 0xba, 0xd8, 0xf0, 0x71, 0x40, 0xc2, 0x3b, 0xd1, 0x2f, 0xe0,
+	0xe8, 0x07, 0xa1, 0xf4, 0xa5, 0x93, 0xd4, 0x38, 0xf7, 0xa7, 0x23, 0xe4, 0x66, 0x47, 0xbc, 0xbf,
+	0x1d, 0x59, 0xd2, 0xa1, 0x13, 0x64, 0x78, 0xff, 0xbf, 0x15, 0x9d, 0x14, 0x36, 0x6a, 0x3e, 0x33,
+	0xa0, 0x07, 0x1a, 0xde, 0xc5, 0x3a, 0x33, 0x7c, 0x10, 0xf4, 0x6f, 0x36, 0xf6, 0x89, 0x1b, 0xf1,
+	0xe9, 0xdb, 0x2c, 0xfc, 0x87, 0x11, 0xee, 0x83, 0x89, 0xba, 0xde, 0x8b, 0x93, 0x8b, 0x9d, 0x4f,
+	0x2e, 0x77, 0x3e, 0xf9, 0xbe, 0xf3, 0xc9, 0xc7, 0xbd, 0xdf, 0xbb, 0xdc, 0xfb, 0xbd, 0x2f, 0x7b,
+	0xbf, 0xf7, 0x46, 0xfe, 0xd6, 0xac, 0x3b, 0xce, 0xe3, 0x0f, 0x58, 0xc0, 0x75, 0x26, 0xb7, 0xed,
+	0x2d, 0xd7, 0x9d, 0x93, 0x51, 0x7d, 0x80, 0xcf, 0x7e, 0x0e, 0x00, 0xb2, 0x18, 0x15, 0xbf, 0xea,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Bribe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bribe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rollovers != 0 {
+		i = encodeVarintVoter(dAtA, i, uint64(m.Rollovers))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Rollover {
+		i--
+		if m.Rollover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxPerVote.Size()
+		i -= size
+		if _, err := m.MaxPerVote.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVoter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVoter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.EpochTime != 0 {
+		i = encodeVarintVoter(dAtA, i, uint64(m.EpochTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintVoter(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Briber) > 0 {
+		i -= len(m.Briber)
+		copy(dAtA[i:], m.Briber)
+		i = encodeVarintVoter(dAtA, i, uint64(len(m.Briber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVoter(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochBribe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochBribe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochBribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Votes.Size()
+		i -= size
+		if _, err := m.Votes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVoter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochTime != 0 {
+		i = encodeVarintVoter(dAtA, i, uint64(m.EpochTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintVoter(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Bribe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVoter(uint64(m.Id))
+	}
+	l = len(m.Briber)
+	if l > 0 {
+		n += 1 + l + sovVoter(uint64(l))
+	}
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovVoter(uint64(l))
+	}
+	if m.EpochTime != 0 {
+		n += 1 + sovVoter(uint64(m.EpochTime))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVoter(uint64(l))
+	l = m.MaxPerVote.Size()
+	n += 1 + l + sovVoter(uint64(l))
+	if m.Rollover {
+		n += 2
+	}
+	if m.Rollovers != 0 {
+		n += 1 + sovVoter(uint64(m.Rollovers))
+	}
+	return n
+}
+
+func (m *EpochBribe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovVoter(uint64(l))
+	}
+	if m.EpochTime != 0 {
+		n += 1 + sovVoter(uint64(m.EpochTime))
+	}
+	l = m.Votes.Size()
+	n += 1 + l + sovVoter(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovVoter(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVoter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoter(x uint64) (n int) {
+	return sovVoter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Bribe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bribe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bribe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Briber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Briber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTime", wireType)
+			}
+			m.EpochTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerVote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rollover = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollovers", wireType)
+			}
+			m.Rollovers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rollovers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochBribe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochBribe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochBribe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTime", wireType)
+			}
+			m.EpochTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoter = fmt.Errorf("proto: unexpected end of group")
+)